@@ -10,12 +10,13 @@ import (
 
 	bootstrap "golang-social-media/apps/auth-service/internal/infrastructure/bootstrap"
 	grpcserver "golang-social-media/apps/auth-service/internal/infrastructure/grpc"
-	"golang-social-media/apps/auth-service/internal/interfaces/grpc"
+	grpcservices "golang-social-media/apps/auth-service/internal/interfaces/grpc"
 	authgrpc "golang-social-media/apps/auth-service/internal/interfaces/grpc/auth"
 	"golang-social-media/apps/auth-service/internal/interfaces/rest"
 	authv1 "golang-social-media/pkg/gen/auth/v1"
 	"golang-social-media/pkg/config"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -26,6 +27,24 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Setup tracing first so the clients created by the bootstrap report to the configured exporter
+	shutdownTracing, err := tracing.Init(ctx, "auth-service")
+	if err != nil {
+		logger.Component("auth.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to setup tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Component("auth.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to flush traces")
+		}
+	}()
+
 	// Setup all dependencies
 	deps, err := bootstrap.SetupDependencies(ctx)
 	if err != nil {
@@ -49,16 +68,44 @@ func main() {
 			Msg("outbox processor started")
 	}
 
+	// Start the consumers of the GDPR saga steps reported by the other services
+	if deps.DeletionSubscriber != nil {
+		go deps.DeletionSubscriber.Consume(ctx)
+	}
+	if deps.ExportSubscriber != nil {
+		go deps.ExportSubscriber.Consume(ctx)
+	}
+
+	// Start signing key rotation in background
+	if deps.KeyRing != nil {
+		go deps.KeyRing.StartRotation(ctx)
+	}
+
 	// Setup HTTP handlers
 	authHandler := rest.NewAuthHandler(deps.RegisterUserCmd, deps.LoginUserCmd)
 	profileHandler := rest.NewProfileHandler(deps.UpdateProfileCmd, deps.GetUserProfileQuery, deps.GetCurrentUserQuery)
 	passwordHandler := rest.NewPasswordHandler(deps.ChangePasswordCmd)
 	tokenHandler := rest.NewTokenHandler(deps.LogoutUserCmd, deps.RefreshTokenCmd, deps.RevokeTokenCmd, deps.ValidateTokenQuery)
 
-	handlers := rest.NewHandlers(authHandler, profileHandler, passwordHandler, tokenHandler)
+	jwksHandler := rest.NewJWKSHandler(deps.GetJWKSQuery)
+	sessionHandler := rest.NewSessionHandler(deps.ListSessionsQuery, deps.RevokeSessionCmd, deps.RevokeOtherSessionsCmd)
+	mfaHandler := rest.NewMFAHandler(deps.EnrollMFACmd, deps.ConfirmMFACmd, deps.DisableMFACmd)
+	verificationHandler := rest.NewVerificationHandler(deps.SendVerificationEmailCmd, deps.VerifyEmailCmd, deps.RequestPasswordResetCmd, deps.ResetPasswordCmd)
+	adminHandler := rest.NewAdminHandler(deps.UnlockUserCmd)
+	rbacHandler := rest.NewRBACHandler(
+		deps.CreateRoleCmd, deps.UpdateRoleCmd, deps.DeleteRoleCmd, deps.SetRoleParentCmd,
+		deps.CreatePermissionCmd, deps.UpdatePermissionCmd, deps.DeletePermissionCmd,
+		deps.GrantPermissionCmd, deps.RevokePermissionCmd, deps.AssignRoleCmd, deps.RevokeRoleCmd,
+		deps.ListRolesQuery, deps.ListPermissionsQuery, deps.GetRolePermissionsQuery, deps.GetUserRolesQuery, deps.GetUserPermissionsQuery,
+	)
+
+	apiKeyHandler := rest.NewAPIKeyHandler(deps.CreateAPIKeyCmd, deps.RevokeAPIKeyCmd, deps.ListAPIKeysQuery)
+	auditHandler := rest.NewAuditHandler(deps.ListAuditEventsQuery, deps.ExportAuditEventsQuery, deps.GetUserAsOfQuery, deps.GetRoleAsOfQuery)
+	gdprHandler := rest.NewGDPRHandler(deps.DeleteUserCmd, deps.RequestDataExportCmd, deps.GetGDPRRequestQuery, deps.DownloadDataExportQuery)
+	handlers := rest.NewHandlers(authHandler, profileHandler, passwordHandler, tokenHandler, jwksHandler, sessionHandler, mfaHandler, verificationHandler, adminHandler, rbacHandler, apiKeyHandler, auditHandler, gdprHandler)
 
 	// Setup HTTP router
-	router := rest.NewRouter(handlers, deps.JwtService, deps.Cache)
+	router := rest.NewRouter(handlers, deps.ValidateTokenQuery, deps.AuthenticateAPIKeyCmd, deps.Cache, deps.CheckPermissionQuery)
 
 	// Start HTTP server in goroutine
 	httpPort := config.GetEnvInt("AUTH_SERVICE_PORT", 9101)
@@ -87,7 +134,7 @@ func main() {
 		// Setup gRPC handler
 		handler := authgrpc.NewHandler(deps)
 		authv1.RegisterAuthServiceServer(server, handler)
-		grpc.RegisterServices(server, deps)
+		grpcservices.RegisterServices(server, deps)
 	}); err != nil {
 		logger.Component("auth.grpc").
 			Error().
@@ -107,6 +154,22 @@ func cleanup(deps *bootstrap.Dependencies) {
 				Msg("failed to close kafka publisher")
 		}
 	}
+	if deps.DeletionSubscriber != nil {
+		if err := deps.DeletionSubscriber.Close(); err != nil {
+			logger.Component("auth.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close GDPR deletion subscriber")
+		}
+	}
+	if deps.ExportSubscriber != nil {
+		if err := deps.ExportSubscriber.Close(); err != nil {
+			logger.Component("auth.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close GDPR export subscriber")
+		}
+	}
 	if deps.Cache != nil {
 		if err := deps.Cache.Close(); err != nil {
 			logger.Component("auth.bootstrap").
@@ -0,0 +1,10 @@
+package breached_password
+
+import (
+	"context"
+)
+
+// Checker tells whether a password appears in a list of passwords exposed in data breaches
+type Checker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
@@ -0,0 +1,37 @@
+package command
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// generateAPIKey returns a new raw key and the SHA256 hash that is stored in its place
+func generateAPIKey() (rawKey, keyHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	rawKey = api_key.RawKeyPrefix + base64.RawURLEncoding.EncodeToString(buf)
+	return rawKey, hashAPIKey(rawKey), nil
+}
+
+// hashAPIKey hashes a raw key the same way tokens are hashed for storage
+func hashAPIKey(rawKey string) string {
+	return user.NewTokenID(rawKey).String()
+}
+
+func toAPIKeyResponse(key api_key.Key) auth.APIKeyResponse {
+	return auth.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		CreatedAt:  key.CreatedAt,
+		LastUsedAt: key.LastUsedAt,
+	}
+}
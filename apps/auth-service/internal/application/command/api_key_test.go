@@ -0,0 +1,153 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/role_permission"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newAPIKeyCommands wires the API key commands for user-1, who holds orders:* and chat:read
+func newAPIKeyCommands(t *testing.T) (*memory.APIKeyRepository, contracts.CreateAPIKeyCommand, contracts.AuthenticateAPIKeyCommand, contracts.RevokeAPIKeyCommand) {
+	roleRepo := memory.NewRoleRepository()
+	permissionRepo := memory.NewPermissionRepository()
+	rolePermissionRepo := memory.NewRolePermissionRepository()
+	userRoleRepo := memory.NewUserRoleRepository()
+
+	assert.NoError(t, roleRepo.Create(role.Role{ID: "role-1", Name: "integrations"}))
+	assert.NoError(t, permissionRepo.Create(permission.Permission{ID: "perm-orders", Name: "Manage orders", Resource: "orders", Action: permission.Wildcard}))
+	assert.NoError(t, permissionRepo.Create(permission.Permission{ID: "perm-chat", Name: "Read chat", Resource: "chat", Action: "read"}))
+	assert.NoError(t, rolePermissionRepo.Create(role_permission.RolePermission{RoleID: "role-1", PermissionID: "perm-orders"}))
+	assert.NoError(t, rolePermissionRepo.Create(role_permission.RolePermission{RoleID: "role-1", PermissionID: "perm-chat"}))
+	assert.NoError(t, userRoleRepo.Create(user_role.UserRole{UserID: "user-1", RoleID: "role-1"}))
+
+	effective := memory.NewEffectivePermissionRepository(roleRepo, userRoleRepo, rolePermissionRepo, permissionRepo)
+	apiKeyRepo := memory.NewAPIKeyRepository()
+	return apiKeyRepo,
+		NewCreateAPIKeyCommand(apiKeyRepo, effective),
+		NewAuthenticateAPIKeyCommand(apiKeyRepo),
+		NewRevokeAPIKeyCommand(apiKeyRepo)
+}
+
+func TestCreateAPIKeyCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo, create, _, _ := newAPIKeyCommands(t)
+
+	t.Run("Stores Only The Hash", func(t *testing.T) {
+		resp, err := create.Execute(ctx, contracts.CreateAPIKeyCommandRequest{
+			UserID: "user-1",
+			Name:   "ci",
+			Scopes: []string{"orders:read", "chat:read"},
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		assert.NotEmpty(t, resp.Key)
+		assert.True(t, len(resp.Key) > len(resp.Prefix))
+		assert.Equal(t, resp.Key[:len(resp.Prefix)], resp.Prefix)
+
+		stored, err := apiKeyRepo.GetByID(ctx, resp.ID)
+		if err != nil {
+			t.Fatalf("GetByID() error = %v", err)
+		}
+		assert.NotEqual(t, resp.Key, stored.KeyHash)
+		assert.Equal(t, []string{"orders:read", "chat:read"}, stored.Scopes)
+	})
+
+	t.Run("Scope Not Held", func(t *testing.T) {
+		_, err := create.Execute(ctx, contracts.CreateAPIKeyCommandRequest{
+			UserID: "user-1",
+			Name:   "ci",
+			Scopes: []string{"chat:*"},
+		})
+
+		appErr, ok := err.(*pkgerrors.AppError)
+		if !ok {
+			t.Fatalf("Execute() error = %v, want AppError", err)
+		}
+		assert.Equal(t, pkgerrors.CodeAPIKeyScopeInvalid, appErr.Code)
+	})
+
+	t.Run("Expiry In The Past", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour)
+		_, err := create.Execute(ctx, contracts.CreateAPIKeyCommandRequest{
+			UserID:    "user-1",
+			Name:      "ci",
+			Scopes:    []string{"orders:read"},
+			ExpiresAt: &past,
+		})
+
+		appErr, ok := err.(*pkgerrors.AppError)
+		if !ok {
+			t.Fatalf("Execute() error = %v, want AppError", err)
+		}
+		assert.Equal(t, pkgerrors.CodeAPIKeyExpiryInvalid, appErr.Code)
+	})
+}
+
+func TestAuthenticateAPIKeyCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	apiKeyRepo, create, authenticate, revoke := newAPIKeyCommands(t)
+
+	created, err := create.Execute(ctx, contracts.CreateAPIKeyCommandRequest{
+		UserID: "user-1",
+		Name:   "ci",
+		Scopes: []string{"orders:*"},
+	})
+	if err != nil {
+		t.Fatalf("CreateAPIKey error = %v", err)
+	}
+
+	t.Run("Valid Key", func(t *testing.T) {
+		resp, err := authenticate.Execute(ctx, created.Key)
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		assert.Equal(t, "user-1", resp.UserID)
+		assert.Equal(t, created.ID, resp.APIKeyID)
+		assert.True(t, permission.AnyMatches(resp.Scopes, "orders", "write"))
+		assert.False(t, permission.AnyMatches(resp.Scopes, "chat", "read"))
+
+		stored, _ := apiKeyRepo.GetByID(ctx, created.ID)
+		assert.NotNil(t, stored.LastUsedAt)
+	})
+
+	t.Run("Unknown Key", func(t *testing.T) {
+		_, err := authenticate.Execute(ctx, created.Key+"x")
+		appErr, ok := err.(*pkgerrors.AppError)
+		if !ok {
+			t.Fatalf("Execute() error = %v, want AppError", err)
+		}
+		assert.Equal(t, pkgerrors.CodeAPIKeyInvalid, appErr.Code)
+	})
+
+	t.Run("Other User Cannot Revoke", func(t *testing.T) {
+		err := revoke.Execute(ctx, contracts.RevokeAPIKeyCommandRequest{UserID: "user-2", APIKeyID: created.ID})
+		assert.IsType(t, &pkgerrors.AppError{}, err)
+
+		_, err = authenticate.Execute(ctx, created.Key)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Revoked Key", func(t *testing.T) {
+		if err := revoke.Execute(ctx, contracts.RevokeAPIKeyCommandRequest{UserID: "user-1", APIKeyID: created.ID}); err != nil {
+			t.Fatalf("RevokeAPIKey error = %v", err)
+		}
+
+		_, err := authenticate.Execute(ctx, created.Key)
+		assert.IsType(t, &pkgerrors.AppError{}, err)
+
+		keys, _ := apiKeyRepo.ListByUser(ctx, "user-1")
+		assert.Empty(t, keys)
+	})
+}
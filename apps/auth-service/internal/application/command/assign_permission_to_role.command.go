@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/role_permission"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.AssignPermissionToRoleCommand = (*assignPermissionToRoleCommand)(nil)
+
+type assignPermissionToRoleCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewAssignPermissionToRoleCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.AssignPermissionToRoleCommand {
+	return &assignPermissionToRoleCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.assign_permission_to_role"),
+	}
+}
+
+func (c *assignPermissionToRoleCommand) Execute(ctx context.Context, req contracts.AssignPermissionToRoleCommandRequest) error {
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]role_permission.DomainEvent, error) {
+		// Verify both sides exist
+		if _, err := uow.Roles().GetByID(req.RoleID); err != nil {
+			return nil, err
+		}
+		if _, err := uow.Permissions().GetByID(req.PermissionID); err != nil {
+			return nil, err
+		}
+
+		rolePermission := role_permission.RolePermission{
+			RoleID:       req.RoleID,
+			PermissionID: req.PermissionID,
+			CreatedAt:    time.Now().UTC(),
+		}
+		rolePermission.Assign()
+
+		// Granting an already granted permission is a no-op
+		if err := uow.RolePermissions().Create(rolePermission); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("role_id", req.RoleID).
+				Str("permission_id", req.PermissionID).
+				Msg("failed to grant permission to role")
+			return nil, err
+		}
+		return rolePermission.Events(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("role_id", req.RoleID).
+		Str("permission_id", req.PermissionID).
+		Msg("permission granted to role")
+
+	return nil
+}
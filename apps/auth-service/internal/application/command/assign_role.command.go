@@ -5,55 +5,61 @@ import (
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
 	"golang-social-media/apps/auth-service/internal/domain/user_role"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres"
 	"golang-social-media/pkg/logger"
 )
 
 var _ contracts.AssignRoleCommand = (*assignRoleCommand)(nil)
 
 type assignRoleCommand struct {
-	userRoleRepo *postgres.UserRoleRepository
-	roleRepo     *postgres.RoleRepository
-	log          *zerolog.Logger
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
 }
 
 func NewAssignRoleCommand(
-	userRoleRepo *postgres.UserRoleRepository,
-	roleRepo *postgres.RoleRepository,
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
 ) contracts.AssignRoleCommand {
 	return &assignRoleCommand{
-		userRoleRepo: userRoleRepo,
-		roleRepo:     roleRepo,
-		log:          logger.Component("auth.command.assign_role"),
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.assign_role"),
 	}
 }
 
 func (c *assignRoleCommand) Execute(ctx context.Context, req contracts.AssignRoleCommandRequest) error {
-	// Verify role exists
-	_, err := c.roleRepo.GetByID(req.RoleID)
-	if err != nil {
-		c.log.Error().
-			Err(err).
-			Str("role_id", req.RoleID).
-			Msg("role not found")
-		return err
-	}
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]user_role.DomainEvent, error) {
+		// Verify role exists
+		if _, err := uow.Roles().GetByID(req.RoleID); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("role_id", req.RoleID).
+				Msg("role not found")
+			return nil, err
+		}
 
-	// Create user role
-	userRole := user_role.UserRole{
-		UserID:    req.UserID,
-		RoleID:    req.RoleID,
-	}
-	userRole.Assign()
+		// Create user role
+		userRole := user_role.UserRole{
+			UserID: req.UserID,
+			RoleID: req.RoleID,
+		}
+		userRole.Assign()
 
-	// Persist
-	if err := c.userRoleRepo.Create(userRole); err != nil {
-		c.log.Error().
-			Err(err).
-			Str("user_id", req.UserID).
-			Str("role_id", req.RoleID).
-			Msg("failed to assign role")
+		// Persist
+		if err := uow.UserRoles().Create(userRole); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("user_id", req.UserID).
+				Str("role_id", req.RoleID).
+				Msg("failed to assign role")
+			return nil, err
+		}
+		return userRole.Events(), nil
+	})
+	if err != nil {
 		return err
 	}
 
@@ -64,4 +70,3 @@ func (c *assignRoleCommand) Execute(ctx context.Context, req contracts.AssignRol
 
 	return nil
 }
-
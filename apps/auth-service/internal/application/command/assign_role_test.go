@@ -6,8 +6,10 @@ import (
 	"testing"
 
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
 	"golang-social-media/apps/auth-service/internal/domain/role"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 	pkgerrors "golang-social-media/pkg/errors"
 
 	"github.com/stretchr/testify/mock"
@@ -18,7 +20,7 @@ type MockUserRoleRepository struct {
 	mock.Mock
 }
 
-func (m *MockUserRoleRepository) Create(ur interface{}) error {
+func (m *MockUserRoleRepository) Create(ur user_role.UserRole) error {
 	args := m.Called(ur)
 	return args.Error(0)
 }
@@ -33,6 +35,16 @@ func (m *MockUserRoleRepository) GetUserRoles(userID string) ([]string, error) {
 	return args.Get(0).([]string), args.Error(1)
 }
 
+func (m *MockUserRoleRepository) GetRoleUsers(roleID string) ([]string, error) {
+	args := m.Called(roleID)
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockUserRoleRepository) HasRole(userID, roleID string) (bool, error) {
+	args := m.Called(userID, roleID)
+	return args.Bool(0), args.Error(1)
+}
+
 // MockRoleRepository is a mock implementation for testing
 type MockRoleRepository struct {
 	mock.Mock
@@ -48,11 +60,37 @@ func (m *MockRoleRepository) GetByID(id string) (role.Role, error) {
 	return args.Get(0).(role.Role), args.Error(1)
 }
 
-func (m *MockRoleRepository) GetAll() ([]role.Role, error) {
-	args := m.Called()
+func (m *MockRoleRepository) GetByName(name string) (role.Role, error) {
+	args := m.Called(name)
+	return args.Get(0).(role.Role), args.Error(1)
+}
+
+func (m *MockRoleRepository) Update(r role.Role) error {
+	args := m.Called(r)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) Delete(id string) error {
+	args := m.Called(id)
+	return args.Error(0)
+}
+
+func (m *MockRoleRepository) List(limit, offset int) ([]role.Role, error) {
+	args := m.Called(limit, offset)
 	return args.Get(0).([]role.Role), args.Error(1)
 }
 
+func (m *MockRoleRepository) Count() (int64, error) {
+	args := m.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// newAssignRoleUoWFactory returns a unit of work factory backed by the role mocks
+func newAssignRoleUoWFactory(userRoleRepo *MockUserRoleRepository, roleRepo *MockRoleRepository) *memory.UnitOfWorkFactory {
+	return memory.NewUnitOfWorkFactory(memory.NewUserRepository(nil), memory.NewRefreshTokenRepository()).
+		WithRBAC(roleRepo, memory.NewPermissionRepository(), memory.NewRolePermissionRepository(), userRoleRepo)
+}
+
 func TestAssignRoleCommand_Execute(t *testing.T) {
 	ctx := context.Background()
 	req := contracts.AssignRoleCommandRequest{
@@ -72,12 +110,16 @@ func TestAssignRoleCommand_Execute(t *testing.T) {
 		mockRoleRepo.On("GetByID", "role-1").Return(testRole, nil)
 		mockUserRoleRepo.On("Create", mock.AnythingOfType("user_role.UserRole")).Return(nil)
 
-		cmd := NewAssignRoleCommand(mockUserRoleRepo, mockRoleRepo)
+		uowFactory := newAssignRoleUoWFactory(mockUserRoleRepo, mockRoleRepo)
+		cmd := NewAssignRoleCommand(uowFactory, event_dispatcher.NewDispatcher())
 		err := cmd.Execute(ctx, req)
 
 		if err != nil {
 			t.Fatalf("Execute() error = %v", err)
 		}
+		if events := uowFactory.Events(); len(events) != 1 {
+			t.Errorf("saved events = %d, want 1", len(events))
+		}
 
 		mockRoleRepo.AssertExpectations(t)
 		mockUserRoleRepo.AssertExpectations(t)
@@ -90,7 +132,7 @@ func TestAssignRoleCommand_Execute(t *testing.T) {
 		roleErr := pkgerrors.NewNotFoundError("role_not_found")
 		mockRoleRepo.On("GetByID", "role-1").Return(role.Role{}, roleErr)
 
-		cmd := NewAssignRoleCommand(mockUserRoleRepo, mockRoleRepo)
+		cmd := NewAssignRoleCommand(newAssignRoleUoWFactory(mockUserRoleRepo, mockRoleRepo), event_dispatcher.NewDispatcher())
 		err := cmd.Execute(ctx, req)
 
 		if err == nil {
@@ -114,15 +156,18 @@ func TestAssignRoleCommand_Execute(t *testing.T) {
 		mockRoleRepo.On("GetByID", "role-1").Return(testRole, nil)
 		mockUserRoleRepo.On("Create", mock.AnythingOfType("user_role.UserRole")).Return(repoErr)
 
-		cmd := NewAssignRoleCommand(mockUserRoleRepo, mockRoleRepo)
+		uowFactory := newAssignRoleUoWFactory(mockUserRoleRepo, mockRoleRepo)
+		cmd := NewAssignRoleCommand(uowFactory, event_dispatcher.NewDispatcher())
 		err := cmd.Execute(ctx, req)
 
 		if err == nil {
 			t.Error("Execute() should return error when repository create fails")
 		}
+		if events := uowFactory.Events(); len(events) != 0 {
+			t.Errorf("saved events = %d, want 0", len(events))
+		}
 
 		mockRoleRepo.AssertExpectations(t)
 		mockUserRoleRepo.AssertExpectations(t)
 	})
 }
-
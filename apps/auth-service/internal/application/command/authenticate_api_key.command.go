@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.AuthenticateAPIKeyCommand = (*authenticateAPIKeyCommand)(nil)
+
+type authenticateAPIKeyCommand struct {
+	apiKeyRepo repository.APIKeyRepository
+	log        *zerolog.Logger
+}
+
+func NewAuthenticateAPIKeyCommand(apiKeyRepo repository.APIKeyRepository) contracts.AuthenticateAPIKeyCommand {
+	return &authenticateAPIKeyCommand{
+		apiKeyRepo: apiKeyRepo,
+		log:        logger.Component("auth.command.authenticate_api_key"),
+	}
+}
+
+// Execute returns CodeAPIKeyInvalid for unknown, expired and revoked keys alike
+func (c *authenticateAPIKeyCommand) Execute(ctx context.Context, rawKey string) (contracts.AuthenticateAPIKeyCommandResponse, error) {
+	if !strings.HasPrefix(rawKey, api_key.RawKeyPrefix) {
+		return contracts.AuthenticateAPIKeyCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeAPIKeyInvalid)
+	}
+
+	key, err := c.apiKeyRepo.GetByHash(ctx, hashAPIKey(rawKey))
+	if err != nil {
+		if stderrors.Is(err, repository.ErrAPIKeyNotFound) {
+			return contracts.AuthenticateAPIKeyCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeAPIKeyInvalid)
+		}
+		c.log.Error().
+			Err(err).
+			Msg("failed to get API key")
+		return contracts.AuthenticateAPIKeyCommandResponse{}, err
+	}
+
+	now := time.Now()
+	if !key.IsActive(now) {
+		c.log.Warn().
+			Str("user_id", key.UserID).
+			Str("api_key_id", key.ID).
+			Bool("revoked", key.IsRevoked()).
+			Msg("inactive API key presented")
+		return contracts.AuthenticateAPIKeyCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeAPIKeyInvalid)
+	}
+
+	// Failing to record the use must not fail the request
+	if key.Touch(now) {
+		if err := c.apiKeyRepo.UpdateLastUsed(ctx, key); err != nil {
+			c.log.Warn().
+				Err(err).
+				Str("api_key_id", key.ID).
+				Msg("failed to record API key use")
+		}
+	}
+
+	return contracts.AuthenticateAPIKeyCommandResponse{
+		UserID:   key.UserID,
+		APIKeyID: key.ID,
+		Scopes:   key.Grants(),
+	}, nil
+}
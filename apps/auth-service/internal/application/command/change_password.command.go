@@ -5,8 +5,8 @@ import (
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
-	"golang-social-media/apps/auth-service/internal/domain/user"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/application/password_hasher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
 	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 )
@@ -14,29 +14,34 @@ import (
 var _ contracts.ChangePasswordCommand = (*changePasswordCommand)(nil)
 
 type changePasswordCommand struct {
-	userRepo        *memory.UserRepository
-	eventDispatcher eventDispatcher
-	log             *zerolog.Logger
-}
-
-type eventDispatcher interface {
-	Dispatch(ctx context.Context, event interface{}) error
+	uowFactory     unit_of_work.Factory
+	passwordHasher password_hasher.PasswordHasher
+	passwordPolicy *PasswordPolicyChecker
+	log            *zerolog.Logger
 }
 
 func NewChangePasswordCommand(
-	userRepo *memory.UserRepository,
-	eventDispatcher eventDispatcher,
+	uowFactory unit_of_work.Factory,
+	passwordHasher password_hasher.PasswordHasher,
+	passwordPolicy *PasswordPolicyChecker,
 ) contracts.ChangePasswordCommand {
 	return &changePasswordCommand{
-		userRepo:        userRepo,
-		eventDispatcher: eventDispatcher,
-		log:             logger.Component("auth.command.change_password"),
+		uowFactory:     uowFactory,
+		passwordHasher: passwordHasher,
+		passwordPolicy: passwordPolicy,
+		log:            logger.Component("auth.command.change_password"),
 	}
 }
 
 func (c *changePasswordCommand) Execute(ctx context.Context, req contracts.ChangePasswordCommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
 	// Get user from repository
-	userEntity, err := c.userRepo.GetByID(req.UserID)
+	userEntity, err := uow.Users().GetByID(req.UserID)
 	if err != nil {
 		c.log.Error().
 			Err(err).
@@ -46,15 +51,23 @@ func (c *changePasswordCommand) Execute(ctx context.Context, req contracts.Chang
 	}
 
 	// Verify current password
-	if userEntity.Password != req.CurrentPassword {
+	valid, err := c.passwordHasher.Verify(req.CurrentPassword, userEntity.Password)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to verify current password")
+		return err
+	}
+	if !valid {
 		c.log.Warn().
 			Str("user_id", req.UserID).
 			Msg("invalid current password")
 		return errors.NewValidationError(errors.CodeInvalidCredentials, nil)
 	}
 
-	// Validate new password using domain method
-	if err := userEntity.ValidatePassword(req.NewPassword); err != nil {
+	// Validate new password against the password policy
+	if err := c.passwordPolicy.Check(ctx, req.NewPassword, &userEntity); err != nil {
 		c.log.Warn().
 			Err(err).
 			Str("user_id", req.UserID).
@@ -62,11 +75,24 @@ func (c *changePasswordCommand) Execute(ctx context.Context, req contracts.Chang
 		return err
 	}
 
+	newPasswordHash, err := c.passwordHasher.Hash(req.NewPassword)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to hash new password")
+		return err
+	}
+
 	// Change password using domain method
-	userEntity.ChangePassword(req.NewPassword)
+	userEntity.ChangePassword(newPasswordHash, c.passwordPolicy.HistorySize())
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := userEntity.Events()
+	userEntity.ClearEvents()
 
 	// Persist changes
-	if err := c.userRepo.Update(userEntity); err != nil {
+	if err := uow.Users().Update(userEntity); err != nil {
 		c.log.Error().
 			Err(err).
 			Str("user_id", req.UserID).
@@ -74,18 +100,25 @@ func (c *changePasswordCommand) Execute(ctx context.Context, req contracts.Chang
 		return err
 	}
 
-	// Dispatch domain events
-	domainEvents := userEntity.Events()
-	userEntity.ClearEvents()
+	// Save events to outbox and event store within the same transaction
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to save events")
+		return err
+	}
 
-	for _, domainEvent := range domainEvents {
-		if err := c.eventDispatcher.Dispatch(ctx, domainEvent); err != nil {
-			c.log.Error().
-				Err(err).
-				Str("event_type", domainEvent.Type()).
-				Str("user_id", req.UserID).
-				Msg("failed to dispatch domain event")
-		}
+	if err := uow.Commit(); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to commit transaction")
+		return err
 	}
 
 	c.log.Info().
@@ -2,39 +2,22 @@ package command
 
 import (
 	"context"
-	"errors"
 	"testing"
 
-	event_dispatcher "golang-social-media/apps/auth-service/internal/application/event_dispatcher"
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
 	"golang-social-media/apps/auth-service/internal/domain/factories"
+	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 	pkgerrors "golang-social-media/pkg/errors"
 )
 
-// mockEventDispatcher is a mock implementation of EventDispatcher for testing
-type mockChangePasswordEventDispatcher struct {
-	dispatch func(ctx context.Context, event interface{}) error
-}
-
-func newMockChangePasswordEventDispatcher() *mockChangePasswordEventDispatcher {
-	return &mockChangePasswordEventDispatcher{}
-}
-
-func (m *mockChangePasswordEventDispatcher) Dispatch(ctx context.Context, event interface{}) error {
-	if m.dispatch != nil {
-		return m.dispatch(ctx, event)
-	}
-	return nil
-}
-
 func TestChangePasswordCommand_Execute(t *testing.T) {
 	repo := memory.NewUserRepository(nil)
 	factory := factories.NewUserFactory()
-	dispatcher := newMockChangePasswordEventDispatcher()
+	uowFactory := memory.NewUnitOfWorkFactory(repo, memory.NewRefreshTokenRepository())
 
 	// Create a test user
-	testUser, err := factory.CreateUser("test@example.com", "oldpassword", "Test User")
+	testUser, err := factory.CreateUser("test@example.com", hashPassword(t, "oldpassword"), "Test User")
 	if err != nil {
 		t.Fatalf("Failed to create test user: %v", err)
 	}
@@ -42,7 +25,7 @@ func TestChangePasswordCommand_Execute(t *testing.T) {
 		t.Fatalf("Failed to save test user: %v", err)
 	}
 
-	cmd := NewChangePasswordCommand(repo, dispatcher)
+	cmd := NewChangePasswordCommand(uowFactory, testPasswordHasher, testPasswordPolicy)
 
 	ctx := context.Background()
 
@@ -63,8 +46,8 @@ func TestChangePasswordCommand_Execute(t *testing.T) {
 		if err != nil {
 			t.Fatalf("GetByID() error = %v", err)
 		}
-		if updatedUser.Password != "newpassword123" {
-			t.Errorf("Updated user password = %v, want %v", updatedUser.Password, "newpassword123")
+		if ok, _ := testPasswordHasher.Verify("newpassword123", updatedUser.Password); !ok {
+			t.Errorf("Updated user password hash = %v, want a hash of %v", updatedUser.Password, "newpassword123")
 		}
 	})
 
@@ -105,8 +88,8 @@ func TestChangePasswordCommand_Execute(t *testing.T) {
 	t.Run("New Password Too Short", func(t *testing.T) {
 		req := contracts.ChangePasswordCommandRequest{
 			UserID:          testUser.ID,
-			CurrentPassword: "oldpassword",
-			NewPassword:     "12345", // Too short
+			CurrentPassword: "newpassword123", // Changed by the first subtest
+			NewPassword:     "12345",          // Too short
 		}
 
 		err := cmd.Execute(ctx, req)
@@ -121,22 +104,19 @@ func TestChangePasswordCommand_Execute(t *testing.T) {
 		}
 	})
 
-	t.Run("Event Dispatch Error (Should Not Fail)", func(t *testing.T) {
-		dispatcher.dispatch = func(ctx context.Context, event interface{}) error {
-			return errors.New("dispatch error")
+	t.Run("Password Changed Event Saved With The Change", func(t *testing.T) {
+		var saved []user.UserPasswordChangedEvent
+		for _, event := range uowFactory.Events() {
+			if changed, ok := event.(user.UserPasswordChangedEvent); ok {
+				saved = append(saved, changed)
+			}
 		}
-
-		req := contracts.ChangePasswordCommandRequest{
-			UserID:          testUser.ID,
-			CurrentPassword: "oldpassword",
-			NewPassword:     "anotherpassword123",
+		// Only the first subtest changed the password
+		if len(saved) != 1 {
+			t.Fatalf("saved UserPasswordChangedEvent count = %d, want 1", len(saved))
 		}
-
-		// Command should still succeed even if event dispatch fails
-		err := cmd.Execute(ctx, req)
-		if err != nil {
-			t.Fatalf("Execute() should not fail on event dispatch error, got %v", err)
+		if saved[0].UserID != testUser.ID {
+			t.Errorf("UserID = %v, want %v", saved[0].UserID, testUser.ID)
 		}
 	})
 }
-
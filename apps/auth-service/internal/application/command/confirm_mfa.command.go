@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ConfirmMFACommand = (*confirmMFACommand)(nil)
+
+type confirmMFACommand struct {
+	uowFactory  unit_of_work.Factory
+	totpService *totp.Service
+	log         *zerolog.Logger
+}
+
+func NewConfirmMFACommand(
+	uowFactory unit_of_work.Factory,
+	totpService *totp.Service,
+) contracts.ConfirmMFACommand {
+	return &confirmMFACommand{
+		uowFactory:  uowFactory,
+		totpService: totpService,
+		log:         logger.Component("auth.command.confirm_mfa"),
+	}
+}
+
+func (c *confirmMFACommand) Execute(ctx context.Context, req contracts.ConfirmMFACommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	userEntity, err := uow.Users().GetByID(req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if userEntity.MFAEnabled {
+		return errors.NewConflictError(errors.CodeMFAAlreadyEnabled)
+	}
+	if userEntity.MFASecret == "" {
+		return errors.NewValidationError(errors.CodeMFANotEnrolled, nil)
+	}
+
+	// Only a TOTP code proves the authenticator app was set up correctly
+	step, ok := c.totpService.ValidateAfter(userEntity.MFASecret, req.Code, time.Now(), userEntity.MFALastUsedStep)
+	if !ok {
+		c.log.Warn().
+			Str("user_id", req.UserID).
+			Msg("invalid TOTP code on MFA confirmation")
+		return errors.NewValidationError(errors.CodeMFACodeInvalid, nil)
+	}
+	// The confirmation code cannot be used again for the first login
+	userEntity.UseTOTPStep(step)
+
+	if err := userEntity.EnableMFA(); err != nil {
+		return err
+	}
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := userEntity.Events()
+	userEntity.ClearEvents()
+
+	if err := uow.Users().Update(userEntity); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to enable MFA")
+		return err
+	}
+
+	// Save events to outbox and event store within the same transaction
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Msg("MFA enabled")
+
+	return nil
+}
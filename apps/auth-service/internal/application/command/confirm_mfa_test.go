@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfirmMFACommand_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Not Enrolled", func(t *testing.T) {
+		uowFactory, _, totpService := setupMFATest(t)
+		cmd := NewConfirmMFACommand(uowFactory, totpService)
+
+		err := cmd.Execute(ctx, contracts.ConfirmMFACommandRequest{UserID: "user-1", Code: "123456"})
+		assertErrorCode(t, err, pkgerrors.CodeMFANotEnrolled)
+	})
+
+	t.Run("Invalid Code", func(t *testing.T) {
+		uowFactory, userRepo, totpService := setupMFATest(t)
+		enroll, err := NewEnrollMFACommand(uowFactory, totpService).Execute(ctx, contracts.EnrollMFACommandRequest{UserID: "user-1"})
+		assert.NoError(t, err)
+
+		// A recovery code does not confirm enrollment
+		err = NewConfirmMFACommand(uowFactory, totpService).Execute(ctx, contracts.ConfirmMFACommandRequest{
+			UserID: "user-1",
+			Code:   enroll.RecoveryCodes[0],
+		})
+		assertErrorCode(t, err, pkgerrors.CodeMFACodeInvalid)
+
+		stored, _ := userRepo.GetByID("user-1")
+		assert.False(t, stored.MFAEnabled)
+	})
+
+	t.Run("Valid Code Enables MFA", func(t *testing.T) {
+		uowFactory, userRepo, totpService := setupMFATest(t)
+		enroll, err := NewEnrollMFACommand(uowFactory, totpService).Execute(ctx, contracts.EnrollMFACommandRequest{UserID: "user-1"})
+		assert.NoError(t, err)
+
+		code, _ := totpService.GenerateCode(enroll.Secret, time.Now())
+		err = NewConfirmMFACommand(uowFactory, totpService).Execute(ctx, contracts.ConfirmMFACommandRequest{
+			UserID: "user-1",
+			Code:   code,
+		})
+		assert.NoError(t, err)
+
+		stored, _ := userRepo.GetByID("user-1")
+		assert.True(t, stored.MFAEnabled)
+
+		events := uowFactory.Events()
+		if assert.Len(t, events, 1) {
+			event, ok := events[0].(user.MFAEnabledEvent)
+			assert.True(t, ok, "expected MFAEnabledEvent, got %T", events[0])
+			assert.Equal(t, "user-1", event.UserID)
+		}
+	})
+}
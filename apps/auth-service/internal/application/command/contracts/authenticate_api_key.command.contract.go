@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+)
+
+// AuthenticateAPIKeyCommandResponse identifies the owner of a valid API key
+type AuthenticateAPIKeyCommandResponse struct {
+	UserID   string
+	APIKeyID string
+	Scopes   []permission.Grant
+}
+
+// AuthenticateAPIKeyCommand resolves a raw API key to its owner and records the use
+type AuthenticateAPIKeyCommand interface {
+	Execute(ctx context.Context, rawKey string) (AuthenticateAPIKeyCommandResponse, error)
+}
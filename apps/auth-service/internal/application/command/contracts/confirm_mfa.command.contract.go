@@ -0,0 +1,14 @@
+package contracts
+
+import "context"
+
+// ConfirmMFACommandRequest represents confirm MFA command request
+type ConfirmMFACommandRequest struct {
+	UserID string
+	Code   string // TOTP code from the authenticator app
+}
+
+// ConfirmMFACommand enables MFA once the user proves the enrolled secret works
+type ConfirmMFACommand interface {
+	Execute(ctx context.Context, req ConfirmMFACommandRequest) error
+}
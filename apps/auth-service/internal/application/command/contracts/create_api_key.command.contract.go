@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// CreateAPIKeyCommandRequest represents create API key command request
+type CreateAPIKeyCommandRequest struct {
+	UserID    string
+	Name      string
+	Scopes    []string
+	ExpiresAt *time.Time // nil for a key that never expires
+}
+
+// CreateAPIKeyCommand issues an API key limited to scopes the user holds; the raw key is returned once
+type CreateAPIKeyCommand interface {
+	Execute(ctx context.Context, req CreateAPIKeyCommandRequest) (auth.CreateAPIKeyResponse, error)
+}
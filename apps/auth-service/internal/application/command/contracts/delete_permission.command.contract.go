@@ -0,0 +1,11 @@
+package contracts
+
+import "context"
+
+type DeletePermissionCommandRequest struct {
+	PermissionID string
+}
+
+type DeletePermissionCommand interface {
+	Execute(ctx context.Context, req DeletePermissionCommandRequest) error
+}
@@ -0,0 +1,11 @@
+package contracts
+
+import "context"
+
+type DeleteRoleCommandRequest struct {
+	RoleID string
+}
+
+type DeleteRoleCommand interface {
+	Execute(ctx context.Context, req DeleteRoleCommandRequest) error
+}
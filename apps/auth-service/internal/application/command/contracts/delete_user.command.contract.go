@@ -0,0 +1,22 @@
+package contracts
+
+import "context"
+
+// DeleteUserCommandRequest represents delete user command request
+type DeleteUserCommandRequest struct {
+	UserID string
+	// VerifyPassword is set when users delete their own account; admins delete without it
+	VerifyPassword bool
+	Password       string
+}
+
+// DeleteUserCommandResponse represents delete user command response
+type DeleteUserCommandResponse struct {
+	RequestID string // GDPR request tracking the erasure in every service
+}
+
+// DeleteUserCommand anonymizes the user, signs them out everywhere and starts the
+// deletion saga in which every service erases its copy of the user's data
+type DeleteUserCommand interface {
+	Execute(ctx context.Context, req DeleteUserCommandRequest) (DeleteUserCommandResponse, error)
+}
@@ -0,0 +1,14 @@
+package contracts
+
+import "context"
+
+// DisableMFACommandRequest represents disable MFA command request
+type DisableMFACommandRequest struct {
+	UserID string
+	Code   string // TOTP code or recovery code
+}
+
+// DisableMFACommand turns off MFA for a user
+type DisableMFACommand interface {
+	Execute(ctx context.Context, req DisableMFACommandRequest) error
+}
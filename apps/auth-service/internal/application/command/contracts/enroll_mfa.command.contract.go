@@ -0,0 +1,17 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// EnrollMFACommandRequest represents enroll MFA command request
+type EnrollMFACommandRequest struct {
+	UserID string
+}
+
+// EnrollMFACommand starts TOTP enrollment and returns the provisioning URI and recovery codes
+type EnrollMFACommand interface {
+	Execute(ctx context.Context, req EnrollMFACommandRequest) (auth.EnrollMFAResponse, error)
+}
@@ -6,8 +6,9 @@ import (
 
 // LogoutUserCommandRequest represents logout command request
 type LogoutUserCommandRequest struct {
-	UserID string
-	Token  string
+	UserID    string
+	Token     string
+	SessionID string // Session of the access token, ended together with the token when set
 }
 
 // LogoutUserCommand handles user logout
@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// RecordGDPRStepCommandRequest is the completion reported by a service
+type RecordGDPRStepCommandRequest struct {
+	RequestID   string
+	Service     string
+	Records     int64           // Deletion: rows erased or anonymized
+	Document    json.RawMessage // Export: the service's JSON document
+	CompletedAt time.Time
+}
+
+// RecordGDPRStepCommand records a service's step of a GDPR request; repeated reports are ignored
+type RecordGDPRStepCommand interface {
+	Execute(ctx context.Context, req RecordGDPRStepCommandRequest) error
+}
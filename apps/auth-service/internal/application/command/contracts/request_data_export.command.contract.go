@@ -0,0 +1,18 @@
+package contracts
+
+import "context"
+
+// RequestDataExportCommandRequest represents request data export command request
+type RequestDataExportCommandRequest struct {
+	UserID string
+}
+
+// RequestDataExportCommandResponse represents request data export command response
+type RequestDataExportCommandResponse struct {
+	RequestID string // GDPR request collecting the documents of every service
+}
+
+// RequestDataExportCommand starts the export saga; the archive can be downloaded once every service has sent its document
+type RequestDataExportCommand interface {
+	Execute(ctx context.Context, req RequestDataExportCommandRequest) (RequestDataExportCommandResponse, error)
+}
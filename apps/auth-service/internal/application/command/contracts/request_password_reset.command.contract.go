@@ -0,0 +1,14 @@
+package contracts
+
+import "context"
+
+// RequestPasswordResetCommandRequest represents request password reset command request
+type RequestPasswordResetCommandRequest struct {
+	Email string
+}
+
+// RequestPasswordResetCommand emails a password reset link.
+// It succeeds for unknown emails so callers cannot probe which accounts exist.
+type RequestPasswordResetCommand interface {
+	Execute(ctx context.Context, req RequestPasswordResetCommandRequest) error
+}
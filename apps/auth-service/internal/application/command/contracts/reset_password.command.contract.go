@@ -0,0 +1,15 @@
+package contracts
+
+import "context"
+
+// ResetPasswordCommandRequest represents reset password command request
+type ResetPasswordCommandRequest struct {
+	Token       string // Token from the password reset link
+	NewPassword string
+}
+
+// ResetPasswordCommand sets a new password using a token from the password reset email
+// and signs the user out of every session
+type ResetPasswordCommand interface {
+	Execute(ctx context.Context, req ResetPasswordCommandRequest) error
+}
@@ -0,0 +1,14 @@
+package contracts
+
+import "context"
+
+// RevokeAPIKeyCommandRequest represents revoke API key command request
+type RevokeAPIKeyCommandRequest struct {
+	UserID   string
+	APIKeyID string
+}
+
+// RevokeAPIKeyCommand revokes one of the user's API keys
+type RevokeAPIKeyCommand interface {
+	Execute(ctx context.Context, req RevokeAPIKeyCommandRequest) error
+}
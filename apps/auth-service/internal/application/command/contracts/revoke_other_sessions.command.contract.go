@@ -0,0 +1,19 @@
+package contracts
+
+import "context"
+
+// RevokeOtherSessionsCommandRequest represents revoke other sessions command request
+type RevokeOtherSessionsCommandRequest struct {
+	UserID           string
+	CurrentSessionID string // Session to keep, usually the caller's own
+}
+
+// RevokeOtherSessionsCommandResponse represents revoke other sessions command response
+type RevokeOtherSessionsCommandResponse struct {
+	Revoked int64
+}
+
+// RevokeOtherSessionsCommand logs the user out everywhere except the current session
+type RevokeOtherSessionsCommand interface {
+	Execute(ctx context.Context, req RevokeOtherSessionsCommandRequest) (RevokeOtherSessionsCommandResponse, error)
+}
@@ -0,0 +1,12 @@
+package contracts
+
+import "context"
+
+type RevokePermissionFromRoleCommandRequest struct {
+	RoleID       string
+	PermissionID string
+}
+
+type RevokePermissionFromRoleCommand interface {
+	Execute(ctx context.Context, req RevokePermissionFromRoleCommandRequest) error
+}
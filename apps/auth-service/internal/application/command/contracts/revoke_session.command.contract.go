@@ -0,0 +1,14 @@
+package contracts
+
+import "context"
+
+// RevokeSessionCommandRequest represents revoke session command request
+type RevokeSessionCommandRequest struct {
+	UserID    string
+	SessionID string
+}
+
+// RevokeSessionCommand ends one of the user's sessions
+type RevokeSessionCommand interface {
+	Execute(ctx context.Context, req RevokeSessionCommandRequest) error
+}
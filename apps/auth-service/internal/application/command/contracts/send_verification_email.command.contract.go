@@ -0,0 +1,13 @@
+package contracts
+
+import "context"
+
+// SendVerificationEmailCommandRequest represents send verification email command request
+type SendVerificationEmailCommandRequest struct {
+	UserID string
+}
+
+// SendVerificationEmailCommand (re)sends the email verification link to the user
+type SendVerificationEmailCommand interface {
+	Execute(ctx context.Context, req SendVerificationEmailCommandRequest) error
+}
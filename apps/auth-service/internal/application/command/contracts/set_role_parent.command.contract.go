@@ -0,0 +1,13 @@
+package contracts
+
+import "context"
+
+// SetRoleParentCommandRequest makes RoleID inherit the permissions of ParentID; an empty ParentID removes inheritance
+type SetRoleParentCommandRequest struct {
+	RoleID   string
+	ParentID string
+}
+
+type SetRoleParentCommand interface {
+	Execute(ctx context.Context, req SetRoleParentCommandRequest) error
+}
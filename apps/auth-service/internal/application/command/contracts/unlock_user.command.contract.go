@@ -0,0 +1,13 @@
+package contracts
+
+import "context"
+
+// UnlockUserCommandRequest represents unlock user command request
+type UnlockUserCommandRequest struct {
+	UserID string
+}
+
+// UnlockUserCommand lifts an account lock caused by failed logins (admin only)
+type UnlockUserCommand interface {
+	Execute(ctx context.Context, req UnlockUserCommandRequest) error
+}
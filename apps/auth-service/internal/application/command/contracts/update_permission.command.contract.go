@@ -0,0 +1,21 @@
+package contracts
+
+import "context"
+
+type UpdatePermissionCommandRequest struct {
+	PermissionID string
+	Name         string
+	Resource     string
+	Action       string
+}
+
+type UpdatePermissionCommandResponse struct {
+	ID       string
+	Name     string
+	Resource string
+	Action   string
+}
+
+type UpdatePermissionCommand interface {
+	Execute(ctx context.Context, req UpdatePermissionCommandRequest) (UpdatePermissionCommandResponse, error)
+}
@@ -0,0 +1,19 @@
+package contracts
+
+import "context"
+
+type UpdateRoleCommandRequest struct {
+	RoleID      string
+	Name        string
+	Description string
+}
+
+type UpdateRoleCommandResponse struct {
+	ID          string
+	Name        string
+	Description string
+}
+
+type UpdateRoleCommand interface {
+	Execute(ctx context.Context, req UpdateRoleCommandRequest) (UpdateRoleCommandResponse, error)
+}
@@ -0,0 +1,13 @@
+package contracts
+
+import "context"
+
+// VerifyEmailCommandRequest represents verify email command request
+type VerifyEmailCommandRequest struct {
+	Token string // Token from the verification link
+}
+
+// VerifyEmailCommand marks the user's email as verified using a token from the verification email
+type VerifyEmailCommand interface {
+	Execute(ctx context.Context, req VerifyEmailCommandRequest) error
+}
@@ -0,0 +1,83 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.CreateAPIKeyCommand = (*createAPIKeyCommand)(nil)
+
+type createAPIKeyCommand struct {
+	apiKeyRepo              repository.APIKeyRepository
+	effectivePermissionRepo repository.EffectivePermissionRepository
+	log                     *zerolog.Logger
+}
+
+func NewCreateAPIKeyCommand(
+	apiKeyRepo repository.APIKeyRepository,
+	effectivePermissionRepo repository.EffectivePermissionRepository,
+) contracts.CreateAPIKeyCommand {
+	return &createAPIKeyCommand{
+		apiKeyRepo:              apiKeyRepo,
+		effectivePermissionRepo: effectivePermissionRepo,
+		log:                     logger.Component("auth.command.create_api_key"),
+	}
+}
+
+func (c *createAPIKeyCommand) Execute(ctx context.Context, req contracts.CreateAPIKeyCommandRequest) (auth.CreateAPIKeyResponse, error) {
+	rawKey, keyHash, err := generateAPIKey()
+	if err != nil {
+		return auth.CreateAPIKeyResponse{}, errors.NewInternalError(err)
+	}
+
+	key := api_key.NewKey(req.UserID, req.Name, rawKey[:api_key.DisplayPrefixLength], keyHash, req.Scopes, req.ExpiresAt)
+	if err := key.Validate(); err != nil {
+		return auth.CreateAPIKeyResponse{}, err
+	}
+
+	// A key can never do more than its owner
+	permissions, err := c.effectivePermissionRepo.GetUserPermissions(req.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to get user permissions")
+		return auth.CreateAPIKeyResponse{}, err
+	}
+	held := make([]permission.Grant, len(permissions))
+	for i, p := range permissions {
+		held[i] = p.Grant()
+	}
+	for _, scope := range key.Grants() {
+		if !permission.AnyMatches(held, scope.Resource, scope.Action) {
+			return auth.CreateAPIKeyResponse{}, errors.NewValidationError(errors.CodeAPIKeyScopeInvalid, map[string]interface{}{"scope": scope.String()})
+		}
+	}
+
+	if err := c.apiKeyRepo.Create(ctx, key); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to create API key")
+		return auth.CreateAPIKeyResponse{}, err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Str("api_key_id", key.ID).
+		Strs("scopes", key.Scopes).
+		Msg("API key created")
+
+	return auth.CreateAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(key),
+		Key:            rawKey,
+	}, nil
+}
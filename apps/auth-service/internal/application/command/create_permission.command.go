@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.CreatePermissionCommand = (*createPermissionCommand)(nil)
+
+type createPermissionCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewCreatePermissionCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.CreatePermissionCommand {
+	return &createPermissionCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.create_permission"),
+	}
+}
+
+func (c *createPermissionCommand) Execute(ctx context.Context, req contracts.CreatePermissionCommandRequest) (contracts.CreatePermissionCommandResponse, error) {
+	now := time.Now().UTC()
+	perm := permission.Permission{
+		ID:        uuid.NewString(),
+		Name:      strings.TrimSpace(req.Name),
+		Resource:  strings.TrimSpace(req.Resource),
+		Action:    strings.TrimSpace(req.Action),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := perm.Validate(); err != nil {
+		return contracts.CreatePermissionCommandResponse{}, err
+	}
+	perm.Create()
+
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]permission.DomainEvent, error) {
+		if err := uow.Permissions().Create(perm); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("resource", perm.Resource).
+				Str("action", perm.Action).
+				Msg("failed to create permission")
+			return nil, err
+		}
+		return perm.Events(), nil
+	})
+	if err != nil {
+		return contracts.CreatePermissionCommandResponse{}, err
+	}
+
+	c.log.Info().
+		Str("permission_id", perm.ID).
+		Str("resource", perm.Resource).
+		Str("action", perm.Action).
+		Msg("permission created")
+
+	return contracts.CreatePermissionCommandResponse{
+		ID:       perm.ID,
+		Name:     perm.Name,
+		Resource: perm.Resource,
+		Action:   perm.Action,
+	}, nil
+}
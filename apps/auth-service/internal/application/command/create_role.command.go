@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.CreateRoleCommand = (*createRoleCommand)(nil)
+
+type createRoleCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewCreateRoleCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.CreateRoleCommand {
+	return &createRoleCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.create_role"),
+	}
+}
+
+func (c *createRoleCommand) Execute(ctx context.Context, req contracts.CreateRoleCommandRequest) (contracts.CreateRoleCommandResponse, error) {
+	now := time.Now().UTC()
+	roleEntity := role.Role{
+		ID:          uuid.NewString(),
+		Name:        strings.TrimSpace(req.Name),
+		Description: req.Description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := roleEntity.Validate(); err != nil {
+		return contracts.CreateRoleCommandResponse{}, err
+	}
+	roleEntity.Create()
+
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]role.DomainEvent, error) {
+		if err := uow.Roles().Create(roleEntity); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("name", roleEntity.Name).
+				Msg("failed to create role")
+			return nil, err
+		}
+		return roleEntity.Events(), nil
+	})
+	if err != nil {
+		return contracts.CreateRoleCommandResponse{}, err
+	}
+
+	c.log.Info().
+		Str("role_id", roleEntity.ID).
+		Str("name", roleEntity.Name).
+		Msg("role created")
+
+	return contracts.CreateRoleCommandResponse{
+		ID:          roleEntity.ID,
+		Name:        roleEntity.Name,
+		Description: roleEntity.Description,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.DeletePermissionCommand = (*deletePermissionCommand)(nil)
+
+type deletePermissionCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewDeletePermissionCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.DeletePermissionCommand {
+	return &deletePermissionCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.delete_permission"),
+	}
+}
+
+func (c *deletePermissionCommand) Execute(ctx context.Context, req contracts.DeletePermissionCommandRequest) error {
+	var perm permission.Permission
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]permission.DomainEvent, error) {
+		var err error
+		perm, err = uow.Permissions().GetByID(req.PermissionID)
+		if err != nil {
+			return nil, err
+		}
+		perm.Delete()
+
+		// Grants of the permission go with it (ON DELETE CASCADE)
+		if err := uow.Permissions().Delete(perm.ID); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("permission_id", req.PermissionID).
+				Msg("failed to delete permission")
+			return nil, err
+		}
+		return perm.Events(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("permission_id", perm.ID).
+		Str("resource", perm.Resource).
+		Str("action", perm.Action).
+		Msg("permission deleted")
+
+	return nil
+}
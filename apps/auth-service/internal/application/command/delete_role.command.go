@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.DeleteRoleCommand = (*deleteRoleCommand)(nil)
+
+type deleteRoleCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewDeleteRoleCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.DeleteRoleCommand {
+	return &deleteRoleCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.delete_role"),
+	}
+}
+
+func (c *deleteRoleCommand) Execute(ctx context.Context, req contracts.DeleteRoleCommandRequest) error {
+	var roleEntity role.Role
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]role.DomainEvent, error) {
+		var err error
+		roleEntity, err = uow.Roles().GetByID(req.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		roleEntity.Delete()
+
+		// Assignments and grants of the role go with it (ON DELETE CASCADE)
+		if err := uow.Roles().Delete(roleEntity.ID); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("role_id", req.RoleID).
+				Msg("failed to delete role")
+			return nil, err
+		}
+		return roleEntity.Events(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("role_id", roleEntity.ID).
+		Str("name", roleEntity.Name).
+		Msg("role deleted")
+
+	return nil
+}
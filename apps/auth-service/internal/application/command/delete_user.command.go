@@ -0,0 +1,213 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/password_hasher"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/pkg/errors"
+	pkgevents "golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.DeleteUserCommand = (*deleteUserCommand)(nil)
+
+type deleteUserCommand struct {
+	uowFactory      unit_of_work.Factory
+	apiKeyRepo      repository.APIKeyRepository
+	passwordHasher  password_hasher.PasswordHasher
+	eventDispatcher *event_dispatcher.Dispatcher
+	services        []string // Services holding user data, each completes a step of the saga
+	log             *zerolog.Logger
+}
+
+func NewDeleteUserCommand(
+	uowFactory unit_of_work.Factory,
+	apiKeyRepo repository.APIKeyRepository,
+	passwordHasher password_hasher.PasswordHasher,
+	eventDispatcher *event_dispatcher.Dispatcher,
+	services []string,
+) contracts.DeleteUserCommand {
+	return &deleteUserCommand{
+		uowFactory:      uowFactory,
+		apiKeyRepo:      apiKeyRepo,
+		passwordHasher:  passwordHasher,
+		eventDispatcher: eventDispatcher,
+		services:        services,
+		log:             logger.Component("auth.command.delete_user"),
+	}
+}
+
+func (c *deleteUserCommand) Execute(ctx context.Context, req contracts.DeleteUserCommandRequest) (contracts.DeleteUserCommandResponse, error) {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+	defer uow.Rollback()
+
+	userEntity, err := uow.Users().GetByID(req.UserID)
+	if err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+	if userEntity.IsDeleted() {
+		return contracts.DeleteUserCommandResponse{}, errors.NewConflictError(errors.CodeUserDeleted)
+	}
+
+	if req.VerifyPassword {
+		valid, err := c.passwordHasher.Verify(req.Password, userEntity.Password)
+		if err != nil {
+			return contracts.DeleteUserCommandResponse{}, err
+		}
+		if !valid {
+			c.log.Warn().
+				Str("user_id", req.UserID).
+				Msg("invalid password for account deletion")
+			return contracts.DeleteUserCommandResponse{}, errors.NewValidationError(errors.CodeInvalidCredentials, nil)
+		}
+	}
+
+	// API keys live outside the transaction and go first: revoking them again is harmless
+	// if the deletion fails, while a deleted user must not keep a working API key
+	revokedKeys, err := c.revokeAPIKeys(ctx, userEntity.ID)
+	if err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+	roleEvents, err := c.revokeRoles(uow, userEntity.ID)
+	if err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	request := gdpr_request.NewDeletion(userEntity.ID, c.services)
+	if err := userEntity.Delete(request.ID); err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := userEntity.Events()
+	userEntity.ClearEvents()
+
+	if err := uow.Users().Update(userEntity); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to anonymize user")
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	// The email and name also live in the user's earlier events; without this the event store
+	// replays (GetUserAsOf) and the audit export would hand them out again
+	redactedEvents, err := uow.RedactUserEvents(ctx, userEntity.ID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to redact events of deleted user")
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	revokedSessions, err := uow.RefreshTokens().RevokeAllFamilies(ctx, userEntity.ID, "", refresh_token.RevokeReasonUserDeleted)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to revoke sessions of deleted user")
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	// The auth step is done within this transaction: the user row plus everything revoked
+	if _, err := request.CompleteStep(pkgevents.ServiceAuth, 1+revokedSessions+revokedKeys+int64(len(roleEvents)), nil, time.Now()); err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+	if err := uow.GDPRRequests().Create(ctx, request); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to create GDPR deletion request")
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	// UserDeleted goes through the outbox and starts the erasure in the other services
+	events := make([]interface{}, 0, len(domainEvents)+len(roleEvents))
+	for _, event := range domainEvents {
+		events = append(events, event)
+	}
+	for _, event := range roleEvents {
+		events = append(events, event)
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return contracts.DeleteUserCommandResponse{}, err
+	}
+
+	// Evicts cached effective permissions like any other revocation
+	dispatchRBACEvents(ctx, c.eventDispatcher, c.log, roleEvents)
+
+	c.log.Info().
+		Str("user_id", userEntity.ID).
+		Str("request_id", request.ID).
+		Int64("revoked_sessions", revokedSessions).
+		Int64("redacted_events", redactedEvents).
+		Msg("user deleted")
+
+	return contracts.DeleteUserCommandResponse{RequestID: request.ID}, nil
+}
+
+// revokeAPIKeys revokes the user's API keys and returns how many were revoked
+func (c *deleteUserCommand) revokeAPIKeys(ctx context.Context, userID string) (int64, error) {
+	var revoked int64
+
+	keys, err := c.apiKeyRepo.ListByUser(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	for _, key := range keys {
+		key.Revoke()
+		if err := c.apiKeyRepo.Revoke(ctx, key); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("user_id", userID).
+				Str("api_key_id", key.ID).
+				Msg("failed to revoke API key of deleted user")
+			return 0, err
+		}
+		revoked++
+	}
+
+	return revoked, nil
+}
+
+// revokeRoles revokes the user's role assignments within the unit of work and returns their events
+func (c *deleteUserCommand) revokeRoles(uow unit_of_work.UnitOfWork, userID string) ([]user_role.DomainEvent, error) {
+	roleIDs, err := uow.UserRoles().GetUserRoles(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []user_role.DomainEvent
+	for _, roleID := range roleIDs {
+		userRole := user_role.UserRole{UserID: userID, RoleID: roleID}
+		userRole.Revoke()
+		if err := uow.UserRoles().Delete(userID, roleID); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("user_id", userID).
+				Str("role_id", roleID).
+				Msg("failed to revoke role of deleted user")
+			return nil, err
+		}
+		events = append(events, userRole.Events()...)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,108 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.DisableMFACommand = (*disableMFACommand)(nil)
+
+type disableMFACommand struct {
+	uowFactory  unit_of_work.Factory
+	totpService *totp.Service
+	lockout     *LoginLockout
+	log         *zerolog.Logger
+}
+
+// NewDisableMFACommand creates the command. Wrong codes count as failed logins on lockout, which may be nil
+func NewDisableMFACommand(
+	uowFactory unit_of_work.Factory,
+	totpService *totp.Service,
+	lockout *LoginLockout,
+) contracts.DisableMFACommand {
+	return &disableMFACommand{
+		uowFactory:  uowFactory,
+		totpService: totpService,
+		lockout:     lockout,
+		log:         logger.Component("auth.command.disable_mfa"),
+	}
+}
+
+func (c *disableMFACommand) Execute(ctx context.Context, req contracts.DisableMFACommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	userEntity, err := uow.Users().GetByID(req.UserID)
+	if err != nil {
+		return err
+	}
+
+	if !userEntity.MFAEnabled {
+		return errors.NewValidationError(errors.CodeMFANotEnabled, nil)
+	}
+
+	if err := c.lockout.Check(ctx, userEntity.ID); err != nil {
+		return err
+	}
+
+	// Require a second factor so a stolen access token alone cannot turn MFA off
+	consumed, err := consumeMFACode(uow.Users(), c.totpService, userEntity, req.Code)
+	if err != nil {
+		return err
+	}
+	if !consumed {
+		c.log.Warn().
+			Str("user_id", req.UserID).
+			Msg("invalid MFA code on disable")
+		// Wrong codes count as failed logins, the access token alone must not allow guessing
+		if err := c.lockout.RecordFailure(ctx, userEntity); err != nil {
+			return err
+		}
+		return errors.NewValidationError(errors.CodeMFACodeInvalid, nil)
+	}
+
+	if err := userEntity.DisableMFA(); err != nil {
+		return err
+	}
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := userEntity.Events()
+	userEntity.ClearEvents()
+
+	if err := uow.Users().Update(userEntity); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to disable MFA")
+		return err
+	}
+
+	// Save events to outbox and event store within the same transaction
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return err
+	}
+	c.lockout.RecordSuccess(ctx, req.UserID)
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Msg("MFA disabled")
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDisableMFACommand_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Not Enabled", func(t *testing.T) {
+		uowFactory, _, totpService := setupMFATest(t)
+		cmd := NewDisableMFACommand(uowFactory, totpService, nil)
+
+		err := cmd.Execute(ctx, contracts.DisableMFACommandRequest{UserID: "user-1", Code: "123456"})
+		assertErrorCode(t, err, pkgerrors.CodeMFANotEnabled)
+	})
+
+	t.Run("Disable With Recovery Code", func(t *testing.T) {
+		uowFactory, userRepo, totpService := setupMFATest(t)
+		enroll, err := NewEnrollMFACommand(uowFactory, totpService).Execute(ctx, contracts.EnrollMFACommandRequest{UserID: "user-1"})
+		assert.NoError(t, err)
+		code, _ := totpService.GenerateCode(enroll.Secret, time.Now())
+		assert.NoError(t, NewConfirmMFACommand(uowFactory, totpService).Execute(ctx, contracts.ConfirmMFACommandRequest{UserID: "user-1", Code: code}))
+
+		cmd := NewDisableMFACommand(uowFactory, totpService, nil)
+
+		err = cmd.Execute(ctx, contracts.DisableMFACommandRequest{UserID: "user-1", Code: "wrong-code"})
+		assertErrorCode(t, err, pkgerrors.CodeMFACodeInvalid)
+
+		err = cmd.Execute(ctx, contracts.DisableMFACommandRequest{UserID: "user-1", Code: enroll.RecoveryCodes[3]})
+		assert.NoError(t, err)
+
+		stored, _ := userRepo.GetByID("user-1")
+		assert.False(t, stored.MFAEnabled)
+		assert.Empty(t, stored.MFASecret)
+		assert.Empty(t, stored.MFARecoveryCodes)
+
+		events := uowFactory.Events()
+		if assert.Len(t, events, 2) {
+			_, ok := events[1].(user.MFADisabledEvent)
+			assert.True(t, ok, "expected MFADisabledEvent, got %T", events[1])
+		}
+	})
+	t.Run("Wrong Codes Lock The Account", func(t *testing.T) {
+		uowFactory, _, totpService := setupMFATest(t)
+		enroll, err := NewEnrollMFACommand(uowFactory, totpService).Execute(ctx, contracts.EnrollMFACommandRequest{UserID: "user-1"})
+		assert.NoError(t, err)
+		code, _ := totpService.GenerateCode(enroll.Secret, time.Now())
+		assert.NoError(t, NewConfirmMFACommand(uowFactory, totpService).Execute(ctx, contracts.ConfirmMFACommandRequest{UserID: "user-1", Code: code}))
+
+		lockout := NewLoginLockout(memory.NewLoginAttemptRepository(), uowFactory, user.LockoutPolicy{
+			MaxAttempts:      3,
+			BaseLockDuration: time.Minute,
+			MaxLockDuration:  time.Hour,
+			ResetAfter:       time.Hour,
+		})
+		cmd := NewDisableMFACommand(uowFactory, totpService, lockout)
+
+		for i := 0; i < 2; i++ {
+			err = cmd.Execute(ctx, contracts.DisableMFACommandRequest{UserID: "user-1", Code: "000000"})
+			assertErrorCode(t, err, pkgerrors.CodeMFACodeInvalid)
+		}
+		err = cmd.Execute(ctx, contracts.DisableMFACommandRequest{UserID: "user-1", Code: "000000"})
+		assertErrorCode(t, err, pkgerrors.CodeAccountLocked)
+
+		// Even a valid recovery code is refused while locked
+		err = cmd.Execute(ctx, contracts.DisableMFACommandRequest{UserID: "user-1", Code: enroll.RecoveryCodes[0]})
+		assertErrorCode(t, err, pkgerrors.CodeAccountLocked)
+	})
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.EnrollMFACommand = (*enrollMFACommand)(nil)
+
+type enrollMFACommand struct {
+	uowFactory  unit_of_work.Factory
+	totpService *totp.Service
+	log         *zerolog.Logger
+}
+
+func NewEnrollMFACommand(
+	uowFactory unit_of_work.Factory,
+	totpService *totp.Service,
+) contracts.EnrollMFACommand {
+	return &enrollMFACommand{
+		uowFactory:  uowFactory,
+		totpService: totpService,
+		log:         logger.Component("auth.command.enroll_mfa"),
+	}
+}
+
+func (c *enrollMFACommand) Execute(ctx context.Context, req contracts.EnrollMFACommandRequest) (auth.EnrollMFAResponse, error) {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return auth.EnrollMFAResponse{}, err
+	}
+	defer uow.Rollback()
+
+	userEntity, err := uow.Users().GetByID(req.UserID)
+	if err != nil {
+		return auth.EnrollMFAResponse{}, err
+	}
+
+	secret, err := c.totpService.GenerateSecret()
+	if err != nil {
+		return auth.EnrollMFAResponse{}, err
+	}
+	recoveryCodes, err := c.totpService.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return auth.EnrollMFAResponse{}, err
+	}
+	recoveryCodeHashes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		recoveryCodeHashes[i] = hashRecoveryCode(code)
+	}
+
+	// Re-enrolling before confirmation replaces the pending secret
+	if err := userEntity.StartMFAEnrollment(secret, recoveryCodeHashes); err != nil {
+		c.log.Warn().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("cannot start MFA enrollment")
+		return auth.EnrollMFAResponse{}, err
+	}
+
+	if err := uow.Users().Update(userEntity); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to store pending MFA secret")
+		return auth.EnrollMFAResponse{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return auth.EnrollMFAResponse{}, err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Msg("MFA enrollment started")
+
+	return auth.EnrollMFAResponse{
+		Secret:          secret,
+		ProvisioningURI: c.totpService.ProvisioningURI(secret, userEntity.Email),
+		RecoveryCodes:   recoveryCodes,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupMFATest(t *testing.T) (*memory.UnitOfWorkFactory, *memory.UserRepository, *totp.Service) {
+	userRepo := memory.NewUserRepository(nil)
+	uowFactory := memory.NewUnitOfWorkFactory(userRepo, memory.NewRefreshTokenRepository())
+
+	testUser := user.User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: "password123",
+		Name:     "Test User",
+	}
+	if err := userRepo.Create(testUser); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	return uowFactory, userRepo, totp.NewService("test")
+}
+
+func TestEnrollMFACommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	uowFactory, userRepo, totpService := setupMFATest(t)
+	cmd := NewEnrollMFACommand(uowFactory, totpService)
+
+	resp, err := cmd.Execute(ctx, contracts.EnrollMFACommandRequest{UserID: "user-1"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Secret)
+	assert.True(t, strings.HasPrefix(resp.ProvisioningURI, "otpauth://totp/"))
+	assert.Contains(t, resp.ProvisioningURI, "test@example.com")
+	assert.Len(t, resp.RecoveryCodes, 10)
+
+	stored, _ := userRepo.GetByID("user-1")
+	assert.False(t, stored.MFAEnabled, "MFA must not be enforced before confirmation")
+	assert.Equal(t, resp.Secret, stored.MFASecret)
+	assert.Len(t, stored.MFARecoveryCodes, 10)
+	assert.NotContains(t, stored.MFARecoveryCodes, resp.RecoveryCodes[0], "recovery codes must be stored hashed")
+	assert.Empty(t, uowFactory.Events())
+
+	t.Run("Already Enabled", func(t *testing.T) {
+		stored.MFAEnabled = true
+		_ = userRepo.Update(stored)
+
+		_, err := cmd.Execute(ctx, contracts.EnrollMFACommandRequest{UserID: "user-1"})
+		assertErrorCode(t, err, pkgerrors.CodeMFAAlreadyEnabled)
+	})
+}
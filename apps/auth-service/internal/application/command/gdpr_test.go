@@ -0,0 +1,194 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testGDPRServices = []string{"auth", "chat", "notification"}
+
+func TestDeleteUserCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Anonymizes User And Starts Saga", func(t *testing.T) {
+		uowFactory, refreshTokenRepo, _, login := setupRefreshTokenTest(t)
+		userRoleRepo := memory.NewUserRoleRepository()
+		apiKeyRepo := memory.NewAPIKeyRepository()
+		assert.NoError(t, userRoleRepo.Create(user_role.UserRole{UserID: login.UserID, RoleID: "role-1"}))
+		assert.NoError(t, apiKeyRepo.Create(ctx, api_key.NewKey(login.UserID, "ci", "gsm_abc", "hash", []string{"chat:read"}, nil)))
+		uowFactory.WithRBAC(memory.NewRoleRepository(), memory.NewPermissionRepository(), memory.NewRolePermissionRepository(), userRoleRepo)
+		dispatcher, recorded := newRecordingDispatcher("UserRoleRevoked")
+
+		resp, err := NewDeleteUserCommand(uowFactory, apiKeyRepo, testPasswordHasher, dispatcher, testGDPRServices).
+			Execute(ctx, contracts.DeleteUserCommandRequest{UserID: login.UserID, VerifyPassword: true, Password: "password123"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		stored, err := uowFactory.GDPRRequests().GetByID(ctx, resp.RequestID)
+		if err != nil {
+			t.Fatalf("GetByID() error = %v", err)
+		}
+		assert.Equal(t, gdpr_request.KindDeletion, stored.Kind)
+		completed, total := stored.Progress()
+		assert.Equal(t, 1, completed, "the auth step completes with the deletion")
+		assert.Equal(t, 3, total)
+		// The user row, one session, one API key and one role
+		assert.Equal(t, int64(4), stored.Steps[0].Records)
+
+		sessions, _ := refreshTokenRepo.ListActiveFamilies(ctx, login.UserID)
+		assert.Empty(t, sessions)
+		keys, _ := apiKeyRepo.ListByUser(ctx, login.UserID)
+		assert.Empty(t, keys)
+		roles, _ := userRoleRepo.GetUserRoles(login.UserID)
+		assert.Empty(t, roles)
+		assert.Equal(t, []string{"UserRoleRevoked"}, recorded.types)
+
+		// The role revocation is saved in the same transaction as the deletion
+		events := uowFactory.Events()
+		if assert.Len(t, events, 2) {
+			event, ok := events[0].(user.UserDeletedEvent)
+			assert.True(t, ok)
+			assert.Equal(t, resp.RequestID, event.RequestID)
+			assert.IsType(t, user_role.UserRoleRevokedEvent{}, events[1])
+		}
+
+		old, _ := refreshTokenRepo.GetTokenByHash(ctx, user.NewTokenID(login.RefreshToken).String())
+		family, _ := refreshTokenRepo.GetFamily(ctx, old.FamilyID)
+		assert.Equal(t, refresh_token.RevokeReasonUserDeleted, family.RevokeReason)
+
+		// The anonymized user can no longer log in, neither with the old nor with the new email
+		uow, _ := uowFactory.New(ctx)
+		deleted, _ := uow.Users().GetByID(login.UserID)
+		assert.True(t, deleted.IsDeleted())
+		assert.NotEqual(t, "test@example.com", deleted.Email)
+
+		_, err = NewDeleteUserCommand(uowFactory, apiKeyRepo, testPasswordHasher, dispatcher, testGDPRServices).
+			Execute(ctx, contracts.DeleteUserCommandRequest{UserID: login.UserID})
+		assertErrorCode(t, err, pkgerrors.CodeUserDeleted)
+	})
+
+	t.Run("Redacts Earlier Events", func(t *testing.T) {
+		uowFactory, _, _, login := setupRefreshTokenTest(t)
+		uow, _ := uowFactory.New(ctx)
+		assert.NoError(t, uow.SaveEvents(ctx, []interface{}{
+			user.UserCreatedEvent{UserID: login.UserID, Email: "test@example.com", Name: "Test User"},
+			user.UserProfileUpdatedEvent{UserID: login.UserID, OldName: "Test User", NewName: "Tester"},
+			user.UserCreatedEvent{UserID: "other-user", Email: "other@example.com", Name: "Other"},
+		}))
+		assert.NoError(t, uow.Commit())
+
+		_, err := NewDeleteUserCommand(uowFactory, memory.NewAPIKeyRepository(), testPasswordHasher, event_dispatcher.NewDispatcher(), testGDPRServices).
+			Execute(ctx, contracts.DeleteUserCommandRequest{UserID: login.UserID})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+
+		events := uowFactory.Events()
+		if assert.Len(t, events, 4) {
+			assert.Equal(t, user.UserCreatedEvent{UserID: login.UserID, Email: user.AnonymizedEmail(login.UserID), Name: user.DeletedUserName}, events[0])
+			assert.Equal(t, user.UserProfileUpdatedEvent{UserID: login.UserID, OldName: user.DeletedUserName, NewName: user.DeletedUserName}, events[1])
+			// Other users keep their data
+			assert.Equal(t, user.UserCreatedEvent{UserID: "other-user", Email: "other@example.com", Name: "Other"}, events[2])
+		}
+	})
+
+	t.Run("Wrong Password", func(t *testing.T) {
+		uowFactory, refreshTokenRepo, _, login := setupRefreshTokenTest(t)
+
+		_, err := NewDeleteUserCommand(uowFactory, memory.NewAPIKeyRepository(), testPasswordHasher, event_dispatcher.NewDispatcher(), testGDPRServices).
+			Execute(ctx, contracts.DeleteUserCommandRequest{UserID: login.UserID, VerifyPassword: true, Password: "wrong-password"})
+		assertErrorCode(t, err, pkgerrors.CodeInvalidCredentials)
+
+		sessions, _ := refreshTokenRepo.ListActiveFamilies(ctx, login.UserID)
+		assert.Len(t, sessions, 1)
+		assert.Empty(t, uowFactory.Events())
+	})
+}
+
+func TestRequestDataExportCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	uowFactory, _, _, login := setupRefreshTokenTest(t)
+	userRoleRepo := memory.NewUserRoleRepository()
+	roleRepo := memory.NewRoleRepository()
+	assert.NoError(t, roleRepo.Create(role.Role{ID: "role-1", Name: "moderator"}))
+	assert.NoError(t, userRoleRepo.Create(user_role.UserRole{UserID: login.UserID, RoleID: "role-1"}))
+
+	resp, err := NewRequestDataExportCommand(uowFactory, userRoleRepo, roleRepo, memory.NewAPIKeyRepository(), testGDPRServices).
+		Execute(ctx, contracts.RequestDataExportCommandRequest{UserID: login.UserID})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	stored, err := uowFactory.GDPRRequests().GetByID(ctx, resp.RequestID)
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	assert.Equal(t, gdpr_request.KindExport, stored.Kind)
+	assert.True(t, stored.Steps[0].IsCompleted())
+
+	var document struct {
+		Profile struct {
+			Email string `json:"email"`
+		} `json:"profile"`
+		Roles []struct {
+			Name string `json:"name"`
+		} `json:"roles"`
+		Sessions []json.RawMessage `json:"sessions"`
+	}
+	if err := json.Unmarshal(stored.Steps[0].Document, &document); err != nil {
+		t.Fatalf("auth document is not JSON: %v", err)
+	}
+	assert.Equal(t, "test@example.com", document.Profile.Email)
+	if assert.Len(t, document.Roles, 1) {
+		assert.Equal(t, "moderator", document.Roles[0].Name)
+	}
+	assert.Len(t, document.Sessions, 1)
+	assert.NotContains(t, string(stored.Steps[0].Document), "password")
+
+	events := uowFactory.Events()
+	if assert.Len(t, events, 1) {
+		_, ok := events[0].(gdpr_request.UserExportRequestedEvent)
+		assert.True(t, ok)
+	}
+}
+
+func TestRecordGDPRStepCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	uowFactory := memory.NewUnitOfWorkFactory(memory.NewUserRepository(nil), memory.NewRefreshTokenRepository())
+	request := gdpr_request.NewDeletion("user-1", []string{"auth", "chat"})
+	_, _ = request.CompleteStep("auth", 1, nil, time.Now())
+	assert.NoError(t, uowFactory.GDPRRequests().Create(ctx, request))
+
+	cmd := NewRecordGDPRStepCommand(uowFactory)
+	report := contracts.RecordGDPRStepCommandRequest{RequestID: request.ID, Service: "chat", Records: 12, CompletedAt: time.Now()}
+
+	assert.NoError(t, cmd.Execute(ctx, report))
+	stored, _ := uowFactory.GDPRRequests().GetByID(ctx, request.ID)
+	assert.True(t, stored.IsCompleted())
+	assert.Equal(t, int64(12), stored.Steps[1].Records)
+
+	// Redelivered and unknown reports are acknowledged without changes
+	report.Records = 99
+	assert.NoError(t, cmd.Execute(ctx, report))
+	assert.NoError(t, cmd.Execute(ctx, contracts.RecordGDPRStepCommandRequest{RequestID: request.ID, Service: "billing"}))
+	assert.NoError(t, cmd.Execute(ctx, contracts.RecordGDPRStepCommandRequest{RequestID: "unknown", Service: "chat"}))
+
+	stored, _ = uowFactory.GDPRRequests().GetByID(ctx, request.ID)
+	assert.Equal(t, int64(12), stored.Steps[1].Records)
+}
@@ -0,0 +1,146 @@
+package command
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+// LoginLockout tracks failed logins per account and temporarily locks the account
+// with exponential backoff, so rotating IPs does not bypass brute-force protection.
+// A nil *LoginLockout disables lockout.
+type LoginLockout struct {
+	attempts   repository.LoginAttemptRepository
+	uowFactory unit_of_work.Factory
+	policy     user.LockoutPolicy
+	log        *zerolog.Logger
+}
+
+// NewLoginLockout creates a new LoginLockout
+func NewLoginLockout(
+	attempts repository.LoginAttemptRepository,
+	uowFactory unit_of_work.Factory,
+	policy user.LockoutPolicy,
+) *LoginLockout {
+	return &LoginLockout{
+		attempts:   attempts,
+		uowFactory: uowFactory,
+		policy:     policy,
+		log:        logger.Component("auth.command.login_lockout"),
+	}
+}
+
+// Check returns a CodeAccountLocked error if the account is currently locked.
+// Storage errors fail closed: while the lock state cannot be read, logins are refused.
+func (l *LoginLockout) Check(ctx context.Context, userID string) error {
+	if l == nil {
+		return nil
+	}
+
+	attempts, err := l.attempts.Get(ctx, userID)
+	if err != nil {
+		l.log.Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to load login attempts, refusing login")
+		return lockoutUnavailableError()
+	}
+
+	if attempts.IsLocked(time.Now()) {
+		return accountLockedError(attempts.RetryAfter(time.Now()))
+	}
+	return nil
+}
+
+// RecordFailure counts a failed login for the user. If it locks the account a UserLockedOut
+// event is written to the outbox and a CodeAccountLocked error is returned.
+// Storage errors fail closed, so a failure that could not be counted is not reported as a wrong password.
+func (l *LoginLockout) RecordFailure(ctx context.Context, u user.User) error {
+	if l == nil {
+		return nil
+	}
+
+	now := time.Now()
+	attempts, event, locked, err := l.attempts.RecordFailure(ctx, u.ID, l.policy, u.Email, now)
+	if err != nil {
+		l.log.Error().
+			Err(err).
+			Str("user_id", u.ID).
+			Msg("failed to record failed login")
+		return lockoutUnavailableError()
+	}
+
+	if !locked {
+		return nil
+	}
+
+	l.log.Warn().
+		Str("user_id", u.ID).
+		Int("failed_attempts", event.FailedAttempts).
+		Int("locks", attempts.Locks).
+		Time("locked_until", attempts.LockedUntil).
+		Msg("account locked after too many failed logins")
+
+	if err := l.saveEvent(ctx, event); err != nil {
+		l.log.Error().
+			Err(err).
+			Str("user_id", u.ID).
+			Msg("failed to save UserLockedOut event")
+	}
+
+	return accountLockedError(attempts.RetryAfter(now))
+}
+
+// RecordSuccess forgets the user's failed logins after a successful login
+func (l *LoginLockout) RecordSuccess(ctx context.Context, userID string) {
+	if l == nil {
+		return
+	}
+	if err := l.attempts.Delete(ctx, userID); err != nil {
+		l.log.Warn().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to reset login attempts")
+	}
+}
+
+// Unlock lifts the user's lock and forgets past failures and locks
+func (l *LoginLockout) Unlock(ctx context.Context, userID string) error {
+	if l == nil {
+		return nil
+	}
+	return l.attempts.Delete(ctx, userID)
+}
+
+// saveEvent writes the lockout event to the outbox and event store
+func (l *LoginLockout) saveEvent(ctx context.Context, event user.UserLockedOutEvent) error {
+	uow, err := l.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	if err := uow.SaveEvents(ctx, []interface{}{event}); err != nil {
+		return err
+	}
+	return uow.Commit()
+}
+
+// lockoutUnavailableError is returned while the failed login state cannot be read or written
+func lockoutUnavailableError() error {
+	return errors.NewAppError(errors.CodeExternalServiceUnavailable, http.StatusServiceUnavailable)
+}
+
+// accountLockedError builds the error returned while an account is locked
+func accountLockedError(retryAfter time.Duration) error {
+	return errors.NewAppError(errors.CodeAccountLocked, http.StatusLocked).
+		WithDetails("retryAfterSeconds", int(math.Ceil(retryAfter.Seconds())))
+}
@@ -0,0 +1,167 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+	"golang-social-media/pkg/contracts/auth"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupLockoutTest(t *testing.T) (*LoginUserHandler, *LoginLockout, *memory.UnitOfWorkFactory, *memory.UserRepository) {
+	userRepo := memory.NewUserRepository(nil)
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+	uowFactory := memory.NewUnitOfWorkFactory(userRepo, refreshTokenRepo)
+
+	testUser := user.User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: hashPassword(t, "password123"),
+		Name:     "Test User",
+	}
+	if err := userRepo.Create(testUser); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	lockout := NewLoginLockout(memory.NewLoginAttemptRepository(), uowFactory, user.LockoutPolicy{
+		MaxAttempts:      3,
+		BaseLockDuration: time.Minute,
+		MaxLockDuration:  time.Hour,
+		ResetAfter:       time.Hour,
+	})
+	handler := NewLoginUserHandler(userRepo, jwt.NewService("test-secret", 1, 168), refreshTokenRepo, totp.NewService("test"), user.UnverifiedUserPolicyAllow, lockout, testPasswordHasher, uowFactory)
+
+	return handler, lockout, uowFactory, userRepo
+}
+
+func TestLoginLockout(t *testing.T) {
+	ctx := context.Background()
+	wrong := auth.LoginRequest{Email: "test@example.com", Password: "wrong-password"}
+	right := auth.LoginRequest{Email: "test@example.com", Password: "password123"}
+
+	t.Run("Locks After Max Attempts", func(t *testing.T) {
+		handler, _, uowFactory, _ := setupLockoutTest(t)
+
+		for i := 0; i < 2; i++ {
+			_, err := handler.Handle(ctx, wrong, refresh_token.Device{})
+			assert.Equal(t, memory.ErrInvalidAuth, err)
+		}
+
+		_, err := handler.Handle(ctx, wrong, refresh_token.Device{})
+		assertErrorCode(t, err, pkgerrors.CodeAccountLocked)
+
+		// Even the right password is refused while locked
+		_, err = handler.Handle(ctx, right, refresh_token.Device{})
+		assertErrorCode(t, err, pkgerrors.CodeAccountLocked)
+
+		events := uowFactory.Events()
+		if assert.Len(t, events, 1) {
+			event, ok := events[0].(user.UserLockedOutEvent)
+			assert.True(t, ok)
+			assert.Equal(t, "user-1", event.UserID)
+			assert.Equal(t, 3, event.FailedAttempts)
+		}
+	})
+
+	t.Run("Successful Login Resets Failures", func(t *testing.T) {
+		handler, _, _, _ := setupLockoutTest(t)
+
+		for i := 0; i < 2; i++ {
+			_, _ = handler.Handle(ctx, wrong, refresh_token.Device{})
+		}
+		_, err := handler.Handle(ctx, right, refresh_token.Device{})
+		assert.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err := handler.Handle(ctx, wrong, refresh_token.Device{})
+			assert.Equal(t, memory.ErrInvalidAuth, err, "failures before the successful login must be forgotten")
+		}
+	})
+
+	t.Run("Admin Unlock", func(t *testing.T) {
+		handler, lockout, _, userRepo := setupLockoutTest(t)
+		for i := 0; i < 3; i++ {
+			_, _ = handler.Handle(ctx, wrong, refresh_token.Device{})
+		}
+
+		cmd := NewUnlockUserCommand(userRepo, lockout)
+
+		assert.NoError(t, cmd.Execute(ctx, contracts.UnlockUserCommandRequest{UserID: "user-1"}))
+
+		_, err := handler.Handle(ctx, right, refresh_token.Device{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Unlock Unknown User", func(t *testing.T) {
+		_, lockout, _, userRepo := setupLockoutTest(t)
+		cmd := NewUnlockUserCommand(userRepo, lockout)
+
+		err := cmd.Execute(ctx, contracts.UnlockUserCommandRequest{UserID: "missing"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Concurrent Failures Are All Counted", func(t *testing.T) {
+		_, lockout, uowFactory, userRepo := setupLockoutTest(t)
+		testUser, _ := userRepo.GetByID("user-1")
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 3)
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- lockout.RecordFailure(ctx, testUser)
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		locks := 0
+		for err := range errs {
+			if err != nil {
+				assertErrorCode(t, err, pkgerrors.CodeAccountLocked)
+				locks++
+			}
+		}
+		assert.Equal(t, 1, locks, "exactly one of the parallel failures must lock the account")
+		assertErrorCode(t, lockout.Check(ctx, "user-1"), pkgerrors.CodeAccountLocked)
+		assert.Len(t, uowFactory.Events(), 1)
+	})
+
+	t.Run("Storage Errors Fail Closed", func(t *testing.T) {
+		_, _, uowFactory, userRepo := setupLockoutTest(t)
+		testUser, _ := userRepo.GetByID("user-1")
+		lockout := NewLoginLockout(failingLoginAttemptRepository{}, uowFactory, user.DefaultLockoutPolicy())
+
+		assertErrorCode(t, lockout.Check(ctx, "user-1"), pkgerrors.CodeExternalServiceUnavailable)
+		assertErrorCode(t, lockout.RecordFailure(ctx, testUser), pkgerrors.CodeExternalServiceUnavailable)
+	})
+}
+
+// failingLoginAttemptRepository fails every call, like the cache during an outage
+type failingLoginAttemptRepository struct{}
+
+var errCacheDown = errors.New("cache unavailable")
+
+func (failingLoginAttemptRepository) Get(ctx context.Context, userID string) (user.LoginAttempts, error) {
+	return user.LoginAttempts{}, errCacheDown
+}
+
+func (failingLoginAttemptRepository) RecordFailure(ctx context.Context, userID string, policy user.LockoutPolicy, email string, now time.Time) (user.LoginAttempts, user.UserLockedOutEvent, bool, error) {
+	return user.LoginAttempts{}, user.UserLockedOutEvent{}, false, errCacheDown
+}
+
+func (failingLoginAttemptRepository) Delete(ctx context.Context, userID string) error {
+	return errCacheDown
+}
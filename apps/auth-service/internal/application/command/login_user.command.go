@@ -2,41 +2,218 @@ package command
 
 import (
 	"context"
+	"net/http"
 
+	"golang-social-media/apps/auth-service/internal/application/password_hasher"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	domainuser "golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
 	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+
+	"github.com/rs/zerolog"
 )
 
 type LoginUserHandler struct {
-	repo      *memory.UserRepository
-	jwtService *jwt.Service
+	repo             repository.UserRepository
+	jwtService       *jwt.Service
+	refreshTokenRepo repository.RefreshTokenRepository
+	totpService      *totp.Service
+	unverifiedPolicy domainuser.UnverifiedUserPolicy
+	lockout          *LoginLockout
+	passwordHasher   password_hasher.PasswordHasher
+	uowFactory       unit_of_work.Factory
+	log              *zerolog.Logger
 }
 
-func NewLoginUserHandler(repo *memory.UserRepository, jwtService *jwt.Service) *LoginUserHandler {
+func NewLoginUserHandler(
+	repo repository.UserRepository,
+	jwtService *jwt.Service,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	totpService *totp.Service,
+	unverifiedPolicy domainuser.UnverifiedUserPolicy,
+	lockout *LoginLockout,
+	passwordHasher password_hasher.PasswordHasher,
+	uowFactory unit_of_work.Factory,
+) *LoginUserHandler {
 	return &LoginUserHandler{
-		repo:       repo,
-		jwtService: jwtService,
+		repo:             repo,
+		jwtService:       jwtService,
+		refreshTokenRepo: refreshTokenRepo,
+		totpService:      totpService,
+		unverifiedPolicy: unverifiedPolicy,
+		lockout:          lockout,
+		passwordHasher:   passwordHasher,
+		uowFactory:       uowFactory,
+		log:              logger.Component("auth.command.login_user"),
 	}
 }
 
-func (h *LoginUserHandler) Handle(ctx context.Context, req auth.LoginRequest) (auth.LoginResponse, error) {
+// Handle authenticates the user and starts a session for the given device.
+// Users with MFA enabled get an MFA challenge token instead, to be completed with HandleMFA.
+func (h *LoginUserHandler) Handle(ctx context.Context, req auth.LoginRequest, device refresh_token.Device) (auth.LoginResponse, error) {
 	user, err := h.repo.FindByEmail(req.Email)
 	if err != nil {
 		return auth.LoginResponse{}, err
 	}
-	if user.Password != req.Password {
+	// Deleted users keep an anonymized row; answer like an unknown email
+	if user.IsDeleted() {
+		return auth.LoginResponse{}, memory.ErrInvalidAuth
+	}
+	// A locked account is refused before the password is checked so it cannot be probed
+	if err := h.lockout.Check(ctx, user.ID); err != nil {
+		return auth.LoginResponse{}, err
+	}
+	valid, err := h.passwordHasher.Verify(req.Password, user.Password)
+	if err != nil {
+		return auth.LoginResponse{}, err
+	}
+	if !valid {
+		if err := h.lockout.RecordFailure(ctx, user); err != nil {
+			return auth.LoginResponse{}, err
+		}
 		return auth.LoginResponse{}, memory.ErrInvalidAuth
 	}
 
+	// The plain password is only known here, so this is where old hashes get upgraded
+	if h.passwordHasher.NeedsRehash(user.Password) {
+		h.rehashPassword(ctx, user, req.Password)
+	}
+
+	if !h.unverifiedPolicy.CanLogin(user) {
+		return auth.LoginResponse{}, errors.NewAppError(errors.CodeEmailNotVerified, http.StatusForbidden)
+	}
+
+	if user.MFAEnabled {
+		challenge, err := h.jwtService.GenerateMFAChallengeToken(user.ID)
+		if err != nil {
+			return auth.LoginResponse{}, err
+		}
+		return auth.LoginResponse{
+			UserID:      user.ID,
+			MFARequired: true,
+			MFAToken:    challenge,
+		}, nil
+	}
+
+	h.lockout.RecordSuccess(ctx, user.ID)
+	return h.startSession(ctx, user.ID, device)
+}
+
+// HandleMFA completes a login with the challenge token from Handle and a TOTP or recovery code
+func (h *LoginUserHandler) HandleMFA(ctx context.Context, req auth.MFALoginRequest, device refresh_token.Device) (auth.LoginResponse, error) {
+	userID, err := h.jwtService.ValidateMFAChallengeToken(req.MFAToken)
+	if err != nil {
+		return auth.LoginResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeTokenInvalid)
+	}
+
+	user, err := h.repo.GetByID(userID)
+	if err != nil {
+		return auth.LoginResponse{}, err
+	}
+	if !user.MFAEnabled {
+		return auth.LoginResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeMFANotEnabled)
+	}
+
+	if err := h.lockout.Check(ctx, user.ID); err != nil {
+		return auth.LoginResponse{}, err
+	}
+
+	// The code is consumed before tokens are handed out, so it cannot be replayed
+	consumed, err := consumeMFACode(h.repo, h.totpService, user, req.Code)
+	if err != nil {
+		return auth.LoginResponse{}, err
+	}
+	if !consumed {
+		// Wrong codes count as failed logins, the challenge token alone must not allow guessing
+		if err := h.lockout.RecordFailure(ctx, user); err != nil {
+			return auth.LoginResponse{}, err
+		}
+		return auth.LoginResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeMFACodeInvalid)
+	}
+
+	h.lockout.RecordSuccess(ctx, user.ID)
+	return h.startSession(ctx, user.ID, device)
+}
+
+// rehashPassword stores a hash made with the current algorithm and records the upgrade.
+// Failures are only logged: the old hash keeps working and is upgraded on a later login.
+func (h *LoginUserHandler) rehashPassword(ctx context.Context, user domainuser.User, password string) {
+	hash, err := h.passwordHasher.Hash(password)
+	if err != nil {
+		h.log.Error().
+			Err(err).
+			Str("user_id", user.ID).
+			Msg("failed to rehash password")
+		return
+	}
+	user.RehashPassword(hash, h.passwordHasher.Algorithm())
+
+	if err := h.saveRehashedPassword(ctx, user); err != nil {
+		h.log.Error().
+			Err(err).
+			Str("user_id", user.ID).
+			Msg("failed to store rehashed password")
+		return
+	}
+
+	h.log.Info().
+		Str("user_id", user.ID).
+		Str("algorithm", h.passwordHasher.Algorithm()).
+		Msg("password rehashed")
+}
+
+// saveRehashedPassword persists the new hash and its event in one transaction
+func (h *LoginUserHandler) saveRehashedPassword(ctx context.Context, user domainuser.User) error {
+	uow, err := h.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := user.Events()
+	user.ClearEvents()
+
+	if err := uow.Users().Update(user); err != nil {
+		return err
+	}
+
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		return err
+	}
+
+	return uow.Commit()
+}
+
+// startSession creates a new refresh token family and issues its first token pair
+func (h *LoginUserHandler) startSession(ctx context.Context, userID string, device refresh_token.Device) (auth.LoginResponse, error) {
+	// Every login starts a new session (refresh token family)
+	family := refresh_token.NewFamily(userID, device)
+	if err := h.refreshTokenRepo.CreateFamily(ctx, family); err != nil {
+		return auth.LoginResponse{}, err
+	}
+
 	// Generate JWT token pair (access + refresh)
-	tokenPair, err := h.jwtService.GenerateTokenPair(user.ID)
+	tokenPair, refreshToken, err := issueTokenPair(h.jwtService, family, "")
 	if err != nil {
 		return auth.LoginResponse{}, err
 	}
+	if err := h.refreshTokenRepo.CreateToken(ctx, refreshToken); err != nil {
+		return auth.LoginResponse{}, err
+	}
 
 	return auth.LoginResponse{
-		UserID:       user.ID,
+		UserID:       userID,
 		AccessToken:  tokenPair.AccessToken,
 		RefreshToken: tokenPair.RefreshToken,
 		ExpiresIn:    tokenPair.ExpiresIn,
@@ -0,0 +1,400 @@
+package command
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
+	passwordhasher "golang-social-media/apps/auth-service/internal/infrastructure/password_hasher"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+	"golang-social-media/pkg/contracts/auth"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// testPasswordHasher uses cheap argon2id parameters to keep the tests fast
+var testPasswordHasher = newTestPasswordHasher()
+
+func newTestPasswordHasher() *passwordhasher.Hasher {
+	hasher, err := passwordhasher.NewHasher(passwordhasher.AlgorithmArgon2id, passwordhasher.Options{
+		Argon2id: passwordhasher.Argon2idParams{
+			Memory:      64,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		},
+		BcryptCost: bcrypt.MinCost,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return hasher
+}
+
+// hashPassword hashes a fixture password with testPasswordHasher
+func hashPassword(t *testing.T, password string) string {
+	t.Helper()
+	hash, err := testPasswordHasher.Hash(password)
+	if err != nil {
+		t.Fatalf("Failed to hash password: %v", err)
+	}
+	return hash
+}
+
+func TestLoginUserHandler_Handle(t *testing.T) {
+	// Setup
+	repo := memory.NewUserRepository(nil)
+	jwtService := jwt.NewService("test-secret", 1, 168)
+
+	// Create a user
+	testUser := user.User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: hashPassword(t, "password123"),
+		Name:     "Test User",
+	}
+	if err := repo.Create(testUser); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	handler := NewLoginUserHandler(repo, jwtService, memory.NewRefreshTokenRepository(), totp.NewService("test"), user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, nil)
+
+	req := auth.LoginRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+
+	resp, err := handler.Handle(context.Background(), req, refresh_token.Device{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	if resp.UserID != testUser.ID {
+		t.Errorf("LoginResponse.UserID = %v, want %v", resp.UserID, testUser.ID)
+	}
+	if resp.AccessToken == "" {
+		t.Error("LoginResponse.AccessToken should not be empty")
+	}
+	if resp.RefreshToken == "" {
+		t.Error("LoginResponse.RefreshToken should not be empty")
+	}
+	if resp.ExpiresIn <= 0 {
+		t.Error("LoginResponse.ExpiresIn should be greater than 0")
+	}
+}
+
+func TestLoginUserHandler_Handle_InvalidEmail(t *testing.T) {
+	repo := memory.NewUserRepository(nil)
+	jwtService := jwt.NewService("test-secret", 1, 168)
+	handler := NewLoginUserHandler(repo, jwtService, memory.NewRefreshTokenRepository(), totp.NewService("test"), user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, nil)
+
+	req := auth.LoginRequest{
+		Email:    "nonexistent@example.com",
+		Password: "password123",
+	}
+
+	_, err := handler.Handle(context.Background(), req, refresh_token.Device{})
+	if err == nil {
+		t.Error("Handle() should return error for non-existent email")
+	}
+}
+
+func TestLoginUserHandler_Handle_InvalidPassword(t *testing.T) {
+	repo := memory.NewUserRepository(nil)
+	jwtService := jwt.NewService("test-secret", 1, 168)
+
+	// Create a user
+	testUser := user.User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: hashPassword(t, "correctpassword"),
+		Name:     "Test User",
+	}
+	if err := repo.Create(testUser); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	handler := NewLoginUserHandler(repo, jwtService, memory.NewRefreshTokenRepository(), totp.NewService("test"), user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, nil)
+
+	req := auth.LoginRequest{
+		Email:    "test@example.com",
+		Password: "wrongpassword",
+	}
+
+	_, err := handler.Handle(context.Background(), req, refresh_token.Device{})
+	if err == nil {
+		t.Error("Handle() should return error for wrong password")
+	}
+
+	if err != memory.ErrInvalidAuth {
+		t.Errorf("Handle() error = %v, want %v", err, memory.ErrInvalidAuth)
+	}
+}
+
+func TestLoginUserHandler_Handle_UnverifiedEmail(t *testing.T) {
+	repo := memory.NewUserRepository(nil)
+	jwtService := jwt.NewService("test-secret", 1, 168)
+
+	testUser := user.User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: hashPassword(t, "password123"),
+		Name:     "Test User",
+	}
+	if err := repo.Create(testUser); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	req := auth.LoginRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	}
+
+	blocking := NewLoginUserHandler(repo, jwtService, memory.NewRefreshTokenRepository(), totp.NewService("test"), user.UnverifiedUserPolicyBlock, nil, testPasswordHasher, nil)
+	_, err := blocking.Handle(context.Background(), req, refresh_token.Device{})
+	appErr, ok := err.(*pkgerrors.AppError)
+	if !ok || appErr.Code != pkgerrors.CodeEmailNotVerified {
+		t.Fatalf("Handle() error = %v, want %v", err, pkgerrors.CodeEmailNotVerified)
+	}
+
+	allowing := NewLoginUserHandler(repo, jwtService, memory.NewRefreshTokenRepository(), totp.NewService("test"), user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, nil)
+	if _, err := allowing.Handle(context.Background(), req, refresh_token.Device{}); err != nil {
+		t.Errorf("Handle() with allow policy error = %v", err)
+	}
+}
+
+func TestLoginUserHandler_Handle_MFA(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewUserRepository(nil)
+	jwtService := jwt.NewService("test-secret", 1, 168)
+	totpService := totp.NewService("test")
+
+	secret, _ := totpService.GenerateSecret()
+	testUser := user.User{
+		ID:               "user-1",
+		Email:            "test@example.com",
+		Password:         hashPassword(t, "password123"),
+		Name:             "Test User",
+		MFAEnabled:       true,
+		MFASecret:        secret,
+		MFARecoveryCodes: []string{user.NewTokenID(totp.NormalizeRecoveryCode("abcd-efgh")).String()},
+	}
+	if err := repo.Create(testUser); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	handler := NewLoginUserHandler(repo, jwtService, memory.NewRefreshTokenRepository(), totpService, user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, nil)
+
+	// Step 1: password only yields a challenge
+	resp, err := handler.Handle(ctx, auth.LoginRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	}, refresh_token.Device{})
+	if err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	if !resp.MFARequired || resp.MFAToken == "" {
+		t.Fatal("Handle() should return an MFA challenge for users with MFA enabled")
+	}
+	if resp.AccessToken != "" || resp.RefreshToken != "" {
+		t.Error("Handle() should not issue tokens before the second factor")
+	}
+
+	// Wrong code is rejected
+	if _, err := handler.HandleMFA(ctx, auth.MFALoginRequest{MFAToken: resp.MFAToken, Code: "000000"}, refresh_token.Device{}); err == nil {
+		t.Error("HandleMFA() should reject an invalid code")
+	}
+
+	// Step 2: TOTP code completes the login
+	code, _ := totpService.GenerateCode(secret, time.Now())
+	mfaResp, err := handler.HandleMFA(ctx, auth.MFALoginRequest{MFAToken: resp.MFAToken, Code: code}, refresh_token.Device{})
+	if err != nil {
+		t.Fatalf("HandleMFA() error = %v", err)
+	}
+	if mfaResp.AccessToken == "" || mfaResp.RefreshToken == "" {
+		t.Error("HandleMFA() should issue a token pair")
+	}
+
+	// The same TOTP code cannot be replayed while it is still within the skew
+	if _, err := handler.HandleMFA(ctx, auth.MFALoginRequest{MFAToken: resp.MFAToken, Code: code}, refresh_token.Device{}); err == nil {
+		t.Error("HandleMFA() should reject a TOTP code that was already used")
+	}
+
+	// Recovery codes work once
+	if _, err := handler.HandleMFA(ctx, auth.MFALoginRequest{MFAToken: resp.MFAToken, Code: "ABCD-EFGH"}, refresh_token.Device{}); err != nil {
+		t.Fatalf("HandleMFA() with recovery code error = %v", err)
+	}
+	if _, err := handler.HandleMFA(ctx, auth.MFALoginRequest{MFAToken: resp.MFAToken, Code: "ABCD-EFGH"}, refresh_token.Device{}); err == nil {
+		t.Error("HandleMFA() should reject a recovery code that was already used")
+	}
+
+	// An access token is not a challenge token
+	if _, err := handler.HandleMFA(ctx, auth.MFALoginRequest{MFAToken: mfaResp.AccessToken, Code: code}, refresh_token.Device{}); err == nil {
+		t.Error("HandleMFA() should reject an access token used as challenge")
+	}
+}
+
+// barrierUserRepository holds every GetByID until the expected number of reads happened,
+// so concurrent requests all see the user before any of them writes
+type barrierUserRepository struct {
+	repository.UserRepository
+	reads sync.WaitGroup
+}
+
+func (r *barrierUserRepository) GetByID(id string) (user.User, error) {
+	u, err := r.UserRepository.GetByID(id)
+	r.reads.Done()
+	r.reads.Wait()
+	return u, err
+}
+
+func TestLoginUserHandler_HandleMFA_ConcurrentCodeAcceptedOnce(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewUserRepository(nil)
+	jwtService := jwt.NewService("test-secret", 1, 168)
+	totpService := totp.NewService("test")
+
+	secret, _ := totpService.GenerateSecret()
+	testUser := user.User{
+		ID:               "user-1",
+		Email:            "test@example.com",
+		Password:         hashPassword(t, "password123"),
+		Name:             "Test User",
+		MFAEnabled:       true,
+		MFASecret:        secret,
+		MFARecoveryCodes: []string{user.NewTokenID(totp.NormalizeRecoveryCode("abcd-efgh")).String()},
+	}
+	if err := repo.Create(testUser); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	barrier := &barrierUserRepository{UserRepository: repo}
+	handler := NewLoginUserHandler(barrier, jwtService, memory.NewRefreshTokenRepository(), totpService, user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, nil)
+	challenge, err := jwtService.GenerateMFAChallengeToken(testUser.ID)
+	if err != nil {
+		t.Fatalf("GenerateMFAChallengeToken() error = %v", err)
+	}
+
+	// Every request reads the user before any of them consumes the code; only one may log in
+	code, _ := totpService.GenerateCode(secret, time.Now())
+	for _, tc := range []struct {
+		name string
+		code string
+	}{
+		{"totp code", code},
+		{"recovery code", "ABCD-EFGH"},
+	} {
+		const attempts = 8
+		var wg sync.WaitGroup
+		var accepted atomic.Int32
+		barrier.reads.Add(attempts)
+		for i := 0; i < attempts; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := handler.HandleMFA(ctx, auth.MFALoginRequest{MFAToken: challenge, Code: tc.code}, refresh_token.Device{}); err == nil {
+					accepted.Add(1)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if got := accepted.Load(); got != 1 {
+			t.Errorf("%s: %d of %d concurrent logins accepted, want 1", tc.name, got, attempts)
+		}
+	}
+}
+
+func TestLoginUserHandler_Handle_RehashesLegacyPassword(t *testing.T) {
+	repo := memory.NewUserRepository(nil)
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+	uowFactory := memory.NewUnitOfWorkFactory(repo, refreshTokenRepo)
+
+	// Hashes stored before the PasswordHasher are unsalted SHA-256 hex digests
+	legacyHash := user.NewPassword("password123").String()
+	if err := repo.Create(user.User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: legacyHash,
+		Name:     "Test User",
+	}); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	handler := NewLoginUserHandler(repo, jwt.NewService("test-secret", 1, 168), refreshTokenRepo, totp.NewService("test"), user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, uowFactory)
+	req := auth.LoginRequest{Email: "test@example.com", Password: "password123"}
+
+	if _, err := handler.Handle(context.Background(), req, refresh_token.Device{}); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+
+	stored, err := repo.GetByID("user-1")
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	if passwordhasher.Identify(stored.Password) != passwordhasher.AlgorithmArgon2id {
+		t.Fatalf("stored hash = %q, want it upgraded to argon2id", stored.Password)
+	}
+	if testPasswordHasher.NeedsRehash(stored.Password) {
+		t.Error("upgraded hash should use the current parameters")
+	}
+
+	events := uowFactory.Events()
+	if len(events) != 1 {
+		t.Fatalf("saved events = %d, want 1", len(events))
+	}
+	event, ok := events[0].(user.UserPasswordRehashedEvent)
+	if !ok {
+		t.Fatalf("saved event type = %T, want UserPasswordRehashedEvent", events[0])
+	}
+	if event.UserID != "user-1" || event.Algorithm != passwordhasher.AlgorithmArgon2id {
+		t.Errorf("UserPasswordRehashedEvent = %+v, want user-1 / argon2id", event)
+	}
+
+	// The upgraded hash keeps working and is not rehashed again
+	if _, err := handler.Handle(context.Background(), req, refresh_token.Device{}); err != nil {
+		t.Fatalf("Handle() after rehash error = %v", err)
+	}
+	if len(uowFactory.Events()) != 1 {
+		t.Error("a current hash should not be rehashed")
+	}
+}
+
+func TestLoginUserHandler_Handle_WrongPasswordKeepsLegacyHash(t *testing.T) {
+	repo := memory.NewUserRepository(nil)
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+	uowFactory := memory.NewUnitOfWorkFactory(repo, refreshTokenRepo)
+
+	legacyHash := user.NewPassword("password123").String()
+	if err := repo.Create(user.User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: legacyHash,
+		Name:     "Test User",
+	}); err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+
+	handler := NewLoginUserHandler(repo, jwt.NewService("test-secret", 1, 168), refreshTokenRepo, totp.NewService("test"), user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, uowFactory)
+	_, err := handler.Handle(context.Background(), auth.LoginRequest{Email: "test@example.com", Password: "wrongpassword"}, refresh_token.Device{})
+	if err != memory.ErrInvalidAuth {
+		t.Fatalf("Handle() error = %v, want %v", err, memory.ErrInvalidAuth)
+	}
+
+	stored, _ := repo.GetByID("user-1")
+	if stored.Password != legacyHash {
+		t.Error("a failed login must not touch the stored hash")
+	}
+	if len(uowFactory.Events()) != 0 {
+		t.Error("a failed login must not record a rehash")
+	}
+}
@@ -6,21 +6,35 @@ import (
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
 	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/redis"
 	"golang-social-media/pkg/logger"
 )
 
+// tokenBlacklist is the part of the token blacklist the logout and revoke commands need
+type tokenBlacklist interface {
+	AddToken(ctx context.Context, tokenID string, expiration time.Duration) error
+}
+
+var _ tokenBlacklist = (*redis.TokenBlacklistRepository)(nil)
+
 var _ contracts.LogoutUserCommand = (*logoutUserCommand)(nil)
 
 type logoutUserCommand struct {
-	tokenBlacklistRepo *redis.TokenBlacklistRepository
+	tokenBlacklistRepo tokenBlacklist
+	refreshTokenRepo   repository.RefreshTokenRepository
 	log                *zerolog.Logger
 }
 
-func NewLogoutUserCommand(tokenBlacklistRepo *redis.TokenBlacklistRepository) contracts.LogoutUserCommand {
+func NewLogoutUserCommand(
+	tokenBlacklistRepo tokenBlacklist,
+	refreshTokenRepo repository.RefreshTokenRepository,
+) contracts.LogoutUserCommand {
 	return &logoutUserCommand{
 		tokenBlacklistRepo: tokenBlacklistRepo,
+		refreshTokenRepo:   refreshTokenRepo,
 		log:                logger.Component("auth.command.logout_user"),
 	}
 }
@@ -41,8 +55,31 @@ func (c *logoutUserCommand) Execute(ctx context.Context, req contracts.LogoutUse
 		return err
 	}
 
+	// End the session so its refresh token can no longer be used
+	if req.SessionID != "" {
+		family, err := c.refreshTokenRepo.GetFamily(ctx, req.SessionID)
+		if err != nil {
+			c.log.Error().
+				Err(err).
+				Str("user_id", req.UserID).
+				Str("session_id", req.SessionID).
+				Msg("failed to get session")
+			return err
+		}
+		family.Revoke(refresh_token.RevokeReasonLogout)
+		if err := c.refreshTokenRepo.RevokeFamily(ctx, family); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("user_id", req.UserID).
+				Str("session_id", req.SessionID).
+				Msg("failed to end session")
+			return err
+		}
+	}
+
 	c.log.Info().
 		Str("user_id", req.UserID).
+		Str("session_id", req.SessionID).
 		Msg("user logged out successfully")
 
 	return nil
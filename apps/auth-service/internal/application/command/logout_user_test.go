@@ -7,7 +7,7 @@ import (
 	"time"
 
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/redis"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -30,18 +30,18 @@ func (m *MockLogoutTokenBlacklistRepository) IsBlacklisted(ctx context.Context,
 
 func TestLogoutUserCommand_Execute(t *testing.T) {
 	ctx := context.Background()
-	mockBlacklistRepo := new(MockLogoutTokenBlacklistRepository)
 
 	testToken := "test-token-string"
 	testUserID := "user-123"
 
 	t.Run("Successful Logout", func(t *testing.T) {
+		mockBlacklistRepo := new(MockLogoutTokenBlacklistRepository)
 		mockBlacklistRepo.On("AddToken", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Duration")).Return(nil)
 
-		cmd := NewLogoutUserCommand(mockBlacklistRepo)
+		cmd := NewLogoutUserCommand(mockBlacklistRepo, memory.NewRefreshTokenRepository())
 		req := contracts.LogoutUserCommandRequest{
 			UserID: testUserID,
-			Token:   testToken,
+			Token:  testToken,
 		}
 
 		err := cmd.Execute(ctx, req)
@@ -51,13 +51,14 @@ func TestLogoutUserCommand_Execute(t *testing.T) {
 	})
 
 	t.Run("Blacklist Add Fails", func(t *testing.T) {
+		mockBlacklistRepo := new(MockLogoutTokenBlacklistRepository)
 		blacklistErr := errors.New("redis connection error")
 		mockBlacklistRepo.On("AddToken", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Duration")).Return(blacklistErr)
 
-		cmd := NewLogoutUserCommand(mockBlacklistRepo)
+		cmd := NewLogoutUserCommand(mockBlacklistRepo, memory.NewRefreshTokenRepository())
 		req := contracts.LogoutUserCommandRequest{
 			UserID: testUserID,
-			Token:   testToken,
+			Token:  testToken,
 		}
 
 		err := cmd.Execute(ctx, req)
@@ -67,4 +68,3 @@ func TestLogoutUserCommand_Execute(t *testing.T) {
 		mockBlacklistRepo.AssertExpectations(t)
 	})
 }
-
@@ -0,0 +1,27 @@
+package command
+
+import (
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+)
+
+// recoveryCodeCount is the number of recovery codes handed out at enrollment
+const recoveryCodeCount = 10
+
+// consumeMFACode accepts either a TOTP code not used before or an unused recovery code.
+// The code is consumed by a conditional write in the repository, so when requests race
+// with the same code only one of them is accepted; u itself is left unchanged.
+func consumeMFACode(users repository.UserRepository, totpService *totp.Service, u user.User, code string) (bool, error) {
+	if step, ok := totpService.ValidateAfter(u.MFASecret, code, time.Now(), u.MFALastUsedStep); ok {
+		return users.ConsumeTOTPStep(u.ID, step)
+	}
+	return users.ConsumeRecoveryCode(u.ID, hashRecoveryCode(code))
+}
+
+// hashRecoveryCode hashes a recovery code the same way regardless of its formatting
+func hashRecoveryCode(code string) string {
+	return user.NewTokenID(totp.NormalizeRecoveryCode(code)).String()
+}
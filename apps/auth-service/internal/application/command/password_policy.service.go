@@ -0,0 +1,76 @@
+package command
+
+import (
+	"context"
+
+	"golang-social-media/apps/auth-service/internal/application/breached_password"
+	"golang-social-media/apps/auth-service/internal/application/password_hasher"
+	domainuser "golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+
+	"github.com/rs/zerolog"
+)
+
+// PasswordPolicyChecker applies the PasswordPolicy to new passwords: length and character
+// classes, reuse of recent passwords and the breached-password list.
+// It is shared by register, change-password and reset-password.
+type PasswordPolicyChecker struct {
+	policy         domainuser.PasswordPolicy
+	passwordHasher password_hasher.PasswordHasher
+	breached       breached_password.Checker
+	log            *zerolog.Logger
+}
+
+// NewPasswordPolicyChecker creates a PasswordPolicyChecker.
+// breached may be nil, which skips the breached-password check.
+func NewPasswordPolicyChecker(
+	policy domainuser.PasswordPolicy,
+	passwordHasher password_hasher.PasswordHasher,
+	breached breached_password.Checker,
+) *PasswordPolicyChecker {
+	return &PasswordPolicyChecker{
+		policy:         policy,
+		passwordHasher: passwordHasher,
+		breached:       breached,
+		log:            logger.Component("auth.command.password_policy"),
+	}
+}
+
+// HistorySize returns how many recent passwords cannot be reused
+func (c *PasswordPolicyChecker) HistorySize() int {
+	return c.policy.HistorySize
+}
+
+// Check validates a new password. u is the user whose password changes, nil on registration.
+func (c *PasswordPolicyChecker) Check(ctx context.Context, password string, u *domainuser.User) error {
+	if err := c.policy.Validate(password); err != nil {
+		return err
+	}
+
+	if u != nil {
+		if err := c.policy.CheckReuse(*u, password, c.verify); err != nil {
+			return err
+		}
+	}
+
+	if c.policy.CheckBreached && c.breached != nil {
+		breached, err := c.breached.IsBreached(ctx, password)
+		if err != nil {
+			c.log.Error().
+				Err(err).
+				Msg("failed to check breached password list")
+			return err
+		}
+		if breached {
+			return errors.NewValidationError(errors.CodePasswordBreached, nil)
+		}
+	}
+	return nil
+}
+
+// verify reports whether password matches a stored hash, unreadable hashes never match
+func (c *PasswordPolicyChecker) verify(password, hash string) bool {
+	ok, err := c.passwordHasher.Verify(password, hash)
+	return err == nil && ok
+}
@@ -0,0 +1,96 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testPasswordPolicy is the default policy without a breached-password list
+var testPasswordPolicy = NewPasswordPolicyChecker(user.DefaultPasswordPolicy(), testPasswordHasher, nil)
+
+// stubBreachedChecker reports the passwords in its list as breached
+type stubBreachedChecker struct {
+	breached map[string]bool
+	err      error
+}
+
+func (s *stubBreachedChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	return s.breached[password], s.err
+}
+
+func TestPasswordPolicyChecker_Check(t *testing.T) {
+	ctx := context.Background()
+	breached := &stubBreachedChecker{breached: map[string]bool{"password123": true}}
+	checker := NewPasswordPolicyChecker(user.DefaultPasswordPolicy(), testPasswordHasher, breached)
+
+	t.Run("Valid Password", func(t *testing.T) {
+		assert.NoError(t, checker.Check(ctx, "a-long-unbreached-password", nil))
+	})
+
+	t.Run("Too Short", func(t *testing.T) {
+		assertErrorCode(t, checker.Check(ctx, "short", nil), pkgerrors.CodePasswordTooShort)
+	})
+
+	t.Run("Breached Password", func(t *testing.T) {
+		assertErrorCode(t, checker.Check(ctx, "password123", nil), pkgerrors.CodePasswordBreached)
+	})
+
+	t.Run("Breached Check Disabled", func(t *testing.T) {
+		policy := user.DefaultPasswordPolicy()
+		policy.CheckBreached = false
+		disabled := NewPasswordPolicyChecker(policy, testPasswordHasher, breached)
+		assert.NoError(t, disabled.Check(ctx, "password123", nil))
+	})
+
+	t.Run("Breached List Error", func(t *testing.T) {
+		failing := NewPasswordPolicyChecker(user.DefaultPasswordPolicy(), testPasswordHasher, &stubBreachedChecker{err: errors.New("read error")})
+		assert.Error(t, failing.Check(ctx, "a-long-unbreached-password", nil))
+	})
+
+	t.Run("Reused Password", func(t *testing.T) {
+		u := &user.User{
+			Password:        hashPassword(t, "current-password"),
+			PasswordHistory: []string{hashPassword(t, "previous-password")},
+		}
+		assertErrorCode(t, checker.Check(ctx, "current-password", u), pkgerrors.CodePasswordReused)
+		assertErrorCode(t, checker.Check(ctx, "previous-password", u), pkgerrors.CodePasswordReused)
+		assert.NoError(t, checker.Check(ctx, "a-brand-new-password", u))
+	})
+}
+
+func TestResetPasswordCommand_Execute_RejectsReuse(t *testing.T) {
+	ctx := context.Background()
+	uowFactory, _, _, login := setupRefreshTokenTest(t)
+	m := &recordingMailer{}
+	reset := NewResetPasswordCommand(uowFactory, testPasswordHasher, testPasswordPolicy)
+
+	requestReset := func() string {
+		err := NewRequestPasswordResetCommand(uowFactory, m, testEmailConfig()).
+			Execute(ctx, contracts.RequestPasswordResetCommandRequest{Email: "test@example.com"})
+		assert.NoError(t, err)
+		return m.lastToken(t)
+	}
+
+	// The current password cannot be set again
+	err := reset.Execute(ctx, contracts.ResetPasswordCommandRequest{Token: requestReset(), NewPassword: "password123"})
+	assertErrorCode(t, err, pkgerrors.CodePasswordReused)
+
+	assert.NoError(t, reset.Execute(ctx, contracts.ResetPasswordCommandRequest{Token: requestReset(), NewPassword: "newpassword123"}))
+
+	// The replaced password is kept in the history
+	err = reset.Execute(ctx, contracts.ResetPasswordCommandRequest{Token: requestReset(), NewPassword: "password123"})
+	assertErrorCode(t, err, pkgerrors.CodePasswordReused)
+
+	uow, _ := uowFactory.New(ctx)
+	stored, err := uow.Users().GetByID(login.UserID)
+	if assert.NoError(t, err) {
+		assert.Len(t, stored.PasswordHistory, 1)
+	}
+}
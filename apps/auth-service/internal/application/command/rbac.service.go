@@ -0,0 +1,68 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+// saveRBACChange runs change in a unit of work and saves the domain events it returns to the outbox and
+// event store in the same transaction, so an RBAC change is never committed without its events.
+// Once committed, the events are dispatched in process to drop cached permissions
+func saveRBACChange[E user.DomainEvent](
+	ctx context.Context,
+	uowFactory unit_of_work.Factory,
+	dispatcher *event_dispatcher.Dispatcher,
+	log *zerolog.Logger,
+	change func(uow unit_of_work.UnitOfWork) ([]E, error),
+) error {
+	uow, err := uowFactory.New(ctx)
+	if err != nil {
+		log.Error().
+			Err(err).
+			Msg("failed to create unit of work")
+		return err
+	}
+	defer uow.Rollback()
+
+	domainEvents, err := change(uow)
+	if err != nil {
+		return err
+	}
+
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		log.Error().
+			Err(err).
+			Msg("failed to save events")
+		return err
+	}
+	if err := uow.Commit(); err != nil {
+		log.Error().
+			Err(err).
+			Msg("failed to commit transaction")
+		return err
+	}
+
+	dispatchRBACEvents(ctx, dispatcher, log, domainEvents)
+	return nil
+}
+
+// dispatchRBACEvents dispatches committed RBAC events to the in-process handlers.
+// Failures are logged only: the events are already in the outbox, and cached permissions expire on their own
+func dispatchRBACEvents[E user.DomainEvent](ctx context.Context, dispatcher *event_dispatcher.Dispatcher, log *zerolog.Logger, events []E) {
+	for _, domainEvent := range events {
+		if err := dispatcher.Dispatch(ctx, domainEvent); err != nil {
+			log.Error().
+				Err(err).
+				Str("event_type", domainEvent.Type()).
+				Msg("failed to dispatch domain event")
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+// recordingHandler records the type of every event it handles
+type recordingHandler struct {
+	types []string
+}
+
+func (h *recordingHandler) Handle(ctx context.Context, domainEvent user.DomainEvent) error {
+	h.types = append(h.types, domainEvent.Type())
+	return nil
+}
+
+func newRecordingDispatcher(eventTypes ...string) (*event_dispatcher.Dispatcher, *recordingHandler) {
+	dispatcher := event_dispatcher.NewDispatcher()
+	handler := &recordingHandler{}
+	for _, eventType := range eventTypes {
+		dispatcher.RegisterHandler(eventType, handler)
+	}
+	return dispatcher, handler
+}
+
+// newRBACUoWFactory returns a unit of work factory over the given RBAC repositories
+func newRBACUoWFactory(roleRepo *memory.RoleRepository, permissionRepo *memory.PermissionRepository, rolePermissionRepo *memory.RolePermissionRepository) *memory.UnitOfWorkFactory {
+	return memory.NewUnitOfWorkFactory(memory.NewUserRepository(nil), memory.NewRefreshTokenRepository()).
+		WithRBAC(roleRepo, permissionRepo, rolePermissionRepo, memory.NewUserRoleRepository())
+}
+
+func TestRoleCommands_CreateUpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	roleRepo := memory.NewRoleRepository()
+	uowFactory := newRBACUoWFactory(roleRepo, memory.NewPermissionRepository(), memory.NewRolePermissionRepository())
+
+	created, err := NewCreateRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreateRoleCommandRequest{
+		Name:        " moderator ",
+		Description: "Moderates chats",
+	})
+	if err != nil {
+		t.Fatalf("CreateRole error = %v", err)
+	}
+	if created.ID == "" || created.Name != "moderator" {
+		t.Fatalf("CreateRole response = %+v, want trimmed name and an ID", created)
+	}
+
+	if _, err := NewCreateRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreateRoleCommandRequest{Name: "moderator"}); err == nil {
+		t.Error("CreateRole should reject a duplicate name")
+	}
+	if _, err := NewCreateRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreateRoleCommandRequest{Name: "x"}); err == nil {
+		t.Error("CreateRole should reject a name that is too short")
+	}
+
+	updated, err := NewUpdateRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.UpdateRoleCommandRequest{
+		RoleID: created.ID,
+		Name:   "mod",
+	})
+	if err != nil {
+		t.Fatalf("UpdateRole error = %v", err)
+	}
+	if updated.Name != "mod" || updated.Description != "" {
+		t.Errorf("UpdateRole response = %+v, want name mod and empty description", updated)
+	}
+	if _, err := roleRepo.GetByName("mod"); err != nil {
+		t.Errorf("renamed role should be found by its new name, got %v", err)
+	}
+
+	if err := NewDeleteRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.DeleteRoleCommandRequest{RoleID: created.ID}); err != nil {
+		t.Fatalf("DeleteRole error = %v", err)
+	}
+	if _, err := roleRepo.GetByID(created.ID); err == nil {
+		t.Error("deleted role should not be found")
+	}
+	if err := NewDeleteRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.DeleteRoleCommandRequest{RoleID: created.ID}); err == nil {
+		t.Error("DeleteRole should fail for an unknown role")
+	}
+
+	// Rejected changes save nothing; each committed change saves its event
+	var saved []string
+	for _, event := range uowFactory.Events() {
+		saved = append(saved, event.(user.DomainEvent).Type())
+	}
+	if len(saved) != 3 || saved[0] != "RoleCreated" || saved[1] != "RoleUpdated" || saved[2] != "RoleDeleted" {
+		t.Errorf("saved events = %v, want [RoleCreated RoleUpdated RoleDeleted]", saved)
+	}
+}
+
+func TestPermissionCommands_CreateUpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	permissionRepo := memory.NewPermissionRepository()
+	uowFactory := newRBACUoWFactory(memory.NewRoleRepository(), permissionRepo, memory.NewRolePermissionRepository())
+
+	created, err := NewCreatePermissionCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreatePermissionCommandRequest{
+		Name:     "Delete messages",
+		Resource: "chat",
+		Action:   "delete",
+	})
+	if err != nil {
+		t.Fatalf("CreatePermission error = %v", err)
+	}
+
+	if _, err := NewCreatePermissionCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreatePermissionCommandRequest{
+		Name:     "Duplicate",
+		Resource: "chat",
+		Action:   "delete",
+	}); err == nil {
+		t.Error("CreatePermission should reject a duplicate resource/action")
+	}
+	if _, err := NewCreatePermissionCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreatePermissionCommandRequest{Name: "No action", Resource: "chat"}); err == nil {
+		t.Error("CreatePermission should reject a missing action")
+	}
+
+	if _, err := NewUpdatePermissionCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.UpdatePermissionCommandRequest{
+		PermissionID: created.ID,
+		Name:         "Moderate messages",
+		Resource:     "chat",
+		Action:       "moderate",
+	}); err != nil {
+		t.Fatalf("UpdatePermission error = %v", err)
+	}
+	if _, err := permissionRepo.GetByResourceAction("chat", "moderate"); err != nil {
+		t.Errorf("updated permission should be found by its new action, got %v", err)
+	}
+	if _, err := permissionRepo.GetByResourceAction("chat", "delete"); err == nil {
+		t.Error("old resource/action should be released after update")
+	}
+
+	if err := NewDeletePermissionCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.DeletePermissionCommandRequest{PermissionID: created.ID}); err != nil {
+		t.Fatalf("DeletePermission error = %v", err)
+	}
+	if _, err := permissionRepo.GetByID(created.ID); err == nil {
+		t.Error("deleted permission should not be found")
+	}
+}
+
+func TestRolePermissionCommands_GrantRevoke(t *testing.T) {
+	ctx := context.Background()
+	roleRepo := memory.NewRoleRepository()
+	permissionRepo := memory.NewPermissionRepository()
+	rolePermissionRepo := memory.NewRolePermissionRepository()
+	uowFactory := newRBACUoWFactory(roleRepo, permissionRepo, rolePermissionRepo)
+
+	roleResp, err := NewCreateRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreateRoleCommandRequest{Name: "moderator"})
+	if err != nil {
+		t.Fatalf("CreateRole error = %v", err)
+	}
+	permResp, err := NewCreatePermissionCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreatePermissionCommandRequest{
+		Name:     "Delete messages",
+		Resource: "chat",
+		Action:   "delete",
+	})
+	if err != nil {
+		t.Fatalf("CreatePermission error = %v", err)
+	}
+
+	dispatcher, recorded := newRecordingDispatcher("RolePermissionAssigned", "RolePermissionRevoked")
+	grant := NewAssignPermissionToRoleCommand(uowFactory, dispatcher)
+	if err := grant.Execute(ctx, contracts.AssignPermissionToRoleCommandRequest{RoleID: roleResp.ID, PermissionID: "missing"}); err == nil {
+		t.Error("granting an unknown permission should fail")
+	}
+	if err := grant.Execute(ctx, contracts.AssignPermissionToRoleCommandRequest{RoleID: roleResp.ID, PermissionID: permResp.ID}); err != nil {
+		t.Fatalf("grant error = %v", err)
+	}
+	if has, _ := rolePermissionRepo.HasPermission(roleResp.ID, permResp.ID); !has {
+		t.Fatal("role should have the granted permission")
+	}
+
+	revoke := NewRevokePermissionFromRoleCommand(uowFactory, dispatcher)
+	if err := revoke.Execute(ctx, contracts.RevokePermissionFromRoleCommandRequest{RoleID: roleResp.ID, PermissionID: permResp.ID}); err != nil {
+		t.Fatalf("revoke error = %v", err)
+	}
+	if has, _ := rolePermissionRepo.HasPermission(roleResp.ID, permResp.ID); has {
+		t.Error("role should not have the revoked permission")
+	}
+
+	// Cached permissions are invalidated from these events
+	if len(recorded.types) != 2 || recorded.types[0] != "RolePermissionAssigned" || recorded.types[1] != "RolePermissionRevoked" {
+		t.Errorf("dispatched events = %v, want [RolePermissionAssigned RolePermissionRevoked]", recorded.types)
+	}
+}
+
+func TestSetRoleParentCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	roleRepo := memory.NewRoleRepository()
+	uowFactory := newRBACUoWFactory(roleRepo, memory.NewPermissionRepository(), memory.NewRolePermissionRepository())
+
+	var ids []string
+	for _, name := range []string{"viewer", "moderator", "admin"} {
+		resp, err := NewCreateRoleCommand(uowFactory, event_dispatcher.NewDispatcher()).Execute(ctx, contracts.CreateRoleCommandRequest{Name: name})
+		if err != nil {
+			t.Fatalf("CreateRole error = %v", err)
+		}
+		ids = append(ids, resp.ID)
+	}
+	viewer, moderator, admin := ids[0], ids[1], ids[2]
+
+	dispatcher, recorded := newRecordingDispatcher("RoleParentChanged")
+	cmd := NewSetRoleParentCommand(uowFactory, dispatcher)
+
+	// admin -> moderator -> viewer
+	if err := cmd.Execute(ctx, contracts.SetRoleParentCommandRequest{RoleID: moderator, ParentID: viewer}); err != nil {
+		t.Fatalf("SetRoleParent error = %v", err)
+	}
+	if err := cmd.Execute(ctx, contracts.SetRoleParentCommandRequest{RoleID: admin, ParentID: moderator}); err != nil {
+		t.Fatalf("SetRoleParent error = %v", err)
+	}
+	if got, _ := roleRepo.GetByID(admin); got.ParentID != moderator {
+		t.Errorf("admin parent = %q, want %q", got.ParentID, moderator)
+	}
+
+	for _, tc := range []struct {
+		name             string
+		roleID, parentID string
+	}{
+		{"self", viewer, viewer},
+		{"direct cycle", moderator, admin},
+		{"indirect cycle", viewer, admin},
+	} {
+		err := cmd.Execute(ctx, contracts.SetRoleParentCommandRequest{RoleID: tc.roleID, ParentID: tc.parentID})
+		appErr, ok := err.(*pkgerrors.AppError)
+		if !ok || appErr.Code != pkgerrors.CodeRoleInheritanceCycle {
+			t.Errorf("%s: error = %v, want %s", tc.name, err, pkgerrors.CodeRoleInheritanceCycle)
+		}
+	}
+
+	if err := cmd.Execute(ctx, contracts.SetRoleParentCommandRequest{RoleID: admin, ParentID: "missing"}); err == nil {
+		t.Error("SetRoleParent should fail for an unknown parent")
+	}
+
+	// An empty parent removes inheritance
+	if err := cmd.Execute(ctx, contracts.SetRoleParentCommandRequest{RoleID: admin}); err != nil {
+		t.Fatalf("SetRoleParent error = %v", err)
+	}
+	if got, _ := roleRepo.GetByID(admin); got.ParentID != "" {
+		t.Errorf("admin parent = %q, want none", got.ParentID)
+	}
+	if len(recorded.types) != 3 {
+		t.Errorf("dispatched %d RoleParentChanged events, want 3", len(recorded.types))
+	}
+}
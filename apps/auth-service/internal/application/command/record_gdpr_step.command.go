@@ -0,0 +1,105 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RecordGDPRStepCommand = (*recordGDPRStepCommand)(nil)
+
+type recordGDPRStepCommand struct {
+	uowFactory unit_of_work.Factory
+	log        *zerolog.Logger
+}
+
+func NewRecordGDPRStepCommand(uowFactory unit_of_work.Factory) contracts.RecordGDPRStepCommand {
+	return &recordGDPRStepCommand{
+		uowFactory: uowFactory,
+		log:        logger.Component("auth.command.record_gdpr_step"),
+	}
+}
+
+func (c *recordGDPRStepCommand) Execute(ctx context.Context, req contracts.RecordGDPRStepCommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	request, err := uow.GDPRRequests().GetByID(ctx, req.RequestID)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrGDPRRequestNotFound) {
+			// Not a request of this auth-service; retrying would not help either
+			c.log.Warn().
+				Str("request_id", req.RequestID).
+				Str("service", req.Service).
+				Msg("ignoring GDPR step of unknown request")
+			return nil
+		}
+		c.log.Error().
+			Err(err).
+			Str("request_id", req.RequestID).
+			Str("service", req.Service).
+			Msg("failed to get GDPR request")
+		return err
+	}
+
+	changed, err := request.CompleteStep(req.Service, req.Records, req.Document, req.CompletedAt)
+	if err != nil {
+		if stderrors.Is(err, gdpr_request.ErrUnknownService) {
+			// A service that is not configured cannot complete the request
+			c.log.Warn().
+				Str("request_id", req.RequestID).
+				Str("service", req.Service).
+				Msg("ignoring GDPR step of unknown service")
+			return nil
+		}
+		return err
+	}
+	if !changed {
+		c.log.Debug().
+			Str("request_id", req.RequestID).
+			Str("service", req.Service).
+			Msg("GDPR step already recorded")
+		return nil
+	}
+
+	if err := uow.GDPRRequests().Update(ctx, request); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("request_id", req.RequestID).
+			Str("service", req.Service).
+			Msg("failed to update GDPR request")
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return err
+	}
+
+	completed, total := request.Progress()
+	c.log.Info().
+		Str("request_id", request.ID).
+		Str("kind", string(request.Kind)).
+		Str("service", req.Service).
+		Int("completed", completed).
+		Int("total", total).
+		Msg("GDPR step recorded")
+
+	if request.IsCompleted() {
+		c.log.Info().
+			Str("request_id", request.ID).
+			Str("user_id", request.UserID).
+			Str("kind", string(request.Kind)).
+			Msg("GDPR request completed")
+	}
+
+	return nil
+}
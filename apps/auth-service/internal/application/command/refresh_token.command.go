@@ -2,14 +2,15 @@ package command
 
 import (
 	"context"
-	"time"
+	stderrors "errors"
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
 	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/redis"
 	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 )
@@ -17,22 +18,19 @@ import (
 var _ contracts.RefreshTokenCommand = (*refreshTokenCommand)(nil)
 
 type refreshTokenCommand struct {
-	userRepo           *memory.UserRepository
-	jwtService         *jwt.Service
-	tokenBlacklistRepo *redis.TokenBlacklistRepository
-	log                *zerolog.Logger
+	uowFactory unit_of_work.Factory
+	jwtService *jwt.Service
+	log        *zerolog.Logger
 }
 
 func NewRefreshTokenCommand(
-	userRepo *memory.UserRepository,
+	uowFactory unit_of_work.Factory,
 	jwtService *jwt.Service,
-	tokenBlacklistRepo *redis.TokenBlacklistRepository,
 ) contracts.RefreshTokenCommand {
 	return &refreshTokenCommand{
-		userRepo:           userRepo,
-		jwtService:         jwtService,
-		tokenBlacklistRepo: tokenBlacklistRepo,
-		log:                logger.Component("auth.command.refresh_token"),
+		uowFactory: uowFactory,
+		jwtService: jwtService,
+		log:        logger.Component("auth.command.refresh_token"),
 	}
 }
 
@@ -43,38 +41,73 @@ func (c *refreshTokenCommand) Execute(ctx context.Context, req contracts.Refresh
 		c.log.Warn().
 			Err(err).
 			Msg("invalid refresh token")
-		return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedError("invalid refresh token")
+		return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeTokenInvalid)
 	}
 
-	// Check if refresh token is blacklisted
-	tokenID := user.NewTokenID(req.RefreshToken)
-	isBlacklisted, err := c.tokenBlacklistRepo.IsBlacklisted(ctx, tokenID.String())
+	uow, err := c.uowFactory.New(ctx)
 	if err != nil {
 		c.log.Error().
 			Err(err).
 			Str("user_id", userID).
-			Msg("failed to check token blacklist")
+			Msg("failed to create unit of work")
 		return contracts.RefreshTokenCommandResponse{}, err
 	}
-	if isBlacklisted {
+	defer uow.Rollback()
+
+	// Look up the presented token in its family
+	tokenHash := user.NewTokenID(req.RefreshToken).String()
+	current, err := uow.RefreshTokens().GetTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrRefreshTokenNotFound) {
+			c.log.Warn().
+				Str("user_id", userID).
+				Msg("refresh token not issued by this service")
+			return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeTokenInvalid)
+		}
+		return contracts.RefreshTokenCommandResponse{}, err
+	}
+	if current.UserID != userID {
 		c.log.Warn().
 			Str("user_id", userID).
-			Msg("refresh token is blacklisted")
-		return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedError("token is blacklisted")
+			Str("token_user_id", current.UserID).
+			Msg("refresh token subject mismatch")
+		return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeTokenInvalid)
 	}
 
-	// Verify user exists
-	_, err = c.userRepo.GetByID(userID)
+	family, err := uow.RefreshTokens().GetFamily(ctx, current.FamilyID)
 	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userID).
+			Str("family_id", current.FamilyID).
+			Msg("failed to get refresh token family")
+		return contracts.RefreshTokenCommandResponse{}, err
+	}
+	if family.IsRevoked() {
+		c.log.Warn().
+			Str("user_id", userID).
+			Str("family_id", family.ID).
+			Str("revoke_reason", family.RevokeReason).
+			Msg("refresh token family is revoked")
+		return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeTokenRevoked)
+	}
+
+	// A rotated token being presented again means it leaked
+	if current.IsRotated() {
+		return contracts.RefreshTokenCommandResponse{}, c.handleReuse(ctx, uow, family, current)
+	}
+
+	// Verify user exists
+	if _, err := uow.Users().GetByID(userID); err != nil {
 		c.log.Warn().
 			Err(err).
 			Str("user_id", userID).
 			Msg("user not found")
-		return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedError("user not found")
+		return contracts.RefreshTokenCommandResponse{}, errors.NewUnauthorizedErrorWithCode(errors.CodeUserNotFound)
 	}
 
-	// Generate new token pair
-	tokenPair, err := c.jwtService.GenerateTokenPair(userID)
+	// Generate new token pair in the same family
+	tokenPair, next, err := issueTokenPair(c.jwtService, family, current.ID)
 	if err != nil {
 		c.log.Error().
 			Err(err).
@@ -83,19 +116,34 @@ func (c *refreshTokenCommand) Execute(ctx context.Context, req contracts.Refresh
 		return contracts.RefreshTokenCommandResponse{}, err
 	}
 
-	// Blacklist old refresh token
-	oldTokenID := user.NewTokenID(req.RefreshToken)
-	// Refresh token expires in 7 days, add some buffer
-	if err := c.tokenBlacklistRepo.AddToken(ctx, oldTokenID.String(), 8*24*time.Hour); err != nil {
-		c.log.Warn().
+	// Rotate the old token first; losing the race to a concurrent refresh counts as reuse
+	current.Rotate(next.ID)
+	if err := uow.RefreshTokens().RotateToken(ctx, current); err != nil {
+		if stderrors.Is(err, repository.ErrRefreshTokenAlreadyRotated) {
+			return contracts.RefreshTokenCommandResponse{}, c.handleReuse(ctx, uow, family, current)
+		}
+		return contracts.RefreshTokenCommandResponse{}, err
+	}
+	if err := uow.RefreshTokens().CreateToken(ctx, next); err != nil {
+		return contracts.RefreshTokenCommandResponse{}, err
+	}
+
+	family.Touch()
+	if err := uow.RefreshTokens().TouchFamily(ctx, family); err != nil {
+		return contracts.RefreshTokenCommandResponse{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		c.log.Error().
 			Err(err).
 			Str("user_id", userID).
-			Msg("failed to blacklist old refresh token")
-		// Don't fail the request, just log warning
+			Msg("failed to commit transaction")
+		return contracts.RefreshTokenCommandResponse{}, err
 	}
 
 	c.log.Info().
 		Str("user_id", userID).
+		Str("family_id", family.ID).
 		Msg("token refreshed successfully")
 
 	return contracts.RefreshTokenCommandResponse{
@@ -104,3 +152,46 @@ func (c *refreshTokenCommand) Execute(ctx context.Context, req contracts.Refresh
 		ExpiresIn:    tokenPair.ExpiresIn,
 	}, nil
 }
+
+// handleReuse revokes the whole family and records the security event in the outbox
+func (c *refreshTokenCommand) handleReuse(ctx context.Context, uow unit_of_work.UnitOfWork, family refresh_token.Family, token refresh_token.Token) error {
+	family.DetectReuse(token.ID)
+
+	if err := uow.RefreshTokens().RevokeFamily(ctx, family); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("family_id", family.ID).
+			Msg("failed to revoke refresh token family")
+		return err
+	}
+
+	domainEvents := family.Events()
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("family_id", family.ID).
+			Msg("failed to save events")
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("family_id", family.ID).
+			Msg("failed to commit transaction")
+		return err
+	}
+	family.ClearEvents()
+
+	c.log.Warn().
+		Str("user_id", family.UserID).
+		Str("family_id", family.ID).
+		Str("token_id", token.ID).
+		Msg("refresh token reuse detected, token family revoked")
+
+	return errors.NewUnauthorizedErrorWithCode(errors.CodeTokenReused)
+}
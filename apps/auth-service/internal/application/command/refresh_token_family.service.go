@@ -0,0 +1,27 @@
+package command
+
+import (
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
+)
+
+// issueTokenPair generates a token pair bound to the given family (session).
+// The returned token entity is not persisted; callers store it with CreateToken.
+func issueTokenPair(jwtService *jwt.Service, family refresh_token.Family, parentID string) (*jwt.TokenPair, refresh_token.Token, error) {
+	tokenPair, err := jwtService.GenerateSessionTokenPair(family.UserID, family.ID, []string{}, []string{})
+	if err != nil {
+		return nil, refresh_token.Token{}, err
+	}
+
+	token := refresh_token.NewToken(
+		family,
+		user.NewTokenID(tokenPair.RefreshToken).String(),
+		parentID,
+		time.Now().Add(jwtService.RefreshExpiration()),
+	)
+
+	return tokenPair, token, nil
+}
@@ -0,0 +1,142 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/factories"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/infrastructure/totp"
+	"golang-social-media/pkg/contracts/auth"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setupRefreshTokenTest(t *testing.T) (*memory.UnitOfWorkFactory, *memory.RefreshTokenRepository, *jwt.Service, auth.LoginResponse) {
+	userRepo := memory.NewUserRepository(nil)
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+	uowFactory := memory.NewUnitOfWorkFactory(userRepo, refreshTokenRepo)
+	jwtService := jwt.NewService("test-secret", 1, 168)
+
+	// Create a test user
+	factory := factories.NewUserFactory()
+	testUser, err := factory.CreateUser("test@example.com", hashPassword(t, "password123"), "Test User")
+	if err != nil {
+		t.Fatalf("Failed to create test user: %v", err)
+	}
+	testUser.ClearEvents()
+	if err := userRepo.Create(*testUser); err != nil {
+		t.Fatalf("Failed to save test user: %v", err)
+	}
+
+	// Login starts a refresh token family
+	login, err := NewLoginUserHandler(userRepo, jwtService, refreshTokenRepo, totp.NewService("test"), user.UnverifiedUserPolicyAllow, nil, testPasswordHasher, uowFactory).Handle(context.Background(), auth.LoginRequest{
+		Email:    "test@example.com",
+		Password: "password123",
+	}, refresh_token.Device{UserAgent: "test-agent", IPAddress: "127.0.0.1"})
+	if err != nil {
+		t.Fatalf("Failed to login: %v", err)
+	}
+
+	return uowFactory, refreshTokenRepo, jwtService, login
+}
+
+func assertErrorCode(t *testing.T, err error, code pkgerrors.ErrorCode) {
+	t.Helper()
+	appErr, ok := err.(*pkgerrors.AppError)
+	if assert.True(t, ok, "expected *AppError, got %T", err) {
+		assert.Equal(t, code, appErr.Code)
+	}
+}
+
+func TestRefreshTokenCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Successful Token Refresh Rotates Token", func(t *testing.T) {
+		uowFactory, refreshTokenRepo, jwtService, login := setupRefreshTokenTest(t)
+		cmd := NewRefreshTokenCommand(uowFactory, jwtService)
+
+		resp, err := cmd.Execute(ctx, contracts.RefreshTokenCommandRequest{RefreshToken: login.RefreshToken})
+
+		assert.Nil(t, err)
+		assert.NotEmpty(t, resp.AccessToken)
+		assert.NotEmpty(t, resp.RefreshToken)
+		assert.NotEqual(t, login.RefreshToken, resp.RefreshToken)
+		assert.Greater(t, resp.ExpiresIn, int64(0))
+
+		// Old token is rotated and points to its replacement in the same family
+		old, err := refreshTokenRepo.GetTokenByHash(ctx, user.NewTokenID(login.RefreshToken).String())
+		assert.Nil(t, err)
+		assert.True(t, old.IsRotated())
+
+		next, err := refreshTokenRepo.GetTokenByHash(ctx, user.NewTokenID(resp.RefreshToken).String())
+		assert.Nil(t, err)
+		assert.Equal(t, old.ReplacedBy, next.ID)
+		assert.Equal(t, old.FamilyID, next.FamilyID)
+		assert.Equal(t, old.ID, next.ParentID)
+
+		// The new token can be refreshed again
+		_, err = cmd.Execute(ctx, contracts.RefreshTokenCommandRequest{RefreshToken: resp.RefreshToken})
+		assert.Nil(t, err)
+	})
+
+	t.Run("Reused Refresh Token Revokes Family", func(t *testing.T) {
+		uowFactory, refreshTokenRepo, jwtService, login := setupRefreshTokenTest(t)
+		cmd := NewRefreshTokenCommand(uowFactory, jwtService)
+
+		resp, err := cmd.Execute(ctx, contracts.RefreshTokenCommandRequest{RefreshToken: login.RefreshToken})
+		assert.Nil(t, err)
+
+		// Replay the rotated token
+		_, err = cmd.Execute(ctx, contracts.RefreshTokenCommandRequest{RefreshToken: login.RefreshToken})
+		assertErrorCode(t, err, pkgerrors.CodeTokenReused)
+
+		// The whole family is revoked, including the latest token
+		_, err = cmd.Execute(ctx, contracts.RefreshTokenCommandRequest{RefreshToken: resp.RefreshToken})
+		assertErrorCode(t, err, pkgerrors.CodeTokenRevoked)
+
+		old, _ := refreshTokenRepo.GetTokenByHash(ctx, user.NewTokenID(login.RefreshToken).String())
+		family, err := refreshTokenRepo.GetFamily(ctx, old.FamilyID)
+		assert.Nil(t, err)
+		assert.True(t, family.IsRevoked())
+		assert.Equal(t, refresh_token.RevokeReasonReuseDetected, family.RevokeReason)
+
+		// Security event is written through the unit of work
+		events := uowFactory.Events()
+		if assert.Len(t, events, 1) {
+			event, ok := events[0].(refresh_token.RefreshTokenReuseDetectedEvent)
+			assert.True(t, ok)
+			assert.Equal(t, family.ID, event.FamilyID)
+			assert.Equal(t, old.ID, event.TokenID)
+		}
+	})
+
+	t.Run("Invalid Refresh Token", func(t *testing.T) {
+		uowFactory, _, jwtService, _ := setupRefreshTokenTest(t)
+		cmd := NewRefreshTokenCommand(uowFactory, jwtService)
+
+		_, err := cmd.Execute(ctx, contracts.RefreshTokenCommandRequest{RefreshToken: "invalid-token"})
+
+		assertErrorCode(t, err, pkgerrors.CodeTokenInvalid)
+	})
+
+	t.Run("Unknown Refresh Token", func(t *testing.T) {
+		uowFactory, _, jwtService, login := setupRefreshTokenTest(t)
+		cmd := NewRefreshTokenCommand(uowFactory, jwtService)
+
+		// Correctly signed but never stored (e.g. issued before token families existed)
+		tokenPair, err := jwtService.GenerateTokenPair(login.UserID)
+		if err != nil {
+			t.Fatalf("Failed to generate token pair: %v", err)
+		}
+
+		_, err = cmd.Execute(ctx, contracts.RefreshTokenCommandRequest{RefreshToken: tokenPair.RefreshToken})
+
+		assertErrorCode(t, err, pkgerrors.CodeTokenInvalid)
+	})
+}
@@ -5,11 +5,13 @@ import (
 	"strings"
 
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/mailer"
+	"golang-social-media/apps/auth-service/internal/application/password_hasher"
 	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
 	event_dispatcher "golang-social-media/apps/auth-service/internal/application/event_dispatcher"
 	"golang-social-media/apps/auth-service/internal/domain/factories"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
 	"golang-social-media/pkg/contracts/auth"
 	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
@@ -23,40 +25,70 @@ type registerUserCommand struct {
 	userRepo        repository.UserRepository
 	uowFactory      unit_of_work.Factory
 	userFactory     factories.UserFactory
+	passwordHasher  password_hasher.PasswordHasher
+	passwordPolicy  *PasswordPolicyChecker
 	eventDispatcher *event_dispatcher.Dispatcher
+	mailer          mailer.Mailer
+	emailConfig     VerificationEmailConfig
 	log             *zerolog.Logger
 }
 
 func NewRegisterUserCommand(
 	userRepo repository.UserRepository,
 	userFactory factories.UserFactory,
+	passwordHasher password_hasher.PasswordHasher,
+	passwordPolicy *PasswordPolicyChecker,
 	eventDispatcher *event_dispatcher.Dispatcher,
 ) contracts.RegisterUserCommand {
 	return &registerUserCommand{
 		userRepo:        userRepo,
 		userFactory:     userFactory,
+		passwordHasher:  passwordHasher,
+		passwordPolicy:  passwordPolicy,
 		eventDispatcher: eventDispatcher,
 		log:             logger.Component("auth.command.register_user"),
 	}
 }
 
-// NewRegisterUserCommandWithUoW creates a new command with Unit of Work support
+// NewRegisterUserCommandWithUoW creates a new command with Unit of Work support.
+// A verification email is sent to the new user once the transaction commits.
 func NewRegisterUserCommandWithUoW(
 	uowFactory unit_of_work.Factory,
 	userFactory factories.UserFactory,
+	passwordHasher password_hasher.PasswordHasher,
+	passwordPolicy *PasswordPolicyChecker,
 	eventDispatcher *event_dispatcher.Dispatcher,
+	mailer mailer.Mailer,
+	emailConfig VerificationEmailConfig,
 ) contracts.RegisterUserCommand {
 	return &registerUserCommand{
 		uowFactory:      uowFactory,
 		userFactory:     userFactory,
+		passwordHasher:  passwordHasher,
+		passwordPolicy:  passwordPolicy,
 		eventDispatcher: eventDispatcher,
+		mailer:          mailer,
+		emailConfig:     emailConfig,
 		log:             logger.Component("auth.command.register_user"),
 	}
 }
 
 func (c *registerUserCommand) Execute(ctx context.Context, req auth.RegisterRequest) (auth.RegisterResponse, error) {
+	// Password rules apply to the plain password, the user only ever holds its hash
+	if err := c.passwordPolicy.Check(ctx, req.Password, nil); err != nil {
+		return auth.RegisterResponse{}, err
+	}
+	passwordHash, err := c.passwordHasher.Hash(req.Password)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("email", req.Email).
+			Msg("failed to hash password")
+		return auth.RegisterResponse{}, err
+	}
+
 	// Use factory to create user
-	userModel, err := c.userFactory.CreateUser(req.Email, req.Password, req.Name)
+	userModel, err := c.userFactory.CreateUser(req.Email, passwordHash, req.Name)
 	if err != nil {
 		c.log.Error().
 			Err(err).
@@ -93,6 +125,16 @@ func (c *registerUserCommand) Execute(ctx context.Context, req auth.RegisterRequ
 			return auth.RegisterResponse{}, err
 		}
 
+		// Issue the email verification token in the same transaction as the user
+		rawToken, err := issueVerificationToken(ctx, uow.VerificationTokens(), userModel.ID, verification_token.PurposeEmailVerification, c.emailConfig.EmailVerificationTTL)
+		if err != nil {
+			c.log.Error().
+				Err(err).
+				Str("email", req.Email).
+				Msg("failed to issue email verification token")
+			return auth.RegisterResponse{}, err
+		}
+
 		// Save events to outbox and event store within the same transaction
 		events := make([]interface{}, len(domainEvents))
 		for i, event := range domainEvents {
@@ -118,6 +160,14 @@ func (c *registerUserCommand) Execute(ctx context.Context, req auth.RegisterRequ
 		// Clear events after successful persistence
 		userModel.ClearEvents()
 
+		// The user can request a new link if delivery fails, so registration still succeeds
+		if err := c.mailer.Send(ctx, c.emailConfig.verificationMessage(*userModel, rawToken)); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("user_id", userModel.ID).
+				Msg("failed to send verification email")
+		}
+
 		c.log.Info().
 			Str("user_id", userModel.ID).
 			Str("email", userModel.Email).
@@ -13,32 +13,21 @@ import (
 	pkgerrors "golang-social-media/pkg/errors"
 )
 
-// mockEventDispatcher is a mock implementation of EventDispatcher for testing
-type mockEventDispatcher struct {
-	dispatch func(ctx context.Context, event user.DomainEvent) error
+// failingEventHandler fails every event it handles
+type failingEventHandler struct {
+	err error
 }
 
-func newMockEventDispatcher() *mockEventDispatcher {
-	return &mockEventDispatcher{}
-}
-
-func (m *mockEventDispatcher) RegisterHandler(eventType string, handler event_dispatcher.EventHandler) {
-	// No-op for testing
-}
-
-func (m *mockEventDispatcher) Dispatch(ctx context.Context, event user.DomainEvent) error {
-	if m.dispatch != nil {
-		return m.dispatch(ctx, event)
-	}
-	return nil
+func (h *failingEventHandler) Handle(ctx context.Context, event user.DomainEvent) error {
+	return h.err
 }
 
 func TestRegisterUserCommand_Execute(t *testing.T) {
 	repo := memory.NewUserRepository(nil)
 	factory := factories.NewUserFactory()
-	dispatcher := newMockEventDispatcher()
+	dispatcher := event_dispatcher.NewDispatcher()
 
-	cmd := NewRegisterUserCommand(repo, factory, dispatcher)
+	cmd := NewRegisterUserCommand(repo, factory, testPasswordHasher, testPasswordPolicy, dispatcher)
 
 	req := auth.RegisterRequest{
 		Email:    "test@example.com",
@@ -74,9 +63,9 @@ func TestRegisterUserCommand_Execute(t *testing.T) {
 func TestRegisterUserCommand_Execute_DuplicateEmail(t *testing.T) {
 	repo := memory.NewUserRepository(nil)
 	factory := factories.NewUserFactory()
-	dispatcher := newMockEventDispatcher()
+	dispatcher := event_dispatcher.NewDispatcher()
 
-	cmd := NewRegisterUserCommand(repo, factory, dispatcher)
+	cmd := NewRegisterUserCommand(repo, factory, testPasswordHasher, testPasswordPolicy, dispatcher)
 
 	req := auth.RegisterRequest{
 		Email:    "test@example.com",
@@ -108,9 +97,9 @@ func TestRegisterUserCommand_Execute_DuplicateEmail(t *testing.T) {
 func TestRegisterUserCommand_Execute_InvalidUser(t *testing.T) {
 	repo := memory.NewUserRepository(nil)
 	factory := factories.NewUserFactory()
-	dispatcher := newMockEventDispatcher()
+	dispatcher := event_dispatcher.NewDispatcher()
 
-	cmd := NewRegisterUserCommand(repo, factory, dispatcher)
+	cmd := NewRegisterUserCommand(repo, factory, testPasswordHasher, testPasswordPolicy, dispatcher)
 
 	tests := []struct {
 		name string
@@ -147,12 +136,10 @@ func TestRegisterUserCommand_Execute_InvalidUser(t *testing.T) {
 func TestRegisterUserCommand_Execute_EventDispatchError(t *testing.T) {
 	repo := memory.NewUserRepository(nil)
 	factory := factories.NewUserFactory()
-	dispatcher := newMockEventDispatcher()
-	dispatcher.dispatch = func(ctx context.Context, event user.DomainEvent) error {
-		return errors.New("event dispatch error")
-	}
+	dispatcher := event_dispatcher.NewDispatcher()
+	dispatcher.RegisterHandler("UserCreated", &failingEventHandler{err: errors.New("event dispatch error")})
 
-	cmd := NewRegisterUserCommand(repo, factory, dispatcher)
+	cmd := NewRegisterUserCommand(repo, factory, testPasswordHasher, testPasswordPolicy, dispatcher)
 
 	req := auth.RegisterRequest{
 		Email:    "test@example.com",
@@ -170,4 +157,3 @@ func TestRegisterUserCommand_Execute_EventDispatchError(t *testing.T) {
 		t.Error("RegisterResponse.ID should not be empty even if event dispatch fails")
 	}
 }
-
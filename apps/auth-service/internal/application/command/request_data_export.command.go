@@ -0,0 +1,191 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+	pkgevents "golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RequestDataExportCommand = (*requestDataExportCommand)(nil)
+
+// authDataExport is the auth-service document of a data export
+type authDataExport struct {
+	Profile  authExportProfile      `json:"profile"`
+	Roles    []auth.RoleResponse    `json:"roles"`
+	Sessions []auth.SessionResponse `json:"sessions"`
+	APIKeys  []auth.APIKeyResponse  `json:"apiKeys"`
+}
+
+type authExportProfile struct {
+	ID            string    `json:"id"`
+	Email         string    `json:"email"`
+	Name          string    `json:"name"`
+	EmailVerified bool      `json:"emailVerified"`
+	MFAEnabled    bool      `json:"mfaEnabled"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type requestDataExportCommand struct {
+	uowFactory   unit_of_work.Factory
+	userRoleRepo repository.UserRoleRepository
+	roleRepo     repository.RoleRepository
+	apiKeyRepo   repository.APIKeyRepository
+	services     []string // Services holding user data, each sends its document
+	log          *zerolog.Logger
+}
+
+func NewRequestDataExportCommand(
+	uowFactory unit_of_work.Factory,
+	userRoleRepo repository.UserRoleRepository,
+	roleRepo repository.RoleRepository,
+	apiKeyRepo repository.APIKeyRepository,
+	services []string,
+) contracts.RequestDataExportCommand {
+	return &requestDataExportCommand{
+		uowFactory:   uowFactory,
+		userRoleRepo: userRoleRepo,
+		roleRepo:     roleRepo,
+		apiKeyRepo:   apiKeyRepo,
+		services:     services,
+		log:          logger.Component("auth.command.request_data_export"),
+	}
+}
+
+func (c *requestDataExportCommand) Execute(ctx context.Context, req contracts.RequestDataExportCommandRequest) (contracts.RequestDataExportCommandResponse, error) {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return contracts.RequestDataExportCommandResponse{}, err
+	}
+	defer uow.Rollback()
+
+	userEntity, err := uow.Users().GetByID(req.UserID)
+	if err != nil {
+		return contracts.RequestDataExportCommandResponse{}, err
+	}
+	if userEntity.IsDeleted() {
+		return contracts.RequestDataExportCommandResponse{}, errors.NewConflictError(errors.CodeUserDeleted)
+	}
+
+	document, err := c.document(ctx, uow, userEntity)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to build auth data export")
+		return contracts.RequestDataExportCommandResponse{}, err
+	}
+
+	request := gdpr_request.NewExport(userEntity.ID, c.services)
+	if _, err := request.CompleteStep(pkgevents.ServiceAuth, 0, document, time.Now()); err != nil {
+		return contracts.RequestDataExportCommandResponse{}, err
+	}
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := request.Events()
+	request.ClearEvents()
+
+	if err := uow.GDPRRequests().Create(ctx, request); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to create GDPR export request")
+		return contracts.RequestDataExportCommandResponse{}, err
+	}
+
+	// UserExportRequested goes through the outbox and asks the other services for their documents
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		return contracts.RequestDataExportCommandResponse{}, err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return contracts.RequestDataExportCommandResponse{}, err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Str("request_id", request.ID).
+		Msg("data export requested")
+
+	return contracts.RequestDataExportCommandResponse{RequestID: request.ID}, nil
+}
+
+// document collects what auth-service holds about the user; secrets (password and MFA hashes, key hashes) are left out
+func (c *requestDataExportCommand) document(ctx context.Context, uow unit_of_work.UnitOfWork, userEntity user.User) (json.RawMessage, error) {
+	export := authDataExport{
+		Profile: authExportProfile{
+			ID:            userEntity.ID,
+			Email:         userEntity.Email,
+			Name:          userEntity.Name,
+			EmailVerified: userEntity.EmailVerified,
+			MFAEnabled:    userEntity.MFAEnabled,
+			UpdatedAt:     userEntity.UpdatedAt,
+		},
+		Roles:    []auth.RoleResponse{},
+		Sessions: []auth.SessionResponse{},
+		APIKeys:  []auth.APIKeyResponse{},
+	}
+
+	roleIDs, err := c.userRoleRepo.GetUserRoles(userEntity.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, roleID := range roleIDs {
+		roleEntity, err := c.roleRepo.GetByID(roleID)
+		if err != nil {
+			return nil, err
+		}
+		export.Roles = append(export.Roles, auth.RoleResponse{
+			ID:          roleEntity.ID,
+			Name:        roleEntity.Name,
+			Description: roleEntity.Description,
+			ParentID:    roleEntity.ParentID,
+		})
+	}
+
+	families, err := uow.RefreshTokens().ListActiveFamilies(ctx, userEntity.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, family := range families {
+		export.Sessions = append(export.Sessions, auth.SessionResponse{
+			ID:         family.ID,
+			UserAgent:  family.Device.UserAgent,
+			IPAddress:  family.Device.IPAddress,
+			CreatedAt:  family.CreatedAt,
+			LastUsedAt: family.LastUsedAt,
+		})
+	}
+
+	keys, err := c.apiKeyRepo.ListByUser(ctx, userEntity.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		export.APIKeys = append(export.APIKeys, auth.APIKeyResponse{
+			ID:         key.ID,
+			Name:       key.Name,
+			Prefix:     key.Prefix,
+			Scopes:     key.Scopes,
+			ExpiresAt:  key.ExpiresAt,
+			CreatedAt:  key.CreatedAt,
+			LastUsedAt: key.LastUsedAt,
+		})
+	}
+
+	return json.Marshal(export)
+}
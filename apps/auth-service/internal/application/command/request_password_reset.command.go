@@ -0,0 +1,86 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/mailer"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RequestPasswordResetCommand = (*requestPasswordResetCommand)(nil)
+
+type requestPasswordResetCommand struct {
+	uowFactory  unit_of_work.Factory
+	mailer      mailer.Mailer
+	emailConfig VerificationEmailConfig
+	log         *zerolog.Logger
+}
+
+func NewRequestPasswordResetCommand(
+	uowFactory unit_of_work.Factory,
+	mailer mailer.Mailer,
+	emailConfig VerificationEmailConfig,
+) contracts.RequestPasswordResetCommand {
+	return &requestPasswordResetCommand{
+		uowFactory:  uowFactory,
+		mailer:      mailer,
+		emailConfig: emailConfig,
+		log:         logger.Component("auth.command.request_password_reset"),
+	}
+}
+
+func (c *requestPasswordResetCommand) Execute(ctx context.Context, req contracts.RequestPasswordResetCommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	userEntity, err := uow.Users().FindByEmail(req.Email)
+	if err != nil {
+		// Unknown emails succeed silently so the endpoint cannot be used to enumerate accounts
+		var appErr *errors.AppError
+		if stderrors.As(err, &appErr) && appErr.HTTPStatus < http.StatusInternalServerError {
+			c.log.Info().
+				Str("email", req.Email).
+				Msg("password reset requested for unknown email")
+			return nil
+		}
+		return err
+	}
+
+	rawToken, err := issueVerificationToken(ctx, uow.VerificationTokens(), userEntity.ID, verification_token.PurposePasswordReset, c.emailConfig.PasswordResetTTL)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to issue password reset token")
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return err
+	}
+
+	// Delivery failures are not reported to the caller for the same reason as unknown emails
+	if err := c.mailer.Send(ctx, c.emailConfig.passwordResetMessage(userEntity, rawToken)); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to send password reset email")
+		return nil
+	}
+
+	c.log.Info().
+		Str("user_id", userEntity.ID).
+		Msg("password reset email sent")
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/password_hasher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ResetPasswordCommand = (*resetPasswordCommand)(nil)
+
+type resetPasswordCommand struct {
+	uowFactory     unit_of_work.Factory
+	passwordHasher password_hasher.PasswordHasher
+	passwordPolicy *PasswordPolicyChecker
+	log            *zerolog.Logger
+}
+
+func NewResetPasswordCommand(
+	uowFactory unit_of_work.Factory,
+	passwordHasher password_hasher.PasswordHasher,
+	passwordPolicy *PasswordPolicyChecker,
+) contracts.ResetPasswordCommand {
+	return &resetPasswordCommand{
+		uowFactory:     uowFactory,
+		passwordHasher: passwordHasher,
+		passwordPolicy: passwordPolicy,
+		log:            logger.Component("auth.command.reset_password"),
+	}
+}
+
+func (c *resetPasswordCommand) Execute(ctx context.Context, req contracts.ResetPasswordCommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	token, err := consumeVerificationToken(ctx, uow.VerificationTokens(), req.Token, verification_token.PurposePasswordReset)
+	if err != nil {
+		c.log.Warn().
+			Err(err).
+			Msg("invalid password reset token")
+		return err
+	}
+
+	// Any other reset link sent to the user stops working as well
+	if err := uow.VerificationTokens().InvalidateForUser(ctx, token.UserID, verification_token.PurposePasswordReset); err != nil {
+		return err
+	}
+
+	userEntity, err := uow.Users().GetByID(token.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := c.passwordPolicy.Check(ctx, req.NewPassword, &userEntity); err != nil {
+		return err
+	}
+
+	newPasswordHash, err := c.passwordHasher.Hash(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	userEntity.ResetPassword(newPasswordHash, c.passwordPolicy.HistorySize())
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := userEntity.Events()
+	userEntity.ClearEvents()
+
+	if err := uow.Users().Update(userEntity); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to reset password")
+		return err
+	}
+
+	// Whoever knew the old password must not keep a session
+	revoked, err := uow.RefreshTokens().RevokeAllFamilies(ctx, userEntity.ID, "", refresh_token.RevokeReasonPasswordReset)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to revoke sessions after password reset")
+		return err
+	}
+
+	// Save events to outbox and event store within the same transaction
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", userEntity.ID).
+		Int64("revoked_sessions", revoked).
+		Msg("password reset")
+
+	return nil
+}
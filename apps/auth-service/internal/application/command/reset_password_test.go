@@ -0,0 +1,84 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResetPasswordCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Resets Password And Revokes Sessions", func(t *testing.T) {
+		uowFactory, refreshTokenRepo, _, login := setupRefreshTokenTest(t)
+		m := &recordingMailer{}
+
+		err := NewRequestPasswordResetCommand(uowFactory, m, testEmailConfig()).
+			Execute(ctx, contracts.RequestPasswordResetCommandRequest{Email: "test@example.com"})
+		assert.NoError(t, err)
+		if assert.Len(t, m.messages, 1) {
+			assert.Contains(t, m.messages[0].Body, "https://app.example.com/reset-password?token=")
+		}
+
+		err = NewResetPasswordCommand(uowFactory, testPasswordHasher, testPasswordPolicy).Execute(ctx, contracts.ResetPasswordCommandRequest{
+			Token:       m.lastToken(t),
+			NewPassword: "newpassword123",
+		})
+		assert.NoError(t, err)
+
+		sessions, _ := refreshTokenRepo.ListActiveFamilies(ctx, login.UserID)
+		assert.Empty(t, sessions, "every session must be revoked after a password reset")
+
+		events := uowFactory.Events()
+		if assert.Len(t, events, 1) {
+			event, ok := events[0].(user.UserPasswordResetEvent)
+			assert.True(t, ok)
+			assert.Equal(t, login.UserID, event.UserID)
+		}
+
+		old, _ := refreshTokenRepo.GetTokenByHash(ctx, user.NewTokenID(login.RefreshToken).String())
+		family, _ := refreshTokenRepo.GetFamily(ctx, old.FamilyID)
+		assert.Equal(t, refresh_token.RevokeReasonPasswordReset, family.RevokeReason)
+	})
+
+	t.Run("Unknown Email Succeeds Without Sending", func(t *testing.T) {
+		uowFactory, _, _, _ := setupRefreshTokenTest(t)
+		m := &recordingMailer{}
+
+		err := NewRequestPasswordResetCommand(uowFactory, m, testEmailConfig()).
+			Execute(ctx, contracts.RequestPasswordResetCommandRequest{Email: "nobody@example.com"})
+		assert.NoError(t, err)
+		assert.Empty(t, m.messages)
+	})
+
+	t.Run("Verification Token Cannot Reset Password", func(t *testing.T) {
+		uowFactory, _, _, login := setupRefreshTokenTest(t)
+		m := &recordingMailer{}
+		_ = NewSendVerificationEmailCommand(uowFactory, m, testEmailConfig()).
+			Execute(ctx, contracts.SendVerificationEmailCommandRequest{UserID: login.UserID})
+
+		err := NewResetPasswordCommand(uowFactory, testPasswordHasher, testPasswordPolicy).Execute(ctx, contracts.ResetPasswordCommandRequest{
+			Token:       m.lastToken(t),
+			NewPassword: "newpassword123",
+		})
+		assertErrorCode(t, err, pkgerrors.CodeVerificationTokenInvalid)
+	})
+
+	t.Run("Invalid New Password", func(t *testing.T) {
+		uowFactory, _, _, _ := setupRefreshTokenTest(t)
+		m := &recordingMailer{}
+		_ = NewRequestPasswordResetCommand(uowFactory, m, testEmailConfig()).
+			Execute(ctx, contracts.RequestPasswordResetCommandRequest{Email: "test@example.com"})
+		token := m.lastToken(t)
+
+		cmd := NewResetPasswordCommand(uowFactory, testPasswordHasher, testPasswordPolicy)
+		err := cmd.Execute(ctx, contracts.ResetPasswordCommandRequest{Token: token, NewPassword: "short"})
+		assert.Error(t, err)
+	})
+}
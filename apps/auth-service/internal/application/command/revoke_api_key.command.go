@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RevokeAPIKeyCommand = (*revokeAPIKeyCommand)(nil)
+
+type revokeAPIKeyCommand struct {
+	apiKeyRepo repository.APIKeyRepository
+	log        *zerolog.Logger
+}
+
+func NewRevokeAPIKeyCommand(apiKeyRepo repository.APIKeyRepository) contracts.RevokeAPIKeyCommand {
+	return &revokeAPIKeyCommand{
+		apiKeyRepo: apiKeyRepo,
+		log:        logger.Component("auth.command.revoke_api_key"),
+	}
+}
+
+func (c *revokeAPIKeyCommand) Execute(ctx context.Context, req contracts.RevokeAPIKeyCommandRequest) error {
+	key, err := c.apiKeyRepo.GetByID(ctx, req.APIKeyID)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrAPIKeyNotFound) {
+			return errors.NewNotFoundError(errors.CodeAPIKeyNotFound)
+		}
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Str("api_key_id", req.APIKeyID).
+			Msg("failed to get API key")
+		return err
+	}
+
+	// Do not reveal keys of other users
+	if key.UserID != req.UserID || key.IsRevoked() {
+		return errors.NewNotFoundError(errors.CodeAPIKeyNotFound)
+	}
+
+	key.Revoke()
+	if err := c.apiKeyRepo.Revoke(ctx, key); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Str("api_key_id", req.APIKeyID).
+			Msg("failed to revoke API key")
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Str("api_key_id", req.APIKeyID).
+		Msg("API key revoked")
+
+	return nil
+}
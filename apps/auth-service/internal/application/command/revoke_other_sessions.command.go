@@ -0,0 +1,44 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RevokeOtherSessionsCommand = (*revokeOtherSessionsCommand)(nil)
+
+type revokeOtherSessionsCommand struct {
+	refreshTokenRepo repository.RefreshTokenRepository
+	log              *zerolog.Logger
+}
+
+func NewRevokeOtherSessionsCommand(refreshTokenRepo repository.RefreshTokenRepository) contracts.RevokeOtherSessionsCommand {
+	return &revokeOtherSessionsCommand{
+		refreshTokenRepo: refreshTokenRepo,
+		log:              logger.Component("auth.command.revoke_other_sessions"),
+	}
+}
+
+func (c *revokeOtherSessionsCommand) Execute(ctx context.Context, req contracts.RevokeOtherSessionsCommandRequest) (contracts.RevokeOtherSessionsCommandResponse, error) {
+	revoked, err := c.refreshTokenRepo.RevokeAllFamilies(ctx, req.UserID, req.CurrentSessionID, refresh_token.RevokeReasonSessionKilled)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to revoke other sessions")
+		return contracts.RevokeOtherSessionsCommandResponse{}, err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Str("current_session_id", req.CurrentSessionID).
+		Int64("revoked", revoked).
+		Msg("other sessions revoked")
+
+	return contracts.RevokeOtherSessionsCommandResponse{Revoked: revoked}, nil
+}
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeOtherSessionsCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+
+	current := refresh_token.NewFamily("user-1", refresh_token.Device{UserAgent: "laptop"})
+	phone := refresh_token.NewFamily("user-1", refresh_token.Device{UserAgent: "phone"})
+	tablet := refresh_token.NewFamily("user-1", refresh_token.Device{UserAgent: "tablet"})
+	otherUser := refresh_token.NewFamily("user-2", refresh_token.Device{UserAgent: "laptop"})
+	for _, session := range []refresh_token.Family{current, phone, tablet, otherUser} {
+		if err := refreshTokenRepo.CreateFamily(ctx, session); err != nil {
+			t.Fatalf("Failed to create session: %v", err)
+		}
+	}
+
+	cmd := NewRevokeOtherSessionsCommand(refreshTokenRepo)
+
+	resp, err := cmd.Execute(ctx, contracts.RevokeOtherSessionsCommandRequest{
+		UserID:           "user-1",
+		CurrentSessionID: current.ID,
+	})
+
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), resp.Revoked)
+
+	active, err := refreshTokenRepo.ListActiveFamilies(ctx, "user-1")
+	assert.Nil(t, err)
+	if assert.Len(t, active, 1) {
+		assert.Equal(t, current.ID, active[0].ID)
+	}
+
+	// Sessions of other users are untouched
+	active, _ = refreshTokenRepo.ListActiveFamilies(ctx, "user-2")
+	assert.Len(t, active, 1)
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/role_permission"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RevokePermissionFromRoleCommand = (*revokePermissionFromRoleCommand)(nil)
+
+type revokePermissionFromRoleCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewRevokePermissionFromRoleCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.RevokePermissionFromRoleCommand {
+	return &revokePermissionFromRoleCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.revoke_permission_from_role"),
+	}
+}
+
+func (c *revokePermissionFromRoleCommand) Execute(ctx context.Context, req contracts.RevokePermissionFromRoleCommandRequest) error {
+	rolePermission := role_permission.RolePermission{
+		RoleID:       req.RoleID,
+		PermissionID: req.PermissionID,
+	}
+	rolePermission.Revoke()
+
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]role_permission.DomainEvent, error) {
+		// Revoking a permission the role does not have is a no-op
+		if err := uow.RolePermissions().Delete(req.RoleID, req.PermissionID); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("role_id", req.RoleID).
+				Str("permission_id", req.PermissionID).
+				Msg("failed to revoke permission from role")
+			return nil, err
+		}
+		return rolePermission.Events(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("role_id", req.RoleID).
+		Str("permission_id", req.PermissionID).
+		Msg("permission revoked from role")
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RevokeRoleCommand = (*revokeRoleCommand)(nil)
+
+type revokeRoleCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewRevokeRoleCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.RevokeRoleCommand {
+	return &revokeRoleCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.revoke_role"),
+	}
+}
+
+func (c *revokeRoleCommand) Execute(ctx context.Context, req contracts.RevokeRoleCommandRequest) error {
+	userRole := user_role.UserRole{
+		UserID: req.UserID,
+		RoleID: req.RoleID,
+	}
+	userRole.Revoke()
+
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]user_role.DomainEvent, error) {
+		// Revoking a role the user does not have is a no-op
+		if err := uow.UserRoles().Delete(req.UserID, req.RoleID); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("user_id", req.UserID).
+				Str("role_id", req.RoleID).
+				Msg("failed to revoke role")
+			return nil, err
+		}
+		return userRole.Events(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Str("role_id", req.RoleID).
+		Msg("role revoked")
+
+	return nil
+}
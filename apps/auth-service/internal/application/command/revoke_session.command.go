@@ -0,0 +1,64 @@
+package command
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.RevokeSessionCommand = (*revokeSessionCommand)(nil)
+
+type revokeSessionCommand struct {
+	refreshTokenRepo repository.RefreshTokenRepository
+	log              *zerolog.Logger
+}
+
+func NewRevokeSessionCommand(refreshTokenRepo repository.RefreshTokenRepository) contracts.RevokeSessionCommand {
+	return &revokeSessionCommand{
+		refreshTokenRepo: refreshTokenRepo,
+		log:              logger.Component("auth.command.revoke_session"),
+	}
+}
+
+func (c *revokeSessionCommand) Execute(ctx context.Context, req contracts.RevokeSessionCommandRequest) error {
+	family, err := c.refreshTokenRepo.GetFamily(ctx, req.SessionID)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrRefreshTokenFamilyNotFound) {
+			return errors.NewNotFoundError(errors.CodeSessionNotFound)
+		}
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Str("session_id", req.SessionID).
+			Msg("failed to get session")
+		return err
+	}
+
+	// Do not reveal sessions of other users
+	if family.UserID != req.UserID || family.IsRevoked() {
+		return errors.NewNotFoundError(errors.CodeSessionNotFound)
+	}
+
+	family.Revoke(refresh_token.RevokeReasonSessionKilled)
+	if err := c.refreshTokenRepo.RevokeFamily(ctx, family); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Str("session_id", req.SessionID).
+			Msg("failed to revoke session")
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Str("session_id", req.SessionID).
+		Msg("session revoked")
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package command
+
+import (
+	"context"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevokeSessionCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+
+	session := refresh_token.NewFamily("user-1", refresh_token.Device{UserAgent: "test-agent"})
+	if err := refreshTokenRepo.CreateFamily(ctx, session); err != nil {
+		t.Fatalf("Failed to create session: %v", err)
+	}
+
+	cmd := NewRevokeSessionCommand(refreshTokenRepo)
+
+	t.Run("Other User's Session", func(t *testing.T) {
+		err := cmd.Execute(ctx, contracts.RevokeSessionCommandRequest{UserID: "user-2", SessionID: session.ID})
+
+		assert.NotNil(t, err)
+		appErr, ok := err.(*pkgerrors.AppError)
+		assert.True(t, ok)
+		assert.Equal(t, pkgerrors.CodeSessionNotFound, appErr.Code)
+
+		stored, _ := refreshTokenRepo.GetFamily(ctx, session.ID)
+		assert.False(t, stored.IsRevoked())
+	})
+
+	t.Run("Successful Session Revocation", func(t *testing.T) {
+		err := cmd.Execute(ctx, contracts.RevokeSessionCommandRequest{UserID: "user-1", SessionID: session.ID})
+
+		assert.Nil(t, err)
+		stored, _ := refreshTokenRepo.GetFamily(ctx, session.ID)
+		assert.True(t, stored.IsRevoked())
+		assert.Equal(t, refresh_token.RevokeReasonSessionKilled, stored.RevokeReason)
+	})
+
+	t.Run("Already Revoked Session", func(t *testing.T) {
+		err := cmd.Execute(ctx, contracts.RevokeSessionCommandRequest{UserID: "user-1", SessionID: session.ID})
+
+		assert.NotNil(t, err)
+		assert.IsType(t, &pkgerrors.AppError{}, err)
+	})
+
+	t.Run("Unknown Session", func(t *testing.T) {
+		err := cmd.Execute(ctx, contracts.RevokeSessionCommandRequest{UserID: "user-1", SessionID: "missing"})
+
+		assert.NotNil(t, err)
+		assert.IsType(t, &pkgerrors.AppError{}, err)
+	})
+}
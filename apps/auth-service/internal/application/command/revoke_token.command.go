@@ -2,13 +2,15 @@ package command
 
 import (
 	"context"
+	stderrors "errors"
 	"time"
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
 	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/redis"
 	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 )
@@ -17,17 +19,20 @@ var _ contracts.RevokeTokenCommand = (*revokeTokenCommand)(nil)
 
 type revokeTokenCommand struct {
 	jwtService         *jwt.Service
-	tokenBlacklistRepo *redis.TokenBlacklistRepository
+	tokenBlacklistRepo tokenBlacklist
+	refreshTokenRepo   repository.RefreshTokenRepository
 	log                *zerolog.Logger
 }
 
 func NewRevokeTokenCommand(
 	jwtService *jwt.Service,
-	tokenBlacklistRepo *redis.TokenBlacklistRepository,
+	tokenBlacklistRepo tokenBlacklist,
+	refreshTokenRepo repository.RefreshTokenRepository,
 ) contracts.RevokeTokenCommand {
 	return &revokeTokenCommand{
 		jwtService:         jwtService,
 		tokenBlacklistRepo: tokenBlacklistRepo,
+		refreshTokenRepo:   refreshTokenRepo,
 		log:                logger.Component("auth.command.revoke_token"),
 	}
 }
@@ -42,22 +47,10 @@ func (c *revokeTokenCommand) Execute(ctx context.Context, req contracts.RevokeTo
 			c.log.Warn().
 				Err(err).
 				Msg("invalid token provided for revocation")
-			return errors.NewUnauthorizedError("invalid token")
+			return errors.NewUnauthorizedErrorWithCode(errors.CodeTokenInvalid)
 		}
-		// It's a refresh token, use longer expiration
-		tokenID := user.NewTokenID(req.Token)
-		expiration := 8 * 24 * time.Hour // Refresh token expires in 7 days, add buffer
-		if err := c.tokenBlacklistRepo.AddToken(ctx, tokenID.String(), expiration); err != nil {
-			c.log.Error().
-				Err(err).
-				Str("user_id", userID).
-				Msg("failed to revoke refresh token")
-			return err
-		}
-		c.log.Info().
-			Str("user_id", userID).
-			Msg("refresh token revoked successfully")
-		return nil
+		// It's a refresh token, revoke its whole family
+		return c.revokeRefreshToken(ctx, userID, req.Token)
 	}
 
 	// It's an access token
@@ -78,3 +71,38 @@ func (c *revokeTokenCommand) Execute(ctx context.Context, req contracts.RevokeTo
 	return nil
 }
 
+// revokeRefreshToken revokes the family of a refresh token so neither it nor any rotated successor can be used
+func (c *revokeTokenCommand) revokeRefreshToken(ctx context.Context, userID string, token string) error {
+	tokenHash := user.NewTokenID(token).String()
+	stored, err := c.refreshTokenRepo.GetTokenByHash(ctx, tokenHash)
+	if err != nil {
+		if stderrors.Is(err, repository.ErrRefreshTokenNotFound) {
+			// Tokens unknown to the store can never be refreshed, nothing to revoke
+			c.log.Info().
+				Str("user_id", userID).
+				Msg("refresh token not found, nothing to revoke")
+			return nil
+		}
+		return err
+	}
+
+	family, err := c.refreshTokenRepo.GetFamily(ctx, stored.FamilyID)
+	if err != nil {
+		return err
+	}
+	family.Revoke(refresh_token.RevokeReasonRevoked)
+	if err := c.refreshTokenRepo.RevokeFamily(ctx, family); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userID).
+			Str("family_id", family.ID).
+			Msg("failed to revoke refresh token family")
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", userID).
+		Str("family_id", family.ID).
+		Msg("refresh token revoked successfully")
+	return nil
+}
@@ -7,9 +7,10 @@ import (
 	"time"
 
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
 	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/redis"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 	pkgerrors "golang-social-media/pkg/errors"
 
 	"github.com/stretchr/testify/assert"
@@ -34,7 +35,7 @@ func (m *MockRevokeTokenBlacklistRepository) IsBlacklisted(ctx context.Context,
 func TestRevokeTokenCommand_Execute(t *testing.T) {
 	ctx := context.Background()
 	jwtService := jwt.NewService("test-secret", 1, 168)
-	mockBlacklistRepo := new(MockRevokeTokenBlacklistRepository)
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
 
 	testUserID := "user-123"
 	tokenPair, err := jwtService.GenerateTokenPair(testUserID)
@@ -42,10 +43,21 @@ func TestRevokeTokenCommand_Execute(t *testing.T) {
 		t.Fatalf("Failed to generate token pair: %v", err)
 	}
 
+	// Store the refresh token in a family as login does
+	family := refresh_token.NewFamily(testUserID, refresh_token.Device{})
+	if err := refreshTokenRepo.CreateFamily(ctx, family); err != nil {
+		t.Fatalf("Failed to create family: %v", err)
+	}
+	storedToken := refresh_token.NewToken(family, user.NewTokenID(tokenPair.RefreshToken).String(), "", time.Now().Add(time.Hour))
+	if err := refreshTokenRepo.CreateToken(ctx, storedToken); err != nil {
+		t.Fatalf("Failed to create refresh token: %v", err)
+	}
+
 	t.Run("Successful Access Token Revocation", func(t *testing.T) {
+		mockBlacklistRepo := new(MockRevokeTokenBlacklistRepository)
 		mockBlacklistRepo.On("AddToken", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Duration")).Return(nil)
 
-		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo)
+		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo, refreshTokenRepo)
 		req := contracts.RevokeTokenCommandRequest{
 			Token: tokenPair.AccessToken,
 		}
@@ -57,9 +69,8 @@ func TestRevokeTokenCommand_Execute(t *testing.T) {
 	})
 
 	t.Run("Successful Refresh Token Revocation", func(t *testing.T) {
-		mockBlacklistRepo.On("AddToken", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Duration")).Return(nil)
-
-		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo)
+		mockBlacklistRepo := new(MockRevokeTokenBlacklistRepository)
+		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo, refreshTokenRepo)
 		req := contracts.RevokeTokenCommandRequest{
 			Token: tokenPair.RefreshToken,
 		}
@@ -67,11 +78,17 @@ func TestRevokeTokenCommand_Execute(t *testing.T) {
 		err := cmd.Execute(ctx, req)
 
 		assert.Nil(t, err)
-		mockBlacklistRepo.AssertExpectations(t)
+
+		// Refresh tokens are revoked by revoking their family, not via the blacklist
+		revokedFamily, err := refreshTokenRepo.GetFamily(ctx, family.ID)
+		assert.Nil(t, err)
+		assert.True(t, revokedFamily.IsRevoked())
+		assert.Equal(t, refresh_token.RevokeReasonRevoked, revokedFamily.RevokeReason)
 	})
 
 	t.Run("Invalid Token", func(t *testing.T) {
-		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo)
+		mockBlacklistRepo := new(MockRevokeTokenBlacklistRepository)
+		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo, refreshTokenRepo)
 		req := contracts.RevokeTokenCommandRequest{
 			Token: "invalid-token",
 		}
@@ -84,10 +101,11 @@ func TestRevokeTokenCommand_Execute(t *testing.T) {
 	})
 
 	t.Run("Blacklist Add Fails", func(t *testing.T) {
+		mockBlacklistRepo := new(MockRevokeTokenBlacklistRepository)
 		blacklistErr := errors.New("redis connection error")
 		mockBlacklistRepo.On("AddToken", ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Duration")).Return(blacklistErr)
 
-		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo)
+		cmd := NewRevokeTokenCommand(jwtService, mockBlacklistRepo, refreshTokenRepo)
 		req := contracts.RevokeTokenCommandRequest{
 			Token: tokenPair.AccessToken,
 		}
@@ -99,4 +117,3 @@ func TestRevokeTokenCommand_Execute(t *testing.T) {
 		mockBlacklistRepo.AssertExpectations(t)
 	})
 }
-
@@ -0,0 +1,78 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/mailer"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.SendVerificationEmailCommand = (*sendVerificationEmailCommand)(nil)
+
+type sendVerificationEmailCommand struct {
+	uowFactory  unit_of_work.Factory
+	mailer      mailer.Mailer
+	emailConfig VerificationEmailConfig
+	log         *zerolog.Logger
+}
+
+func NewSendVerificationEmailCommand(
+	uowFactory unit_of_work.Factory,
+	mailer mailer.Mailer,
+	emailConfig VerificationEmailConfig,
+) contracts.SendVerificationEmailCommand {
+	return &sendVerificationEmailCommand{
+		uowFactory:  uowFactory,
+		mailer:      mailer,
+		emailConfig: emailConfig,
+		log:         logger.Component("auth.command.send_verification_email"),
+	}
+}
+
+func (c *sendVerificationEmailCommand) Execute(ctx context.Context, req contracts.SendVerificationEmailCommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	userEntity, err := uow.Users().GetByID(req.UserID)
+	if err != nil {
+		return err
+	}
+	if userEntity.EmailVerified {
+		return errors.NewConflictError(errors.CodeEmailAlreadyVerified)
+	}
+
+	rawToken, err := issueVerificationToken(ctx, uow.VerificationTokens(), userEntity.ID, verification_token.PurposeEmailVerification, c.emailConfig.EmailVerificationTTL)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to issue email verification token")
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return err
+	}
+
+	if err := c.mailer.Send(ctx, c.emailConfig.verificationMessage(userEntity, rawToken)); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to send verification email")
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Msg("verification email sent")
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.SetRoleParentCommand = (*setRoleParentCommand)(nil)
+
+type setRoleParentCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewSetRoleParentCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.SetRoleParentCommand {
+	return &setRoleParentCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.set_role_parent"),
+	}
+}
+
+func (c *setRoleParentCommand) Execute(ctx context.Context, req contracts.SetRoleParentCommandRequest) error {
+	var roleEntity role.Role
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]role.DomainEvent, error) {
+		var err error
+		roleEntity, err = uow.Roles().GetByID(req.RoleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if req.ParentID != "" {
+			if err := ensureNoCycle(uow.Roles(), roleEntity.ID, req.ParentID); err != nil {
+				return nil, err
+			}
+		}
+
+		roleEntity.SetParent(req.ParentID)
+		if err := roleEntity.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := uow.Roles().Update(roleEntity); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("role_id", req.RoleID).
+				Str("parent_id", req.ParentID).
+				Msg("failed to set role parent")
+			return nil, err
+		}
+		return roleEntity.Events(), nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("role_id", roleEntity.ID).
+		Str("parent_id", roleEntity.ParentID).
+		Msg("role parent set")
+
+	return nil
+}
+
+// ensureNoCycle walks up from the new parent and fails if it reaches the role itself
+func ensureNoCycle(roles repository.RoleRepository, roleID, parentID string) error {
+	visited := make(map[string]bool)
+	for ancestorID := parentID; ancestorID != ""; {
+		if ancestorID == roleID {
+			return errors.NewValidationError(errors.CodeRoleInheritanceCycle, nil)
+		}
+		// Stop on cycles that already exist above the parent
+		if visited[ancestorID] {
+			return nil
+		}
+		visited[ancestorID] = true
+
+		ancestor, err := roles.GetByID(ancestorID)
+		if err != nil {
+			return err
+		}
+		ancestorID = ancestor.ParentID
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.UnlockUserCommand = (*unlockUserCommand)(nil)
+
+type unlockUserCommand struct {
+	userRepo repository.UserRepository
+	lockout  *LoginLockout
+	log      *zerolog.Logger
+}
+
+func NewUnlockUserCommand(
+	userRepo repository.UserRepository,
+	lockout *LoginLockout,
+) contracts.UnlockUserCommand {
+	return &unlockUserCommand{
+		userRepo: userRepo,
+		lockout:  lockout,
+		log:      logger.Component("auth.command.unlock_user"),
+	}
+}
+
+func (c *unlockUserCommand) Execute(ctx context.Context, req contracts.UnlockUserCommandRequest) error {
+	// Make sure the user exists so typos do not silently succeed
+	if _, err := c.userRepo.GetByID(req.UserID); err != nil {
+		return err
+	}
+
+	if err := c.lockout.Unlock(ctx, req.UserID); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to unlock user")
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", req.UserID).
+		Msg("user unlocked")
+
+	return nil
+}
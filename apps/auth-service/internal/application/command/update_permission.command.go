@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.UpdatePermissionCommand = (*updatePermissionCommand)(nil)
+
+type updatePermissionCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewUpdatePermissionCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.UpdatePermissionCommand {
+	return &updatePermissionCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.update_permission"),
+	}
+}
+
+func (c *updatePermissionCommand) Execute(ctx context.Context, req contracts.UpdatePermissionCommandRequest) (contracts.UpdatePermissionCommandResponse, error) {
+	var perm permission.Permission
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]permission.DomainEvent, error) {
+		var err error
+		perm, err = uow.Permissions().GetByID(req.PermissionID)
+		if err != nil {
+			return nil, err
+		}
+
+		perm.Update(strings.TrimSpace(req.Name), strings.TrimSpace(req.Resource), strings.TrimSpace(req.Action))
+		if err := perm.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := uow.Permissions().Update(perm); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("permission_id", req.PermissionID).
+				Msg("failed to update permission")
+			return nil, err
+		}
+		return perm.Events(), nil
+	})
+	if err != nil {
+		return contracts.UpdatePermissionCommandResponse{}, err
+	}
+
+	c.log.Info().
+		Str("permission_id", perm.ID).
+		Str("resource", perm.Resource).
+		Str("action", perm.Action).
+		Msg("permission updated")
+
+	return contracts.UpdatePermissionCommandResponse{
+		ID:       perm.ID,
+		Name:     perm.Name,
+		Resource: perm.Resource,
+		Action:   perm.Action,
+	}, nil
+}
@@ -5,8 +5,8 @@ import (
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
 	"golang-social-media/apps/auth-service/internal/domain/factories"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 )
@@ -14,32 +14,31 @@ import (
 var _ contracts.UpdateProfileCommand = (*updateProfileCommand)(nil)
 
 type updateProfileCommand struct {
-	userRepo        *memory.UserRepository
-	userFactory     factories.UserFactory
-	eventDispatcher eventDispatcher
-	log             *zerolog.Logger
-}
-
-type eventDispatcher interface {
-	Dispatch(ctx context.Context, event interface{}) error
+	uowFactory  unit_of_work.Factory
+	userFactory factories.UserFactory
+	log         *zerolog.Logger
 }
 
 func NewUpdateProfileCommand(
-	userRepo *memory.UserRepository,
+	uowFactory unit_of_work.Factory,
 	userFactory factories.UserFactory,
-	eventDispatcher eventDispatcher,
 ) contracts.UpdateProfileCommand {
 	return &updateProfileCommand{
-		userRepo:        userRepo,
-		userFactory:     userFactory,
-		eventDispatcher: eventDispatcher,
-		log:             logger.Component("auth.command.update_profile"),
+		uowFactory:  uowFactory,
+		userFactory: userFactory,
+		log:         logger.Component("auth.command.update_profile"),
 	}
 }
 
 func (c *updateProfileCommand) Execute(ctx context.Context, req contracts.UpdateProfileCommandRequest) (contracts.UpdateProfileCommandResponse, error) {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return contracts.UpdateProfileCommandResponse{}, err
+	}
+	defer uow.Rollback()
+
 	// Get user from repository
-	user, err := c.userRepo.GetByID(req.UserID)
+	user, err := uow.Users().GetByID(req.UserID)
 	if err != nil {
 		c.log.Error().
 			Err(err).
@@ -66,8 +65,12 @@ func (c *updateProfileCommand) Execute(ctx context.Context, req contracts.Update
 		}, nil
 	}
 
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := user.Events()
+	user.ClearEvents()
+
 	// Persist changes
-	if err := c.userRepo.Update(user); err != nil {
+	if err := uow.Users().Update(user); err != nil {
 		c.log.Error().
 			Err(err).
 			Str("user_id", user.ID).
@@ -75,18 +78,25 @@ func (c *updateProfileCommand) Execute(ctx context.Context, req contracts.Update
 		return contracts.UpdateProfileCommandResponse{}, err
 	}
 
-	// Dispatch domain events
-	domainEvents := user.Events()
-	user.ClearEvents()
+	// Save events to outbox and event store within the same transaction
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", user.ID).
+			Msg("failed to save events")
+		return contracts.UpdateProfileCommandResponse{}, err
+	}
 
-	for _, domainEvent := range domainEvents {
-		if err := c.eventDispatcher.Dispatch(ctx, domainEvent); err != nil {
-			c.log.Error().
-				Err(err).
-				Str("event_type", domainEvent.Type()).
-				Str("user_id", user.ID).
-				Msg("failed to dispatch domain event")
-		}
+	if err := uow.Commit(); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", user.ID).
+			Msg("failed to commit transaction")
+		return contracts.UpdateProfileCommandResponse{}, err
 	}
 
 	c.log.Info().
@@ -2,34 +2,18 @@ package command
 
 import (
 	"context"
-	"errors"
 	"testing"
 
 	"golang-social-media/apps/auth-service/internal/application/command/contracts"
 	"golang-social-media/apps/auth-service/internal/domain/factories"
+	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 )
 
-// mockEventDispatcher is a mock implementation of EventDispatcher for testing
-type mockUpdateProfileEventDispatcher struct {
-	dispatch func(ctx context.Context, event interface{}) error
-}
-
-func newMockUpdateProfileEventDispatcher() *mockUpdateProfileEventDispatcher {
-	return &mockUpdateProfileEventDispatcher{}
-}
-
-func (m *mockUpdateProfileEventDispatcher) Dispatch(ctx context.Context, event interface{}) error {
-	if m.dispatch != nil {
-		return m.dispatch(ctx, event)
-	}
-	return nil
-}
-
 func TestUpdateProfileCommand_Execute(t *testing.T) {
 	repo := memory.NewUserRepository(nil)
 	factory := factories.NewUserFactory()
-	dispatcher := newMockUpdateProfileEventDispatcher()
+	uowFactory := memory.NewUnitOfWorkFactory(repo, memory.NewRefreshTokenRepository())
 
 	// Create a test user
 	testUser, err := factory.CreateUser("test@example.com", "password123", "Old Name")
@@ -40,7 +24,7 @@ func TestUpdateProfileCommand_Execute(t *testing.T) {
 		t.Fatalf("Failed to save test user: %v", err)
 	}
 
-	cmd := NewUpdateProfileCommand(repo, factory, dispatcher)
+	cmd := NewUpdateProfileCommand(uowFactory, factory)
 
 	ctx := context.Background()
 	req := contracts.UpdateProfileCommandRequest{
@@ -103,25 +87,19 @@ func TestUpdateProfileCommand_Execute(t *testing.T) {
 		}
 	})
 
-	t.Run("Event Dispatch Error (Should Not Fail)", func(t *testing.T) {
-		dispatcher.dispatch = func(ctx context.Context, event interface{}) error {
-			return errors.New("dispatch error")
-		}
-
-		req := contracts.UpdateProfileCommandRequest{
-			UserID: testUser.ID,
-			Name:   "Another Name",
+	t.Run("Profile Updated Event Saved With The Change", func(t *testing.T) {
+		var saved []user.UserProfileUpdatedEvent
+		for _, event := range uowFactory.Events() {
+			if updated, ok := event.(user.UserProfileUpdatedEvent); ok {
+				saved = append(saved, updated)
+			}
 		}
-
-		// Command should still succeed even if event dispatch fails
-		resp, err := cmd.Execute(ctx, req)
-		if err != nil {
-			t.Fatalf("Execute() should not fail on event dispatch error, got %v", err)
+		// Only the first subtest changed the name
+		if len(saved) != 1 {
+			t.Fatalf("saved UserProfileUpdatedEvent count = %d, want 1", len(saved))
 		}
-
-		if resp.Name != "Another Name" {
-			t.Errorf("Response.Name = %v, want %v", resp.Name, "Another Name")
+		if saved[0].OldName != "Old Name" || saved[0].NewName != "New Name" {
+			t.Errorf("event names = %v -> %v, want Old Name -> New Name", saved[0].OldName, saved[0].NewName)
 		}
 	})
 }
-
@@ -0,0 +1,71 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.UpdateRoleCommand = (*updateRoleCommand)(nil)
+
+type updateRoleCommand struct {
+	uowFactory      unit_of_work.Factory
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewUpdateRoleCommand(
+	uowFactory unit_of_work.Factory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.UpdateRoleCommand {
+	return &updateRoleCommand{
+		uowFactory:      uowFactory,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("auth.command.update_role"),
+	}
+}
+
+func (c *updateRoleCommand) Execute(ctx context.Context, req contracts.UpdateRoleCommandRequest) (contracts.UpdateRoleCommandResponse, error) {
+	var roleEntity role.Role
+	err := saveRBACChange(ctx, c.uowFactory, c.eventDispatcher, c.log, func(uow unit_of_work.UnitOfWork) ([]role.DomainEvent, error) {
+		var err error
+		roleEntity, err = uow.Roles().GetByID(req.RoleID)
+		if err != nil {
+			return nil, err
+		}
+
+		roleEntity.Update(strings.TrimSpace(req.Name), req.Description)
+		if err := roleEntity.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := uow.Roles().Update(roleEntity); err != nil {
+			c.log.Error().
+				Err(err).
+				Str("role_id", req.RoleID).
+				Msg("failed to update role")
+			return nil, err
+		}
+		return roleEntity.Events(), nil
+	})
+	if err != nil {
+		return contracts.UpdateRoleCommandResponse{}, err
+	}
+
+	c.log.Info().
+		Str("role_id", roleEntity.ID).
+		Str("name", roleEntity.Name).
+		Msg("role updated")
+
+	return contracts.UpdateRoleCommandResponse{
+		ID:          roleEntity.ID,
+		Name:        roleEntity.Name,
+		Description: roleEntity.Description,
+	}, nil
+}
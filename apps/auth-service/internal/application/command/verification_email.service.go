@@ -0,0 +1,98 @@
+package command
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	stderrors "errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/mailer"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+)
+
+// VerificationEmailConfig configures the links sent for email verification and password reset
+type VerificationEmailConfig struct {
+	// BaseURL is the frontend URL the links point to, e.g. https://app.example.com
+	BaseURL              string
+	EmailVerificationTTL time.Duration
+	PasswordResetTTL     time.Duration
+}
+
+// issueVerificationToken invalidates the user's previous tokens for the purpose and stores a new one.
+// The raw token is returned so it can be put in the email; only its hash is persisted.
+func issueVerificationToken(ctx context.Context, repo repository.VerificationTokenRepository, userID string, purpose verification_token.Purpose, ttl time.Duration) (string, error) {
+	if err := repo.InvalidateForUser(ctx, userID, purpose); err != nil {
+		return "", err
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	rawToken := base64.RawURLEncoding.EncodeToString(buf)
+
+	token := verification_token.NewToken(userID, purpose, user.NewTokenID(rawToken).String(), ttl)
+	if err := repo.Create(ctx, token); err != nil {
+		return "", err
+	}
+	return rawToken, nil
+}
+
+// consumeVerificationToken looks up a raw token and marks it as used.
+// Unknown, expired, already used or wrong-purpose tokens all return ErrVerificationTokenNotFound.
+func consumeVerificationToken(ctx context.Context, repo repository.VerificationTokenRepository, rawToken string, purpose verification_token.Purpose) (verification_token.Token, error) {
+	if rawToken == "" {
+		return verification_token.Token{}, repository.ErrVerificationTokenNotFound
+	}
+
+	token, err := repo.GetByHash(ctx, user.NewTokenID(rawToken).String())
+	if err != nil {
+		return verification_token.Token{}, err
+	}
+	if !token.IsUsableFor(purpose) {
+		return verification_token.Token{}, repository.ErrVerificationTokenNotFound
+	}
+
+	token.Use()
+	if err := repo.MarkUsed(ctx, token); err != nil {
+		if stderrors.Is(err, repository.ErrVerificationTokenAlreadyUsed) {
+			return verification_token.Token{}, repository.ErrVerificationTokenNotFound
+		}
+		return verification_token.Token{}, err
+	}
+	return token, nil
+}
+
+// verificationMessage builds the email verification email
+func (c VerificationEmailConfig) verificationMessage(u user.User, rawToken string) mailer.Message {
+	return mailer.Message{
+		To:      u.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nPlease verify your email address by opening the link below:\n\n%s\n\nThe link expires in %s.\n",
+			u.Name, c.link("/verify-email", rawToken), c.EmailVerificationTTL,
+		),
+	}
+}
+
+// passwordResetMessage builds the password reset email
+func (c VerificationEmailConfig) passwordResetMessage(u user.User, rawToken string) mailer.Message {
+	return mailer.Message{
+		To:      u.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWe received a request to reset your password. Open the link below to choose a new one:\n\n%s\n\nThe link expires in %s. If you did not request this, you can ignore this email.\n",
+			u.Name, c.link("/reset-password", rawToken), c.PasswordResetTTL,
+		),
+	}
+}
+
+func (c VerificationEmailConfig) link(path, rawToken string) string {
+	return strings.TrimRight(c.BaseURL, "/") + path + "?token=" + url.QueryEscape(rawToken)
+}
@@ -0,0 +1,81 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.VerifyEmailCommand = (*verifyEmailCommand)(nil)
+
+type verifyEmailCommand struct {
+	uowFactory unit_of_work.Factory
+	log        *zerolog.Logger
+}
+
+func NewVerifyEmailCommand(uowFactory unit_of_work.Factory) contracts.VerifyEmailCommand {
+	return &verifyEmailCommand{
+		uowFactory: uowFactory,
+		log:        logger.Component("auth.command.verify_email"),
+	}
+}
+
+func (c *verifyEmailCommand) Execute(ctx context.Context, req contracts.VerifyEmailCommandRequest) error {
+	uow, err := c.uowFactory.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer uow.Rollback()
+
+	token, err := consumeVerificationToken(ctx, uow.VerificationTokens(), req.Token, verification_token.PurposeEmailVerification)
+	if err != nil {
+		c.log.Warn().
+			Err(err).
+			Msg("invalid email verification token")
+		return err
+	}
+
+	userEntity, err := uow.Users().GetByID(token.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := userEntity.VerifyEmail(); err != nil {
+		return err
+	}
+
+	// Take events before persisting so the stored entity does not carry them
+	domainEvents := userEntity.Events()
+	userEntity.ClearEvents()
+
+	if err := uow.Users().Update(userEntity); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", userEntity.ID).
+			Msg("failed to mark email as verified")
+		return err
+	}
+
+	// Save events to outbox and event store within the same transaction
+	events := make([]interface{}, len(domainEvents))
+	for i, event := range domainEvents {
+		events[i] = event
+	}
+	if err := uow.SaveEvents(ctx, events); err != nil {
+		return err
+	}
+
+	if err := uow.Commit(); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", userEntity.ID).
+		Msg("email verified")
+
+	return nil
+}
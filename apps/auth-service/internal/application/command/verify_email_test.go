@@ -0,0 +1,123 @@
+package command
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/application/mailer"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingMailer keeps sent messages so tests can follow the emailed links
+type recordingMailer struct {
+	messages []mailer.Message
+}
+
+func (m *recordingMailer) Send(ctx context.Context, msg mailer.Message) error {
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+var linkPattern = regexp.MustCompile(`https?://\S+`)
+
+// lastToken returns the token query parameter of the link in the last sent email
+func (m *recordingMailer) lastToken(t *testing.T) string {
+	t.Helper()
+	if len(m.messages) == 0 {
+		t.Fatal("no email sent")
+	}
+	link, err := url.Parse(linkPattern.FindString(m.messages[len(m.messages)-1].Body))
+	if err != nil {
+		t.Fatalf("Failed to parse link: %v", err)
+	}
+	return link.Query().Get("token")
+}
+
+func testEmailConfig() VerificationEmailConfig {
+	return VerificationEmailConfig{
+		BaseURL:              "https://app.example.com",
+		EmailVerificationTTL: 24 * time.Hour,
+		PasswordResetTTL:     time.Hour,
+	}
+}
+
+func TestVerifyEmailCommand_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Verifies Email From Link", func(t *testing.T) {
+		uowFactory, userRepo, _ := setupMFATest(t)
+		m := &recordingMailer{}
+
+		err := NewSendVerificationEmailCommand(uowFactory, m, testEmailConfig()).
+			Execute(ctx, contracts.SendVerificationEmailCommandRequest{UserID: "user-1"})
+		assert.NoError(t, err)
+		if assert.Len(t, m.messages, 1) {
+			assert.Equal(t, "test@example.com", m.messages[0].To)
+			assert.Contains(t, m.messages[0].Body, "https://app.example.com/verify-email?token=")
+		}
+
+		cmd := NewVerifyEmailCommand(uowFactory)
+		err = cmd.Execute(ctx, contracts.VerifyEmailCommandRequest{Token: m.lastToken(t)})
+		assert.NoError(t, err)
+
+		stored, _ := userRepo.GetByID("user-1")
+		assert.True(t, stored.EmailVerified)
+
+		events := uowFactory.Events()
+		if assert.Len(t, events, 1) {
+			_, ok := events[0].(user.UserEmailVerifiedEvent)
+			assert.True(t, ok)
+		}
+	})
+
+	t.Run("Token Is Single Use", func(t *testing.T) {
+		uowFactory, _, _ := setupMFATest(t)
+		m := &recordingMailer{}
+		_ = NewSendVerificationEmailCommand(uowFactory, m, testEmailConfig()).
+			Execute(ctx, contracts.SendVerificationEmailCommandRequest{UserID: "user-1"})
+		token := m.lastToken(t)
+
+		cmd := NewVerifyEmailCommand(uowFactory)
+		assert.NoError(t, cmd.Execute(ctx, contracts.VerifyEmailCommandRequest{Token: token}))
+
+		err := cmd.Execute(ctx, contracts.VerifyEmailCommandRequest{Token: token})
+		assertErrorCode(t, err, pkgerrors.CodeVerificationTokenInvalid)
+	})
+
+	t.Run("Resend Invalidates Previous Link", func(t *testing.T) {
+		uowFactory, _, _ := setupMFATest(t)
+		m := &recordingMailer{}
+		send := NewSendVerificationEmailCommand(uowFactory, m, testEmailConfig())
+		_ = send.Execute(ctx, contracts.SendVerificationEmailCommandRequest{UserID: "user-1"})
+		first := m.lastToken(t)
+		_ = send.Execute(ctx, contracts.SendVerificationEmailCommandRequest{UserID: "user-1"})
+
+		err := NewVerifyEmailCommand(uowFactory).Execute(ctx, contracts.VerifyEmailCommandRequest{Token: first})
+		assertErrorCode(t, err, pkgerrors.CodeVerificationTokenInvalid)
+	})
+
+	t.Run("Already Verified", func(t *testing.T) {
+		uowFactory, userRepo, _ := setupMFATest(t)
+		stored, _ := userRepo.GetByID("user-1")
+		stored.EmailVerified = true
+		_ = userRepo.Update(stored)
+
+		err := NewSendVerificationEmailCommand(uowFactory, &recordingMailer{}, testEmailConfig()).
+			Execute(ctx, contracts.SendVerificationEmailCommandRequest{UserID: "user-1"})
+		assertErrorCode(t, err, pkgerrors.CodeEmailAlreadyVerified)
+	})
+
+	t.Run("Unknown Token", func(t *testing.T) {
+		uowFactory, _, _ := setupMFATest(t)
+
+		err := NewVerifyEmailCommand(uowFactory).Execute(ctx, contracts.VerifyEmailCommandRequest{Token: "unknown"})
+		assertErrorCode(t, err, pkgerrors.CodeVerificationTokenInvalid)
+	})
+}
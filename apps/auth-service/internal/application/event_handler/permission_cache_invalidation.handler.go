@@ -0,0 +1,77 @@
+package event_handler
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/pkg/logger"
+)
+
+// PermissionCacheInvalidationEvents lists the RBAC events that change some user's effective permissions
+var PermissionCacheInvalidationEvents = []string{
+	"UserRoleAssigned",
+	"UserRoleRevoked",
+	"RolePermissionAssigned",
+	"RolePermissionRevoked",
+	"RoleParentChanged",
+	"RoleDeleted",
+	"PermissionUpdated",
+	"PermissionDeleted",
+}
+
+// PermissionCacheInvalidationHandler drops cached effective permissions when RBAC data changes.
+// User role changes only affect that user; role and permission changes may affect anyone
+type PermissionCacheInvalidationHandler struct {
+	permissionCache repository.EffectivePermissionCache
+	log             *zerolog.Logger
+}
+
+// NewPermissionCacheInvalidationHandler creates a new PermissionCacheInvalidationHandler
+func NewPermissionCacheInvalidationHandler(permissionCache repository.EffectivePermissionCache) *PermissionCacheInvalidationHandler {
+	return &PermissionCacheInvalidationHandler{
+		permissionCache: permissionCache,
+		log:             logger.Component("auth.event_handler.permission_cache_invalidation"),
+	}
+}
+
+// Handle processes RBAC domain events
+func (h *PermissionCacheInvalidationHandler) Handle(ctx context.Context, domainEvent user.DomainEvent) error {
+	var userID string
+	switch event := domainEvent.(type) {
+	case user_role.UserRoleAssignedEvent:
+		userID = event.UserID
+	case user_role.UserRoleRevokedEvent:
+		userID = event.UserID
+	}
+
+	if userID != "" {
+		if err := h.permissionCache.InvalidateUser(ctx, userID); err != nil {
+			h.log.Error().
+				Err(err).
+				Str("event_type", domainEvent.Type()).
+				Str("user_id", userID).
+				Msg("failed to invalidate user permissions")
+			return err
+		}
+		h.log.Debug().
+			Str("event_type", domainEvent.Type()).
+			Str("user_id", userID).
+			Msg("user permissions invalidated")
+		return nil
+	}
+
+	if err := h.permissionCache.InvalidateAll(ctx); err != nil {
+		h.log.Error().
+			Err(err).
+			Str("event_type", domainEvent.Type()).
+			Msg("failed to invalidate permissions")
+		return err
+	}
+	h.log.Debug().
+		Str("event_type", domainEvent.Type()).
+		Msg("all cached permissions invalidated")
+	return nil
+}
@@ -0,0 +1,16 @@
+package password_hasher
+
+// PasswordHasher hashes and verifies user passwords.
+// Hashes are self-describing (PHC string format) so the algorithm and its parameters
+// can change over time while hashes stored earlier keep verifying.
+type PasswordHasher interface {
+	// Algorithm returns the identifier of the algorithm new hashes are created with
+	Algorithm() string
+	// Hash hashes a plain password with the current algorithm and parameters
+	Hash(password string) (string, error)
+	// Verify checks a plain password against a hash produced by any supported algorithm
+	Verify(password, encoded string) (bool, error)
+	// NeedsRehash reports whether a hash was produced by another algorithm
+	// or with weaker parameters than the current ones
+	NeedsRehash(encoded string) bool
+}
@@ -0,0 +1,59 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.BatchGetUsersQuery = (*batchGetUsersQuery)(nil)
+
+type batchGetUsersQuery struct {
+	repo repository.UserRepository
+	log  *zerolog.Logger
+}
+
+func NewBatchGetUsersQuery(repo repository.UserRepository) contracts.BatchGetUsersQuery {
+	return &batchGetUsersQuery{
+		repo: repo,
+		log:  logger.Component("auth.query.batch_get_users"),
+	}
+}
+
+// Execute returns the profiles of the users that exist, in request order; unknown IDs are skipped
+func (q *batchGetUsersQuery) Execute(ctx context.Context, userIDs []string) ([]auth.ProfileResponse, error) {
+	if len(userIDs) > contracts.MaxBatchGetUsers {
+		return nil, errors.NewInvalidRequestError(fmt.Sprintf("at most %d user IDs can be requested at once", contracts.MaxBatchGetUsers))
+	}
+
+	users, err := q.repo.GetByIDs(userIDs)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Int("requested", len(userIDs)).
+			Msg("failed to batch get users")
+		return nil, err
+	}
+
+	profiles := make([]auth.ProfileResponse, len(users))
+	for i, u := range users {
+		profiles[i] = auth.ProfileResponse{
+			ID:    u.ID,
+			Email: u.Email,
+			Name:  u.Name,
+		}
+	}
+
+	q.log.Debug().
+		Int("requested", len(userIDs)).
+		Int("found", len(profiles)).
+		Msg("users retrieved")
+
+	return profiles, nil
+}
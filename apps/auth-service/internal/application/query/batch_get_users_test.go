@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/factories"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+)
+
+func TestBatchGetUsersQuery_Execute(t *testing.T) {
+	repo := memory.NewUserRepository(nil)
+	factory := factories.NewUserFactory()
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		u, err := factory.CreateUser(fmt.Sprintf("user%d@example.com", i), "password123", fmt.Sprintf("User %d", i))
+		if err != nil {
+			t.Fatalf("Failed to create test user: %v", err)
+		}
+		if err := repo.Create(*u); err != nil {
+			t.Fatalf("Failed to save test user: %v", err)
+		}
+		ids = append(ids, u.ID)
+	}
+
+	query := NewBatchGetUsersQuery(repo)
+	ctx := context.Background()
+
+	t.Run("Returns Users In Request Order", func(t *testing.T) {
+		resp, err := query.Execute(ctx, []string{ids[2], "non-existent", ids[0], ids[2]})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if len(resp) != 2 {
+			t.Fatalf("Execute() returned %d users, want 2", len(resp))
+		}
+		if resp[0].ID != ids[2] || resp[1].ID != ids[0] {
+			t.Errorf("Execute() order = [%s %s], want [%s %s]", resp[0].ID, resp[1].ID, ids[2], ids[0])
+		}
+		if resp[0].Email != "user2@example.com" {
+			t.Errorf("Response.Email = %v, want user2@example.com", resp[0].Email)
+		}
+	})
+
+	t.Run("Too Many IDs", func(t *testing.T) {
+		tooMany := make([]string, contracts.MaxBatchGetUsers+1)
+		if _, err := query.Execute(ctx, tooMany); err == nil {
+			t.Error("Execute() should reject more than MaxBatchGetUsers IDs")
+		}
+	})
+}
@@ -0,0 +1,198 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockEffectivePermissionRepository is a mock implementation for testing
+type MockEffectivePermissionRepository struct {
+	mock.Mock
+}
+
+func (m *MockEffectivePermissionRepository) GetUserPermissions(userID string) ([]permission.Permission, error) {
+	args := m.Called(userID)
+	return args.Get(0).([]permission.Permission), args.Error(1)
+}
+
+// MockEffectivePermissionCache is a mock implementation for testing
+type MockEffectivePermissionCache struct {
+	mock.Mock
+}
+
+func (m *MockEffectivePermissionCache) Get(ctx context.Context, userID string) ([]permission.Grant, bool, error) {
+	args := m.Called(ctx, userID)
+	grants, _ := args.Get(0).([]permission.Grant)
+	return grants, args.Bool(1), args.Error(2)
+}
+
+func (m *MockEffectivePermissionCache) Set(ctx context.Context, userID string, grants []permission.Grant, ttl time.Duration) error {
+	args := m.Called(ctx, userID, grants, ttl)
+	return args.Error(0)
+}
+
+func (m *MockEffectivePermissionCache) InvalidateUser(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockEffectivePermissionCache) InvalidateAll(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func TestCheckPermissionQuery_Execute(t *testing.T) {
+	ctx := context.Background()
+	req := contracts.CheckPermissionQueryRequest{
+		UserID:   "user-1",
+		Resource: "chat",
+		Action:   "create",
+	}
+
+	t.Run("User Has Permission", func(t *testing.T) {
+		mockRepo := new(MockEffectivePermissionRepository)
+		mockRepo.On("GetUserPermissions", "user-1").Return([]permission.Permission{
+			{ID: "perm-1", Name: "Create Chat", Resource: "chat", Action: "create"},
+		}, nil)
+
+		query := NewCheckPermissionQuery(mockRepo, nil, time.Minute)
+		resp, err := query.Execute(ctx, req)
+
+		assert.Nil(t, err)
+		assert.True(t, resp.HasPermission)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("User Does Not Have Permission", func(t *testing.T) {
+		mockRepo := new(MockEffectivePermissionRepository)
+		mockRepo.On("GetUserPermissions", "user-1").Return([]permission.Permission{
+			{ID: "perm-2", Name: "Read Chat", Resource: "chat", Action: "read"},
+		}, nil)
+
+		query := NewCheckPermissionQuery(mockRepo, nil, time.Minute)
+		resp, err := query.Execute(ctx, req)
+
+		assert.Nil(t, err)
+		assert.False(t, resp.HasPermission)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Wildcard Permission", func(t *testing.T) {
+		for _, grant := range []permission.Permission{
+			{ID: "perm-all-chat", Resource: "chat", Action: permission.Wildcard},
+			{ID: "perm-all-create", Resource: permission.Wildcard, Action: "create"},
+			{ID: "perm-all", Resource: permission.Wildcard, Action: permission.Wildcard},
+		} {
+			mockRepo := new(MockEffectivePermissionRepository)
+			mockRepo.On("GetUserPermissions", "user-1").Return([]permission.Permission{grant}, nil)
+
+			query := NewCheckPermissionQuery(mockRepo, nil, time.Minute)
+			resp, err := query.Execute(ctx, req)
+
+			assert.Nil(t, err)
+			assert.True(t, resp.HasPermission, "%s:%s should match chat:create", grant.Resource, grant.Action)
+		}
+	})
+
+	t.Run("GetUserPermissions Fails", func(t *testing.T) {
+		mockRepo := new(MockEffectivePermissionRepository)
+		mockRepo.On("GetUserPermissions", "user-1").Return([]permission.Permission{}, errors.New("database error"))
+
+		query := NewCheckPermissionQuery(mockRepo, nil, time.Minute)
+		resp, err := query.Execute(ctx, req)
+
+		assert.NotNil(t, err)
+		assert.False(t, resp.HasPermission)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Cache Hit Skips Repository", func(t *testing.T) {
+		mockRepo := new(MockEffectivePermissionRepository)
+		mockCache := new(MockEffectivePermissionCache)
+		mockCache.On("Get", ctx, "user-1").Return([]permission.Grant{{Resource: "chat", Action: "create"}}, true, nil)
+
+		query := NewCheckPermissionQuery(mockRepo, mockCache, time.Minute)
+		resp, err := query.Execute(ctx, req)
+
+		assert.Nil(t, err)
+		assert.True(t, resp.HasPermission)
+		mockCache.AssertExpectations(t)
+		mockRepo.AssertNotCalled(t, "GetUserPermissions", mock.Anything)
+	})
+
+	t.Run("Cache Miss Populates Cache", func(t *testing.T) {
+		mockRepo := new(MockEffectivePermissionRepository)
+		mockCache := new(MockEffectivePermissionCache)
+		mockCache.On("Get", ctx, "user-1").Return(nil, false, nil)
+		mockRepo.On("GetUserPermissions", "user-1").Return([]permission.Permission{
+			{ID: "perm-1", Resource: "chat", Action: "create"},
+		}, nil)
+		mockCache.On("Set", ctx, "user-1", []permission.Grant{{Resource: "chat", Action: "create"}}, time.Minute).Return(nil)
+
+		query := NewCheckPermissionQuery(mockRepo, mockCache, time.Minute)
+		resp, err := query.Execute(ctx, req)
+
+		assert.Nil(t, err)
+		assert.True(t, resp.HasPermission)
+		mockRepo.AssertExpectations(t)
+		mockCache.AssertExpectations(t)
+	})
+
+	t.Run("Cache Failure Falls Back To Repository", func(t *testing.T) {
+		mockRepo := new(MockEffectivePermissionRepository)
+		mockCache := new(MockEffectivePermissionCache)
+		mockCache.On("Get", ctx, "user-1").Return(nil, false, errors.New("redis down"))
+		mockCache.On("Set", ctx, "user-1", mock.Anything, time.Minute).Return(errors.New("redis down"))
+		mockRepo.On("GetUserPermissions", "user-1").Return([]permission.Permission{
+			{ID: "perm-1", Resource: "chat", Action: "create"},
+		}, nil)
+
+		query := NewCheckPermissionQuery(mockRepo, mockCache, time.Minute)
+		resp, err := query.Execute(ctx, req)
+
+		assert.Nil(t, err)
+		assert.True(t, resp.HasPermission)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestCheckPermissionQuery_Execute_InheritedPermission(t *testing.T) {
+	ctx := context.Background()
+	repos := newRBACRepos()
+	repos.seedHierarchy(t)
+
+	// Caching must not change the outcome
+	for _, permissionCache := range []repository.EffectivePermissionCache{nil, memory.NewEffectivePermissionCache()} {
+		query := NewCheckPermissionQuery(repos.effective, permissionCache, time.Minute)
+
+		for _, tc := range []struct {
+			userID, resource, action string
+			want                     bool
+		}{
+			// admin inherits chat:delete from moderator
+			{"user-admin", "chat", "delete", true},
+			{"user-admin", "users", "unlock", true},
+			// orders:* granted to admin matches any action on orders
+			{"user-admin", "orders", "refund", true},
+			{"user-mod", "chat", "delete", true},
+			{"user-mod", "users", "unlock", false},
+			{"user-mod", "orders", "refund", false},
+		} {
+			resp, err := query.Execute(ctx, contracts.CheckPermissionQueryRequest{UserID: tc.userID, Resource: tc.resource, Action: tc.action})
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			assert.Equal(t, tc.want, resp.HasPermission, "%s %s:%s", tc.userID, tc.resource, tc.action)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// MaxBatchGetUsers caps how many users a single batch lookup may request
+const MaxBatchGetUsers = 100
+
+// BatchGetUsersQuery retrieves the profiles of several users at once
+type BatchGetUsersQuery interface {
+	Execute(ctx context.Context, userIDs []string) ([]auth.ProfileResponse, error)
+}
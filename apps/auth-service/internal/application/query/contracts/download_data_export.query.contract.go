@@ -0,0 +1,14 @@
+package contracts
+
+import "context"
+
+// DownloadDataExportQueryRequest represents download data export query request
+type DownloadDataExportQueryRequest struct {
+	RequestID string
+	UserID    string // Only the owner can download the export
+}
+
+// DownloadDataExportQuery builds the zip archive of a completed data export, one <service>.json document per service
+type DownloadDataExportQuery interface {
+	Execute(ctx context.Context, req DownloadDataExportQueryRequest) ([]byte, error)
+}
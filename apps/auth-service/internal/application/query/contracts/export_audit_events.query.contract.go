@@ -0,0 +1,13 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// ExportAuditEventsQuery streams every matching event of the audit trail, oldest first
+type ExportAuditEventsQuery interface {
+	// Execute calls write for each event and stops at the first error it returns
+	Execute(ctx context.Context, filter AuditEventFilter, write func(auth.AuditEventResponse) error) error
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// GetGDPRRequestQueryRequest represents get GDPR request query request
+type GetGDPRRequestQueryRequest struct {
+	RequestID string
+	UserID    string // Owner of the request; empty when an admin looks it up
+}
+
+// GetGDPRRequestQuery returns the progress of a deletion or data export
+type GetGDPRRequestQuery interface {
+	Execute(ctx context.Context, req GetGDPRRequestQueryRequest) (auth.GDPRRequestResponse, error)
+}
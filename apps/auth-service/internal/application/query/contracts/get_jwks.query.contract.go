@@ -0,0 +1,28 @@
+package contracts
+
+import (
+	"context"
+)
+
+// JSONWebKey represents a public signing key in JWK format
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	KeyID     string `json:"kid"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+	Y         string `json:"y,omitempty"`
+}
+
+// GetJWKSQueryResponse represents get JWKS query response
+type GetJWKSQueryResponse struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// GetJWKSQuery returns the public keys used to verify access tokens
+type GetJWKSQuery interface {
+	Execute(ctx context.Context) (GetJWKSQueryResponse, error)
+}
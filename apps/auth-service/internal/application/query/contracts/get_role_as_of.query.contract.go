@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// GetRoleAsOfQueryRequest represents get role as of query request
+type GetRoleAsOfQueryRequest struct {
+	RoleID string
+	AsOf   time.Time // Zero for now
+}
+
+// GetRoleAsOfQuery replays the role's events to show its state at a point in time
+type GetRoleAsOfQuery interface {
+	Execute(ctx context.Context, req GetRoleAsOfQueryRequest) (auth.RoleStateResponse, error)
+}
@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// GetUserAsOfQueryRequest represents get user as of query request
+type GetUserAsOfQueryRequest struct {
+	UserID string
+	AsOf   time.Time // Zero for now
+}
+
+// GetUserAsOfQuery replays the user's events to show its state at a point in time
+type GetUserAsOfQuery interface {
+	Execute(ctx context.Context, req GetUserAsOfQueryRequest) (auth.UserStateResponse, error)
+}
@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// GetUserPermissionsQuery returns the effective permissions of a user, granted through any of their roles
+type GetUserPermissionsQuery interface {
+	Execute(ctx context.Context, userID string) (auth.UserPermissionsResponse, error)
+}
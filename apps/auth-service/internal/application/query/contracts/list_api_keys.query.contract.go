@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// ListAPIKeysQuery lists the user's API keys that have not been revoked
+type ListAPIKeysQuery interface {
+	Execute(ctx context.Context, userID string) (auth.ListAPIKeysResponse, error)
+}
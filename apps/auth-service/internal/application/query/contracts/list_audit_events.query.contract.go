@@ -0,0 +1,30 @@
+package contracts
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// AuditEventFilter selects events of the audit trail; empty fields do not filter
+type AuditEventFilter struct {
+	AggregateID   string
+	AggregateType string   // User, Role or Permission
+	EventTypes    []string // Domain event types, e.g. UserRoleAssigned
+	ActorID       string   // User who caused the change
+	From          *time.Time
+	To            *time.Time
+}
+
+// ListAuditEventsQueryRequest represents list audit events query request
+type ListAuditEventsQueryRequest struct {
+	Filter AuditEventFilter
+	Cursor string // NextCursor of the previous page, empty for the first page
+	Limit  int
+}
+
+// ListAuditEventsQuery pages through the event store, newest first
+type ListAuditEventsQuery interface {
+	Execute(ctx context.Context, req ListAuditEventsQueryRequest) (auth.ListAuditEventsResponse, error)
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// ListPermissionsQueryRequest represents list permissions query request
+type ListPermissionsQueryRequest struct {
+	Limit  int // Page size, defaults to 20, capped at 100
+	Offset int
+}
+
+// ListPermissionsQuery lists permissions page by page
+type ListPermissionsQuery interface {
+	Execute(ctx context.Context, req ListPermissionsQueryRequest) (auth.ListPermissionsResponse, error)
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// ListRolesQueryRequest represents list roles query request
+type ListRolesQueryRequest struct {
+	Limit  int // Page size, defaults to 20, capped at 100
+	Offset int
+}
+
+// ListRolesQuery lists roles page by page
+type ListRolesQuery interface {
+	Execute(ctx context.Context, req ListRolesQueryRequest) (auth.ListRolesResponse, error)
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/contracts/auth"
+)
+
+// ListSessionsQueryRequest represents list sessions query request
+type ListSessionsQueryRequest struct {
+	UserID           string
+	CurrentSessionID string // Marks the caller's own session in the result
+}
+
+// ListSessionsQuery lists the user's active sessions
+type ListSessionsQuery interface {
+	Execute(ctx context.Context, req ListSessionsQueryRequest) (auth.ListSessionsResponse, error)
+}
@@ -2,16 +2,21 @@ package contracts
 
 import (
 	"context"
+	"time"
 )
 
-// ValidateTokenQueryResponse represents validate token query response
+// ValidateTokenQueryResponse represents validate token query response.
+// Roles and Permissions reflect the user's current RBAC assignments, not the ones at issuance
 type ValidateTokenQueryResponse struct {
-	Valid  bool
-	UserID string
+	Valid       bool
+	UserID      string
+	SessionID   string    // Session (refresh token family) the token was issued for, empty for tokens issued without one
+	Roles       []string  // Role names
+	Permissions []string  // Effective permissions as "resource:action"
+	ExpiresAt   time.Time // Expiry of the token
 }
 
 // ValidateTokenQuery validates a JWT token
 type ValidateTokenQuery interface {
 	Execute(ctx context.Context, token string) (ValidateTokenQueryResponse, error)
 }
-
@@ -0,0 +1,66 @@
+package query
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.DownloadDataExportQuery = (*downloadDataExportQuery)(nil)
+
+type downloadDataExportQuery struct {
+	gdprRequestRepo repository.GDPRRequestRepository
+	log             *zerolog.Logger
+}
+
+func NewDownloadDataExportQuery(gdprRequestRepo repository.GDPRRequestRepository) contracts.DownloadDataExportQuery {
+	return &downloadDataExportQuery{
+		gdprRequestRepo: gdprRequestRepo,
+		log:             logger.Component("auth.query.download_data_export"),
+	}
+}
+
+func (q *downloadDataExportQuery) Execute(ctx context.Context, req contracts.DownloadDataExportQueryRequest) ([]byte, error) {
+	request, err := getOwnedGDPRRequest(ctx, q.gdprRequestRepo, req.RequestID, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if request.Kind != gdpr_request.KindExport {
+		return nil, repository.ErrGDPRRequestNotFound
+	}
+	if !request.IsCompleted() {
+		return nil, errors.NewConflictError(errors.CodeDataExportNotReady)
+	}
+
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+	for _, step := range request.Steps {
+		file, err := archive.Create(step.Service + ".json")
+		if err != nil {
+			return nil, err
+		}
+		document := step.Document
+		if len(document) == 0 {
+			document = []byte("{}")
+		}
+		if _, err := file.Write(document); err != nil {
+			return nil, err
+		}
+	}
+	if err := archive.Close(); err != nil {
+		q.log.Error().
+			Err(err).
+			Str("request_id", request.ID).
+			Msg("failed to build data export archive")
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ExportAuditEventsQuery = (*exportAuditEventsQuery)(nil)
+
+type exportAuditEventsQuery struct {
+	auditLogRepo repository.AuditLogRepository
+	log          *zerolog.Logger
+}
+
+func NewExportAuditEventsQuery(auditLogRepo repository.AuditLogRepository) contracts.ExportAuditEventsQuery {
+	return &exportAuditEventsQuery{
+		auditLogRepo: auditLogRepo,
+		log:          logger.Component("auth.query.export_audit_events"),
+	}
+}
+
+func (q *exportAuditEventsQuery) Execute(ctx context.Context, filter contracts.AuditEventFilter, write func(auth.AuditEventResponse) error) error {
+	exported := 0
+	err := q.auditLogRepo.Stream(ctx, auditEventFilter(filter), func(event repository.AuditEvent) error {
+		if err := write(auditEventResponse(event)); err != nil {
+			return err
+		}
+		exported++
+		return nil
+	})
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Int("exported", exported).
+			Msg("failed to export audit events")
+		return err
+	}
+
+	q.log.Info().
+		Int("exported", exported).
+		Msg("audit events exported")
+	return nil
+}
@@ -5,7 +5,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/query/contracts"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 )
@@ -13,11 +13,11 @@ import (
 var _ contracts.GetCurrentUserQuery = (*getCurrentUserQuery)(nil)
 
 type getCurrentUserQuery struct {
-	userRepo *memory.UserRepository
+	userRepo repository.UserRepository
 	log      *zerolog.Logger
 }
 
-func NewGetCurrentUserQuery(userRepo *memory.UserRepository) contracts.GetCurrentUserQuery {
+func NewGetCurrentUserQuery(userRepo repository.UserRepository) contracts.GetCurrentUserQuery {
 	return &getCurrentUserQuery{
 		userRepo: userRepo,
 		log:      logger.Component("auth.query.get_current_user"),
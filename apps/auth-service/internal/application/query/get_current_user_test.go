@@ -4,7 +4,6 @@ import (
 	"context"
 	"testing"
 
-	"golang-social-media/apps/auth-service/internal/application/query/contracts"
 	"golang-social-media/apps/auth-service/internal/domain/factories"
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 	pkgerrors "golang-social-media/pkg/errors"
@@ -59,4 +58,3 @@ func TestGetCurrentUserQuery_Execute(t *testing.T) {
 		}
 	})
 }
-
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetGDPRRequestQuery = (*getGDPRRequestQuery)(nil)
+
+type getGDPRRequestQuery struct {
+	gdprRequestRepo repository.GDPRRequestRepository
+	log             *zerolog.Logger
+}
+
+func NewGetGDPRRequestQuery(gdprRequestRepo repository.GDPRRequestRepository) contracts.GetGDPRRequestQuery {
+	return &getGDPRRequestQuery{
+		gdprRequestRepo: gdprRequestRepo,
+		log:             logger.Component("auth.query.get_gdpr_request"),
+	}
+}
+
+func (q *getGDPRRequestQuery) Execute(ctx context.Context, req contracts.GetGDPRRequestQueryRequest) (auth.GDPRRequestResponse, error) {
+	request, err := getOwnedGDPRRequest(ctx, q.gdprRequestRepo, req.RequestID, req.UserID)
+	if err != nil {
+		return auth.GDPRRequestResponse{}, err
+	}
+	return toGDPRRequestResponse(request), nil
+}
+
+// getOwnedGDPRRequest loads the request; requests of other users are reported as not found
+func getOwnedGDPRRequest(ctx context.Context, repo repository.GDPRRequestRepository, requestID, userID string) (gdpr_request.Request, error) {
+	request, err := repo.GetByID(ctx, requestID)
+	if err != nil {
+		return gdpr_request.Request{}, err
+	}
+	if userID != "" && request.UserID != userID {
+		return gdpr_request.Request{}, repository.ErrGDPRRequestNotFound
+	}
+	return request, nil
+}
+
+func toGDPRRequestResponse(request gdpr_request.Request) auth.GDPRRequestResponse {
+	completed, total := request.Progress()
+	steps := make([]auth.GDPRStepResponse, len(request.Steps))
+	for i, step := range request.Steps {
+		steps[i] = auth.GDPRStepResponse{
+			Service:     step.Service,
+			Completed:   step.IsCompleted(),
+			CompletedAt: step.CompletedAt,
+			Records:     step.Records,
+		}
+	}
+	return auth.GDPRRequestResponse{
+		ID:          request.ID,
+		UserID:      request.UserID,
+		Kind:        string(request.Kind),
+		Status:      string(request.Status),
+		Steps:       steps,
+		Completed:   completed,
+		Total:       total,
+		CreatedAt:   request.CreatedAt,
+		CompletedAt: request.CompletedAt,
+	}
+}
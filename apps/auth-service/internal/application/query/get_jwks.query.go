@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetJWKSQuery = (*getJWKSQuery)(nil)
+
+type getJWKSQuery struct {
+	jwtService *jwt.Service
+	log        *zerolog.Logger
+}
+
+func NewGetJWKSQuery(jwtService *jwt.Service) contracts.GetJWKSQuery {
+	return &getJWKSQuery{
+		jwtService: jwtService,
+		log:        logger.Component("auth.query.get_jwks"),
+	}
+}
+
+func (q *getJWKSQuery) Execute(ctx context.Context) (contracts.GetJWKSQueryResponse, error) {
+	keyRing := q.jwtService.KeyRing()
+	if keyRing == nil {
+		// HS256 secrets are never published
+		q.log.Debug().Msg("no asymmetric key ring configured, returning empty key set")
+		return contracts.GetJWKSQueryResponse{Keys: []contracts.JSONWebKey{}}, nil
+	}
+
+	set := keyRing.JWKS()
+	keys := make([]contracts.JSONWebKey, len(set.Keys))
+	for i, key := range set.Keys {
+		keys[i] = contracts.JSONWebKey{
+			KeyType:   key.KeyType,
+			Use:       key.Use,
+			Algorithm: key.Algorithm,
+			KeyID:     key.KeyID,
+			N:         key.N,
+			E:         key.E,
+			Curve:     key.Curve,
+			X:         key.X,
+			Y:         key.Y,
+		}
+	}
+
+	return contracts.GetJWKSQueryResponse{Keys: keys}, nil
+}
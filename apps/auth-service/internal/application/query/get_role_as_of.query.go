@@ -0,0 +1,60 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetRoleAsOfQuery = (*getRoleAsOfQuery)(nil)
+
+type getRoleAsOfQuery struct {
+	historyRepo repository.AggregateHistoryRepository
+	log         *zerolog.Logger
+}
+
+func NewGetRoleAsOfQuery(historyRepo repository.AggregateHistoryRepository) contracts.GetRoleAsOfQuery {
+	return &getRoleAsOfQuery{
+		historyRepo: historyRepo,
+		log:         logger.Component("auth.query.get_role_as_of"),
+	}
+}
+
+func (q *getRoleAsOfQuery) Execute(ctx context.Context, req contracts.GetRoleAsOfQueryRequest) (auth.RoleStateResponse, error) {
+	asOf := req.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now().UTC()
+	}
+
+	history, err := q.historyRepo.RoleAsOf(ctx, req.RoleID, asOf)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("role_id", req.RoleID).
+			Time("as_of", asOf).
+			Msg("failed to replay role events")
+		return auth.RoleStateResponse{}, err
+	}
+	if history == nil {
+		return auth.RoleStateResponse{}, pkgerrors.NewNotFoundError(pkgerrors.CodeNotFound)
+	}
+
+	r := history.Role
+	return auth.RoleStateResponse{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		ParentID:    r.ParentID,
+		Deleted:     history.Deleted,
+		CreatedAt:   r.CreatedAt,
+		AsOf:        asOf,
+		Version:     history.Version,
+		LastEventAt: history.LastEventAt,
+	}, nil
+}
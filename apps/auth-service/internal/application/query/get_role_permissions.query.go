@@ -0,0 +1,72 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetRolePermissionsQuery = (*getRolePermissionsQuery)(nil)
+
+type getRolePermissionsQuery struct {
+	roleRepo           repository.RoleRepository
+	rolePermissionRepo repository.RolePermissionRepository
+	permissionRepo     repository.PermissionRepository
+	log                *zerolog.Logger
+}
+
+func NewGetRolePermissionsQuery(
+	roleRepo repository.RoleRepository,
+	rolePermissionRepo repository.RolePermissionRepository,
+	permissionRepo repository.PermissionRepository,
+) contracts.GetRolePermissionsQuery {
+	return &getRolePermissionsQuery{
+		roleRepo:           roleRepo,
+		rolePermissionRepo: rolePermissionRepo,
+		permissionRepo:     permissionRepo,
+		log:                logger.Component("auth.query.get_role_permissions"),
+	}
+}
+
+func (q *getRolePermissionsQuery) Execute(ctx context.Context, roleID string) (contracts.GetRolePermissionsQueryResponse, error) {
+	if _, err := q.roleRepo.GetByID(roleID); err != nil {
+		return contracts.GetRolePermissionsQueryResponse{}, err
+	}
+
+	permissionIDs, err := q.rolePermissionRepo.GetRolePermissions(roleID)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("role_id", roleID).
+			Msg("failed to get role permissions")
+		return contracts.GetRolePermissionsQueryResponse{}, err
+	}
+
+	permissions, err := loadPermissions(q.permissionRepo, permissionIDs)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("role_id", roleID).
+			Msg("failed to load role permissions")
+		return contracts.GetRolePermissionsQueryResponse{}, err
+	}
+
+	resp := contracts.GetRolePermissionsQueryResponse{
+		RoleID:        roleID,
+		PermissionIDs: make([]string, 0, len(permissions)),
+		Permissions:   make([]contracts.PermissionInfo, 0, len(permissions)),
+	}
+	for _, perm := range permissions {
+		resp.PermissionIDs = append(resp.PermissionIDs, perm.ID)
+		resp.Permissions = append(resp.Permissions, contracts.PermissionInfo{
+			ID:       perm.ID,
+			Name:     perm.Name,
+			Resource: perm.Resource,
+			Action:   perm.Action,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetUserAsOfQuery = (*getUserAsOfQuery)(nil)
+
+type getUserAsOfQuery struct {
+	historyRepo repository.AggregateHistoryRepository
+	log         *zerolog.Logger
+}
+
+func NewGetUserAsOfQuery(historyRepo repository.AggregateHistoryRepository) contracts.GetUserAsOfQuery {
+	return &getUserAsOfQuery{
+		historyRepo: historyRepo,
+		log:         logger.Component("auth.query.get_user_as_of"),
+	}
+}
+
+func (q *getUserAsOfQuery) Execute(ctx context.Context, req contracts.GetUserAsOfQueryRequest) (auth.UserStateResponse, error) {
+	asOf := req.AsOf
+	if asOf.IsZero() {
+		asOf = time.Now().UTC()
+	}
+
+	history, err := q.historyRepo.UserAsOf(ctx, req.UserID, asOf)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Time("as_of", asOf).
+			Msg("failed to replay user events")
+		return auth.UserStateResponse{}, err
+	}
+	// Users that did not exist yet, or whose UserCreated event predates the event store
+	if history == nil {
+		return auth.UserStateResponse{}, pkgerrors.NewNotFoundError(pkgerrors.CodeUserNotFound)
+	}
+
+	u := history.User
+	return auth.UserStateResponse{
+		ID:            u.ID,
+		Email:         u.Email,
+		Name:          u.Name,
+		EmailVerified: u.EmailVerified,
+		MFAEnabled:    u.MFAEnabled,
+		CreatedAt:     history.CreatedAt,
+		DeletedAt:     u.DeletedAt,
+		AsOf:          asOf,
+		Version:       history.Version,
+		LastEventAt:   history.LastEventAt,
+	}, nil
+}
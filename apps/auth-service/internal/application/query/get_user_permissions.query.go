@@ -0,0 +1,48 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetUserPermissionsQuery = (*getUserPermissionsQuery)(nil)
+
+type getUserPermissionsQuery struct {
+	effectivePermissionRepo repository.EffectivePermissionRepository
+	log                     *zerolog.Logger
+}
+
+func NewGetUserPermissionsQuery(
+	effectivePermissionRepo repository.EffectivePermissionRepository,
+) contracts.GetUserPermissionsQuery {
+	return &getUserPermissionsQuery{
+		effectivePermissionRepo: effectivePermissionRepo,
+		log:                     logger.Component("auth.query.get_user_permissions"),
+	}
+}
+
+func (q *getUserPermissionsQuery) Execute(ctx context.Context, userID string) (auth.UserPermissionsResponse, error) {
+	// Includes permissions inherited through parent roles, each listed once
+	permissions, err := q.effectivePermissionRepo.GetUserPermissions(userID)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to load user permissions")
+		return auth.UserPermissionsResponse{}, err
+	}
+
+	resp := auth.UserPermissionsResponse{
+		UserID:      userID,
+		Permissions: make([]auth.PermissionResponse, 0, len(permissions)),
+	}
+	for _, perm := range permissions {
+		resp.Permissions = append(resp.Permissions, toPermissionResponse(perm))
+	}
+	return resp, nil
+}
@@ -0,0 +1,137 @@
+package query
+
+import (
+	"context"
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/role_permission"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// rbacRepos wires the in-memory RBAC repositories used by the permission queries
+type rbacRepos struct {
+	roles           *memory.RoleRepository
+	permissions     *memory.PermissionRepository
+	rolePermissions *memory.RolePermissionRepository
+	userRoles       *memory.UserRoleRepository
+	effective       *memory.EffectivePermissionRepository
+}
+
+func newRBACRepos() rbacRepos {
+	repos := rbacRepos{
+		roles:           memory.NewRoleRepository(),
+		permissions:     memory.NewPermissionRepository(),
+		rolePermissions: memory.NewRolePermissionRepository(),
+		userRoles:       memory.NewUserRoleRepository(),
+	}
+	repos.effective = memory.NewEffectivePermissionRepository(repos.roles, repos.userRoles, repos.rolePermissions, repos.permissions)
+	return repos
+}
+
+// seedHierarchy creates admin inheriting from moderator, with user-admin and user-mod assigned to them
+func (r rbacRepos) seedHierarchy(t *testing.T) {
+	assert.NoError(t, r.roles.Create(role.Role{ID: "role-mod", Name: "moderator"}))
+	assert.NoError(t, r.roles.Create(role.Role{ID: "role-admin", Name: "admin", ParentID: "role-mod"}))
+	assert.NoError(t, r.permissions.Create(permission.Permission{ID: "perm-read", Name: "Read chat", Resource: "chat", Action: "read"}))
+	assert.NoError(t, r.permissions.Create(permission.Permission{ID: "perm-delete", Name: "Delete chat", Resource: "chat", Action: "delete"}))
+	assert.NoError(t, r.permissions.Create(permission.Permission{ID: "perm-unlock", Name: "Unlock users", Resource: "users", Action: "unlock"}))
+	assert.NoError(t, r.permissions.Create(permission.Permission{ID: "perm-orders", Name: "Manage orders", Resource: "orders", Action: permission.Wildcard}))
+
+	for _, rp := range []role_permission.RolePermission{
+		{RoleID: "role-mod", PermissionID: "perm-read"},
+		{RoleID: "role-mod", PermissionID: "perm-delete"},
+		{RoleID: "role-admin", PermissionID: "perm-read"},
+		{RoleID: "role-admin", PermissionID: "perm-unlock"},
+		{RoleID: "role-admin", PermissionID: "perm-orders"},
+	} {
+		assert.NoError(t, r.rolePermissions.Create(rp))
+	}
+	assert.NoError(t, r.userRoles.Create(user_role.UserRole{UserID: "user-admin", RoleID: "role-admin"}))
+	assert.NoError(t, r.userRoles.Create(user_role.UserRole{UserID: "user-mod", RoleID: "role-mod"}))
+}
+
+func TestGetUserPermissionsQuery_Execute(t *testing.T) {
+	ctx := context.Background()
+	repos := newRBACRepos()
+	repos.seedHierarchy(t)
+	// Assigning the parent role directly as well must not duplicate its permissions
+	assert.NoError(t, repos.userRoles.Create(user_role.UserRole{UserID: "user-admin", RoleID: "role-mod"}))
+
+	query := NewGetUserPermissionsQuery(repos.effective)
+
+	resp, err := query.Execute(ctx, "user-admin")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	assert.Equal(t, "user-admin", resp.UserID)
+
+	var got []string
+	for _, perm := range resp.Permissions {
+		got = append(got, perm.Resource+":"+perm.Action)
+	}
+	// chat:read is granted by both roles and must be listed once; chat:delete is inherited
+	assert.Equal(t, []string{"chat:delete", "chat:read", "orders:*", "users:unlock"}, got)
+
+	// Permissions deleted after being granted are skipped
+	assert.NoError(t, repos.permissions.Delete("perm-unlock"))
+	resp, err = query.Execute(ctx, "user-admin")
+	assert.NoError(t, err)
+	assert.Len(t, resp.Permissions, 3)
+
+	// Inheritance cycles terminate
+	modRole, err := repos.roles.GetByID("role-mod")
+	if err != nil {
+		t.Fatalf("GetByID() error = %v", err)
+	}
+	modRole.ParentID = "role-admin"
+	assert.NoError(t, repos.roles.Update(modRole))
+	resp, err = query.Execute(ctx, "user-mod")
+	assert.NoError(t, err)
+	assert.Len(t, resp.Permissions, 3)
+
+	resp, err = query.Execute(ctx, "user-without-roles")
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Permissions)
+}
+
+func TestListRolesQuery_Execute_Paginates(t *testing.T) {
+	ctx := context.Background()
+	roleRepo := memory.NewRoleRepository()
+	for _, name := range []string{"editor", "admin", "moderator"} {
+		assert.NoError(t, roleRepo.Create(role.Role{ID: "role-" + name, Name: name}))
+	}
+
+	query := NewListRolesQuery(roleRepo)
+
+	resp, err := query.Execute(ctx, contracts.ListRolesQueryRequest{Limit: 2})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	assert.Equal(t, int64(3), resp.Total)
+	assert.Equal(t, 2, resp.Limit)
+	if !assert.Len(t, resp.Roles, 2) {
+		return
+	}
+	assert.Equal(t, "admin", resp.Roles[0].Name)
+	assert.Equal(t, "editor", resp.Roles[1].Name)
+
+	resp, err = query.Execute(ctx, contracts.ListRolesQueryRequest{Limit: 2, Offset: 2})
+	assert.NoError(t, err)
+	if !assert.Len(t, resp.Roles, 1) {
+		return
+	}
+	assert.Equal(t, "moderator", resp.Roles[0].Name)
+
+	// Defaults apply when no page size is given
+	resp, err = query.Execute(ctx, contracts.ListRolesQueryRequest{Offset: -1})
+	assert.NoError(t, err)
+	assert.Equal(t, 20, resp.Limit)
+	assert.Equal(t, 0, resp.Offset)
+	assert.Len(t, resp.Roles, 3)
+}
@@ -5,7 +5,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/query/contracts"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/pkg/contracts/auth"
 	"golang-social-media/pkg/logger"
 )
@@ -13,11 +13,11 @@ import (
 var _ contracts.GetUserProfileQuery = (*getUserProfileQuery)(nil)
 
 type getUserProfileQuery struct {
-	repo *memory.UserRepository
+	repo repository.UserRepository
 	log  *zerolog.Logger
 }
 
-func NewGetUserProfileHandler(repo *memory.UserRepository) contracts.GetUserProfileQuery {
+func NewGetUserProfileHandler(repo repository.UserRepository) contracts.GetUserProfileQuery {
 	return &getUserProfileQuery{
 		repo: repo,
 		log:  logger.Component("auth.query.get_user_profile"),
@@ -4,7 +4,6 @@ import (
 	"context"
 	"testing"
 
-	"golang-social-media/apps/auth-service/internal/application/query/contracts"
 	"golang-social-media/apps/auth-service/internal/domain/factories"
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 )
@@ -50,4 +49,3 @@ func TestGetUserProfileQuery_Execute(t *testing.T) {
 		}
 	})
 }
-
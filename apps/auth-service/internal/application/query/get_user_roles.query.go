@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetUserRolesQuery = (*getUserRolesQuery)(nil)
+
+type getUserRolesQuery struct {
+	userRoleRepo repository.UserRoleRepository
+	roleRepo     repository.RoleRepository
+	log          *zerolog.Logger
+}
+
+func NewGetUserRolesQuery(
+	userRoleRepo repository.UserRoleRepository,
+	roleRepo repository.RoleRepository,
+) contracts.GetUserRolesQuery {
+	return &getUserRolesQuery{
+		userRoleRepo: userRoleRepo,
+		roleRepo:     roleRepo,
+		log:          logger.Component("auth.query.get_user_roles"),
+	}
+}
+
+func (q *getUserRolesQuery) Execute(ctx context.Context, userID string) (contracts.GetUserRolesQueryResponse, error) {
+	roleIDs, err := q.userRoleRepo.GetUserRoles(userID)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to get user roles")
+		return contracts.GetUserRolesQueryResponse{}, err
+	}
+
+	resp := contracts.GetUserRolesQueryResponse{
+		UserID:    userID,
+		RoleIDs:   make([]string, 0, len(roleIDs)),
+		RoleNames: make([]string, 0, len(roleIDs)),
+	}
+	for _, roleID := range roleIDs {
+		roleEntity, err := q.roleRepo.GetByID(roleID)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return contracts.GetUserRolesQueryResponse{}, err
+		}
+		resp.RoleIDs = append(resp.RoleIDs, roleEntity.ID)
+		resp.RoleNames = append(resp.RoleNames, roleEntity.Name)
+	}
+	return resp, nil
+}
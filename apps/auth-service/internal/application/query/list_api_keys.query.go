@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ListAPIKeysQuery = (*listAPIKeysQuery)(nil)
+
+type listAPIKeysQuery struct {
+	apiKeyRepo repository.APIKeyRepository
+	log        *zerolog.Logger
+}
+
+func NewListAPIKeysQuery(apiKeyRepo repository.APIKeyRepository) contracts.ListAPIKeysQuery {
+	return &listAPIKeysQuery{
+		apiKeyRepo: apiKeyRepo,
+		log:        logger.Component("auth.query.list_api_keys"),
+	}
+}
+
+func (q *listAPIKeysQuery) Execute(ctx context.Context, userID string) (auth.ListAPIKeysResponse, error) {
+	keys, err := q.apiKeyRepo.ListByUser(ctx, userID)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to list API keys")
+		return auth.ListAPIKeysResponse{}, err
+	}
+
+	apiKeys := make([]auth.APIKeyResponse, len(keys))
+	for i, key := range keys {
+		apiKeys[i] = auth.APIKeyResponse{
+			ID:         key.ID,
+			Name:       key.Name,
+			Prefix:     key.Prefix,
+			Scopes:     key.Scopes,
+			ExpiresAt:  key.ExpiresAt,
+			CreatedAt:  key.CreatedAt,
+			LastUsedAt: key.LastUsedAt,
+		}
+	}
+
+	return auth.ListAPIKeysResponse{APIKeys: apiKeys}, nil
+}
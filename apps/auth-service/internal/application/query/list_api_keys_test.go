@@ -0,0 +1,39 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAPIKeysQuery_Execute(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewAPIKeyRepository()
+
+	older := api_key.NewKey("user-1", "deploy", "gsm_aaaaaaaa", "hash-1", []string{"orders:read"}, nil)
+	older.CreatedAt = time.Now().Add(-time.Hour)
+	newer := api_key.NewKey("user-1", "ci", "gsm_bbbbbbbb", "hash-2", []string{"chat:read"}, nil)
+	revoked := api_key.NewKey("user-1", "old", "gsm_cccccccc", "hash-3", []string{"chat:read"}, nil)
+	revoked.Revoke()
+	otherUser := api_key.NewKey("user-2", "ci", "gsm_dddddddd", "hash-4", []string{"chat:read"}, nil)
+	for _, key := range []api_key.Key{older, newer, revoked, otherUser} {
+		assert.NoError(t, repo.Create(ctx, key))
+	}
+
+	resp, err := NewListAPIKeysQuery(repo).Execute(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// Revoked keys and keys of other users are not listed, newest first
+	if assert.Len(t, resp.APIKeys, 2) {
+		assert.Equal(t, newer.ID, resp.APIKeys[0].ID)
+		assert.Equal(t, older.ID, resp.APIKeys[1].ID)
+		assert.Equal(t, "gsm_aaaaaaaa", resp.APIKeys[1].Prefix)
+	}
+}
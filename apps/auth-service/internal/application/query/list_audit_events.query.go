@@ -0,0 +1,126 @@
+package query
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 500
+)
+
+var _ contracts.ListAuditEventsQuery = (*listAuditEventsQuery)(nil)
+
+type listAuditEventsQuery struct {
+	auditLogRepo repository.AuditLogRepository
+	log          *zerolog.Logger
+}
+
+func NewListAuditEventsQuery(auditLogRepo repository.AuditLogRepository) contracts.ListAuditEventsQuery {
+	return &listAuditEventsQuery{
+		auditLogRepo: auditLogRepo,
+		log:          logger.Component("auth.query.list_audit_events"),
+	}
+}
+
+func (q *listAuditEventsQuery) Execute(ctx context.Context, req contracts.ListAuditEventsQueryRequest) (auth.ListAuditEventsResponse, error) {
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultAuditPageSize
+	}
+	if limit > maxAuditPageSize {
+		limit = maxAuditPageSize
+	}
+
+	var after *repository.AuditCursor
+	if req.Cursor != "" {
+		cursor, err := decodeAuditCursor(req.Cursor)
+		if err != nil {
+			return auth.ListAuditEventsResponse{}, pkgerrors.NewInvalidRequestError("invalid cursor")
+		}
+		after = &cursor
+	}
+
+	// One extra event tells whether there is a next page
+	events, err := q.auditLogRepo.List(ctx, auditEventFilter(req.Filter), after, limit+1)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Msg("failed to list audit events")
+		return auth.ListAuditEventsResponse{}, err
+	}
+
+	resp := auth.ListAuditEventsResponse{
+		Events: make([]auth.AuditEventResponse, 0, limit),
+	}
+	if len(events) > limit {
+		events = events[:limit]
+		last := events[limit-1]
+		resp.NextCursor = encodeAuditCursor(repository.AuditCursor{OccurredAt: last.OccurredAt, ID: last.ID})
+	}
+	for _, event := range events {
+		resp.Events = append(resp.Events, auditEventResponse(event))
+	}
+	return resp, nil
+}
+
+func auditEventFilter(filter contracts.AuditEventFilter) repository.AuditEventFilter {
+	return repository.AuditEventFilter{
+		AggregateID:   filter.AggregateID,
+		AggregateType: filter.AggregateType,
+		EventTypes:    filter.EventTypes,
+		ActorID:       filter.ActorID,
+		From:          filter.From,
+		To:            filter.To,
+	}
+}
+
+func auditEventResponse(event repository.AuditEvent) auth.AuditEventResponse {
+	return auth.AuditEventResponse{
+		ID:            event.ID,
+		AggregateID:   event.AggregateID,
+		AggregateType: event.AggregateType,
+		EventType:     event.EventType,
+		EventVersion:  event.EventVersion,
+		Payload:       event.Payload,
+		ActorID:       event.Metadata.ActorID,
+		APIKeyID:      event.Metadata.APIKeyID,
+		IPAddress:     event.Metadata.IPAddress,
+		UserAgent:     event.Metadata.UserAgent,
+		RequestID:     event.Metadata.RequestID,
+		OccurredAt:    event.OccurredAt,
+	}
+}
+
+// encodeAuditCursor makes an opaque page token from the position of the last event
+func encodeAuditCursor(cursor repository.AuditCursor) string {
+	raw := strconv.FormatInt(cursor.OccurredAt.UnixNano(), 10) + ":" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeAuditCursor(token string) (repository.AuditCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return repository.AuditCursor{}, err
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return repository.AuditCursor{}, strconv.ErrSyntax
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return repository.AuditCursor{}, err
+	}
+	return repository.AuditCursor{OccurredAt: time.Unix(0, unixNano).UTC(), ID: id}, nil
+}
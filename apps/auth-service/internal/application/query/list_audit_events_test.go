@@ -0,0 +1,115 @@
+package query
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+	"golang-social-media/apps/auth-service/internal/pkg/auditctx"
+	"golang-social-media/pkg/contracts/auth"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func seedAuditLog(start time.Time) *memory.AuditLogRepository {
+	repo := memory.NewAuditLogRepository()
+	for i, eventType := range []string{"UserCreated", "UserRoleAssigned", "UserProfileUpdated", "UserRoleRevoked", "UserRoleAssigned"} {
+		repo.Append(repository.AuditEvent{
+			ID:            string(rune('a' + i)),
+			AggregateID:   "user-1",
+			AggregateType: "User",
+			EventType:     eventType,
+			EventVersion:  1,
+			Payload:       json.RawMessage(`{}`),
+			Metadata:      auditctx.Metadata{ActorID: "admin-1", RequestID: "req-" + string(rune('a'+i))},
+			OccurredAt:    start.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	repo.Append(repository.AuditEvent{
+		ID:            "z",
+		AggregateID:   "user-2",
+		AggregateType: "User",
+		EventType:     "UserRoleAssigned",
+		Payload:       json.RawMessage(`{}`),
+		Metadata:      auditctx.Metadata{ActorID: "admin-2"},
+		OccurredAt:    start,
+	})
+	return repo
+}
+
+func TestListAuditEventsQuery_Execute_PagesNewestFirst(t *testing.T) {
+	ctx := context.Background()
+	q := NewListAuditEventsQuery(seedAuditLog(time.Now().Add(-time.Hour)))
+
+	filter := contracts.AuditEventFilter{
+		AggregateID: "user-1",
+		EventTypes:  []string{"UserRoleAssigned", "UserRoleRevoked"},
+	}
+	first, err := q.Execute(ctx, contracts.ListAuditEventsQueryRequest{Filter: filter, Limit: 2})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if assert.Len(t, first.Events, 2) {
+		assert.Equal(t, "e", first.Events[0].ID)
+		assert.Equal(t, "d", first.Events[1].ID)
+		assert.Equal(t, "admin-1", first.Events[0].ActorID)
+		assert.Equal(t, "req-e", first.Events[0].RequestID)
+	}
+	assert.NotEmpty(t, first.NextCursor)
+
+	second, err := q.Execute(ctx, contracts.ListAuditEventsQueryRequest{Filter: filter, Limit: 2, Cursor: first.NextCursor})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if assert.Len(t, second.Events, 1) {
+		assert.Equal(t, "b", second.Events[0].ID)
+	}
+	assert.Empty(t, second.NextCursor)
+}
+
+func TestListAuditEventsQuery_Execute_FiltersActorAndTimeRange(t *testing.T) {
+	ctx := context.Background()
+	start := time.Now().Add(-time.Hour)
+	q := NewListAuditEventsQuery(seedAuditLog(start))
+
+	from := start.Add(time.Minute)
+	to := start.Add(3 * time.Minute)
+	resp, err := q.Execute(ctx, contracts.ListAuditEventsQueryRequest{
+		Filter: contracts.AuditEventFilter{ActorID: "admin-1", From: &from, To: &to},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	// From is inclusive, To is exclusive
+	ids := make([]string, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		ids = append(ids, event.ID)
+	}
+	assert.Equal(t, []string{"c", "b"}, ids)
+}
+
+func TestListAuditEventsQuery_Execute_InvalidCursor(t *testing.T) {
+	q := NewListAuditEventsQuery(memory.NewAuditLogRepository())
+
+	_, err := q.Execute(context.Background(), contracts.ListAuditEventsQueryRequest{Cursor: "not-a-cursor"})
+	assert.Error(t, err)
+}
+
+func TestExportAuditEventsQuery_Execute_OldestFirst(t *testing.T) {
+	q := NewExportAuditEventsQuery(seedAuditLog(time.Now().Add(-time.Hour)))
+
+	var ids []string
+	err := q.Execute(context.Background(), contracts.AuditEventFilter{ActorID: "admin-1"}, func(event auth.AuditEventResponse) error {
+		ids = append(ids, event.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, ids)
+}
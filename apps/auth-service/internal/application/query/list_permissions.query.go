@@ -0,0 +1,58 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ListPermissionsQuery = (*listPermissionsQuery)(nil)
+
+type listPermissionsQuery struct {
+	permissionRepo repository.PermissionRepository
+	log            *zerolog.Logger
+}
+
+func NewListPermissionsQuery(permissionRepo repository.PermissionRepository) contracts.ListPermissionsQuery {
+	return &listPermissionsQuery{
+		permissionRepo: permissionRepo,
+		log:            logger.Component("auth.query.list_permissions"),
+	}
+}
+
+func (q *listPermissionsQuery) Execute(ctx context.Context, req contracts.ListPermissionsQueryRequest) (auth.ListPermissionsResponse, error) {
+	limit, offset := normalizePage(req.Limit, req.Offset)
+
+	permissions, err := q.permissionRepo.List(limit, offset)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Int("limit", limit).
+			Int("offset", offset).
+			Msg("failed to list permissions")
+		return auth.ListPermissionsResponse{}, err
+	}
+
+	total, err := q.permissionRepo.Count()
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Msg("failed to count permissions")
+		return auth.ListPermissionsResponse{}, err
+	}
+
+	resp := auth.ListPermissionsResponse{
+		Permissions: make([]auth.PermissionResponse, 0, len(permissions)),
+		Total:       total,
+		Limit:       limit,
+		Offset:      offset,
+	}
+	for _, perm := range permissions {
+		resp.Permissions = append(resp.Permissions, toPermissionResponse(perm))
+	}
+	return resp, nil
+}
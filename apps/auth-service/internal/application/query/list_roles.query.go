@@ -0,0 +1,63 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ListRolesQuery = (*listRolesQuery)(nil)
+
+type listRolesQuery struct {
+	roleRepo repository.RoleRepository
+	log      *zerolog.Logger
+}
+
+func NewListRolesQuery(roleRepo repository.RoleRepository) contracts.ListRolesQuery {
+	return &listRolesQuery{
+		roleRepo: roleRepo,
+		log:      logger.Component("auth.query.list_roles"),
+	}
+}
+
+func (q *listRolesQuery) Execute(ctx context.Context, req contracts.ListRolesQueryRequest) (auth.ListRolesResponse, error) {
+	limit, offset := normalizePage(req.Limit, req.Offset)
+
+	roles, err := q.roleRepo.List(limit, offset)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Int("limit", limit).
+			Int("offset", offset).
+			Msg("failed to list roles")
+		return auth.ListRolesResponse{}, err
+	}
+
+	total, err := q.roleRepo.Count()
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Msg("failed to count roles")
+		return auth.ListRolesResponse{}, err
+	}
+
+	resp := auth.ListRolesResponse{
+		Roles:  make([]auth.RoleResponse, 0, len(roles)),
+		Total:  total,
+		Limit:  limit,
+		Offset: offset,
+	}
+	for _, roleEntity := range roles {
+		resp.Roles = append(resp.Roles, auth.RoleResponse{
+			ID:          roleEntity.ID,
+			Name:        roleEntity.Name,
+			Description: roleEntity.Description,
+			ParentID:    roleEntity.ParentID,
+		})
+	}
+	return resp, nil
+}
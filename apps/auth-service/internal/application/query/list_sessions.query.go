@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ListSessionsQuery = (*listSessionsQuery)(nil)
+
+type listSessionsQuery struct {
+	refreshTokenRepo repository.RefreshTokenRepository
+	log              *zerolog.Logger
+}
+
+func NewListSessionsQuery(refreshTokenRepo repository.RefreshTokenRepository) contracts.ListSessionsQuery {
+	return &listSessionsQuery{
+		refreshTokenRepo: refreshTokenRepo,
+		log:              logger.Component("auth.query.list_sessions"),
+	}
+}
+
+func (q *listSessionsQuery) Execute(ctx context.Context, req contracts.ListSessionsQueryRequest) (auth.ListSessionsResponse, error) {
+	families, err := q.refreshTokenRepo.ListActiveFamilies(ctx, req.UserID)
+	if err != nil {
+		q.log.Error().
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to list sessions")
+		return auth.ListSessionsResponse{}, err
+	}
+
+	sessions := make([]auth.SessionResponse, len(families))
+	for i, family := range families {
+		sessions[i] = auth.SessionResponse{
+			ID:         family.ID,
+			UserAgent:  family.Device.UserAgent,
+			IPAddress:  family.Device.IPAddress,
+			CreatedAt:  family.CreatedAt,
+			LastUsedAt: family.LastUsedAt,
+			Current:    family.ID == req.CurrentSessionID,
+		}
+	}
+
+	return auth.ListSessionsResponse{Sessions: sessions}, nil
+}
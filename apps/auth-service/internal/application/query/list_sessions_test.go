@@ -0,0 +1,51 @@
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+)
+
+func TestListSessionsQuery_Execute(t *testing.T) {
+	ctx := context.Background()
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+
+	older := refresh_token.NewFamily("user-1", refresh_token.Device{UserAgent: "phone", IPAddress: "10.0.0.2"})
+	older.LastUsedAt = time.Now().Add(-time.Hour)
+	current := refresh_token.NewFamily("user-1", refresh_token.Device{UserAgent: "laptop", IPAddress: "10.0.0.1"})
+	revoked := refresh_token.NewFamily("user-1", refresh_token.Device{UserAgent: "old"})
+	revoked.Revoke(refresh_token.RevokeReasonLogout)
+	for _, session := range []refresh_token.Family{older, current, revoked} {
+		if err := refreshTokenRepo.CreateFamily(ctx, session); err != nil {
+			t.Fatalf("CreateFamily() error = %v", err)
+		}
+	}
+
+	query := NewListSessionsQuery(refreshTokenRepo)
+
+	resp, err := query.Execute(ctx, contracts.ListSessionsQueryRequest{
+		UserID:           "user-1",
+		CurrentSessionID: current.ID,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	if len(resp.Sessions) != 2 {
+		t.Fatalf("expected 2 active sessions, got %d", len(resp.Sessions))
+	}
+	// Most recently used first
+	if resp.Sessions[0].ID != current.ID || !resp.Sessions[0].Current {
+		t.Errorf("first session should be the current one, got %+v", resp.Sessions[0])
+	}
+	if resp.Sessions[1].ID != older.ID || resp.Sessions[1].Current {
+		t.Errorf("second session should be the older one, got %+v", resp.Sessions[1])
+	}
+	if resp.Sessions[1].UserAgent != "phone" || resp.Sessions[1].IPAddress != "10.0.0.2" {
+		t.Errorf("unexpected device info: %+v", resp.Sessions[1])
+	}
+}
@@ -0,0 +1,107 @@
+package query
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 100
+)
+
+// normalizePage applies the default and maximum page size and clamps negative offsets
+func normalizePage(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = defaultPageLimit
+	}
+	if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
+
+// isNotFound reports whether err is a not found AppError, e.g. a role deleted while still referenced
+func isNotFound(err error) bool {
+	var appErr *errors.AppError
+	return stderrors.As(err, &appErr) && appErr.HTTPStatus == http.StatusNotFound
+}
+
+// loadPermissions resolves permission IDs, skipping permissions that no longer exist
+func loadPermissions(permissionRepo repository.PermissionRepository, permissionIDs []string) ([]permission.Permission, error) {
+	permissions := make([]permission.Permission, 0, len(permissionIDs))
+	for _, permissionID := range permissionIDs {
+		perm, err := permissionRepo.GetByID(permissionID)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		permissions = append(permissions, perm)
+	}
+	return permissions, nil
+}
+
+func toPermissionResponse(perm permission.Permission) auth.PermissionResponse {
+	return auth.PermissionResponse{
+		ID:       perm.ID,
+		Name:     perm.Name,
+		Resource: perm.Resource,
+		Action:   perm.Action,
+	}
+}
+
+// effectiveGrants reads the user's grants through the cache (which may be nil). Cache failures
+// are logged and fall back to the repository so a cache outage never denies access
+func effectiveGrants(
+	ctx context.Context,
+	effectivePermissionRepo repository.EffectivePermissionRepository,
+	permissionCache repository.EffectivePermissionCache,
+	cacheTTL time.Duration,
+	log *zerolog.Logger,
+	userID string,
+) ([]permission.Grant, error) {
+	if permissionCache != nil {
+		grants, found, err := permissionCache.Get(ctx, userID)
+		if err != nil {
+			log.Warn().
+				Err(err).
+				Str("user_id", userID).
+				Msg("failed to read effective permissions from cache")
+		} else if found {
+			return grants, nil
+		}
+	}
+
+	permissions, err := effectivePermissionRepo.GetUserPermissions(userID)
+	if err != nil {
+		return nil, err
+	}
+	grants := make([]permission.Grant, 0, len(permissions))
+	for _, perm := range permissions {
+		grants = append(grants, perm.Grant())
+	}
+
+	if permissionCache != nil {
+		if err := permissionCache.Set(ctx, userID, grants, cacheTTL); err != nil {
+			log.Warn().
+				Err(err).
+				Str("user_id", userID).
+				Msg("failed to cache effective permissions")
+		}
+	}
+	return grants, nil
+}
@@ -2,38 +2,65 @@ package query
 
 import (
 	"context"
+	"time"
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/redis"
-	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 )
 
 var _ contracts.ValidateTokenQuery = (*validateTokenQuery)(nil)
 
+// tokenBlacklist is the part of the token blacklist the query needs
+type tokenBlacklist interface {
+	IsBlacklisted(ctx context.Context, tokenID string) (bool, error)
+}
+
+var _ tokenBlacklist = (*redis.TokenBlacklistRepository)(nil)
+
 type validateTokenQuery struct {
 	jwtService         *jwt.Service
-	tokenBlacklistRepo *redis.TokenBlacklistRepository
+	tokenBlacklistRepo tokenBlacklist
+	refreshTokenRepo   repository.RefreshTokenRepository
+	userRoleRepo       repository.UserRoleRepository
+	roleRepo           repository.RoleRepository
+	effectivePermRepo  repository.EffectivePermissionRepository
+	permissionCache    repository.EffectivePermissionCache
+	cacheTTL           time.Duration
 	log                *zerolog.Logger
 }
 
+// NewValidateTokenQuery creates the token validation query. permissionCache may be nil
 func NewValidateTokenQuery(
 	jwtService *jwt.Service,
-	tokenBlacklistRepo *redis.TokenBlacklistRepository,
+	tokenBlacklistRepo tokenBlacklist,
+	refreshTokenRepo repository.RefreshTokenRepository,
+	userRoleRepo repository.UserRoleRepository,
+	roleRepo repository.RoleRepository,
+	effectivePermRepo repository.EffectivePermissionRepository,
+	permissionCache repository.EffectivePermissionCache,
+	cacheTTL time.Duration,
 ) contracts.ValidateTokenQuery {
 	return &validateTokenQuery{
 		jwtService:         jwtService,
 		tokenBlacklistRepo: tokenBlacklistRepo,
+		refreshTokenRepo:   refreshTokenRepo,
+		userRoleRepo:       userRoleRepo,
+		roleRepo:           roleRepo,
+		effectivePermRepo:  effectivePermRepo,
+		permissionCache:    permissionCache,
+		cacheTTL:           cacheTTL,
 		log:                logger.Component("auth.query.validate_token"),
 	}
 }
 
 func (q *validateTokenQuery) Execute(ctx context.Context, token string) (contracts.ValidateTokenQueryResponse, error) {
 	// Validate token
-	userID, err := q.jwtService.ValidateToken(token)
+	claims, err := q.jwtService.ValidateTokenWithClaims(token)
 	if err != nil {
 		q.log.Warn().
 			Err(err).
@@ -44,6 +71,8 @@ func (q *validateTokenQuery) Execute(ctx context.Context, token string) (contrac
 		}, nil // Return valid=false, not error
 	}
 
+	userID := claims.UserID
+
 	// Check if token is blacklisted
 	tokenID := user.NewTokenID(token)
 	isBlacklisted, err := q.tokenBlacklistRepo.IsBlacklisted(ctx, tokenID.String())
@@ -68,8 +97,71 @@ func (q *validateTokenQuery) Execute(ctx context.Context, token string) (contrac
 		}, nil
 	}
 
-	return contracts.ValidateTokenQueryResponse{
-		Valid:  true,
-		UserID: userID,
-	}, nil
+	// Check if the session the token belongs to is still active
+	if claims.SessionID != "" {
+		session, err := q.refreshTokenRepo.GetFamily(ctx, claims.SessionID)
+		if err != nil || session.IsRevoked() {
+			q.log.Warn().
+				Err(err).
+				Str("user_id", userID).
+				Str("session_id", claims.SessionID).
+				Msg("token session is revoked or missing")
+			return contracts.ValidateTokenQueryResponse{
+				Valid:  false,
+				UserID: "",
+			}, nil
+		}
+	}
+
+	resp := contracts.ValidateTokenQueryResponse{
+		Valid:     true,
+		UserID:    userID,
+		SessionID: claims.SessionID,
+		ExpiresAt: claims.ExpiresAt,
+	}
+
+	// A failed RBAC lookup leaves the token valid but without roles or permissions, which only denies more
+	roles, err := q.roleNames(userID)
+	if err != nil {
+		q.log.Warn().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to resolve user roles")
+	}
+	resp.Roles = roles
+
+	grants, err := effectiveGrants(ctx, q.effectivePermRepo, q.permissionCache, q.cacheTTL, q.log, userID)
+	if err != nil {
+		q.log.Warn().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to resolve effective permissions")
+	}
+	resp.Permissions = make([]string, 0, len(grants))
+	for _, grant := range grants {
+		resp.Permissions = append(resp.Permissions, grant.String())
+	}
+
+	return resp, nil
+}
+
+// roleNames returns the names of the roles assigned to the user, skipping roles deleted meanwhile
+func (q *validateTokenQuery) roleNames(userID string) ([]string, error) {
+	roleIDs, err := q.userRoleRepo.GetUserRoles(userID)
+	if err != nil {
+		return []string{}, err
+	}
+
+	names := make([]string, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		roleEntity, err := q.roleRepo.GetByID(roleID)
+		if err != nil {
+			if isNotFound(err) {
+				continue
+			}
+			return []string{}, err
+		}
+		names = append(names, roleEntity.Name)
+	}
+	return names, nil
 }
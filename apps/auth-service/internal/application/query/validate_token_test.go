@@ -4,15 +4,18 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
 	"golang-social-media/apps/auth-service/internal/domain/user"
 	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/redis"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
 )
 
 // mockTokenBlacklistRepository is a mock implementation for testing
 type mockTokenBlacklistRepository struct {
-	blacklisted map[string]bool
+	blacklisted   map[string]bool
 	isBlacklisted func(ctx context.Context, tokenID string) (bool, error)
 }
 
@@ -39,11 +42,17 @@ func (m *mockTokenBlacklistRepository) Remove(ctx context.Context, tokenID strin
 	return nil
 }
 
+// newValidateTokenQuery creates the query with empty in-memory RBAC repositories
+func newValidateTokenQuery(jwtService *jwt.Service, blacklistRepo *mockTokenBlacklistRepository, refreshTokenRepo *memory.RefreshTokenRepository) contracts.ValidateTokenQuery {
+	repos := newRBACRepos()
+	return NewValidateTokenQuery(jwtService, blacklistRepo, refreshTokenRepo, repos.userRoles, repos.roles, repos.effective, nil, time.Minute)
+}
+
 func TestValidateTokenQuery_Execute(t *testing.T) {
 	jwtService := jwt.NewService("test-secret", 1, 168)
 	blacklistRepo := newMockTokenBlacklistRepository()
 
-	query := NewValidateTokenQuery(jwtService, blacklistRepo)
+	query := newValidateTokenQuery(jwtService, blacklistRepo, memory.NewRefreshTokenRepository())
 
 	// Generate a valid token
 	userID := "user-123"
@@ -69,7 +78,7 @@ func TestValidateTokenQuery_Execute_InvalidToken(t *testing.T) {
 	jwtService := jwt.NewService("test-secret", 1, 168)
 	blacklistRepo := newMockTokenBlacklistRepository()
 
-	query := NewValidateTokenQuery(jwtService, blacklistRepo)
+	query := newValidateTokenQuery(jwtService, blacklistRepo, memory.NewRefreshTokenRepository())
 
 	tests := []struct {
 		name  string
@@ -109,7 +118,7 @@ func TestValidateTokenQuery_Execute_BlacklistedToken(t *testing.T) {
 	jwtService := jwt.NewService("test-secret", 1, 168)
 	blacklistRepo := newMockTokenBlacklistRepository()
 
-	query := NewValidateTokenQuery(jwtService, blacklistRepo)
+	query := newValidateTokenQuery(jwtService, blacklistRepo, memory.NewRefreshTokenRepository())
 
 	// Generate a valid token
 	userID := "user-123"
@@ -144,7 +153,7 @@ func TestValidateTokenQuery_Execute_BlacklistCheckError(t *testing.T) {
 		return false, errors.New("database error")
 	}
 
-	query := NewValidateTokenQuery(jwtService, blacklistRepo)
+	query := newValidateTokenQuery(jwtService, blacklistRepo, memory.NewRefreshTokenRepository())
 
 	// Generate a valid token
 	userID := "user-123"
@@ -163,3 +172,87 @@ func TestValidateTokenQuery_Execute_BlacklistCheckError(t *testing.T) {
 	}
 }
 
+func TestValidateTokenQuery_Execute_RevokedSession(t *testing.T) {
+	ctx := context.Background()
+	jwtService := jwt.NewService("test-secret", 1, 168)
+	blacklistRepo := newMockTokenBlacklistRepository()
+	refreshTokenRepo := memory.NewRefreshTokenRepository()
+
+	query := newValidateTokenQuery(jwtService, blacklistRepo, refreshTokenRepo)
+
+	userID := "user-123"
+	session := refresh_token.NewFamily(userID, refresh_token.Device{UserAgent: "test-agent"})
+	if err := refreshTokenRepo.CreateFamily(ctx, session); err != nil {
+		t.Fatalf("CreateFamily() error = %v", err)
+	}
+	tokenPair, err := jwtService.GenerateSessionTokenPair(userID, session.ID, []string{}, []string{})
+	if err != nil {
+		t.Fatalf("GenerateSessionTokenPair() error = %v", err)
+	}
+
+	resp, err := query.Execute(ctx, tokenPair.AccessToken)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !resp.Valid {
+		t.Fatal("ValidateTokenQueryResponse.Valid should be true while session is active")
+	}
+	if resp.SessionID != session.ID {
+		t.Errorf("ValidateTokenQueryResponse.SessionID = %q, want %q", resp.SessionID, session.ID)
+	}
+
+	// Kill the session
+	session.Revoke(refresh_token.RevokeReasonSessionKilled)
+	if err := refreshTokenRepo.RevokeFamily(ctx, session); err != nil {
+		t.Fatalf("RevokeFamily() error = %v", err)
+	}
+
+	resp, err = query.Execute(ctx, tokenPair.AccessToken)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if resp.Valid {
+		t.Error("ValidateTokenQueryResponse.Valid should be false for token of a revoked session")
+	}
+}
+
+func TestValidateTokenQuery_Execute_RolesPermissionsAndExpiry(t *testing.T) {
+	jwtService := jwt.NewService("test-secret", 1, 168)
+	repos := newRBACRepos()
+	repos.seedHierarchy(t)
+
+	query := NewValidateTokenQuery(jwtService, newMockTokenBlacklistRepository(), memory.NewRefreshTokenRepository(),
+		repos.userRoles, repos.roles, repos.effective, memory.NewEffectivePermissionCache(), time.Minute)
+
+	tokenPair, err := jwtService.GenerateTokenPair("user-admin")
+	if err != nil {
+		t.Fatalf("GenerateTokenPair() error = %v", err)
+	}
+
+	resp, err := query.Execute(context.Background(), tokenPair.AccessToken)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+	if !resp.Valid {
+		t.Fatal("ValidateTokenQueryResponse.Valid should be true for valid token")
+	}
+	if len(resp.Roles) != 1 || resp.Roles[0] != "admin" {
+		t.Errorf("ValidateTokenQueryResponse.Roles = %v, want [admin]", resp.Roles)
+	}
+
+	// Inherited and wildcard permissions are included
+	want := []string{"chat:delete", "chat:read", "orders:*", "users:unlock"}
+	if len(resp.Permissions) != len(want) {
+		t.Fatalf("ValidateTokenQueryResponse.Permissions = %v, want %v", resp.Permissions, want)
+	}
+	for i := range want {
+		if resp.Permissions[i] != want[i] {
+			t.Errorf("ValidateTokenQueryResponse.Permissions = %v, want %v", resp.Permissions, want)
+			break
+		}
+	}
+
+	if until := time.Until(resp.ExpiresAt); until <= 0 || until > time.Hour {
+		t.Errorf("ValidateTokenQueryResponse.ExpiresAt = %v, want within the next hour", resp.ExpiresAt)
+	}
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+// UserHistory is a user rebuilt from its events. Password hashes and MFA secrets are never
+// part of events, so they are empty
+type UserHistory struct {
+	User        user.User
+	CreatedAt   time.Time
+	Version     int // Number of events in the user's stream up to the point in time
+	LastEventAt time.Time
+}
+
+// RoleHistory is a role rebuilt from its events
+type RoleHistory struct {
+	Role        role.Role
+	Deleted     bool
+	Version     int // Number of events in the role's stream up to the point in time
+	LastEventAt time.Time
+}
+
+// AggregateHistoryRepository rehydrates aggregates from the event store instead of the current-state tables
+type AggregateHistoryRepository interface {
+	// UserAsOf returns the user as it was at asOf (inclusive), or nil if it did not exist yet
+	UserAsOf(ctx context.Context, userID string, asOf time.Time) (*UserHistory, error)
+	// RoleAsOf returns the role as it was at asOf (inclusive), or nil if it did not exist yet
+	RoleAsOf(ctx context.Context, roleID string, asOf time.Time) (*RoleHistory, error)
+	// ReplayUsers rebuilds every user that has a UserCreated event and calls fn with its current state
+	ReplayUsers(ctx context.Context, fn func(UserHistory) error) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+var ErrAPIKeyNotFound = pkgerrors.NewNotFoundError(pkgerrors.CodeAPIKeyNotFound)
+
+// APIKeyRepository defines the interface for API key persistence
+type APIKeyRepository interface {
+	Create(ctx context.Context, key api_key.Key) error
+	GetByID(ctx context.Context, id string) (api_key.Key, error)
+	GetByHash(ctx context.Context, keyHash string) (api_key.Key, error)
+	// ListByUser returns the user's keys that are not revoked, newest first
+	ListByUser(ctx context.Context, userID string) ([]api_key.Key, error)
+	Revoke(ctx context.Context, key api_key.Key) error
+	UpdateLastUsed(ctx context.Context, key api_key.Key) error
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/pkg/auditctx"
+)
+
+// AuditEvent is an event_store entry as shown in the audit trail
+type AuditEvent struct {
+	ID            string
+	AggregateID   string
+	AggregateType string
+	EventType     string
+	EventVersion  int
+	Payload       json.RawMessage
+	Metadata      auditctx.Metadata
+	OccurredAt    time.Time
+}
+
+// AuditCursor is the position after the last event of a page, events are ordered newest first
+type AuditCursor struct {
+	OccurredAt time.Time
+	ID         string
+}
+
+// AuditEventFilter selects audit events; empty fields do not filter
+type AuditEventFilter struct {
+	AggregateID   string
+	AggregateType string
+	EventTypes    []string
+	ActorID       string
+	From          *time.Time // Inclusive
+	To            *time.Time // Exclusive
+}
+
+// AuditLogRepository reads the event store for the audit trail
+type AuditLogRepository interface {
+	// List returns up to limit events older than after (nil for the first page), newest first
+	List(ctx context.Context, filter AuditEventFilter, after *AuditCursor, limit int) ([]AuditEvent, error)
+	// Stream calls fn for every matching event, oldest first, without loading them all into memory.
+	// It stops at the first error returned by fn
+	Stream(ctx context.Context, filter AuditEventFilter, fn func(AuditEvent) error) error
+}
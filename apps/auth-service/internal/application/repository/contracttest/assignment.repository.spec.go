@@ -0,0 +1,158 @@
+package contracttest
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/role_permission"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+)
+
+func runUserRoleSpecs(t *testing.T, newRepositories Factory) {
+	runSpecs(t, newRepositories, []spec{
+		{"assignments are listed both ways, ordered by ID", func(t *testing.T, repos Repositories) {
+			alice := newUser("alice@example.com")
+			bob := newUser("bob@example.com")
+			editor := newRole("editor")
+			viewer := newRole("viewer")
+			mustNoError(t, repos.Users.Create(alice), "Create(alice)")
+			mustNoError(t, repos.Users.Create(bob), "Create(bob)")
+			mustNoError(t, repos.Roles.Create(editor), "Create(editor)")
+			mustNoError(t, repos.Roles.Create(viewer), "Create(viewer)")
+
+			for _, assignment := range []user_role.UserRole{
+				{UserID: alice.ID, RoleID: viewer.ID, CreatedAt: time.Now().UTC()},
+				{UserID: alice.ID, RoleID: editor.ID, CreatedAt: time.Now().UTC()},
+				{UserID: bob.ID, RoleID: editor.ID, CreatedAt: time.Now().UTC()},
+			} {
+				mustNoError(t, repos.UserRoles.Create(assignment), "Create(assignment)")
+			}
+
+			roleIDs, err := repos.UserRoles.GetUserRoles(alice.ID)
+			mustNoError(t, err, "GetUserRoles")
+			assertIDs(t, roleIDs, sortedIDs(editor.ID, viewer.ID), "GetUserRoles")
+
+			userIDs, err := repos.UserRoles.GetRoleUsers(editor.ID)
+			mustNoError(t, err, "GetRoleUsers")
+			assertIDs(t, userIDs, sortedIDs(alice.ID, bob.ID), "GetRoleUsers")
+		}},
+		{"assigning twice keeps one assignment", func(t *testing.T, repos Repositories) {
+			alice := newUser("alice@example.com")
+			editor := newRole("editor")
+			mustNoError(t, repos.Users.Create(alice), "Create(alice)")
+			mustNoError(t, repos.Roles.Create(editor), "Create(editor)")
+
+			assignment := user_role.UserRole{UserID: alice.ID, RoleID: editor.ID, CreatedAt: time.Now().UTC()}
+			mustNoError(t, repos.UserRoles.Create(assignment), "Create")
+			mustNoError(t, repos.UserRoles.Create(assignment), "Create(again)")
+
+			roleIDs, err := repos.UserRoles.GetUserRoles(alice.ID)
+			mustNoError(t, err, "GetUserRoles")
+			assertIDs(t, roleIDs, []string{editor.ID}, "GetUserRoles")
+		}},
+		{"revoking removes the assignment and tolerates missing ones", func(t *testing.T, repos Repositories) {
+			alice := newUser("alice@example.com")
+			editor := newRole("editor")
+			mustNoError(t, repos.Users.Create(alice), "Create(alice)")
+			mustNoError(t, repos.Roles.Create(editor), "Create(editor)")
+			mustNoError(t, repos.UserRoles.Create(user_role.UserRole{UserID: alice.ID, RoleID: editor.ID, CreatedAt: time.Now().UTC()}), "Create")
+
+			mustNoError(t, repos.UserRoles.Delete(alice.ID, editor.ID), "Delete")
+			mustNoError(t, repos.UserRoles.Delete(alice.ID, editor.ID), "Delete(again)")
+
+			has, err := repos.UserRoles.HasRole(alice.ID, editor.ID)
+			mustNoError(t, err, "HasRole")
+			if has {
+				t.Fatal("HasRole() = true after the assignment was deleted")
+			}
+			roleIDs, err := repos.UserRoles.GetUserRoles(alice.ID)
+			mustNoError(t, err, "GetUserRoles")
+			assertIDs(t, roleIDs, []string{}, "GetUserRoles")
+		}},
+		{"unknown users and roles have no assignments", func(t *testing.T, repos Repositories) {
+			roleIDs, err := repos.UserRoles.GetUserRoles(newID())
+			mustNoError(t, err, "GetUserRoles")
+			assertIDs(t, roleIDs, []string{}, "GetUserRoles(unknown)")
+
+			userIDs, err := repos.UserRoles.GetRoleUsers(newID())
+			mustNoError(t, err, "GetRoleUsers")
+			assertIDs(t, userIDs, []string{}, "GetRoleUsers(unknown)")
+		}},
+	})
+}
+
+func runRolePermissionSpecs(t *testing.T, newRepositories Factory) {
+	runSpecs(t, newRepositories, []spec{
+		{"grants are listed both ways, ordered by ID", func(t *testing.T, repos Repositories) {
+			editor := newRole("editor")
+			viewer := newRole("viewer")
+			read := newPermission("chat", "read")
+			write := newPermission("chat", "write")
+			mustNoError(t, repos.Roles.Create(editor), "Create(editor)")
+			mustNoError(t, repos.Roles.Create(viewer), "Create(viewer)")
+			mustNoError(t, repos.Permissions.Create(read), "Create(read)")
+			mustNoError(t, repos.Permissions.Create(write), "Create(write)")
+
+			for _, grant := range []role_permission.RolePermission{
+				{RoleID: editor.ID, PermissionID: write.ID, CreatedAt: time.Now().UTC()},
+				{RoleID: editor.ID, PermissionID: read.ID, CreatedAt: time.Now().UTC()},
+				{RoleID: viewer.ID, PermissionID: read.ID, CreatedAt: time.Now().UTC()},
+			} {
+				mustNoError(t, repos.RolePermissions.Create(grant), "Create(grant)")
+			}
+
+			permissionIDs, err := repos.RolePermissions.GetRolePermissions(editor.ID)
+			mustNoError(t, err, "GetRolePermissions")
+			assertIDs(t, permissionIDs, sortedIDs(read.ID, write.ID), "GetRolePermissions")
+
+			roleIDs, err := repos.RolePermissions.GetPermissionRoles(read.ID)
+			mustNoError(t, err, "GetPermissionRoles")
+			assertIDs(t, roleIDs, sortedIDs(editor.ID, viewer.ID), "GetPermissionRoles")
+		}},
+		{"granting twice keeps one grant", func(t *testing.T, repos Repositories) {
+			editor := newRole("editor")
+			read := newPermission("chat", "read")
+			mustNoError(t, repos.Roles.Create(editor), "Create(editor)")
+			mustNoError(t, repos.Permissions.Create(read), "Create(read)")
+
+			grant := role_permission.RolePermission{RoleID: editor.ID, PermissionID: read.ID, CreatedAt: time.Now().UTC()}
+			mustNoError(t, repos.RolePermissions.Create(grant), "Create")
+			mustNoError(t, repos.RolePermissions.Create(grant), "Create(again)")
+
+			permissionIDs, err := repos.RolePermissions.GetRolePermissions(editor.ID)
+			mustNoError(t, err, "GetRolePermissions")
+			assertIDs(t, permissionIDs, []string{read.ID}, "GetRolePermissions")
+		}},
+		{"revoking removes the grant and tolerates missing ones", func(t *testing.T, repos Repositories) {
+			editor := newRole("editor")
+			read := newPermission("chat", "read")
+			mustNoError(t, repos.Roles.Create(editor), "Create(editor)")
+			mustNoError(t, repos.Permissions.Create(read), "Create(read)")
+			mustNoError(t, repos.RolePermissions.Create(role_permission.RolePermission{RoleID: editor.ID, PermissionID: read.ID, CreatedAt: time.Now().UTC()}), "Create")
+
+			mustNoError(t, repos.RolePermissions.Delete(editor.ID, read.ID), "Delete")
+			mustNoError(t, repos.RolePermissions.Delete(editor.ID, read.ID), "Delete(again)")
+
+			has, err := repos.RolePermissions.HasPermission(editor.ID, read.ID)
+			mustNoError(t, err, "HasPermission")
+			if has {
+				t.Fatal("HasPermission() = true after the grant was deleted")
+			}
+		}},
+		{"unknown roles and permissions have no grants", func(t *testing.T, repos Repositories) {
+			permissionIDs, err := repos.RolePermissions.GetRolePermissions(newID())
+			mustNoError(t, err, "GetRolePermissions")
+			assertIDs(t, permissionIDs, []string{}, "GetRolePermissions(unknown)")
+
+			roleIDs, err := repos.RolePermissions.GetPermissionRoles(newID())
+			mustNoError(t, err, "GetPermissionRoles")
+			assertIDs(t, roleIDs, []string{}, "GetPermissionRoles(unknown)")
+		}},
+	})
+}
+
+func sortedIDs(ids ...string) []string {
+	sort.Strings(ids)
+	return ids
+}
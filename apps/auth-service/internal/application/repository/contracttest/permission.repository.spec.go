@@ -0,0 +1,136 @@
+package contracttest
+
+import (
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+const (
+	codePermissionNotFound      pkgerrors.ErrorCode = "permission_not_found"
+	codePermissionAlreadyExists pkgerrors.ErrorCode = "permission_already_exists"
+)
+
+func newPermission(resource, action string) permission.Permission {
+	p := permission.Permission{
+		ID:       newID(),
+		Name:     resource + " " + action,
+		Resource: resource,
+		Action:   action,
+	}
+	// Records a PermissionCreated event, which must not be persisted
+	p.Create()
+	return p
+}
+
+func runPermissionSpecs(t *testing.T, newRepositories Factory) {
+	runSpecs(t, newRepositories, []spec{
+		{"create then read by ID and resource/action", func(t *testing.T, repos Repositories) {
+			p := newPermission("chat", "read")
+			mustNoError(t, repos.Permissions.Create(p), "Create")
+
+			byID, err := repos.Permissions.GetByID(p.ID)
+			mustNoError(t, err, "GetByID")
+			assertSamePermission(t, byID, p)
+
+			byPair, err := repos.Permissions.GetByResourceAction("chat", "read")
+			mustNoError(t, err, "GetByResourceAction")
+			assertSamePermission(t, byPair, p)
+		}},
+		{"create with a taken resource/action conflicts", func(t *testing.T, repos Repositories) {
+			mustNoError(t, repos.Permissions.Create(newPermission("chat", "read")), "Create")
+
+			err := repos.Permissions.Create(newPermission("chat", "read"))
+			assertErrorCode(t, err, codePermissionAlreadyExists, "Create(duplicate resource/action)")
+		}},
+		{"unknown permissions are not found", func(t *testing.T, repos Repositories) {
+			_, err := repos.Permissions.GetByID(newID())
+			assertErrorCode(t, err, codePermissionNotFound, "GetByID(unknown)")
+
+			_, err = repos.Permissions.GetByResourceAction("chat", "missing")
+			assertErrorCode(t, err, codePermissionNotFound, "GetByResourceAction(unknown)")
+
+			err = repos.Permissions.Update(newPermission("chat", "missing"))
+			assertErrorCode(t, err, codePermissionNotFound, "Update(unknown)")
+
+			err = repos.Permissions.Delete(newID())
+			assertErrorCode(t, err, codePermissionNotFound, "Delete(unknown)")
+		}},
+		{"update moves the permission to its new resource/action", func(t *testing.T, repos Repositories) {
+			p := newPermission("chat", "read")
+			mustNoError(t, repos.Permissions.Create(p), "Create")
+
+			p.Update("Chat write", "chat", "write")
+			mustNoError(t, repos.Permissions.Update(p), "Update")
+
+			got, err := repos.Permissions.GetByResourceAction("chat", "write")
+			mustNoError(t, err, "GetByResourceAction(new)")
+			assertSamePermission(t, got, p)
+
+			_, err = repos.Permissions.GetByResourceAction("chat", "read")
+			assertErrorCode(t, err, codePermissionNotFound, "GetByResourceAction(previous)")
+		}},
+		{"update to a taken resource/action conflicts", func(t *testing.T, repos Repositories) {
+			read := newPermission("chat", "read")
+			write := newPermission("chat", "write")
+			mustNoError(t, repos.Permissions.Create(read), "Create(read)")
+			mustNoError(t, repos.Permissions.Create(write), "Create(write)")
+
+			write.Action = "read"
+			err := repos.Permissions.Update(write)
+			assertErrorCode(t, err, codePermissionAlreadyExists, "Update(taken resource/action)")
+		}},
+		{"delete removes the permission", func(t *testing.T, repos Repositories) {
+			p := newPermission("chat", "read")
+			mustNoError(t, repos.Permissions.Create(p), "Create")
+
+			mustNoError(t, repos.Permissions.Delete(p.ID), "Delete")
+
+			_, err := repos.Permissions.GetByID(p.ID)
+			assertErrorCode(t, err, codePermissionNotFound, "GetByID(deleted)")
+			_, err = repos.Permissions.GetByResourceAction("chat", "read")
+			assertErrorCode(t, err, codePermissionNotFound, "GetByResourceAction(deleted)")
+		}},
+		{"list pages by resource then action", func(t *testing.T, repos Repositories) {
+			// "chat" sorts before "chat-admin" as a resource, whatever the separator sorts like
+			for _, pair := range [][2]string{{"chat-admin", "read"}, {"chat", "write"}, {"user", "read"}, {"chat", "read"}} {
+				mustNoError(t, repos.Permissions.Create(newPermission(pair[0], pair[1])), "Create")
+			}
+
+			pages := []struct {
+				limit, offset int
+				want          []string
+			}{
+				{3, 0, []string{"chat read", "chat write", "chat-admin read"}},
+				{3, 3, []string{"user read"}},
+				{3, 6, []string{}},
+			}
+			for _, page := range pages {
+				permissions, err := repos.Permissions.List(page.limit, page.offset)
+				mustNoError(t, err, "List")
+				names := make([]string, len(permissions))
+				for i, p := range permissions {
+					names[i] = p.Resource + " " + p.Action
+				}
+				assertIDs(t, names, page.want, "List resource/action")
+			}
+
+			count, err := repos.Permissions.Count()
+			mustNoError(t, err, "Count")
+			if count != 4 {
+				t.Fatalf("Count() = %d, want 4", count)
+			}
+		}},
+	})
+}
+
+func assertSamePermission(t *testing.T, got, want permission.Permission) {
+	t.Helper()
+	if got.ID != want.ID || got.Name != want.Name || got.Resource != want.Resource || got.Action != want.Action {
+		t.Fatalf("permission = %+v, want %s/%s/%s:%s", got, want.ID, want.Name, want.Resource, want.Action)
+	}
+	if len(got.Events()) != 0 {
+		t.Fatalf("stored permission has %d domain events, events are never persisted", len(got.Events()))
+	}
+}
@@ -0,0 +1,147 @@
+package contracttest
+
+import (
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+const (
+	codeRoleNotFound      pkgerrors.ErrorCode = "role_not_found"
+	codeRoleAlreadyExists pkgerrors.ErrorCode = "role_already_exists"
+)
+
+func newRole(name string) role.Role {
+	r := role.Role{
+		ID:          newID(),
+		Name:        name,
+		Description: "Contract role " + name,
+	}
+	// Records a RoleCreated event, which must not be persisted
+	r.Create()
+	return r
+}
+
+func runRoleSpecs(t *testing.T, newRepositories Factory) {
+	runSpecs(t, newRepositories, []spec{
+		{"create then read by ID and name", func(t *testing.T, repos Repositories) {
+			r := newRole("editor")
+			mustNoError(t, repos.Roles.Create(r), "Create")
+
+			byID, err := repos.Roles.GetByID(r.ID)
+			mustNoError(t, err, "GetByID")
+			assertSameRole(t, byID, r)
+
+			byName, err := repos.Roles.GetByName(r.Name)
+			mustNoError(t, err, "GetByName")
+			assertSameRole(t, byName, r)
+		}},
+		{"create with a taken name conflicts", func(t *testing.T, repos Repositories) {
+			mustNoError(t, repos.Roles.Create(newRole("editor")), "Create")
+
+			err := repos.Roles.Create(newRole("editor"))
+			assertErrorCode(t, err, codeRoleAlreadyExists, "Create(duplicate name)")
+		}},
+		{"unknown roles are not found", func(t *testing.T, repos Repositories) {
+			_, err := repos.Roles.GetByID(newID())
+			assertErrorCode(t, err, codeRoleNotFound, "GetByID(unknown)")
+
+			_, err = repos.Roles.GetByName("missing")
+			assertErrorCode(t, err, codeRoleNotFound, "GetByName(unknown)")
+
+			err = repos.Roles.Update(newRole("missing"))
+			assertErrorCode(t, err, codeRoleNotFound, "Update(unknown)")
+
+			err = repos.Roles.Delete(newID())
+			assertErrorCode(t, err, codeRoleNotFound, "Delete(unknown)")
+		}},
+		{"update renames and writes an emptied description", func(t *testing.T, repos Repositories) {
+			parent := newRole("staff")
+			r := newRole("editor")
+			mustNoError(t, repos.Roles.Create(parent), "Create(parent)")
+			mustNoError(t, repos.Roles.Create(r), "Create")
+
+			r.Update("senior-editor", "")
+			r.ParentID = parent.ID
+			mustNoError(t, repos.Roles.Update(r), "Update")
+
+			got, err := repos.Roles.GetByID(r.ID)
+			mustNoError(t, err, "GetByID")
+			assertSameRole(t, got, r)
+
+			_, err = repos.Roles.GetByName("editor")
+			assertErrorCode(t, err, codeRoleNotFound, "GetByName(previous name)")
+		}},
+		{"update to a taken name conflicts", func(t *testing.T, repos Repositories) {
+			editor := newRole("editor")
+			viewer := newRole("viewer")
+			mustNoError(t, repos.Roles.Create(editor), "Create(editor)")
+			mustNoError(t, repos.Roles.Create(viewer), "Create(viewer)")
+
+			viewer.Name = editor.Name
+			err := repos.Roles.Update(viewer)
+			assertErrorCode(t, err, codeRoleAlreadyExists, "Update(taken name)")
+		}},
+		{"delete removes the role and detaches its children", func(t *testing.T, repos Repositories) {
+			parent := newRole("staff")
+			child := newRole("editor")
+			child.ParentID = parent.ID
+			mustNoError(t, repos.Roles.Create(parent), "Create(parent)")
+			mustNoError(t, repos.Roles.Create(child), "Create(child)")
+
+			mustNoError(t, repos.Roles.Delete(parent.ID), "Delete")
+
+			_, err := repos.Roles.GetByID(parent.ID)
+			assertErrorCode(t, err, codeRoleNotFound, "GetByID(deleted)")
+			_, err = repos.Roles.GetByName(parent.Name)
+			assertErrorCode(t, err, codeRoleNotFound, "GetByName(deleted)")
+
+			got, err := repos.Roles.GetByID(child.ID)
+			mustNoError(t, err, "GetByID(child)")
+			if got.ParentID != "" {
+				t.Fatalf("child ParentID = %s after its parent was deleted, want none", got.ParentID)
+			}
+		}},
+		{"list pages by name", func(t *testing.T, repos Repositories) {
+			for _, name := range []string{"role-c", "role-a", "role-e", "role-b", "role-d"} {
+				mustNoError(t, repos.Roles.Create(newRole(name)), "Create("+name+")")
+			}
+
+			pages := []struct {
+				limit, offset int
+				want          []string
+			}{
+				{2, 0, []string{"role-a", "role-b"}},
+				{2, 2, []string{"role-c", "role-d"}},
+				{2, 4, []string{"role-e"}},
+				{2, 10, []string{}},
+			}
+			for _, page := range pages {
+				roles, err := repos.Roles.List(page.limit, page.offset)
+				mustNoError(t, err, "List")
+				names := make([]string, len(roles))
+				for i, r := range roles {
+					names[i] = r.Name
+				}
+				assertIDs(t, names, page.want, "List names")
+			}
+
+			count, err := repos.Roles.Count()
+			mustNoError(t, err, "Count")
+			if count != 5 {
+				t.Fatalf("Count() = %d, want 5", count)
+			}
+		}},
+	})
+}
+
+func assertSameRole(t *testing.T, got, want role.Role) {
+	t.Helper()
+	if got.ID != want.ID || got.Name != want.Name || got.Description != want.Description || got.ParentID != want.ParentID {
+		t.Fatalf("role = %+v, want %s/%s/%q/parent %q", got, want.ID, want.Name, want.Description, want.ParentID)
+	}
+	if len(got.Events()) != 0 {
+		t.Fatalf("stored role has %d domain events, events are never persisted", len(got.Events()))
+	}
+}
@@ -0,0 +1,94 @@
+// Package contracttest holds the behavioral specs every repository implementation must meet.
+// The memory and Postgres repositories run the same specs, so they cannot drift apart
+package contracttest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+// Repositories are the implementations under test. They must share one empty store:
+// a user created through UnitOfWork is read back through Users
+type Repositories struct {
+	Users           repository.UserRepository
+	Roles           repository.RoleRepository
+	Permissions     repository.PermissionRepository
+	RolePermissions repository.RolePermissionRepository
+	UserRoles       repository.UserRoleRepository
+	UnitOfWork      unit_of_work.Factory
+}
+
+// Factory returns repositories over an empty store; it is called once per spec
+type Factory func(t *testing.T) Repositories
+
+// Run runs every spec against the repositories returned by newRepositories
+func Run(t *testing.T, newRepositories Factory) {
+	t.Run("UserRepository", func(t *testing.T) { runUserSpecs(t, newRepositories) })
+	t.Run("RoleRepository", func(t *testing.T) { runRoleSpecs(t, newRepositories) })
+	t.Run("PermissionRepository", func(t *testing.T) { runPermissionSpecs(t, newRepositories) })
+	t.Run("UserRoleRepository", func(t *testing.T) { runUserRoleSpecs(t, newRepositories) })
+	t.Run("RolePermissionRepository", func(t *testing.T) { runRolePermissionSpecs(t, newRepositories) })
+	t.Run("UnitOfWork", func(t *testing.T) { runUnitOfWorkSpecs(t, newRepositories) })
+}
+
+// spec is one behavior, run against a fresh store
+type spec struct {
+	name string
+	run  func(t *testing.T, repos Repositories)
+}
+
+func runSpecs(t *testing.T, newRepositories Factory, specs []spec) {
+	for _, s := range specs {
+		t.Run(s.name, func(t *testing.T) {
+			s.run(t, newRepositories(t))
+		})
+	}
+}
+
+// newID returns a UUID, the Postgres tables key on uuid columns
+func newID() string {
+	return uuid.NewString()
+}
+
+func mustNoError(t *testing.T, err error, action string) {
+	t.Helper()
+	if err != nil {
+		t.Fatalf("%s: unexpected error %v", action, err)
+	}
+}
+
+// assertErrorCode checks the application error code; implementations return distinct error
+// values with the same code, so errors are compared by code rather than identity
+func assertErrorCode(t *testing.T, err error, code pkgerrors.ErrorCode, action string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("%s: error = nil, want %s", action, code)
+	}
+	var appErr *pkgerrors.AppError
+	if !errors.As(err, &appErr) {
+		t.Fatalf("%s: error = %v, want application error %s", action, err, code)
+	}
+	if appErr.Code != code {
+		t.Fatalf("%s: error code = %s, want %s", action, appErr.Code, code)
+	}
+}
+
+func assertIDs(t *testing.T, got, want []string, action string) {
+	t.Helper()
+	if got == nil {
+		t.Fatalf("%s = nil, want an empty slice at least", action)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("%s = %v, want %v", action, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s = %v, want %v", action, got, want)
+		}
+	}
+}
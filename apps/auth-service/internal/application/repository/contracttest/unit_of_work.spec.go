@@ -0,0 +1,92 @@
+package contracttest
+
+import (
+	"context"
+	"testing"
+
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+func runUnitOfWorkSpecs(t *testing.T, newRepositories Factory) {
+	runSpecs(t, newRepositories, []spec{
+		{"committed writes are visible outside the unit of work", func(t *testing.T, repos Repositories) {
+			uow, err := repos.UnitOfWork.New(context.Background())
+			mustNoError(t, err, "New")
+			u := newUser("alice@example.com")
+			mustNoError(t, uow.Users().Create(u), "Create")
+			mustNoError(t, uow.Commit(), "Commit")
+			// Deferred rollbacks run after Commit and must not undo it
+			mustNoError(t, uow.Rollback(), "Rollback(after commit)")
+
+			got, err := repos.Users.GetByID(u.ID)
+			mustNoError(t, err, "GetByID")
+			assertSameUser(t, got, u)
+		}},
+		{"reads inside the unit of work see its own writes", func(t *testing.T, repos Repositories) {
+			uow, err := repos.UnitOfWork.New(context.Background())
+			mustNoError(t, err, "New")
+			defer uow.Rollback()
+
+			u := newUser("alice@example.com")
+			mustNoError(t, uow.Users().Create(u), "Create")
+			u.Name = "Alice Smith"
+			mustNoError(t, uow.Users().Update(u), "Update")
+
+			got, err := uow.Users().FindByEmail(u.Email)
+			mustNoError(t, err, "FindByEmail")
+			assertSameUser(t, got, u)
+		}},
+		{"rollback discards created users", func(t *testing.T, repos Repositories) {
+			uow, err := repos.UnitOfWork.New(context.Background())
+			mustNoError(t, err, "New")
+			u := newUser("alice@example.com")
+			mustNoError(t, uow.Users().Create(u), "Create")
+			mustNoError(t, uow.Rollback(), "Rollback")
+
+			_, err = repos.Users.GetByID(u.ID)
+			assertErrorCode(t, err, pkgerrors.CodeUserNotFound, "GetByID(rolled back)")
+			// The email is free again
+			mustNoError(t, repos.Users.Create(newUser(u.Email)), "Create(same email)")
+		}},
+		{"rollback restores updated users", func(t *testing.T, repos Repositories) {
+			u := newUser("alice@example.com")
+			mustNoError(t, repos.Users.Create(u), "Create")
+
+			uow, err := repos.UnitOfWork.New(context.Background())
+			mustNoError(t, err, "New")
+			changed := u
+			changed.Name = "Mallory"
+			changed.Email = "mallory@example.com"
+			mustNoError(t, uow.Users().Update(changed), "Update")
+			changed.Name = "Mallory Again"
+			mustNoError(t, uow.Users().Update(changed), "Update(again)")
+			mustNoError(t, uow.Rollback(), "Rollback")
+
+			got, err := repos.Users.GetByID(u.ID)
+			mustNoError(t, err, "GetByID")
+			assertSameUser(t, got, u)
+			_, err = repos.Users.FindByEmail(changed.Email)
+			assertErrorCode(t, err, pkgerrors.CodeInvalidCredentials, "FindByEmail(rolled back email)")
+		}},
+		{"rollback restores consumed MFA codes", func(t *testing.T, repos Repositories) {
+			u := newUser("alice@example.com")
+			u.MFAEnabled = true
+			u.MFALastUsedStep = 100
+			u.MFARecoveryCodes = []string{"hash-1"}
+			mustNoError(t, repos.Users.Create(u), "Create")
+
+			uow, err := repos.UnitOfWork.New(context.Background())
+			mustNoError(t, err, "New")
+			_, err = uow.Users().ConsumeTOTPStep(u.ID, 101)
+			mustNoError(t, err, "ConsumeTOTPStep")
+			_, err = uow.Users().ConsumeRecoveryCode(u.ID, "hash-1")
+			mustNoError(t, err, "ConsumeRecoveryCode")
+			mustNoError(t, uow.Rollback(), "Rollback")
+
+			got, err := repos.Users.GetByID(u.ID)
+			mustNoError(t, err, "GetByID")
+			assertSameUser(t, got, u)
+			assertIDs(t, got.MFARecoveryCodes, []string{"hash-1"}, "MFARecoveryCodes")
+		}},
+	})
+}
@@ -0,0 +1,179 @@
+package contracttest
+
+import (
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+func newUser(email string) user.User {
+	return user.User{
+		ID:       newID(),
+		Email:    email,
+		Password: "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$aGFzaA",
+		Name:     "Contract User",
+	}
+}
+
+func runUserSpecs(t *testing.T, newRepositories Factory) {
+	runSpecs(t, newRepositories, []spec{
+		{"create then read by ID and email", func(t *testing.T, repos Repositories) {
+			u := newUser("alice@example.com")
+			u.EmailVerified = true
+			mustNoError(t, repos.Users.Create(u), "Create")
+
+			byID, err := repos.Users.GetByID(u.ID)
+			mustNoError(t, err, "GetByID")
+			assertSameUser(t, byID, u)
+
+			byEmail, err := repos.Users.FindByEmail(u.Email)
+			mustNoError(t, err, "FindByEmail")
+			assertSameUser(t, byEmail, u)
+		}},
+		{"create with a taken email conflicts", func(t *testing.T, repos Repositories) {
+			mustNoError(t, repos.Users.Create(newUser("alice@example.com")), "Create")
+
+			err := repos.Users.Create(newUser("alice@example.com"))
+			assertErrorCode(t, err, pkgerrors.CodeEmailAlreadyExists, "Create(duplicate email)")
+		}},
+		{"unknown users are not found", func(t *testing.T, repos Repositories) {
+			_, err := repos.Users.GetByID(newID())
+			assertErrorCode(t, err, pkgerrors.CodeUserNotFound, "GetByID(unknown)")
+
+			// Unknown emails read as invalid credentials, so login does not reveal which emails exist
+			_, err = repos.Users.FindByEmail("nobody@example.com")
+			assertErrorCode(t, err, pkgerrors.CodeInvalidCredentials, "FindByEmail(unknown)")
+		}},
+		{"update writes every field, including cleared ones", func(t *testing.T, repos Repositories) {
+			u := newUser("alice@example.com")
+			u.MFAEnabled = true
+			u.MFASecret = "JBSWY3DPEHPK3PXP"
+			u.MFALastUsedStep = 56789012
+			mustNoError(t, repos.Users.Create(u), "Create")
+
+			u.Name = "Alice Smith"
+			u.Email = "alice.smith@example.com"
+			u.MFAEnabled = false
+			u.MFASecret = ""
+			u.MFALastUsedStep = 0
+			mustNoError(t, repos.Users.Update(u), "Update")
+
+			got, err := repos.Users.GetByID(u.ID)
+			mustNoError(t, err, "GetByID")
+			assertSameUser(t, got, u)
+
+			_, err = repos.Users.FindByEmail("alice@example.com")
+			assertErrorCode(t, err, pkgerrors.CodeInvalidCredentials, "FindByEmail(previous email)")
+		}},
+		{"update of an unknown user is not found", func(t *testing.T, repos Repositories) {
+			err := repos.Users.Update(newUser("ghost@example.com"))
+			assertErrorCode(t, err, pkgerrors.CodeUserNotFound, "Update(unknown)")
+		}},
+		{"update to a taken email conflicts", func(t *testing.T, repos Repositories) {
+			alice := newUser("alice@example.com")
+			bob := newUser("bob@example.com")
+			mustNoError(t, repos.Users.Create(alice), "Create(alice)")
+			mustNoError(t, repos.Users.Create(bob), "Create(bob)")
+
+			bob.Email = alice.Email
+			err := repos.Users.Update(bob)
+			assertErrorCode(t, err, pkgerrors.CodeEmailAlreadyExists, "Update(taken email)")
+
+			got, err := repos.Users.FindByEmail(alice.Email)
+			mustNoError(t, err, "FindByEmail")
+			if got.ID != alice.ID {
+				t.Fatalf("FindByEmail(%s) = user %s, want %s", alice.Email, got.ID, alice.ID)
+			}
+		}},
+		{"a TOTP step is consumed once and never before a later one", func(t *testing.T, repos Repositories) {
+			u := newUser("alice@example.com")
+			u.MFAEnabled = true
+			u.MFALastUsedStep = 100
+			mustNoError(t, repos.Users.Create(u), "Create")
+
+			for _, tc := range []struct {
+				step int64
+				want bool
+			}{
+				{100, false}, // Used already
+				{99, false},  // Older than the last used step
+				{101, true},
+				{101, false}, // Replayed
+			} {
+				consumed, err := repos.Users.ConsumeTOTPStep(u.ID, tc.step)
+				mustNoError(t, err, "ConsumeTOTPStep")
+				if consumed != tc.want {
+					t.Fatalf("ConsumeTOTPStep(%d) = %v, want %v", tc.step, consumed, tc.want)
+				}
+			}
+
+			got, err := repos.Users.GetByID(u.ID)
+			mustNoError(t, err, "GetByID")
+			if got.MFALastUsedStep != 101 {
+				t.Fatalf("MFALastUsedStep = %d, want 101", got.MFALastUsedStep)
+			}
+		}},
+		{"a recovery code is consumed once", func(t *testing.T, repos Repositories) {
+			u := newUser("alice@example.com")
+			u.MFAEnabled = true
+			u.MFARecoveryCodes = []string{"hash-1", "hash-2", "hash-3"}
+			mustNoError(t, repos.Users.Create(u), "Create")
+
+			for _, tc := range []struct {
+				hash string
+				want bool
+			}{
+				{"hash-2", true},
+				{"hash-2", false}, // Replayed
+				{"unknown", false},
+			} {
+				consumed, err := repos.Users.ConsumeRecoveryCode(u.ID, tc.hash)
+				mustNoError(t, err, "ConsumeRecoveryCode")
+				if consumed != tc.want {
+					t.Fatalf("ConsumeRecoveryCode(%s) = %v, want %v", tc.hash, consumed, tc.want)
+				}
+			}
+
+			got, err := repos.Users.GetByID(u.ID)
+			mustNoError(t, err, "GetByID")
+			assertIDs(t, got.MFARecoveryCodes, []string{"hash-1", "hash-3"}, "MFARecoveryCodes")
+		}},
+		{"get by IDs keeps the requested order and skips unknown IDs", func(t *testing.T, repos Repositories) {
+			alice := newUser("alice@example.com")
+			bob := newUser("bob@example.com")
+			mustNoError(t, repos.Users.Create(alice), "Create(alice)")
+			mustNoError(t, repos.Users.Create(bob), "Create(bob)")
+
+			users, err := repos.Users.GetByIDs([]string{bob.ID, newID(), alice.ID, bob.ID})
+			mustNoError(t, err, "GetByIDs")
+			ids := make([]string, len(users))
+			for i, u := range users {
+				ids[i] = u.ID
+			}
+			assertIDs(t, ids, []string{bob.ID, alice.ID}, "GetByIDs")
+
+			users, err = repos.Users.GetByIDs(nil)
+			mustNoError(t, err, "GetByIDs(nil)")
+			if len(users) != 0 {
+				t.Fatalf("GetByIDs(nil) = %v, want no users", users)
+			}
+		}},
+	})
+}
+
+func assertSameUser(t *testing.T, got, want user.User) {
+	t.Helper()
+	if got.ID != want.ID || got.Email != want.Email || got.Name != want.Name || got.Password != want.Password {
+		t.Fatalf("user = %s/%s/%s, want %s/%s/%s", got.ID, got.Email, got.Name, want.ID, want.Email, want.Name)
+	}
+	if got.EmailVerified != want.EmailVerified || got.MFAEnabled != want.MFAEnabled || got.MFASecret != want.MFASecret ||
+		got.MFALastUsedStep != want.MFALastUsedStep {
+		t.Fatalf("user flags = verified %v, mfa %v/%q/%d, want verified %v, mfa %v/%q/%d",
+			got.EmailVerified, got.MFAEnabled, got.MFASecret, got.MFALastUsedStep,
+			want.EmailVerified, want.MFAEnabled, want.MFASecret, want.MFALastUsedStep)
+	}
+	if len(got.Events()) != 0 {
+		t.Fatalf("stored user has %d domain events, events are never persisted", len(got.Events()))
+	}
+}
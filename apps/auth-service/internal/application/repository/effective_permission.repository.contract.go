@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+)
+
+// EffectivePermissionRepository resolves the permissions a user holds through their roles
+// and every role those roles inherit from
+type EffectivePermissionRepository interface {
+	// GetUserPermissions returns each effective permission once
+	GetUserPermissions(userID string) ([]permission.Permission, error)
+}
+
+// EffectivePermissionCache caches the effective grants of a user between permission checks
+type EffectivePermissionCache interface {
+	// Get returns the cached grants; found is false when nothing is cached for the user
+	Get(ctx context.Context, userID string) (grants []permission.Grant, found bool, err error)
+	// Set caches the grants; they are dropped after ttl
+	Set(ctx context.Context, userID string, grants []permission.Grant, ttl time.Duration) error
+	// InvalidateUser drops the cached grants of one user, e.g. after a role assignment
+	InvalidateUser(ctx context.Context, userID string) error
+	// InvalidateAll drops every cached set, e.g. after a role's permissions or parent change
+	InvalidateAll(ctx context.Context) error
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+var ErrGDPRRequestNotFound = pkgerrors.NewNotFoundError(pkgerrors.CodeGDPRRequestNotFound)
+
+// GDPRRequestRepository persists GDPR deletion and export requests with their per-service steps
+type GDPRRequestRepository interface {
+	Create(ctx context.Context, request gdpr_request.Request) error
+	GetByID(ctx context.Context, id string) (gdpr_request.Request, error)
+	// Update stores the status and every step of the request
+	Update(ctx context.Context, request gdpr_request.Request) error
+}
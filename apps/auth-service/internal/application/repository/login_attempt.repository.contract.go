@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+// LoginAttemptRepository stores failed login state per account
+type LoginAttemptRepository interface {
+	// Get returns the user's state, or a zero state with UserID set if there is none
+	Get(ctx context.Context, userID string) (user.LoginAttempts, error)
+	// RecordFailure atomically counts a failed login on the user's state, so concurrent failures are
+	// never lost, and keeps the state for LoginAttempts.TTL. It returns the new state and, when the
+	// failure locked the account, the UserLockedOut event with true.
+	RecordFailure(ctx context.Context, userID string, policy user.LockoutPolicy, email string, now time.Time) (user.LoginAttempts, user.UserLockedOutEvent, bool, error)
+	// Delete forgets the user's failures and locks
+	Delete(ctx context.Context, userID string) error
+}
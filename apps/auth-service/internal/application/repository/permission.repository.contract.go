@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+)
+
+// PermissionRepository defines the interface for permission persistence
+type PermissionRepository interface {
+	Create(perm permission.Permission) error
+	GetByID(id string) (permission.Permission, error)
+	GetByResourceAction(resource, action string) (permission.Permission, error)
+	Update(perm permission.Permission) error
+	Delete(id string) error
+	// List returns a page of permissions ordered by resource and action
+	List(limit, offset int) ([]permission.Permission, error)
+	Count() (int64, error)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+var (
+	ErrRefreshTokenNotFound       = pkgerrors.NewNotFoundError(pkgerrors.CodeTokenInvalid)
+	ErrRefreshTokenFamilyNotFound = pkgerrors.NewNotFoundError(pkgerrors.CodeTokenInvalid)
+	// ErrRefreshTokenAlreadyRotated is returned when another request rotated the token first
+	ErrRefreshTokenAlreadyRotated = errors.New("refresh token already rotated")
+)
+
+// RefreshTokenRepository defines the interface for refresh token family persistence
+type RefreshTokenRepository interface {
+	CreateFamily(ctx context.Context, family refresh_token.Family) error
+	GetFamily(ctx context.Context, id string) (refresh_token.Family, error)
+	// ListActiveFamilies returns the user's non-revoked families (sessions), most recently used first
+	ListActiveFamilies(ctx context.Context, userID string) ([]refresh_token.Family, error)
+	// TouchFamily persists the last-used time of the family
+	TouchFamily(ctx context.Context, family refresh_token.Family) error
+	// RevokeFamily persists the revocation state of the family
+	RevokeFamily(ctx context.Context, family refresh_token.Family) error
+	// RevokeAllFamilies revokes every active family of the user except exceptFamilyID (may be empty)
+	RevokeAllFamilies(ctx context.Context, userID string, exceptFamilyID string, reason string) (int64, error)
+
+	CreateToken(ctx context.Context, token refresh_token.Token) error
+	GetTokenByHash(ctx context.Context, tokenHash string) (refresh_token.Token, error)
+	// RotateToken marks the token as rotated only if it has not been rotated yet,
+	// otherwise it returns ErrRefreshTokenAlreadyRotated
+	RotateToken(ctx context.Context, token refresh_token.Token) error
+}
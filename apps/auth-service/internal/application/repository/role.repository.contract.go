@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"golang-social-media/apps/auth-service/internal/domain/role"
+)
+
+// RoleRepository defines the interface for role persistence
+type RoleRepository interface {
+	Create(roleEntity role.Role) error
+	GetByID(id string) (role.Role, error)
+	GetByName(name string) (role.Role, error)
+	Update(roleEntity role.Role) error
+	Delete(id string) error
+	// List returns a page of roles ordered by name
+	List(limit, offset int) ([]role.Role, error)
+	Count() (int64, error)
+}
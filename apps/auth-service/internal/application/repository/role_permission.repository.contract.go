@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"golang-social-media/apps/auth-service/internal/domain/role_permission"
+)
+
+// RolePermissionRepository defines the interface for role -> permission grants
+type RolePermissionRepository interface {
+	Create(rp role_permission.RolePermission) error
+	GetRolePermissions(roleID string) ([]string, error)
+	GetPermissionRoles(permissionID string) ([]string, error)
+	Delete(roleID, permissionID string) error
+	HasPermission(roleID, permissionID string) (bool, error)
+}
@@ -8,7 +8,15 @@ import (
 type UserRepository interface {
 	Create(u user.User) error
 	GetByID(id string) (user.User, error)
+	// GetByIDs returns the users that exist, in the order of ids; unknown ids are skipped
+	GetByIDs(ids []string) ([]user.User, error)
 	FindByEmail(email string) (user.User, error)
 	Update(u user.User) error
+	// ConsumeTOTPStep stores step as the last used TOTP time step unless that step, or a later one, was used already.
+	// It reports whether the step was consumed; the check and the write are atomic, so a code is accepted only once
+	ConsumeTOTPStep(userID string, step int64) (bool, error)
+	// ConsumeRecoveryCode removes the recovery code with the given hash and reports whether it was still unused.
+	// Like ConsumeTOTPStep the check and the write are atomic
+	ConsumeRecoveryCode(userID, codeHash string) (bool, error)
 }
 
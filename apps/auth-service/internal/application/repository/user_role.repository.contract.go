@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+)
+
+// UserRoleRepository defines the interface for user -> role assignments
+type UserRoleRepository interface {
+	Create(userRole user_role.UserRole) error
+	GetUserRoles(userID string) ([]string, error)
+	GetRoleUsers(roleID string) ([]string, error)
+	Delete(userID, roleID string) error
+	HasRole(userID, roleID string) (bool, error)
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+var (
+	ErrVerificationTokenNotFound = pkgerrors.NewValidationError(pkgerrors.CodeVerificationTokenInvalid, nil)
+	// ErrVerificationTokenAlreadyUsed is returned when another request consumed the token first
+	ErrVerificationTokenAlreadyUsed = errors.New("verification token already used")
+)
+
+// VerificationTokenRepository defines the interface for email verification and password reset token persistence
+type VerificationTokenRepository interface {
+	Create(ctx context.Context, token verification_token.Token) error
+	GetByHash(ctx context.Context, tokenHash string) (verification_token.Token, error)
+	// MarkUsed consumes the token only if it has not been used yet,
+	// otherwise it returns ErrVerificationTokenAlreadyUsed
+	MarkUsed(ctx context.Context, token verification_token.Token) error
+	// InvalidateForUser consumes every unused token of the user for the purpose,
+	// so only the most recently sent link works
+	InvalidateForUser(ctx context.Context, userID string, purpose verification_token.Purpose) error
+}
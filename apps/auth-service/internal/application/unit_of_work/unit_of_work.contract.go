@@ -12,9 +12,35 @@ type UnitOfWork interface {
 	// Users returns the user repository within this unit of work
 	Users() repository.UserRepository
 
+	// RefreshTokens returns the refresh token repository within this unit of work
+	RefreshTokens() repository.RefreshTokenRepository
+
+	// VerificationTokens returns the email verification / password reset token repository within this unit of work
+	VerificationTokens() repository.VerificationTokenRepository
+
+	// GDPRRequests returns the GDPR deletion / export request repository within this unit of work
+	GDPRRequests() repository.GDPRRequestRepository
+
+	// Roles returns the role repository within this unit of work
+	Roles() repository.RoleRepository
+
+	// Permissions returns the permission repository within this unit of work
+	Permissions() repository.PermissionRepository
+
+	// RolePermissions returns the role -> permission grant repository within this unit of work
+	RolePermissions() repository.RolePermissionRepository
+
+	// UserRoles returns the user -> role assignment repository within this unit of work
+	UserRoles() repository.UserRoleRepository
+
 	// SaveEvents saves domain events to outbox and event store within the transaction
 	SaveEvents(ctx context.Context, events []interface{}) error
 
+	// RedactUserEvents replaces the personal data in the stored and outboxed events of a deleted user
+	// with anonymized values, so replays and the audit trail only show the erased state.
+	// Returns how many rows were redacted
+	RedactUserEvents(ctx context.Context, userID string) (int64, error)
+
 	// Commit commits the transaction
 	Commit() error
 
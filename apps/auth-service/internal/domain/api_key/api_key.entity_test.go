@@ -0,0 +1,77 @@
+package api_key
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKey_Validate(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		key     Key
+		wantErr bool
+	}{
+		{"valid", NewKey("user-1", "ci", "gsm_abc", "hash", []string{"orders:read", "chat:*"}, nil), false},
+		{"missing name", NewKey("user-1", "  ", "gsm_abc", "hash", []string{"orders:read"}, nil), true},
+		{"no scopes", NewKey("user-1", "ci", "gsm_abc", "hash", nil, nil), true},
+		{"malformed scope", NewKey("user-1", "ci", "gsm_abc", "hash", []string{"orders"}, nil), true},
+		{"expiry in the past", NewKey("user-1", "ci", "gsm_abc", "hash", []string{"orders:read"}, &past), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.key.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKey_Allows(t *testing.T) {
+	key := NewKey("user-1", "ci", "gsm_abc", "hash", []string{"orders:read", "chat:*"}, nil)
+
+	if !key.Allows("orders", "read") {
+		t.Error("key should allow a scope it holds")
+	}
+	if !key.Allows("chat", "delete") {
+		t.Error("key should allow actions covered by a wildcard scope")
+	}
+	if key.Allows("orders", "write") {
+		t.Error("key should not allow a permission outside its scopes")
+	}
+}
+
+func TestKey_IsActive(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	key := NewKey("user-1", "ci", "gsm_abc", "hash", []string{"orders:read"}, &expiresAt)
+
+	if !key.IsActive(time.Now()) {
+		t.Error("new key should be active")
+	}
+	if key.IsActive(expiresAt.Add(time.Second)) {
+		t.Error("key should not be active after its expiry")
+	}
+
+	key.Revoke()
+	if !key.IsRevoked() || key.IsActive(time.Now()) {
+		t.Error("revoked key should not be active")
+	}
+}
+
+func TestKey_Touch(t *testing.T) {
+	key := NewKey("user-1", "ci", "gsm_abc", "hash", []string{"orders:read"}, nil)
+	now := time.Now()
+
+	if !key.Touch(now) {
+		t.Fatal("first Touch() should record the use")
+	}
+	if key.Touch(now.Add(10 * time.Second)) {
+		t.Error("Touch() within LastUsedResolution should not record the use again")
+	}
+	if !key.Touch(now.Add(LastUsedResolution)) {
+		t.Error("Touch() after LastUsedResolution should record the use")
+	}
+}
@@ -29,12 +29,13 @@ func NewUserFactoryWithIDGenerator(idGenerator func() string) UserFactory {
 }
 
 // CreateUser creates a new User with proper initialization
-// This factory encapsulates the complex creation logic
-func (f *UserFactoryImpl) CreateUser(email, password, name string) (*user.User, error) {
+// This factory encapsulates the complex creation logic.
+// The password must already be hashed by the PasswordHasher.
+func (f *UserFactoryImpl) CreateUser(email, passwordHash, name string) (*user.User, error) {
 	if email == "" {
 		return nil, &UserFactoryError{Message: "email cannot be empty"}
 	}
-	if password == "" {
+	if passwordHash == "" {
 		return nil, &UserFactoryError{Message: "password cannot be empty"}
 	}
 	if name == "" {
@@ -44,7 +45,7 @@ func (f *UserFactoryImpl) CreateUser(email, password, name string) (*user.User,
 	userModel := &user.User{
 		ID:       f.idGenerator(),
 		Email:    email,
-		Password: password,
+		Password: passwordHash,
 		Name:     name,
 	}
 
@@ -1,10 +1,9 @@
 package factories
 
 import (
+	"errors"
 	"strings"
 	"testing"
-
-	"golang-social-media/apps/auth-service/internal/domain/user"
 )
 
 func TestNewUserFactory(t *testing.T) {
@@ -83,13 +82,6 @@ func TestUserFactoryImpl_CreateUser(t *testing.T) {
 			userName: "Test User",
 			wantErr:  true,
 		},
-		{
-			name:     "password too short",
-			email:    "test@example.com",
-			password: "12345",
-			userName: "Test User",
-			wantErr:  true,
-		},
 	}
 
 	for _, tt := range tests {
@@ -154,7 +146,7 @@ func TestUserFactoryError_Error(t *testing.T) {
 }
 
 func TestUserFactoryError_Error_WithCause(t *testing.T) {
-	cause := &user.User{}
+	cause := errors.New("cause")
 	err := &UserFactoryError{
 		Message: "test error",
 		Cause:   cause,
@@ -170,7 +162,7 @@ func TestUserFactoryError_Error_WithCause(t *testing.T) {
 }
 
 func TestUserFactoryError_Unwrap(t *testing.T) {
-	cause := &user.User{}
+	cause := errors.New("cause")
 	err := &UserFactoryError{
 		Message: "test error",
 		Cause:   cause,
@@ -180,4 +172,3 @@ func TestUserFactoryError_Unwrap(t *testing.T) {
 		t.Errorf("UserFactoryError.Unwrap() = %v, want %v", err.Unwrap(), cause)
 	}
 }
-
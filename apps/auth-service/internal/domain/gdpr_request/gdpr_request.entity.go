@@ -0,0 +1,150 @@
+package gdpr_request
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrUnknownService is returned when a service reports a step that is not part of the request
+var ErrUnknownService = errors.New("service is not part of the GDPR request")
+
+// Kind is what the user asked for
+type Kind string
+
+const (
+	KindDeletion Kind = "deletion"
+	KindExport   Kind = "export"
+)
+
+// Status tracks the saga across services
+type Status string
+
+const (
+	StatusInProgress Status = "in_progress"
+	StatusCompleted  Status = "completed"
+)
+
+// Step is the part of a request handled by one service
+type Step struct {
+	Service     string
+	CompletedAt *time.Time
+	Records     int64           // Deletion: rows erased or anonymized
+	Document    json.RawMessage // Export: the JSON document the service holds about the user
+}
+
+// IsCompleted reports whether the service has reported completion
+func (s Step) IsCompleted() bool {
+	return s.CompletedAt != nil
+}
+
+// Request is a GDPR deletion or export of one user's data. It coordinates the saga:
+// every service holding user data completes its step, and the request completes with the last one.
+type Request struct {
+	ID          string
+	UserID      string
+	Kind        Kind
+	Status      Status
+	Steps       []Step
+	CreatedAt   time.Time
+	CompletedAt *time.Time
+
+	// Domain events (internal, not persisted)
+	events []DomainEvent
+}
+
+// NewDeletion creates the request tracking the erasure of the user's data in services.
+// The UserDeleted event of the user starts the saga
+func NewDeletion(userID string, services []string) Request {
+	return newRequest(userID, KindDeletion, services)
+}
+
+// NewExport creates the request collecting the user's data from services and adds a domain event
+func NewExport(userID string, services []string) Request {
+	request := newRequest(userID, KindExport, services)
+	request.addEvent(UserExportRequestedEvent{
+		UserID:      request.UserID,
+		RequestID:   request.ID,
+		RequestedAt: request.CreatedAt.Format(time.RFC3339),
+	})
+	return request
+}
+
+func newRequest(userID string, kind Kind, services []string) Request {
+	steps := make([]Step, len(services))
+	for i, service := range services {
+		steps[i] = Step{Service: service}
+	}
+	return Request{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Kind:      kind,
+		Status:    StatusInProgress,
+		Steps:     steps,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// CompleteStep records the result of a service. Services may report more than once
+// (events are delivered at least once); repeated reports are ignored and return false.
+// The request completes when every step has completed
+func (r *Request) CompleteStep(service string, records int64, document json.RawMessage, completedAt time.Time) (bool, error) {
+	index := -1
+	for i, step := range r.Steps {
+		if step.Service == service {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return false, ErrUnknownService
+	}
+	if r.Steps[index].IsCompleted() {
+		return false, nil
+	}
+
+	at := completedAt.UTC()
+	r.Steps[index].CompletedAt = &at
+	r.Steps[index].Records = records
+	r.Steps[index].Document = document
+
+	if completed, total := r.Progress(); completed == total {
+		now := time.Now().UTC()
+		r.Status = StatusCompleted
+		r.CompletedAt = &now
+	}
+	return true, nil
+}
+
+// Progress returns how many steps have completed out of all steps
+func (r Request) Progress() (int, int) {
+	completed := 0
+	for _, step := range r.Steps {
+		if step.IsCompleted() {
+			completed++
+		}
+	}
+	return completed, len(r.Steps)
+}
+
+// IsCompleted reports whether every service has completed its step
+func (r Request) IsCompleted() bool {
+	return r.Status == StatusCompleted
+}
+
+// Events returns all domain events
+func (r Request) Events() []DomainEvent {
+	return r.events
+}
+
+// ClearEvents clears all domain events
+func (r *Request) ClearEvents() {
+	r.events = nil
+}
+
+// addEvent adds a domain event (internal method)
+func (r *Request) addEvent(event DomainEvent) {
+	r.events = append(r.events, event)
+}
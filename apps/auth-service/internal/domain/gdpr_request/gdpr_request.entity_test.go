@@ -0,0 +1,66 @@
+package gdpr_request
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNewExport_AddsEvent(t *testing.T) {
+	request := NewExport("user-1", []string{"auth", "chat"})
+
+	if request.Kind != KindExport || request.Status != StatusInProgress || len(request.Steps) != 2 {
+		t.Fatalf("NewExport() = %+v, want an in progress export with 2 steps", request)
+	}
+
+	events := request.Events()
+	if len(events) != 1 {
+		t.Fatalf("NewExport() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(UserExportRequestedEvent)
+	if !ok {
+		t.Fatalf("NewExport() event type = %T, want UserExportRequestedEvent", events[0])
+	}
+	if event.UserID != "user-1" || event.RequestID != request.ID {
+		t.Errorf("UserExportRequestedEvent = %+v, want user-1 / %s", event, request.ID)
+	}
+
+	if len(NewDeletion("user-1", []string{"auth"}).Events()) != 0 {
+		t.Error("NewDeletion() should not add events, the user's UserDeleted event starts the saga")
+	}
+}
+
+func TestRequest_CompleteStep(t *testing.T) {
+	request := NewDeletion("user-1", []string{"auth", "chat", "notification"})
+	now := time.Now()
+
+	completed, err := request.CompleteStep("chat", 12, nil, now)
+	if err != nil || !completed {
+		t.Fatalf("CompleteStep(chat) = %v, %v, want true, nil", completed, err)
+	}
+	if done, total := request.Progress(); done != 1 || total != 3 {
+		t.Errorf("Progress() = %d/%d, want 1/3", done, total)
+	}
+
+	// Reports are delivered at least once, a repeated report changes nothing
+	completed, err = request.CompleteStep("chat", 99, nil, now)
+	if err != nil || completed {
+		t.Errorf("CompleteStep(chat) again = %v, %v, want false, nil", completed, err)
+	}
+	if request.Steps[1].Records != 12 {
+		t.Errorf("Steps[chat].Records = %d, want 12", request.Steps[1].Records)
+	}
+
+	if _, err := request.CompleteStep("billing", 0, nil, now); err != ErrUnknownService {
+		t.Errorf("CompleteStep(billing) error = %v, want ErrUnknownService", err)
+	}
+
+	request.CompleteStep("auth", 1, nil, now)
+	if request.IsCompleted() {
+		t.Fatal("request should not complete before every step")
+	}
+	request.CompleteStep("notification", 3, json.RawMessage(`{}`), now)
+	if !request.IsCompleted() || request.CompletedAt == nil {
+		t.Errorf("request = %s, want completed once every step has completed", request.Status)
+	}
+}
@@ -0,0 +1,17 @@
+package gdpr_request
+
+// DomainEvent represents a domain event interface
+type DomainEvent interface {
+	Type() string
+}
+
+// UserExportRequestedEvent is a domain event emitted when a user asks for a copy of their data
+type UserExportRequestedEvent struct {
+	UserID      string
+	RequestID   string
+	RequestedAt string
+}
+
+func (e UserExportRequestedEvent) Type() string {
+	return "UserExportRequested"
+}
@@ -0,0 +1,47 @@
+package permission
+
+import "strings"
+
+// Wildcard matches any resource or any action, e.g. "orders:*" or "*:read"
+const Wildcard = "*"
+
+// Grant is a resource/action pair held by a user; either side may be the wildcard
+type Grant struct {
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+// Grant returns the resource/action pair the permission grants
+func (p Permission) Grant() Grant {
+	return Grant{Resource: p.Resource, Action: p.Action}
+}
+
+// Matches reports whether the grant covers the given resource and action
+func (g Grant) Matches(resource, action string) bool {
+	return (g.Resource == Wildcard || g.Resource == resource) &&
+		(g.Action == Wildcard || g.Action == action)
+}
+
+// AnyMatches reports whether any of the grants covers the given resource and action
+func AnyMatches(grants []Grant, resource, action string) bool {
+	for _, grant := range grants {
+		if grant.Matches(resource, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// String formats the grant as "resource:action"
+func (g Grant) String() string {
+	return g.Resource + ":" + g.Action
+}
+
+// ParseGrant parses a "resource:action" string; both sides must be non-empty
+func ParseGrant(s string) (Grant, bool) {
+	resource, action, ok := strings.Cut(s, ":")
+	if !ok || resource == "" || action == "" || strings.Contains(action, ":") {
+		return Grant{}, false
+	}
+	return Grant{Resource: resource, Action: action}, true
+}
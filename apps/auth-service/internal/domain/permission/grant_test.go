@@ -0,0 +1,47 @@
+package permission
+
+import "testing"
+
+func TestGrant_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		grant    Grant
+		resource string
+		action   string
+		want     bool
+	}{
+		{"exact match", Grant{Resource: "orders", Action: "read"}, "orders", "read", true},
+		{"different action", Grant{Resource: "orders", Action: "read"}, "orders", "delete", false},
+		{"different resource", Grant{Resource: "orders", Action: "read"}, "users", "read", false},
+		{"any action", Grant{Resource: "orders", Action: Wildcard}, "orders", "delete", true},
+		{"any action other resource", Grant{Resource: "orders", Action: Wildcard}, "users", "delete", false},
+		{"any resource", Grant{Resource: Wildcard, Action: "read"}, "users", "read", true},
+		{"any resource other action", Grant{Resource: Wildcard, Action: "read"}, "users", "delete", false},
+		{"everything", Grant{Resource: Wildcard, Action: Wildcard}, "rbac", "manage", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.grant.Matches(tt.resource, tt.action); got != tt.want {
+				t.Errorf("Grant%+v.Matches(%q, %q) = %v, want %v", tt.grant, tt.resource, tt.action, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnyMatches(t *testing.T) {
+	grants := []Grant{
+		{Resource: "chat", Action: "read"},
+		{Resource: "orders", Action: Wildcard},
+	}
+
+	if !AnyMatches(grants, "orders", "refund") {
+		t.Error("AnyMatches() should match orders:refund through orders:*")
+	}
+	if AnyMatches(grants, "chat", "delete") {
+		t.Error("AnyMatches() should not match chat:delete")
+	}
+	if AnyMatches(nil, "chat", "read") {
+		t.Error("AnyMatches() should not match without grants")
+	}
+}
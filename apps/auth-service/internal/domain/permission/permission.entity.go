@@ -45,6 +45,32 @@ func (p *Permission) Create() {
 	})
 }
 
+// Update updates permission information
+func (p *Permission) Update(name, resource, action string) {
+	p.Name = name
+	p.Resource = resource
+	p.Action = action
+	p.UpdatedAt = time.Now().UTC()
+
+	p.addEvent(PermissionUpdatedEvent{
+		PermissionID: p.ID,
+		Name:         name,
+		Resource:     resource,
+		Action:       action,
+		UpdatedAt:    time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// Delete marks the permission as deleted and adds a domain event
+func (p *Permission) Delete() {
+	p.addEvent(PermissionDeletedEvent{
+		PermissionID: p.ID,
+		Resource:     p.Resource,
+		Action:       p.Action,
+		DeletedAt:    time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
 // Events returns all domain events
 func (p Permission) Events() []DomainEvent {
 	return p.events
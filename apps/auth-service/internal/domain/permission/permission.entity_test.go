@@ -11,7 +11,7 @@ func TestPermission_Validate(t *testing.T) {
 		name    string
 		perm    Permission
 		wantErr bool
-		errCode string
+		errCode errors.ErrorCode
 	}{
 		{
 			name: "valid permission",
@@ -167,3 +167,55 @@ func TestPermission_ClearEvents(t *testing.T) {
 	}
 }
 
+func TestPermission_Update(t *testing.T) {
+	perm := &Permission{
+		ID:       "perm-1",
+		Name:     "Create Chat",
+		Resource: "chat",
+		Action:   "create",
+	}
+
+	perm.Update("Edit Chat", "chat", "update")
+
+	if perm.Name != "Edit Chat" || perm.Action != "update" {
+		t.Errorf("Permission.Update() = %v/%v, want Edit Chat/update", perm.Name, perm.Action)
+	}
+
+	events := perm.Events()
+	if len(events) != 1 {
+		t.Fatalf("Permission.Update() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(PermissionUpdatedEvent)
+	if !ok {
+		t.Fatalf("Permission.Update() event type = %T, want PermissionUpdatedEvent", events[0])
+	}
+	if event.PermissionID != perm.ID || event.Action != "update" {
+		t.Errorf("PermissionUpdatedEvent = %+v, want permission %v with action update", event, perm.ID)
+	}
+}
+
+func TestPermission_Delete(t *testing.T) {
+	perm := &Permission{
+		ID:       "perm-1",
+		Name:     "Create Chat",
+		Resource: "chat",
+		Action:   "create",
+	}
+
+	perm.Delete()
+
+	events := perm.Events()
+	if len(events) != 1 {
+		t.Fatalf("Permission.Delete() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(PermissionDeletedEvent)
+	if !ok {
+		t.Fatalf("Permission.Delete() event type = %T, want PermissionDeletedEvent", events[0])
+	}
+	if event.PermissionID != perm.ID {
+		t.Errorf("PermissionDeletedEvent.PermissionID = %v, want %v", event.PermissionID, perm.ID)
+	}
+	if event.DeletedAt == "" {
+		t.Error("PermissionDeletedEvent.DeletedAt should not be empty")
+	}
+}
@@ -18,3 +18,28 @@ func (e PermissionCreatedEvent) Type() string {
 	return "PermissionCreated"
 }
 
+
+// PermissionUpdatedEvent is a domain event emitted when a permission is updated
+type PermissionUpdatedEvent struct {
+	PermissionID string
+	Name         string
+	Resource     string
+	Action       string
+	UpdatedAt    string
+}
+
+func (e PermissionUpdatedEvent) Type() string {
+	return "PermissionUpdated"
+}
+
+// PermissionDeletedEvent is a domain event emitted when a permission is deleted
+type PermissionDeletedEvent struct {
+	PermissionID string
+	Resource     string
+	Action       string
+	DeletedAt    string
+}
+
+func (e PermissionDeletedEvent) Type() string {
+	return "PermissionDeleted"
+}
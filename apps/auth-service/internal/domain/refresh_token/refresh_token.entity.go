@@ -0,0 +1,141 @@
+package refresh_token
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revoke reasons recorded on a token family
+const (
+	RevokeReasonReuseDetected = "reuse_detected"
+	RevokeReasonRevoked       = "revoked"
+	RevokeReasonLogout        = "logout"
+	RevokeReasonSessionKilled = "session_killed"
+	RevokeReasonPasswordReset = "password_reset"
+	RevokeReasonUserDeleted   = "user_deleted"
+)
+
+// Device describes the client a login came from
+type Device struct {
+	UserAgent string
+	IPAddress string
+}
+
+// Family groups every refresh token issued from a single login and is exposed to users as a session.
+// Each refresh rotates the token inside the family; revoking the family invalidates all of them.
+type Family struct {
+	ID           string
+	UserID       string
+	Device       Device
+	CreatedAt    time.Time
+	LastUsedAt   time.Time
+	RevokedAt    *time.Time
+	RevokeReason string
+
+	// Domain events (internal, not persisted)
+	events []DomainEvent
+}
+
+// NewFamily starts a new token family for a login
+func NewFamily(userID string, device Device) Family {
+	now := time.Now().UTC()
+	return Family{
+		ID:         uuid.NewString(),
+		UserID:     userID,
+		Device:     device,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+}
+
+// Touch records that the session was used to refresh tokens
+func (f *Family) Touch() {
+	f.LastUsedAt = time.Now().UTC()
+}
+
+// IsRevoked reports whether the family can no longer be used
+func (f Family) IsRevoked() bool {
+	return f.RevokedAt != nil
+}
+
+// Revoke revokes the family; revoking an already revoked family is a no-op
+func (f *Family) Revoke(reason string) {
+	if f.IsRevoked() {
+		return
+	}
+	now := time.Now().UTC()
+	f.RevokedAt = &now
+	f.RevokeReason = reason
+}
+
+// DetectReuse revokes the family after an already-rotated token was presented again
+// and records a security event
+func (f *Family) DetectReuse(tokenID string) {
+	f.Revoke(RevokeReasonReuseDetected)
+
+	f.addEvent(RefreshTokenReuseDetectedEvent{
+		UserID:     f.UserID,
+		FamilyID:   f.ID,
+		TokenID:    tokenID,
+		DetectedAt: time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// Events returns all domain events
+func (f Family) Events() []DomainEvent {
+	return f.events
+}
+
+// ClearEvents clears all domain events
+func (f *Family) ClearEvents() {
+	f.events = nil
+}
+
+// addEvent adds a domain event (internal method)
+func (f *Family) addEvent(event DomainEvent) {
+	f.events = append(f.events, event)
+}
+
+// Token is a single refresh token in a family. Only the SHA256 hash of the token is stored.
+type Token struct {
+	ID         string
+	FamilyID   string
+	UserID     string
+	TokenHash  string
+	ParentID   string // token this one replaced, empty for the first token of a family
+	ExpiresAt  time.Time
+	CreatedAt  time.Time
+	RotatedAt  *time.Time
+	ReplacedBy string
+}
+
+// NewToken creates the next token of a family
+func NewToken(family Family, tokenHash, parentID string, expiresAt time.Time) Token {
+	return Token{
+		ID:        uuid.NewString(),
+		FamilyID:  family.ID,
+		UserID:    family.UserID,
+		TokenHash: tokenHash,
+		ParentID:  parentID,
+		ExpiresAt: expiresAt.UTC(),
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// IsRotated reports whether the token was already exchanged for a newer one
+func (t Token) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+// IsExpired reports whether the token is past its expiry
+func (t Token) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Rotate marks the token as exchanged for the given replacement
+func (t *Token) Rotate(replacedBy string) {
+	now := time.Now().UTC()
+	t.RotatedAt = &now
+	t.ReplacedBy = replacedBy
+}
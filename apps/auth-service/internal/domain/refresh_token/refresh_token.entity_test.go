@@ -0,0 +1,103 @@
+package refresh_token
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFamily_Revoke(t *testing.T) {
+	family := NewFamily("user-1", Device{})
+	if family.IsRevoked() {
+		t.Fatal("new family should not be revoked")
+	}
+
+	family.Revoke(RevokeReasonLogout)
+	if !family.IsRevoked() {
+		t.Fatal("family should be revoked")
+	}
+	revokedAt := *family.RevokedAt
+
+	// Revoking again keeps the original reason and time
+	family.Revoke(RevokeReasonRevoked)
+	if family.RevokeReason != RevokeReasonLogout {
+		t.Errorf("RevokeReason = %v, want %v", family.RevokeReason, RevokeReasonLogout)
+	}
+	if !family.RevokedAt.Equal(revokedAt) {
+		t.Error("RevokedAt should not change on second revoke")
+	}
+	if len(family.Events()) != 0 {
+		t.Errorf("Revoke() should not emit events, got %d", len(family.Events()))
+	}
+}
+
+func TestFamily_DetectReuse(t *testing.T) {
+	family := NewFamily("user-1", Device{})
+
+	family.DetectReuse("token-1")
+
+	if !family.IsRevoked() {
+		t.Fatal("family should be revoked after reuse")
+	}
+	if family.RevokeReason != RevokeReasonReuseDetected {
+		t.Errorf("RevokeReason = %v, want %v", family.RevokeReason, RevokeReasonReuseDetected)
+	}
+
+	events := family.Events()
+	if len(events) != 1 {
+		t.Fatalf("expected 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(RefreshTokenReuseDetectedEvent)
+	if !ok {
+		t.Fatalf("expected RefreshTokenReuseDetectedEvent, got %T", events[0])
+	}
+	if event.UserID != "user-1" || event.FamilyID != family.ID || event.TokenID != "token-1" {
+		t.Errorf("unexpected event payload: %+v", event)
+	}
+
+	family.ClearEvents()
+	if len(family.Events()) != 0 {
+		t.Error("ClearEvents() should remove all events")
+	}
+}
+
+func TestFamily_Touch(t *testing.T) {
+	family := NewFamily("user-1", Device{UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.1"})
+	if !family.LastUsedAt.Equal(family.CreatedAt) {
+		t.Error("new family LastUsedAt should equal CreatedAt")
+	}
+
+	time.Sleep(time.Millisecond)
+	family.Touch()
+	if !family.LastUsedAt.After(family.CreatedAt) {
+		t.Error("Touch() should advance LastUsedAt")
+	}
+	if family.Device.IPAddress != "10.0.0.1" {
+		t.Errorf("Device.IPAddress = %v, want 10.0.0.1", family.Device.IPAddress)
+	}
+}
+
+func TestToken_Rotate(t *testing.T) {
+	family := NewFamily("user-1", Device{})
+	token := NewToken(family, "hash", "", time.Now().Add(time.Hour))
+
+	if token.FamilyID != family.ID || token.UserID != "user-1" {
+		t.Errorf("token not linked to family: %+v", token)
+	}
+	if token.IsRotated() {
+		t.Fatal("new token should not be rotated")
+	}
+	if token.IsExpired(time.Now()) {
+		t.Fatal("new token should not be expired")
+	}
+
+	token.Rotate("token-2")
+	if !token.IsRotated() {
+		t.Error("token should be rotated")
+	}
+	if token.ReplacedBy != "token-2" {
+		t.Errorf("ReplacedBy = %v, want token-2", token.ReplacedBy)
+	}
+	if !token.IsExpired(time.Now().Add(2 * time.Hour)) {
+		t.Error("token should be expired after ExpiresAt")
+	}
+}
@@ -0,0 +1,18 @@
+package refresh_token
+
+// DomainEvent represents a domain event interface
+type DomainEvent interface {
+	Type() string
+}
+
+// RefreshTokenReuseDetectedEvent is a domain event emitted when a rotated refresh token is replayed
+type RefreshTokenReuseDetectedEvent struct {
+	UserID     string
+	FamilyID   string
+	TokenID    string
+	DetectedAt string
+}
+
+func (e RefreshTokenReuseDetectedEvent) Type() string {
+	return "RefreshTokenReuseDetected"
+}
@@ -12,6 +12,7 @@ type Role struct {
 	ID          string
 	Name        string
 	Description string
+	ParentID    string // Role whose permissions this role inherits, empty for none
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 
@@ -30,15 +31,19 @@ func (r Role) Validate() error {
 	if len(r.Name) > 50 {
 		return errors.NewValidationError(errors.CodeNameTooLong, nil)
 	}
+	if r.ParentID != "" && r.ParentID == r.ID {
+		return errors.NewValidationError(errors.CodeRoleInheritanceCycle, nil)
+	}
 	return nil
 }
 
 // Create is a domain method that creates a role and adds a domain event
 func (r *Role) Create() {
 	r.addEvent(RoleCreatedEvent{
-		RoleID:    r.ID,
-		Name:      r.Name,
-		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+		RoleID:      r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		CreatedAt:   time.Now().UTC().Format(time.RFC3339),
 	})
 }
 
@@ -50,10 +55,35 @@ func (r *Role) Update(name, description string) {
 	r.UpdatedAt = time.Now().UTC()
 
 	r.addEvent(RoleUpdatedEvent{
+		RoleID:         r.ID,
+		OldName:        oldName,
+		NewName:        name,
+		NewDescription: &description,
+		UpdatedAt:      time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// SetParent makes the role inherit every permission of the parent role; an empty parentID removes inheritance.
+// Cycles through longer chains are checked by the caller, which can load the ancestors
+func (r *Role) SetParent(parentID string) {
+	oldParentID := r.ParentID
+	r.ParentID = parentID
+	r.UpdatedAt = time.Now().UTC()
+
+	r.addEvent(RoleParentChangedEvent{
+		RoleID:      r.ID,
+		OldParentID: oldParentID,
+		NewParentID: parentID,
+		ChangedAt:   time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// Delete marks the role as deleted and adds a domain event
+func (r *Role) Delete() {
+	r.addEvent(RoleDeletedEvent{
 		RoleID:    r.ID,
-		OldName:   oldName,
-		NewName:   name,
-		UpdatedAt: time.Now().UTC().Format(time.RFC3339),
+		Name:      r.Name,
+		DeletedAt: time.Now().UTC().Format(time.RFC3339),
 	})
 }
 
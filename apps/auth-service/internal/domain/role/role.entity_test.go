@@ -2,7 +2,6 @@ package role
 
 import (
 	"testing"
-	"time"
 
 	"golang-social-media/pkg/errors"
 )
@@ -12,7 +11,7 @@ func TestRole_Validate(t *testing.T) {
 		name    string
 		role    Role
 		wantErr bool
-		errCode string
+		errCode errors.ErrorCode
 	}{
 		{
 			name: "valid role",
@@ -196,4 +195,58 @@ func TestRole_ClearEvents(t *testing.T) {
 	}
 }
 
+func TestRole_Delete(t *testing.T) {
+	role := &Role{
+		ID:   "role-1",
+		Name: "Admin",
+	}
 
+	role.Delete()
+
+	events := role.Events()
+	if len(events) != 1 {
+		t.Fatalf("Role.Delete() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(RoleDeletedEvent)
+	if !ok {
+		t.Fatalf("Role.Delete() event type = %T, want RoleDeletedEvent", events[0])
+	}
+	if event.RoleID != role.ID {
+		t.Errorf("RoleDeletedEvent.RoleID = %v, want %v", event.RoleID, role.ID)
+	}
+	if event.Name != role.Name {
+		t.Errorf("RoleDeletedEvent.Name = %v, want %v", event.Name, role.Name)
+	}
+	if event.DeletedAt == "" {
+		t.Error("RoleDeletedEvent.DeletedAt should not be empty")
+	}
+}
+
+func TestRole_SetParent(t *testing.T) {
+	role := &Role{
+		ID:   "role-admin",
+		Name: "admin",
+	}
+
+	role.SetParent("role-moderator")
+
+	if role.ParentID != "role-moderator" {
+		t.Errorf("Role.ParentID = %v, want role-moderator", role.ParentID)
+	}
+	events := role.Events()
+	if len(events) != 1 {
+		t.Fatalf("Role.SetParent() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(RoleParentChangedEvent)
+	if !ok {
+		t.Fatalf("Role.SetParent() event type = %T, want RoleParentChangedEvent", events[0])
+	}
+	if event.OldParentID != "" || event.NewParentID != "role-moderator" {
+		t.Errorf("RoleParentChangedEvent = %+v, want parent change from none to role-moderator", event)
+	}
+
+	role.SetParent(role.ID)
+	if err := role.Validate(); err == nil {
+		t.Error("Role.Validate() should reject a role inheriting from itself")
+	}
+}
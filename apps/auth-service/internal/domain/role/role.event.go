@@ -5,26 +5,61 @@ type DomainEvent interface {
 	Type() string
 }
 
-// RoleCreatedEvent is a domain event emitted when a role is created
+// RoleCreatedEvent is a domain event emitted when a role is created.
+// Version 2 added Description; version 1 events are upcast with an empty description
 type RoleCreatedEvent struct {
-	RoleID    string
-	Name      string
-	CreatedAt string
+	RoleID      string
+	Name        string
+	Description string
+	CreatedAt   string
 }
 
 func (e RoleCreatedEvent) Type() string {
 	return "RoleCreated"
 }
 
-// RoleUpdatedEvent is a domain event emitted when a role is updated
+func (e RoleCreatedEvent) Version() int {
+	return 2
+}
+
+// RoleUpdatedEvent is a domain event emitted when a role is updated.
+// Version 2 added NewDescription; version 1 events are upcast with the description unknown
 type RoleUpdatedEvent struct {
-	RoleID    string
-	OldName   string
-	NewName   string
-	UpdatedAt string
+	RoleID         string
+	OldName        string
+	NewName        string
+	NewDescription *string // Nil for upcast version 1 events, which did not record it
+	UpdatedAt      string
 }
 
 func (e RoleUpdatedEvent) Type() string {
 	return "RoleUpdated"
 }
 
+func (e RoleUpdatedEvent) Version() int {
+	return 2
+}
+
+
+// RoleParentChangedEvent is a domain event emitted when the role a role inherits from changes
+type RoleParentChangedEvent struct {
+	RoleID      string
+	OldParentID string
+	NewParentID string
+	ChangedAt   string
+}
+
+func (e RoleParentChangedEvent) Type() string {
+	return "RoleParentChanged"
+}
+
+// RoleDeletedEvent is a domain event emitted when a role is deleted
+type RoleDeletedEvent struct {
+	RoleID    string
+	Name      string
+	DeletedAt string
+}
+
+func (e RoleDeletedEvent) Type() string {
+	return "RoleDeleted"
+}
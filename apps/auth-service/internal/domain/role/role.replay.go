@@ -0,0 +1,29 @@
+package role
+
+import "time"
+
+// Apply replays a stored event on the role without recording a new one; occurredAt is when the
+// event was stored. It reports whether the event belongs to the role state: role permission
+// events share the role's stream and are ignored
+func (r *Role) Apply(event DomainEvent, occurredAt time.Time) bool {
+	switch e := event.(type) {
+	case RoleCreatedEvent:
+		r.ID = e.RoleID
+		r.Name = e.Name
+		r.Description = e.Description
+		r.CreatedAt = occurredAt
+	case RoleUpdatedEvent:
+		r.Name = e.NewName
+		if e.NewDescription != nil {
+			r.Description = *e.NewDescription
+		}
+	case RoleParentChangedEvent:
+		r.ParentID = e.NewParentID
+	case RoleDeletedEvent:
+		// The row is gone; the state before deletion is kept for investigations
+	default:
+		return false
+	}
+	r.UpdatedAt = occurredAt
+	return true
+}
@@ -0,0 +1,44 @@
+package role
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRole_Apply(t *testing.T) {
+	created := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	description := "Moderates content"
+	var r Role
+
+	steps := []DomainEvent{
+		RoleCreatedEvent{RoleID: "role-1", Name: "Mod", Description: "Moderator"},
+		RoleUpdatedEvent{RoleID: "role-1", OldName: "Mod", NewName: "Moderator", NewDescription: &description},
+		RoleParentChangedEvent{RoleID: "role-1", NewParentID: "role-0"},
+	}
+	for i, event := range steps {
+		if !r.Apply(event, created.Add(time.Duration(i)*time.Hour)) {
+			t.Fatalf("Apply(%s) = false, want true", event.Type())
+		}
+	}
+
+	if r.ID != "role-1" || r.Name != "Moderator" || r.Description != description || r.ParentID != "role-0" {
+		t.Errorf("role = %+v, want the state after every event", r)
+	}
+	if !r.CreatedAt.Equal(created) {
+		t.Errorf("CreatedAt = %v, want %v", r.CreatedAt, created)
+	}
+	if want := created.Add(2 * time.Hour); !r.UpdatedAt.Equal(want) {
+		t.Errorf("UpdatedAt = %v, want %v", r.UpdatedAt, want)
+	}
+}
+
+func TestRole_Apply_UpdateWithoutDescription(t *testing.T) {
+	r := Role{ID: "role-1", Name: "Mod", Description: "Moderator"}
+
+	// Upcast version 1 events did not record the description
+	r.Apply(RoleUpdatedEvent{RoleID: "role-1", NewName: "Moderator"}, time.Now())
+
+	if r.Description != "Moderator" {
+		t.Errorf("Description = %v, want the previous description", r.Description)
+	}
+}
@@ -81,4 +81,3 @@ func TestRolePermission_ClearEvents(t *testing.T) {
 		t.Errorf("RolePermission.ClearEvents() should clear all events, got %d", len(rolePerm.Events()))
 	}
 }
-
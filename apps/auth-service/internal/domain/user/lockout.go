@@ -0,0 +1,90 @@
+package user
+
+import (
+	"time"
+)
+
+// LockoutPolicy configures how failed logins lock an account
+type LockoutPolicy struct {
+	// MaxAttempts is the number of consecutive failed logins that locks the account
+	MaxAttempts int
+	// BaseLockDuration is the first lock duration; every following lock doubles it
+	BaseLockDuration time.Duration
+	// MaxLockDuration caps the exponential backoff
+	MaxLockDuration time.Duration
+	// ResetAfter is how long failures and past locks are remembered without new failures
+	ResetAfter time.Duration
+}
+
+// DefaultLockoutPolicy locks after 5 failures for 1 minute, doubling up to 1 hour
+func DefaultLockoutPolicy() LockoutPolicy {
+	return LockoutPolicy{
+		MaxAttempts:      5,
+		BaseLockDuration: time.Minute,
+		MaxLockDuration:  time.Hour,
+		ResetAfter:       24 * time.Hour,
+	}
+}
+
+// LockDuration returns how long the account is locked for the n-th lock (starting at 1)
+func (p LockoutPolicy) LockDuration(n int) time.Duration {
+	duration := p.BaseLockDuration
+	for i := 1; i < n && duration < p.MaxLockDuration; i++ {
+		duration *= 2
+	}
+	if duration > p.MaxLockDuration {
+		duration = p.MaxLockDuration
+	}
+	return duration
+}
+
+// LoginAttempts is the failed login state of an account
+type LoginAttempts struct {
+	UserID        string
+	Failures      int // Consecutive failures since the last lock or successful login
+	Locks         int // Locks since the last successful login, drives the backoff
+	LockedUntil   time.Time
+	LastFailureAt time.Time
+}
+
+// IsLocked returns true if the account is locked at now
+func (a LoginAttempts) IsLocked(now time.Time) bool {
+	return now.Before(a.LockedUntil)
+}
+
+// RetryAfter returns how long until the lock expires
+func (a LoginAttempts) RetryAfter(now time.Time) time.Duration {
+	if !a.IsLocked(now) {
+		return 0
+	}
+	return a.LockedUntil.Sub(now)
+}
+
+// RecordFailure registers a failed login. When the failure reaches the policy limit the account
+// is locked and the returned event must be published.
+func (a *LoginAttempts) RecordFailure(policy LockoutPolicy, email string, now time.Time) (UserLockedOutEvent, bool) {
+	a.Failures++
+	a.LastFailureAt = now
+
+	if a.Failures < policy.MaxAttempts {
+		return UserLockedOutEvent{}, false
+	}
+
+	failedAttempts := a.Failures
+	a.Locks++
+	a.Failures = 0
+	a.LockedUntil = now.Add(policy.LockDuration(a.Locks))
+
+	return UserLockedOutEvent{
+		UserID:         a.UserID,
+		Email:          email,
+		FailedAttempts: failedAttempts,
+		LockedUntil:    a.LockedUntil.UTC().Format(time.RFC3339),
+		LockedAt:       now.UTC().Format(time.RFC3339),
+	}, true
+}
+
+// TTL returns how long the state must be kept from now
+func (a LoginAttempts) TTL(policy LockoutPolicy, now time.Time) time.Duration {
+	return a.RetryAfter(now) + policy.ResetAfter
+}
@@ -0,0 +1,78 @@
+package user
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockoutPolicy_LockDuration(t *testing.T) {
+	policy := LockoutPolicy{
+		MaxAttempts:      3,
+		BaseLockDuration: time.Minute,
+		MaxLockDuration:  10 * time.Minute,
+	}
+
+	tests := []struct {
+		n    int
+		want time.Duration
+	}{
+		{n: 1, want: time.Minute},
+		{n: 2, want: 2 * time.Minute},
+		{n: 3, want: 4 * time.Minute},
+		{n: 4, want: 8 * time.Minute},
+		{n: 5, want: 10 * time.Minute},
+		{n: 50, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := policy.LockDuration(tt.n); got != tt.want {
+			t.Errorf("LockDuration(%d) = %v, want %v", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestLoginAttempts_RecordFailure(t *testing.T) {
+	policy := LockoutPolicy{
+		MaxAttempts:      3,
+		BaseLockDuration: time.Minute,
+		MaxLockDuration:  time.Hour,
+		ResetAfter:       time.Hour,
+	}
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	attempts := LoginAttempts{UserID: "user-1"}
+
+	for i := 0; i < 2; i++ {
+		if _, locked := attempts.RecordFailure(policy, "test@example.com", now); locked {
+			t.Fatalf("RecordFailure() locked after %d failures", i+1)
+		}
+	}
+	if attempts.IsLocked(now) {
+		t.Fatal("IsLocked() should be false below MaxAttempts")
+	}
+
+	event, locked := attempts.RecordFailure(policy, "test@example.com", now)
+	if !locked {
+		t.Fatal("RecordFailure() should lock at MaxAttempts")
+	}
+	if event.UserID != "user-1" || event.FailedAttempts != 3 {
+		t.Errorf("UserLockedOutEvent = %+v", event)
+	}
+	if got := attempts.RetryAfter(now); got != time.Minute {
+		t.Errorf("RetryAfter() = %v, want %v", got, time.Minute)
+	}
+	if attempts.IsLocked(now.Add(time.Minute)) {
+		t.Error("IsLocked() should be false once the lock expired")
+	}
+
+	// The next lock doubles the duration
+	later := now.Add(2 * time.Minute)
+	for i := 0; i < 3; i++ {
+		attempts.RecordFailure(policy, "test@example.com", later)
+	}
+	if got := attempts.RetryAfter(later); got != 2*time.Minute {
+		t.Errorf("RetryAfter() after second lock = %v, want %v", got, 2*time.Minute)
+	}
+	if got := attempts.TTL(policy, later); got != 2*time.Minute+time.Hour {
+		t.Errorf("TTL() = %v, want %v", got, 2*time.Minute+time.Hour)
+	}
+}
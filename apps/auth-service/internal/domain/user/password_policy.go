@@ -0,0 +1,137 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang-social-media/pkg/errors"
+)
+
+// CharacterClass is a kind of character a password can be required to contain
+type CharacterClass string
+
+const (
+	CharacterClassLower  CharacterClass = "lower"
+	CharacterClassUpper  CharacterClass = "upper"
+	CharacterClassDigit  CharacterClass = "digit"
+	CharacterClassSymbol CharacterClass = "symbol"
+)
+
+// ParseCharacterClasses parses a comma separated list of character classes, e.g. "lower,upper,digit"
+func ParseCharacterClasses(value string) ([]CharacterClass, error) {
+	var classes []CharacterClass
+	for _, part := range strings.Split(value, ",") {
+		switch class := CharacterClass(strings.ToLower(strings.TrimSpace(part))); class {
+		case "":
+			continue
+		case CharacterClassLower, CharacterClassUpper, CharacterClassDigit, CharacterClassSymbol:
+			classes = append(classes, class)
+		default:
+			return nil, fmt.Errorf("unknown password character class %q", part)
+		}
+	}
+	return classes, nil
+}
+
+// matches returns true if r belongs to the class
+func (c CharacterClass) matches(r rune) bool {
+	switch c {
+	case CharacterClassLower:
+		return unicode.IsLower(r)
+	case CharacterClassUpper:
+		return unicode.IsUpper(r)
+	case CharacterClassDigit:
+		return unicode.IsDigit(r)
+	case CharacterClassSymbol:
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+	default:
+		return false
+	}
+}
+
+// PasswordPolicy configures the rules new passwords must follow
+type PasswordPolicy struct {
+	// MinLength and MaxLength are counted in characters
+	MinLength int
+	MaxLength int
+	// RequiredClasses lists the character classes a password must contain at least once
+	RequiredClasses []CharacterClass
+	// HistorySize is the number of most recent passwords, the current one included,
+	// that cannot be reused. 0 disables the check.
+	HistorySize int
+	// CheckBreached rejects passwords found in the breached-password list
+	CheckBreached bool
+}
+
+// DefaultPasswordPolicy requires 8 to 128 characters, forbids reusing the last 5 passwords
+// and rejects breached passwords. Length matters more than composition, so no classes are required.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:     8,
+		MaxLength:     128,
+		HistorySize:   5,
+		CheckBreached: true,
+	}
+}
+
+// Validate checks the length and character class rules
+func (p PasswordPolicy) Validate(password string) error {
+	if strings.TrimSpace(password) == "" {
+		return errors.NewValidationError(errors.CodePasswordRequired, nil)
+	}
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		return errors.NewValidationError(errors.CodePasswordTooShort, map[string]interface{}{
+			"minLength": p.MinLength,
+		})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		return errors.NewValidationError(errors.CodePasswordTooLong, map[string]interface{}{
+			"maxLength": p.MaxLength,
+		})
+	}
+
+	var missing []string
+	for _, class := range p.RequiredClasses {
+		if !strings.ContainsFunc(password, class.matches) {
+			missing = append(missing, string(class))
+		}
+	}
+	if len(missing) > 0 {
+		return errors.NewValidationError(errors.CodePasswordMissingCharacterClass, map[string]interface{}{
+			"missing": missing,
+		})
+	}
+	return nil
+}
+
+// CheckReuse rejects a password matching the user's current password or one of the
+// previous ones kept in the history. verify compares a plain password with a stored hash.
+func (p PasswordPolicy) CheckReuse(u User, password string, verify func(password, hash string) bool) error {
+	if p.HistorySize <= 0 {
+		return nil
+	}
+
+	recent := make([]string, 0, p.HistorySize)
+	if u.Password != "" {
+		recent = append(recent, u.Password)
+	}
+	for _, hash := range u.PasswordHistory {
+		if len(recent) == p.HistorySize {
+			break
+		}
+		recent = append(recent, hash)
+	}
+
+	for _, hash := range recent {
+		if verify(password, hash) {
+			return errors.NewValidationError(errors.CodePasswordReused, map[string]interface{}{
+				"historySize": p.HistorySize,
+			})
+		}
+	}
+	return nil
+}
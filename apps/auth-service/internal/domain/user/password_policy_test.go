@@ -0,0 +1,107 @@
+package user
+
+import (
+	"testing"
+
+	"golang-social-media/pkg/errors"
+)
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:       8,
+		MaxLength:       16,
+		RequiredClasses: []CharacterClass{CharacterClassLower, CharacterClassDigit},
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		errCode  errors.ErrorCode
+	}{
+		{name: "valid password", password: "password123"},
+		{name: "counts characters, not bytes", password: "mật khẩu 1"},
+		{name: "empty password", password: "", errCode: errors.CodePasswordRequired},
+		{name: "whitespace password", password: "        ", errCode: errors.CodePasswordRequired},
+		{name: "too short", password: "pass123", errCode: errors.CodePasswordTooShort},
+		{name: "too long", password: "password123456789", errCode: errors.CodePasswordTooLong},
+		{name: "missing digit", password: "passwordonly", errCode: errors.CodePasswordMissingCharacterClass},
+		{name: "missing lower case", password: "PASSWORD123", errCode: errors.CodePasswordMissingCharacterClass},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if tt.errCode == "" {
+				if err != nil {
+					t.Errorf("PasswordPolicy.Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			appErr, ok := err.(*errors.AppError)
+			if !ok {
+				t.Fatalf("PasswordPolicy.Validate() error = %v, want %v", err, tt.errCode)
+			}
+			if appErr.Code != tt.errCode {
+				t.Errorf("PasswordPolicy.Validate() error code = %v, want %v", appErr.Code, tt.errCode)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicy_Validate_MissingClassesInDetails(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:       1,
+		RequiredClasses: []CharacterClass{CharacterClassUpper, CharacterClassSymbol},
+	}
+
+	err := policy.Validate("password")
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("PasswordPolicy.Validate() error = %v, want *errors.AppError", err)
+	}
+	missing, _ := appErr.Details["missing"].([]string)
+	if len(missing) != 2 || missing[0] != "upper" || missing[1] != "symbol" {
+		t.Errorf("details.missing = %v, want [upper symbol]", appErr.Details["missing"])
+	}
+}
+
+func TestPasswordPolicy_CheckReuse(t *testing.T) {
+	// Plain "hashes" keep the test independent of the password hasher
+	verify := func(password, hash string) bool { return password == hash }
+	u := User{
+		Password:        "current",
+		PasswordHistory: []string{"previous-1", "previous-2", "previous-3"},
+	}
+
+	policy := PasswordPolicy{HistorySize: 3}
+	for _, password := range []string{"current", "previous-1", "previous-2"} {
+		err := policy.CheckReuse(u, password, verify)
+		if appErr, ok := err.(*errors.AppError); !ok || appErr.Code != errors.CodePasswordReused {
+			t.Errorf("CheckReuse(%q) error = %v, want %v", password, err, errors.CodePasswordReused)
+		}
+	}
+
+	// Older than the last 3 passwords
+	if err := policy.CheckReuse(u, "previous-3", verify); err != nil {
+		t.Errorf("CheckReuse(previous-3) error = %v, want nil", err)
+	}
+
+	disabled := PasswordPolicy{}
+	if err := disabled.CheckReuse(u, "current", verify); err != nil {
+		t.Errorf("CheckReuse() with history disabled error = %v, want nil", err)
+	}
+}
+
+func TestParseCharacterClasses(t *testing.T) {
+	classes, err := ParseCharacterClasses(" Lower, digit,,symbol ")
+	if err != nil {
+		t.Fatalf("ParseCharacterClasses() error = %v", err)
+	}
+	if len(classes) != 3 || classes[0] != CharacterClassLower || classes[1] != CharacterClassDigit || classes[2] != CharacterClassSymbol {
+		t.Errorf("ParseCharacterClasses() = %v, want [lower digit symbol]", classes)
+	}
+
+	if _, err := ParseCharacterClasses("lower,emoji"); err == nil {
+		t.Error("ParseCharacterClasses() should reject unknown classes")
+	}
+}
@@ -0,0 +1,33 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+)
+
+// UnverifiedUserPolicy decides what users who have not verified their email address may do
+type UnverifiedUserPolicy string
+
+const (
+	// UnverifiedUserPolicyAllow lets unverified users log in normally
+	UnverifiedUserPolicyAllow UnverifiedUserPolicy = "allow"
+	// UnverifiedUserPolicyBlock refuses login until the email address is verified
+	UnverifiedUserPolicyBlock UnverifiedUserPolicy = "block"
+)
+
+// ParseUnverifiedUserPolicy parses a policy name, an empty value means allow
+func ParseUnverifiedUserPolicy(value string) (UnverifiedUserPolicy, error) {
+	switch policy := UnverifiedUserPolicy(strings.ToLower(strings.TrimSpace(value))); policy {
+	case "", UnverifiedUserPolicyAllow:
+		return UnverifiedUserPolicyAllow, nil
+	case UnverifiedUserPolicyBlock:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("unknown unverified user policy %q", value)
+	}
+}
+
+// CanLogin returns true if the policy lets the user log in
+func (p UnverifiedUserPolicy) CanLogin(u User) bool {
+	return u.EmailVerified || p != UnverifiedUserPolicyBlock
+}
@@ -0,0 +1,42 @@
+package user
+
+import "testing"
+
+func TestParseUnverifiedUserPolicy(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    UnverifiedUserPolicy
+		wantErr bool
+	}{
+		{value: "", want: UnverifiedUserPolicyAllow},
+		{value: "allow", want: UnverifiedUserPolicyAllow},
+		{value: " BLOCK ", want: UnverifiedUserPolicyBlock},
+		{value: "sometimes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseUnverifiedUserPolicy(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseUnverifiedUserPolicy(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseUnverifiedUserPolicy(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestUnverifiedUserPolicy_CanLogin(t *testing.T) {
+	unverified := User{ID: "user-1"}
+	verified := User{ID: "user-2", EmailVerified: true}
+
+	if !UnverifiedUserPolicyAllow.CanLogin(unverified) {
+		t.Error("allow policy should let unverified users log in")
+	}
+	if UnverifiedUserPolicyBlock.CanLogin(unverified) {
+		t.Error("block policy should refuse unverified users")
+	}
+	if !UnverifiedUserPolicyBlock.CanLogin(verified) {
+		t.Error("block policy should let verified users log in")
+	}
+}
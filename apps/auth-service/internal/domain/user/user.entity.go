@@ -1,6 +1,7 @@
 package user
 
 import (
+	"crypto/subtle"
 	"strings"
 	"time"
 
@@ -10,10 +11,25 @@ import (
 type User struct {
 	ID        string
 	Email     string
-	Password  string
+	Password  string // Self-describing hash (PHC string format), never the plain password
 	Name      string
 	UpdatedAt time.Time
 
+	// PasswordHistory holds the hashes of previous passwords, newest first, for the reuse check
+	PasswordHistory []string
+
+	// EmailVerified is set once the user follows the link from the verification email
+	EmailVerified bool
+
+	// Multi-factor authentication (TOTP)
+	MFAEnabled       bool
+	MFASecret        string   // Base32 TOTP secret, set while enrolling and kept while enabled
+	MFARecoveryCodes []string // SHA256 hashes of unused recovery codes
+	MFALastUsedStep  int64    // TOTP time step of the last accepted code; codes of this step or earlier are rejected
+
+	// DeletedAt is set once the user is deleted; the row stays, anonymized, so IDs referenced elsewhere remain valid
+	DeletedAt *time.Time
+
 	// Domain events (internal, not persisted)
 	events []DomainEvent
 }
@@ -26,29 +42,16 @@ func (u User) Validate() error {
 	if !strings.Contains(u.Email, "@") {
 		return errors.NewValidationError(errors.CodeEmailInvalid, nil)
 	}
+	// Password is a hash here, the plain password is checked by the PasswordPolicy
 	if strings.TrimSpace(u.Password) == "" {
 		return errors.NewValidationError(errors.CodePasswordRequired, nil)
 	}
-	if len(u.Password) < 6 {
-		return errors.NewValidationError(errors.CodePasswordTooShort, nil)
-	}
 	if strings.TrimSpace(u.Name) == "" {
 		return errors.NewValidationError(errors.CodeNameRequired, nil)
 	}
 	return nil
 }
 
-// ValidatePassword validates password rules
-func (u User) ValidatePassword(password string) error {
-	if strings.TrimSpace(password) == "" {
-		return errors.NewValidationError(errors.CodePasswordRequired, nil)
-	}
-	if len(password) < 6 {
-		return errors.NewValidationError(errors.CodePasswordTooShort, nil)
-	}
-	return nil
-}
-
 // Create is a domain method that creates a user and adds a domain event
 func (u *User) Create() {
 	u.addEvent(UserCreatedEvent{
@@ -76,9 +79,11 @@ func (u *User) UpdateProfile(name string) {
 	})
 }
 
-// ChangePassword changes user password and adds a domain event
-func (u *User) ChangePassword(newPassword string) {
-	u.Password = newPassword
+// ChangePassword stores the hash of a new password and adds a domain event.
+// The replaced hash is kept in the history so the policy can refuse reusing it.
+func (u *User) ChangePassword(newPasswordHash string, historySize int) {
+	u.rememberPassword(historySize)
+	u.Password = newPasswordHash
 	u.UpdatedAt = time.Now().UTC()
 
 	u.addEvent(UserPasswordChangedEvent{
@@ -87,6 +92,174 @@ func (u *User) ChangePassword(newPassword string) {
 	})
 }
 
+// VerifyEmail marks the email address as verified and adds a domain event
+func (u *User) VerifyEmail() error {
+	if u.EmailVerified {
+		return errors.NewConflictError(errors.CodeEmailAlreadyVerified)
+	}
+	u.EmailVerified = true
+	u.UpdatedAt = time.Now().UTC()
+
+	u.addEvent(UserEmailVerifiedEvent{
+		UserID:     u.ID,
+		Email:      u.Email,
+		VerifiedAt: u.UpdatedAt.Format(time.RFC3339),
+	})
+	return nil
+}
+
+// ResetPassword stores the hash of a password set through the forgot-password flow and adds a domain event.
+// Unlike ChangePassword the current password is not known.
+func (u *User) ResetPassword(newPasswordHash string, historySize int) {
+	u.rememberPassword(historySize)
+	u.Password = newPasswordHash
+	u.UpdatedAt = time.Now().UTC()
+
+	u.addEvent(UserPasswordResetEvent{
+		UserID:  u.ID,
+		ResetAt: u.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+// RehashPassword replaces the stored hash with one of the same password made with the
+// current algorithm (e.g. upgrading a legacy SHA-256 hash) and adds a domain event
+func (u *User) RehashPassword(newPasswordHash, algorithm string) {
+	u.Password = newPasswordHash
+	u.UpdatedAt = time.Now().UTC()
+
+	u.addEvent(UserPasswordRehashedEvent{
+		UserID:     u.ID,
+		Algorithm:  algorithm,
+		RehashedAt: u.UpdatedAt.Format(time.RFC3339),
+	})
+}
+
+// rememberPassword moves the current hash into the history. The current password counts
+// towards historySize, so only historySize-1 previous hashes are kept.
+func (u *User) rememberPassword(historySize int) {
+	keep := historySize - 1
+	if keep <= 0 || u.Password == "" {
+		u.PasswordHistory = nil
+		return
+	}
+	history := append([]string{u.Password}, u.PasswordHistory...)
+	if len(history) > keep {
+		history = history[:keep]
+	}
+	u.PasswordHistory = history
+}
+
+// StartMFAEnrollment stores a pending TOTP secret and recovery codes.
+// MFA is only enforced after the user proves possession of the secret with EnableMFA.
+func (u *User) StartMFAEnrollment(secret string, recoveryCodeHashes []string) error {
+	if u.MFAEnabled {
+		return errors.NewConflictError(errors.CodeMFAAlreadyEnabled)
+	}
+	u.MFASecret = secret
+	u.MFARecoveryCodes = recoveryCodeHashes
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// EnableMFA turns on MFA for a pending enrollment and adds a domain event
+func (u *User) EnableMFA() error {
+	if u.MFAEnabled {
+		return errors.NewConflictError(errors.CodeMFAAlreadyEnabled)
+	}
+	if u.MFASecret == "" {
+		return errors.NewValidationError(errors.CodeMFANotEnrolled, nil)
+	}
+	u.MFAEnabled = true
+	u.UpdatedAt = time.Now().UTC()
+
+	u.addEvent(MFAEnabledEvent{
+		UserID:    u.ID,
+		EnabledAt: u.UpdatedAt.Format(time.RFC3339),
+	})
+	return nil
+}
+
+// DisableMFA turns off MFA, drops the secret and recovery codes and adds a domain event
+func (u *User) DisableMFA() error {
+	if !u.MFAEnabled {
+		return errors.NewValidationError(errors.CodeMFANotEnabled, nil)
+	}
+	u.MFAEnabled = false
+	u.MFASecret = ""
+	u.MFARecoveryCodes = nil
+	u.MFALastUsedStep = 0
+	u.UpdatedAt = time.Now().UTC()
+
+	u.addEvent(MFADisabledEvent{
+		UserID:     u.ID,
+		DisabledAt: u.UpdatedAt.Format(time.RFC3339),
+	})
+	return nil
+}
+
+// UseTOTPStep records the time step of an accepted TOTP code.
+// It returns false when a code of that step, or a later one, was already accepted.
+func (u *User) UseTOTPStep(step int64) bool {
+	if step <= u.MFALastUsedStep {
+		return false
+	}
+	u.MFALastUsedStep = step
+	u.UpdatedAt = time.Now().UTC()
+	return true
+}
+
+// UseRecoveryCode consumes a recovery code by its hash.
+// It returns false when the code is unknown or was already used.
+func (u *User) UseRecoveryCode(codeHash string) bool {
+	for i, hash := range u.MFARecoveryCodes {
+		if subtle.ConstantTimeCompare([]byte(hash), []byte(codeHash)) == 1 {
+			u.MFARecoveryCodes = append(u.MFARecoveryCodes[:i:i], u.MFARecoveryCodes[i+1:]...)
+			u.UpdatedAt = time.Now().UTC()
+			return true
+		}
+	}
+	return false
+}
+
+// DeletedUserName replaces the name of deleted users
+const DeletedUserName = "Deleted user"
+
+// deletedPasswordHash is not a valid PHC string, so no password ever verifies against it
+const deletedPasswordHash = "!deleted"
+
+// Delete erases the personal data of the user and adds a domain event.
+// The email is replaced by a unique placeholder so the address can register again;
+// requestID is the GDPR request that tracks the erasure in the other services.
+func (u *User) Delete(requestID string) error {
+	if u.IsDeleted() {
+		return errors.NewConflictError(errors.CodeUserDeleted)
+	}
+	now := time.Now().UTC()
+	u.Email = AnonymizedEmail(u.ID)
+	u.Name = DeletedUserName
+	u.Password = deletedPasswordHash
+	u.PasswordHistory = nil
+	u.EmailVerified = false
+	u.MFAEnabled = false
+	u.MFASecret = ""
+	u.MFARecoveryCodes = nil
+	u.MFALastUsedStep = 0
+	u.DeletedAt = &now
+	u.UpdatedAt = now
+
+	u.addEvent(UserDeletedEvent{
+		UserID:    u.ID,
+		RequestID: requestID,
+		DeletedAt: now.Format(time.RFC3339),
+	})
+	return nil
+}
+
+// IsDeleted reports whether the user has been deleted
+func (u User) IsDeleted() bool {
+	return u.DeletedAt != nil
+}
+
 // Events returns all domain events
 func (u User) Events() []DomainEvent {
 	return u.events
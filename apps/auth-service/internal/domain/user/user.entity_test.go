@@ -0,0 +1,551 @@
+package user
+
+import (
+	"testing"
+
+	"golang-social-media/pkg/errors"
+)
+
+func TestUser_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		user    User
+		wantErr bool
+		errCode errors.ErrorCode
+	}{
+		{
+			name: "valid user",
+			user: User{
+				ID:       "user-1",
+				Email:    "test@example.com",
+				Password: "password123",
+				Name:     "Test User",
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty email",
+			user: User{
+				ID:       "user-1",
+				Email:    "",
+				Password: "password123",
+				Name:     "Test User",
+			},
+			wantErr: true,
+			errCode: errors.CodeEmailRequired,
+		},
+		{
+			name: "whitespace email",
+			user: User{
+				ID:       "user-1",
+				Email:    "   ",
+				Password: "password123",
+				Name:     "Test User",
+			},
+			wantErr: true,
+			errCode: errors.CodeEmailRequired,
+		},
+		{
+			name: "invalid email format",
+			user: User{
+				ID:       "user-1",
+				Email:    "notanemail",
+				Password: "password123",
+				Name:     "Test User",
+			},
+			wantErr: true,
+			errCode: errors.CodeEmailInvalid,
+		},
+		{
+			name: "empty password",
+			user: User{
+				ID:       "user-1",
+				Email:    "test@example.com",
+				Password: "",
+				Name:     "Test User",
+			},
+			wantErr: true,
+			errCode: errors.CodePasswordRequired,
+		},
+		{
+			// Password holds a hash, length rules are checked by the PasswordPolicy
+			name: "short password hash",
+			user: User{
+				ID:       "user-1",
+				Email:    "test@example.com",
+				Password: "12345",
+				Name:     "Test User",
+			},
+			wantErr: false,
+		},
+		{
+			name: "empty name",
+			user: User{
+				ID:       "user-1",
+				Email:    "test@example.com",
+				Password: "password123",
+				Name:     "",
+			},
+			wantErr: true,
+			errCode: errors.CodeNameRequired,
+		},
+		{
+			name: "whitespace name",
+			user: User{
+				ID:       "user-1",
+				Email:    "test@example.com",
+				Password: "password123",
+				Name:     "   ",
+			},
+			wantErr: true,
+			errCode: errors.CodeNameRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.user.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("User.Validate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if appErr, ok := err.(*errors.AppError); ok {
+					if appErr.Code != tt.errCode {
+						t.Errorf("User.Validate() error code = %v, want %v", appErr.Code, tt.errCode)
+					}
+				} else {
+					t.Errorf("User.Validate() error type = %T, want *errors.AppError", err)
+				}
+			}
+		})
+	}
+}
+
+func TestUser_Create(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: "password123",
+		Name:     "Test User",
+	}
+
+	user.Create()
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.Create() should add 1 event, got %d", len(events))
+	}
+
+	event, ok := events[0].(UserCreatedEvent)
+	if !ok {
+		t.Fatalf("User.Create() event type = %T, want UserCreatedEvent", events[0])
+	}
+
+	if event.UserID != user.ID {
+		t.Errorf("UserCreatedEvent.UserID = %v, want %v", event.UserID, user.ID)
+	}
+	if event.Email != user.Email {
+		t.Errorf("UserCreatedEvent.Email = %v, want %v", event.Email, user.Email)
+	}
+	if event.Name != user.Name {
+		t.Errorf("UserCreatedEvent.Name = %v, want %v", event.Name, user.Name)
+	}
+	if event.CreatedAt == "" {
+		t.Error("UserCreatedEvent.CreatedAt should not be empty")
+	}
+}
+
+func TestUser_UpdateProfile(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: "password123",
+		Name:     "Old Name",
+	}
+
+	newName := "New Name"
+	user.UpdateProfile(newName)
+
+	if user.Name != newName {
+		t.Errorf("User.Name = %v, want %v", user.Name, newName)
+	}
+
+	if user.UpdatedAt.IsZero() {
+		t.Error("User.UpdatedAt should be set")
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.UpdateProfile() should add 1 event, got %d", len(events))
+	}
+
+	event, ok := events[0].(UserProfileUpdatedEvent)
+	if !ok {
+		t.Fatalf("User.UpdateProfile() event type = %T, want UserProfileUpdatedEvent", events[0])
+	}
+
+	if event.UserID != user.ID {
+		t.Errorf("UserProfileUpdatedEvent.UserID = %v, want %v", event.UserID, user.ID)
+	}
+	if event.OldName != "Old Name" {
+		t.Errorf("UserProfileUpdatedEvent.OldName = %v, want %v", event.OldName, "Old Name")
+	}
+	if event.NewName != newName {
+		t.Errorf("UserProfileUpdatedEvent.NewName = %v, want %v", event.NewName, newName)
+	}
+}
+
+func TestUser_UpdateProfile_EmptyName(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: "password123",
+		Name:     "Old Name",
+	}
+
+	user.UpdateProfile("")
+
+	// Should not update if name is empty
+	if user.Name != "Old Name" {
+		t.Errorf("User.Name = %v, want %v", user.Name, "Old Name")
+	}
+
+	events := user.Events()
+	if len(events) != 0 {
+		t.Errorf("User.UpdateProfile() with empty name should not add event, got %d", len(events))
+	}
+}
+
+func TestUser_ChangePassword(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: "oldpassword",
+		Name:     "Test User",
+	}
+
+	newPassword := "newpassword123"
+	user.ChangePassword(newPassword, 0)
+
+	if user.Password != newPassword {
+		t.Errorf("User.Password = %v, want %v", user.Password, newPassword)
+	}
+
+	if user.UpdatedAt.IsZero() {
+		t.Error("User.UpdatedAt should be set")
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.ChangePassword() should add 1 event, got %d", len(events))
+	}
+
+	event, ok := events[0].(UserPasswordChangedEvent)
+	if !ok {
+		t.Fatalf("User.ChangePassword() event type = %T, want UserPasswordChangedEvent", events[0])
+	}
+
+	if event.UserID != user.ID {
+		t.Errorf("UserPasswordChangedEvent.UserID = %v, want %v", event.UserID, user.ID)
+	}
+	if event.UpdatedAt == "" {
+		t.Error("UserPasswordChangedEvent.UpdatedAt should not be empty")
+	}
+}
+
+func TestUser_VerifyEmail(t *testing.T) {
+	user := &User{
+		ID:    "user-1",
+		Email: "test@example.com",
+	}
+
+	if err := user.VerifyEmail(); err != nil {
+		t.Fatalf("User.VerifyEmail() error = %v", err)
+	}
+	if !user.EmailVerified {
+		t.Error("User.EmailVerified should be true")
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.VerifyEmail() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(UserEmailVerifiedEvent)
+	if !ok {
+		t.Fatalf("User.VerifyEmail() event type = %T, want UserEmailVerifiedEvent", events[0])
+	}
+	if event.UserID != user.ID || event.Email != user.Email {
+		t.Errorf("UserEmailVerifiedEvent = %+v, want user %v", event, user.ID)
+	}
+
+	if err := user.VerifyEmail(); err == nil {
+		t.Error("User.VerifyEmail() should fail when already verified")
+	}
+}
+
+func TestUser_ResetPassword(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Password: "forgotten",
+	}
+
+	user.ResetPassword("newpassword123", 0)
+
+	if user.Password != "newpassword123" {
+		t.Errorf("User.Password = %v, want newpassword123", user.Password)
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.ResetPassword() should add 1 event, got %d", len(events))
+	}
+	if _, ok := events[0].(UserPasswordResetEvent); !ok {
+		t.Errorf("User.ResetPassword() event type = %T, want UserPasswordResetEvent", events[0])
+	}
+}
+
+func TestUser_ChangePassword_KeepsHistory(t *testing.T) {
+	user := &User{ID: "user-1", Password: "hash-1"}
+
+	user.ChangePassword("hash-2", 3)
+	user.ChangePassword("hash-3", 3)
+	user.ResetPassword("hash-4", 3)
+
+	// The current password counts towards the history size, so 2 previous hashes are kept
+	if user.Password != "hash-4" {
+		t.Errorf("User.Password = %v, want hash-4", user.Password)
+	}
+	if len(user.PasswordHistory) != 2 || user.PasswordHistory[0] != "hash-3" || user.PasswordHistory[1] != "hash-2" {
+		t.Errorf("User.PasswordHistory = %v, want [hash-3 hash-2]", user.PasswordHistory)
+	}
+
+	user.ChangePassword("hash-5", 0)
+	if user.PasswordHistory != nil {
+		t.Errorf("User.PasswordHistory = %v, want nil when history is disabled", user.PasswordHistory)
+	}
+}
+
+func TestUser_RehashPassword(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Password: "ef92b778bafe771e89245b89ecbc08a44a4e166c06659911881f383d4473e94f",
+	}
+
+	user.RehashPassword("$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5", "argon2id")
+
+	if user.Password != "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5" {
+		t.Errorf("User.Password = %v, want the new hash", user.Password)
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.RehashPassword() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(UserPasswordRehashedEvent)
+	if !ok {
+		t.Fatalf("User.RehashPassword() event type = %T, want UserPasswordRehashedEvent", events[0])
+	}
+	if event.UserID != user.ID || event.Algorithm != "argon2id" {
+		t.Errorf("UserPasswordRehashedEvent = %+v, want user-1 / argon2id", event)
+	}
+	if event.RehashedAt == "" {
+		t.Error("UserPasswordRehashedEvent.RehashedAt should not be empty")
+	}
+}
+
+func TestUser_ClearEvents(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: "password123",
+		Name:     "Test User",
+	}
+
+	user.Create()
+	if len(user.Events()) != 1 {
+		t.Fatal("Expected 1 event after Create()")
+	}
+
+	user.ClearEvents()
+	if len(user.Events()) != 0 {
+		t.Errorf("User.ClearEvents() should clear all events, got %d", len(user.Events()))
+	}
+}
+
+func TestUser_Events(t *testing.T) {
+	user := &User{
+		ID:       "user-1",
+		Email:    "test@example.com",
+		Password: "password123",
+		Name:     "Test User",
+	}
+
+	// Initially no events
+	if len(user.Events()) != 0 {
+		t.Errorf("New User should have 0 events, got %d", len(user.Events()))
+	}
+
+	// Add multiple events
+	user.Create()
+	user.UpdateProfile("New Name")
+	user.ChangePassword("newpass", 0)
+
+	events := user.Events()
+	if len(events) != 3 {
+		t.Errorf("User should have 3 events, got %d", len(events))
+	}
+}
+
+func TestUser_EnableMFA(t *testing.T) {
+	user := &User{
+		ID:    "user-1",
+		Email: "test@example.com",
+		Name:  "Test User",
+	}
+
+	if err := user.EnableMFA(); err == nil {
+		t.Fatal("User.EnableMFA() without enrollment should fail")
+	}
+
+	if err := user.StartMFAEnrollment("JBSWY3DPEHPK3PXP", []string{"hash-1", "hash-2"}); err != nil {
+		t.Fatalf("User.StartMFAEnrollment() error = %v", err)
+	}
+	if user.MFAEnabled {
+		t.Fatal("User.MFAEnabled should stay false until enrollment is confirmed")
+	}
+
+	if err := user.EnableMFA(); err != nil {
+		t.Fatalf("User.EnableMFA() error = %v", err)
+	}
+	if !user.MFAEnabled {
+		t.Error("User.MFAEnabled should be true")
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.EnableMFA() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(MFAEnabledEvent)
+	if !ok {
+		t.Fatalf("User.EnableMFA() event type = %T, want MFAEnabledEvent", events[0])
+	}
+	if event.UserID != user.ID {
+		t.Errorf("MFAEnabledEvent.UserID = %v, want %v", event.UserID, user.ID)
+	}
+
+	if err := user.StartMFAEnrollment("OTHERSECRET", nil); err == nil {
+		t.Error("User.StartMFAEnrollment() should fail while MFA is enabled")
+	}
+}
+
+func TestUser_DisableMFA(t *testing.T) {
+	user := &User{ID: "user-1"}
+
+	if err := user.DisableMFA(); err == nil {
+		t.Fatal("User.DisableMFA() should fail when MFA is not enabled")
+	}
+
+	_ = user.StartMFAEnrollment("JBSWY3DPEHPK3PXP", []string{"hash-1"})
+	_ = user.EnableMFA()
+	user.ClearEvents()
+
+	if err := user.DisableMFA(); err != nil {
+		t.Fatalf("User.DisableMFA() error = %v", err)
+	}
+	if user.MFAEnabled || user.MFASecret != "" || len(user.MFARecoveryCodes) != 0 {
+		t.Error("User.DisableMFA() should clear MFA state")
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.DisableMFA() should add 1 event, got %d", len(events))
+	}
+	if _, ok := events[0].(MFADisabledEvent); !ok {
+		t.Errorf("User.DisableMFA() event type = %T, want MFADisabledEvent", events[0])
+	}
+}
+
+func TestUser_UseRecoveryCode(t *testing.T) {
+	user := &User{
+		ID:               "user-1",
+		MFARecoveryCodes: []string{"hash-1", "hash-2"},
+	}
+
+	if !user.UseRecoveryCode("hash-1") {
+		t.Fatal("User.UseRecoveryCode() should accept an unused code")
+	}
+	if user.UseRecoveryCode("hash-1") {
+		t.Error("User.UseRecoveryCode() should reject a code that was already used")
+	}
+	if user.UseRecoveryCode("unknown") {
+		t.Error("User.UseRecoveryCode() should reject an unknown code")
+	}
+	if len(user.MFARecoveryCodes) != 1 || user.MFARecoveryCodes[0] != "hash-2" {
+		t.Errorf("User.MFARecoveryCodes = %v, want [hash-2]", user.MFARecoveryCodes)
+	}
+}
+
+func TestUser_UseTOTPStep(t *testing.T) {
+	user := &User{ID: "user-1", MFALastUsedStep: 100}
+
+	if user.UseTOTPStep(100) {
+		t.Error("User.UseTOTPStep() should reject the last used step")
+	}
+	if user.UseTOTPStep(99) {
+		t.Error("User.UseTOTPStep() should reject an earlier step")
+	}
+	if !user.UseTOTPStep(101) {
+		t.Fatal("User.UseTOTPStep() should accept a later step")
+	}
+	if user.MFALastUsedStep != 101 {
+		t.Errorf("User.MFALastUsedStep = %d, want 101", user.MFALastUsedStep)
+	}
+}
+
+func TestUser_Delete(t *testing.T) {
+	user := &User{
+		ID:               "user-1",
+		Email:            "test@example.com",
+		Password:         "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5",
+		Name:             "Test User",
+		PasswordHistory:  []string{"old-hash"},
+		MFAEnabled:       true,
+		MFASecret:        "SECRET",
+		MFARecoveryCodes: []string{"hash-1"},
+	}
+
+	if err := user.Delete("request-1"); err != nil {
+		t.Fatalf("User.Delete() error = %v", err)
+	}
+
+	if !user.IsDeleted() {
+		t.Error("User.IsDeleted() should be true after Delete")
+	}
+	if user.Email != "deleted-user-1@users.invalid" || user.Name != DeletedUserName {
+		t.Errorf("User = %s / %s, want anonymized email and name", user.Email, user.Name)
+	}
+	if user.Password == "$argon2id$v=19$m=65536,t=3,p=2$c2FsdA$a2V5" || user.PasswordHistory != nil {
+		t.Error("User.Delete() should drop the password hashes")
+	}
+	if user.MFAEnabled || user.MFASecret != "" || user.MFARecoveryCodes != nil {
+		t.Error("User.Delete() should drop the MFA secrets")
+	}
+
+	events := user.Events()
+	if len(events) != 1 {
+		t.Fatalf("User.Delete() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(UserDeletedEvent)
+	if !ok {
+		t.Fatalf("User.Delete() event type = %T, want UserDeletedEvent", events[0])
+	}
+	if event.UserID != "user-1" || event.RequestID != "request-1" || event.DeletedAt == "" {
+		t.Errorf("UserDeletedEvent = %+v, want user-1 / request-1 with a timestamp", event)
+	}
+
+	err := user.Delete("request-2")
+	if appErr, ok := err.(*errors.AppError); !ok || appErr.Code != errors.CodeUserDeleted {
+		t.Errorf("User.Delete() twice error = %v, want %s", err, errors.CodeUserDeleted)
+	}
+}
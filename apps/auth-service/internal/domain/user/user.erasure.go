@@ -0,0 +1,53 @@
+package user
+
+import "fmt"
+
+// AnonymizedEmail is the placeholder email of a deleted user, unique so the address can register again
+func AnonymizedEmail(userID string) string {
+	return fmt.Sprintf("deleted-%s@users.invalid", userID)
+}
+
+// ErasedEventFields maps the payload fields of user events that hold personal data to the value
+// they are redacted to when the user is deleted. Stored payloads are keyed by the event field names
+func ErasedEventFields(userID string) map[string]string {
+	return map[string]string{
+		"Email":   AnonymizedEmail(userID),
+		"Name":    DeletedUserName,
+		"OldName": DeletedUserName,
+		"NewName": DeletedUserName,
+	}
+}
+
+// Redact returns event with the personal data of userID replaced like ErasedEventFields does.
+// It reports false for events of other users and for events that carry no personal data
+func Redact(event DomainEvent, userID string) (DomainEvent, bool) {
+	switch e := event.(type) {
+	case UserCreatedEvent:
+		if e.UserID != userID {
+			return event, false
+		}
+		e.Email = AnonymizedEmail(userID)
+		e.Name = DeletedUserName
+		return e, true
+	case UserProfileUpdatedEvent:
+		if e.UserID != userID {
+			return event, false
+		}
+		e.OldName = DeletedUserName
+		e.NewName = DeletedUserName
+		return e, true
+	case UserEmailVerifiedEvent:
+		if e.UserID != userID {
+			return event, false
+		}
+		e.Email = AnonymizedEmail(userID)
+		return e, true
+	case UserLockedOutEvent:
+		if e.UserID != userID {
+			return event, false
+		}
+		e.Email = AnonymizedEmail(userID)
+		return e, true
+	}
+	return event, false
+}
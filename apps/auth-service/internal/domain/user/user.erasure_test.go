@@ -0,0 +1,90 @@
+package user
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name  string
+		event DomainEvent
+		want  DomainEvent
+	}{
+		{
+			name:  "created",
+			event: UserCreatedEvent{UserID: "user-1", Email: "alice@example.com", Name: "Alice", CreatedAt: "2026-01-01T00:00:00Z"},
+			want:  UserCreatedEvent{UserID: "user-1", Email: "deleted-user-1@users.invalid", Name: DeletedUserName, CreatedAt: "2026-01-01T00:00:00Z"},
+		},
+		{
+			name:  "profile updated",
+			event: UserProfileUpdatedEvent{UserID: "user-1", OldName: "Alice", NewName: "Alice Smith"},
+			want:  UserProfileUpdatedEvent{UserID: "user-1", OldName: DeletedUserName, NewName: DeletedUserName},
+		},
+		{
+			name:  "email verified",
+			event: UserEmailVerifiedEvent{UserID: "user-1", Email: "alice@example.com"},
+			want:  UserEmailVerifiedEvent{UserID: "user-1", Email: "deleted-user-1@users.invalid"},
+		},
+		{
+			name:  "locked out",
+			event: UserLockedOutEvent{UserID: "user-1", Email: "alice@example.com", FailedAttempts: 5},
+			want:  UserLockedOutEvent{UserID: "user-1", Email: "deleted-user-1@users.invalid", FailedAttempts: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Redact(tt.event, "user-1")
+			if !ok {
+				t.Fatal("Redact() = false, want true")
+			}
+			if got != tt.want {
+				t.Errorf("Redact() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRedact_LeavesOtherEvents(t *testing.T) {
+	otherUser := UserCreatedEvent{UserID: "user-2", Email: "bob@example.com", Name: "Bob"}
+	if got, ok := Redact(otherUser, "user-1"); ok || got != otherUser {
+		t.Errorf("Redact() = %+v, %v for another user, want it unchanged", got, ok)
+	}
+
+	noPersonalData := MFAEnabledEvent{UserID: "user-1"}
+	if _, ok := Redact(noPersonalData, "user-1"); ok {
+		t.Error("Redact() = true for an event without personal data")
+	}
+}
+
+func TestRedact_ReplaysAsAnonymized(t *testing.T) {
+	created := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	var u User
+
+	for i, event := range []DomainEvent{
+		UserCreatedEvent{UserID: "user-1", Email: "alice@example.com", Name: "Alice"},
+		UserProfileUpdatedEvent{UserID: "user-1", OldName: "Alice", NewName: "Alice Smith"},
+	} {
+		redacted, _ := Redact(event, "user-1")
+		u.Apply(redacted, created.Add(time.Duration(i)*time.Hour))
+	}
+
+	// A replay before the deletion must not bring the erased identity back
+	if u.Email != AnonymizedEmail("user-1") || u.Name != DeletedUserName {
+		t.Errorf("identity = %s/%s, want the anonymized email and name", u.Email, u.Name)
+	}
+}
+
+func TestErasedEventFields(t *testing.T) {
+	fields := ErasedEventFields("user-1")
+
+	if fields["Email"] != "deleted-user-1@users.invalid" {
+		t.Errorf("Email = %q, want the anonymized address", fields["Email"])
+	}
+	for _, field := range []string{"Name", "OldName", "NewName"} {
+		if fields[field] != DeletedUserName {
+			t.Errorf("%s = %q, want %q", field, fields[field], DeletedUserName)
+		}
+	}
+}
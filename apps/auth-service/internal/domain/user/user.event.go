@@ -38,3 +38,79 @@ type UserPasswordChangedEvent struct {
 func (e UserPasswordChangedEvent) Type() string {
 	return "UserPasswordChanged"
 }
+
+// UserEmailVerifiedEvent is a domain event emitted when a user verifies their email address
+type UserEmailVerifiedEvent struct {
+	UserID     string
+	Email      string
+	VerifiedAt string
+}
+
+func (e UserEmailVerifiedEvent) Type() string {
+	return "UserEmailVerified"
+}
+
+// UserPasswordResetEvent is a domain event emitted when a user resets a forgotten password
+type UserPasswordResetEvent struct {
+	UserID  string
+	ResetAt string
+}
+
+func (e UserPasswordResetEvent) Type() string {
+	return "UserPasswordReset"
+}
+
+// UserPasswordRehashedEvent is a domain event emitted when a stored password hash is upgraded to the current algorithm
+type UserPasswordRehashedEvent struct {
+	UserID     string
+	Algorithm  string
+	RehashedAt string
+}
+
+func (e UserPasswordRehashedEvent) Type() string {
+	return "UserPasswordRehashed"
+}
+
+// MFAEnabledEvent is a domain event emitted when a user turns on multi-factor authentication
+type MFAEnabledEvent struct {
+	UserID    string
+	EnabledAt string
+}
+
+func (e MFAEnabledEvent) Type() string {
+	return "MFAEnabled"
+}
+
+// MFADisabledEvent is a domain event emitted when a user turns off multi-factor authentication
+type MFADisabledEvent struct {
+	UserID     string
+	DisabledAt string
+}
+
+func (e MFADisabledEvent) Type() string {
+	return "MFADisabled"
+}
+
+// UserLockedOutEvent is a domain event emitted when too many failed logins lock an account
+type UserLockedOutEvent struct {
+	UserID         string
+	Email          string
+	FailedAttempts int
+	LockedUntil    string
+	LockedAt       string
+}
+
+func (e UserLockedOutEvent) Type() string {
+	return "UserLockedOut"
+}
+
+// UserDeletedEvent is a domain event emitted when a user is deleted and their personal data erased
+type UserDeletedEvent struct {
+	UserID    string
+	RequestID string
+	DeletedAt string
+}
+
+func (e UserDeletedEvent) Type() string {
+	return "UserDeleted"
+}
@@ -0,0 +1,39 @@
+package user
+
+import (
+	"time"
+)
+
+// Apply replays a stored event on the user without recording a new one; occurredAt is when the
+// event was stored. It reports whether the event belongs to the user state: events of other
+// aggregates keyed by the user (roles, API keys, sessions) share the stream and are ignored.
+// Password hashes, MFA secrets and recovery codes are never part of events, so they stay empty
+func (u *User) Apply(event DomainEvent, occurredAt time.Time) bool {
+	switch e := event.(type) {
+	case UserCreatedEvent:
+		u.ID = e.UserID
+		u.Email = e.Email
+		u.Name = e.Name
+	case UserProfileUpdatedEvent:
+		u.Name = e.NewName
+	case UserEmailVerifiedEvent:
+		u.EmailVerified = true
+	case UserPasswordChangedEvent, UserPasswordResetEvent, UserPasswordRehashedEvent:
+		// Only the hash changed
+	case MFAEnabledEvent:
+		u.MFAEnabled = true
+	case MFADisabledEvent:
+		u.MFAEnabled = false
+	case UserDeletedEvent:
+		deletedAt := occurredAt
+		u.Email = AnonymizedEmail(u.ID)
+		u.Name = DeletedUserName
+		u.EmailVerified = false
+		u.MFAEnabled = false
+		u.DeletedAt = &deletedAt
+	default:
+		return false
+	}
+	u.UpdatedAt = occurredAt
+	return true
+}
@@ -0,0 +1,76 @@
+package user
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUser_Apply(t *testing.T) {
+	created := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	var u User
+
+	steps := []struct {
+		event DomainEvent
+		at    time.Time
+	}{
+		{UserCreatedEvent{UserID: "user-1", Email: "alice@example.com", Name: "Alice"}, created},
+		{UserEmailVerifiedEvent{UserID: "user-1", Email: "alice@example.com"}, created.Add(time.Hour)},
+		{UserProfileUpdatedEvent{UserID: "user-1", OldName: "Alice", NewName: "Alice Smith"}, created.Add(2 * time.Hour)},
+		{MFAEnabledEvent{UserID: "user-1"}, created.Add(3 * time.Hour)},
+		{UserPasswordChangedEvent{UserID: "user-1"}, created.Add(4 * time.Hour)},
+	}
+	for _, step := range steps {
+		if !u.Apply(step.event, step.at) {
+			t.Fatalf("Apply(%s) = false, want true", step.event.Type())
+		}
+	}
+
+	if u.ID != "user-1" || u.Email != "alice@example.com" || u.Name != "Alice Smith" {
+		t.Errorf("identity = %s/%s/%s, want user-1/alice@example.com/Alice Smith", u.ID, u.Email, u.Name)
+	}
+	if !u.EmailVerified {
+		t.Error("EmailVerified = false, want true")
+	}
+	if !u.MFAEnabled {
+		t.Error("MFAEnabled = false, want true")
+	}
+	if u.Password != "" || u.MFASecret != "" {
+		t.Error("secrets should stay empty, they are not part of events")
+	}
+	if want := created.Add(4 * time.Hour); !u.UpdatedAt.Equal(want) {
+		t.Errorf("UpdatedAt = %v, want %v", u.UpdatedAt, want)
+	}
+}
+
+func TestUser_Apply_Deleted(t *testing.T) {
+	deletedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	u := User{ID: "user-1", Email: "alice@example.com", Name: "Alice", EmailVerified: true, MFAEnabled: true}
+
+	if !u.Apply(UserDeletedEvent{UserID: "user-1"}, deletedAt) {
+		t.Fatal("Apply(UserDeleted) = false, want true")
+	}
+	if u.Email != "deleted-user-1@users.invalid" {
+		t.Errorf("Email = %v, want the anonymized address", u.Email)
+	}
+	if u.Name != DeletedUserName {
+		t.Errorf("Name = %v, want %v", u.Name, DeletedUserName)
+	}
+	if u.DeletedAt == nil || !u.DeletedAt.Equal(deletedAt) {
+		t.Errorf("DeletedAt = %v, want %v", u.DeletedAt, deletedAt)
+	}
+}
+
+func TestUser_Apply_IgnoresOtherEvents(t *testing.T) {
+	u := User{ID: "user-1", Name: "Alice"}
+
+	if u.Apply(otherEvent{}, time.Now()) {
+		t.Error("Apply() = true for an event that does not change the user")
+	}
+	if !u.UpdatedAt.IsZero() {
+		t.Error("UpdatedAt should not change for ignored events")
+	}
+}
+
+type otherEvent struct{}
+
+func (otherEvent) Type() string { return "UserRoleAssigned" }
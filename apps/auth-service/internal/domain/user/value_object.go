@@ -2,6 +2,7 @@ package user
 
 import (
 	"crypto/sha256"
+	"crypto/subtle"
 	"encoding/hex"
 	"time"
 )
@@ -25,15 +26,17 @@ func (t TokenID) String() string {
 	return t.value
 }
 
-// Password represents a password value object
+// Password represents a legacy password hash: an unsalted SHA-256 hex digest
 type Password struct {
 	hashed string
 }
 
-// NewPassword creates a new Password value object
-// In production, should use bcrypt or similar
+// NewPassword creates a legacy Password value object.
+//
+// Deprecated: unsalted SHA-256 is not suitable for storing passwords. New hashes are created
+// by the PasswordHasher port (argon2id / bcrypt), which still verifies these legacy digests
+// and upgrades them on the next successful login.
 func NewPassword(plainPassword string) Password {
-	// Simple hash for now - should use bcrypt in production
 	hash := sha256.Sum256([]byte(plainPassword))
 	return Password{
 		hashed: hex.EncodeToString(hash[:]),
@@ -49,7 +52,7 @@ func (p Password) String() string {
 func (p Password) Verify(plainPassword string) bool {
 	hash := sha256.Sum256([]byte(plainPassword))
 	hashed := hex.EncodeToString(hash[:])
-	return p.hashed == hashed
+	return subtle.ConstantTimeCompare([]byte(p.hashed), []byte(hashed)) == 1
 }
 
 // Email represents an email value object
@@ -172,4 +172,3 @@ func TestTimestamp_Value(t *testing.T) {
 		t.Error("Timestamp.value should not be zero")
 	}
 }
-
@@ -81,4 +81,3 @@ func TestUserRole_ClearEvents(t *testing.T) {
 		t.Errorf("UserRole.ClearEvents() should clear all events, got %d", len(userRole.Events()))
 	}
 }
-
@@ -0,0 +1,61 @@
+package verification_token
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Purpose tells what a verification token can be used for
+type Purpose string
+
+const (
+	PurposeEmailVerification Purpose = "email_verification"
+	PurposePasswordReset     Purpose = "password_reset"
+)
+
+// Token is a single-use, expiring token sent to the user by email.
+// Only the SHA256 hash of the token is stored.
+type Token struct {
+	ID        string
+	UserID    string
+	Purpose   Purpose
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+}
+
+// NewToken creates a token for the user valid for ttl
+func NewToken(userID string, purpose Purpose, tokenHash string, ttl time.Duration) Token {
+	now := time.Now().UTC()
+	return Token{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Purpose:   purpose,
+		TokenHash: tokenHash,
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}
+}
+
+// IsUsed returns true if the token has already been consumed
+func (t Token) IsUsed() bool {
+	return t.UsedAt != nil
+}
+
+// IsExpired returns true if the token is past its expiration
+func (t Token) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// IsUsableFor returns true if the token can still be consumed for the purpose
+func (t Token) IsUsableFor(purpose Purpose) bool {
+	return t.Purpose == purpose && !t.IsUsed() && !t.IsExpired()
+}
+
+// Use marks the token as consumed
+func (t *Token) Use() {
+	now := time.Now().UTC()
+	t.UsedAt = &now
+}
@@ -0,0 +1,39 @@
+package verification_token
+
+import (
+	"testing"
+	"time"
+)
+
+func TestToken_IsUsableFor(t *testing.T) {
+	token := NewToken("user-1", PurposeEmailVerification, "hash", time.Hour)
+
+	if token.ID == "" {
+		t.Error("NewToken() should assign an ID")
+	}
+	if !token.IsUsableFor(PurposeEmailVerification) {
+		t.Error("new token should be usable for its purpose")
+	}
+	if token.IsUsableFor(PurposePasswordReset) {
+		t.Error("token should not be usable for another purpose")
+	}
+
+	token.Use()
+	if !token.IsUsed() {
+		t.Error("Use() should mark the token as used")
+	}
+	if token.IsUsableFor(PurposeEmailVerification) {
+		t.Error("token should be single-use")
+	}
+}
+
+func TestToken_IsExpired(t *testing.T) {
+	token := NewToken("user-1", PurposePasswordReset, "hash", -time.Minute)
+
+	if !token.IsExpired() {
+		t.Error("token past its expiration should be expired")
+	}
+	if token.IsUsableFor(PurposePasswordReset) {
+		t.Error("expired token should not be usable")
+	}
+}
@@ -0,0 +1,70 @@
+package breached_password
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang-social-media/apps/auth-service/internal/application/breached_password"
+)
+
+// PrefixLength is the number of SHA-1 hex characters used to pick a range file
+const PrefixLength = 5
+
+var _ breached_password.Checker = (*RangeFileChecker)(nil)
+
+// RangeFileChecker looks passwords up in a local copy of a breached-password list in
+// k-anonymity range format (as served by the Pwned Passwords range API), so no network is needed.
+//
+// The directory holds one file per SHA-1 prefix, named <PREFIX>.txt (5 uppercase hex characters),
+// with one "<SUFFIX>:<COUNT>" line per breached password whose SHA-1 starts with that prefix.
+// Only the file of the password's prefix is read for each lookup.
+type RangeFileChecker struct {
+	dir string
+}
+
+// NewRangeFileChecker creates a checker reading range files from dir
+func NewRangeFileChecker(dir string) *RangeFileChecker {
+	return &RangeFileChecker{dir: dir}
+}
+
+// IsBreached returns true if the password's SHA-1 suffix is listed in its prefix file.
+// A missing prefix file means no breached password has that prefix.
+func (c *RangeFileChecker) IsBreached(ctx context.Context, password string) (bool, error) {
+	prefix, suffix := HashRange(password)
+
+	file, err := os.Open(filepath.Join(c.dir, prefix+".txt"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		line := strings.TrimSpace(scanner.Text())
+		candidate, _, _ := strings.Cut(line, ":")
+		if strings.EqualFold(candidate, suffix) {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// HashRange splits the uppercase SHA-1 hex of a password into its range prefix and suffix
+func HashRange(password string) (prefix, suffix string) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	return hash[:PrefixLength], hash[PrefixLength:]
+}
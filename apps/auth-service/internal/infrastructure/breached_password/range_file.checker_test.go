@@ -0,0 +1,64 @@
+package breached_password
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestHashRange(t *testing.T) {
+	// SHA-1("password") = 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+	prefix, suffix := HashRange("password")
+	if prefix != "5BAA6" {
+		t.Errorf("prefix = %q, want 5BAA6", prefix)
+	}
+	if suffix != "1E4C9B93F3F0682250B6CF8331B7EE68FD8" {
+		t.Errorf("suffix = %q, want 1E4C9B93F3F0682250B6CF8331B7EE68FD8", suffix)
+	}
+}
+
+func TestRangeFileChecker_IsBreached(t *testing.T) {
+	dir := t.TempDir()
+	content := "0018A45C4D1DEF81644B54AB7F969B88D65:1\n1e4c9b93f3f0682250b6cf8331b7ee68fd8:9545824\n"
+	if err := os.WriteFile(filepath.Join(dir, "5BAA6.txt"), []byte(content), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	checker := NewRangeFileChecker(dir)
+
+	tests := []struct {
+		name     string
+		password string
+		want     bool
+	}{
+		{name: "listed suffix, case insensitive", password: "password", want: true},
+		{name: "no file for prefix", password: "correct horse battery staple", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := checker.IsBreached(context.Background(), tt.password)
+			if err != nil {
+				t.Fatalf("IsBreached() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("IsBreached() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRangeFileChecker_IsBreached_SuffixNotListed(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "5BAA6.txt"), []byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	got, err := NewRangeFileChecker(dir).IsBreached(context.Background(), "password")
+	if err != nil {
+		t.Fatalf("IsBreached() error = %v", err)
+	}
+	if got {
+		t.Error("IsBreached() should be false when the suffix is not in the prefix file")
+	}
+}
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/cache"
+)
+
+var _ repository.EffectivePermissionCache = (*EffectivePermissionCache)(nil)
+
+// EffectivePermissionCache keeps each user's effective grants in the shared cache,
+// so invalidation on one replica is seen by all of them
+type EffectivePermissionCache struct {
+	cache cache.Cache
+}
+
+// NewEffectivePermissionCache creates a new EffectivePermissionCache
+func NewEffectivePermissionCache(cache cache.Cache) *EffectivePermissionCache {
+	return &EffectivePermissionCache{cache: cache}
+}
+
+// Get retrieves the cached grants of a user
+func (c *EffectivePermissionCache) Get(ctx context.Context, userID string) ([]permission.Grant, bool, error) {
+	data, err := c.cache.Get(ctx, c.userKey(userID))
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+	var grants []permission.Grant
+	if err := json.Unmarshal(data, &grants); err != nil {
+		return nil, false, err
+	}
+	return grants, true, nil
+}
+
+// Set caches the grants of a user
+func (c *EffectivePermissionCache) Set(ctx context.Context, userID string, grants []permission.Grant, ttl time.Duration) error {
+	data, err := json.Marshal(grants)
+	if err != nil {
+		return err
+	}
+	return c.cache.Set(ctx, c.userKey(userID), data, ttl)
+}
+
+// InvalidateUser drops the cached grants of a user
+func (c *EffectivePermissionCache) InvalidateUser(ctx context.Context, userID string) error {
+	return c.cache.Delete(ctx, c.userKey(userID))
+}
+
+// InvalidateAll drops the cached grants of every user
+func (c *EffectivePermissionCache) InvalidateAll(ctx context.Context) error {
+	return c.cache.DeletePattern(ctx, "auth:permissions:user:*")
+}
+
+// userKey generates a cache key for a user's effective grants
+func (c *EffectivePermissionCache) userKey(userID string) string {
+	return fmt.Sprintf("auth:permissions:user:%s", userID)
+}
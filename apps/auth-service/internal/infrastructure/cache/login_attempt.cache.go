@@ -0,0 +1,81 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/pkg/cache"
+)
+
+var _ repository.LoginAttemptRepository = (*LoginAttemptCache)(nil)
+
+// LoginAttemptCache keeps failed login counters and account locks in the cache,
+// shared by every auth-service replica
+type LoginAttemptCache struct {
+	cache cache.Cache
+}
+
+// NewLoginAttemptCache creates a new LoginAttemptCache
+func NewLoginAttemptCache(cache cache.Cache) *LoginAttemptCache {
+	return &LoginAttemptCache{cache: cache}
+}
+
+// Get retrieves the failed login state of a user
+func (c *LoginAttemptCache) Get(ctx context.Context, userID string) (user.LoginAttempts, error) {
+	data, err := c.cache.Get(ctx, c.attemptsKey(userID))
+	if err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return user.LoginAttempts{UserID: userID}, nil
+		}
+		return user.LoginAttempts{}, err
+	}
+	var attempts user.LoginAttempts
+	if err := json.Unmarshal(data, &attempts); err != nil {
+		return user.LoginAttempts{}, err
+	}
+	return attempts, nil
+}
+
+// RecordFailure counts a failed login with a check-and-set update of the user's state
+func (c *LoginAttemptCache) RecordFailure(ctx context.Context, userID string, policy user.LockoutPolicy, email string, now time.Time) (user.LoginAttempts, user.UserLockedOutEvent, bool, error) {
+	var (
+		attempts user.LoginAttempts
+		event    user.UserLockedOutEvent
+		locked   bool
+	)
+	err := c.cache.Update(ctx, c.attemptsKey(userID), func(current []byte) ([]byte, time.Duration, error) {
+		// Start over from the stored state, fn runs again when another failure won the race
+		attempts = user.LoginAttempts{UserID: userID}
+		if current != nil {
+			if err := json.Unmarshal(current, &attempts); err != nil {
+				return nil, 0, err
+			}
+		}
+		event, locked = attempts.RecordFailure(policy, email, now)
+
+		data, err := json.Marshal(attempts)
+		if err != nil {
+			return nil, 0, err
+		}
+		return data, attempts.TTL(policy, now), nil
+	})
+	if err != nil {
+		return user.LoginAttempts{}, user.UserLockedOutEvent{}, false, err
+	}
+	return attempts, event, locked, nil
+}
+
+// Delete removes the failed login state of a user
+func (c *LoginAttemptCache) Delete(ctx context.Context, userID string) error {
+	return c.cache.Delete(ctx, c.attemptsKey(userID))
+}
+
+// attemptsKey generates a cache key for a user's login attempts
+func (c *LoginAttemptCache) attemptsKey(userID string) string {
+	return fmt.Sprintf("auth:login:attempts:%s", userID)
+}
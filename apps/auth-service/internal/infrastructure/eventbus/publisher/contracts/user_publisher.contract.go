@@ -9,6 +9,7 @@ import (
 // UserPublisher publishes user-related events
 type UserPublisher interface {
 	PublishUserCreated(ctx context.Context, event events.UserCreated) error
+	// Publish writes any pkg/events payload to topic, partitioned by key
+	Publish(ctx context.Context, topic, key string, event interface{}) error
 	Close() error
 }
-
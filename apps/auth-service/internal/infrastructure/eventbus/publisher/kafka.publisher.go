@@ -9,6 +9,7 @@ import (
 	"golang-social-media/apps/auth-service/internal/infrastructure/eventbus/publisher/contracts"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -24,9 +25,9 @@ func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
 		return nil, errors.New("kafka brokers must be provided")
 	}
 
+	// Topic is set per message so one writer serves every auth topic
 	writer := &kafka.Writer{
 		Addr:     kafka.TCP(brokers...),
-		Topic:    events.TopicUserCreated,
 		Balancer: &kafka.LeastBytes{},
 
 		// Batching Configuration - optimized for low latency (user creation is critical)
@@ -64,25 +65,26 @@ func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
 func (p *KafkaPublisher) PublishUserCreated(ctx context.Context, event events.UserCreated) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("auth.publisher").
+		logger.ComponentCtx(ctx, "auth.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal UserCreated event")
 		return err
 	}
 
-	if err := p.writer.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.writer, kafka.Message{
+		Topic: events.TopicUserCreated,
 		Key:   []byte(event.ID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("auth.publisher").
+		logger.ComponentCtx(ctx, "auth.publisher").
 			Error().
 			Err(err).
 			Msg("failed to publish UserCreated event")
 		return err
 	}
 
-	logger.Component("auth.publisher").
+	logger.ComponentCtx(ctx, "auth.publisher").
 		Info().
 		Str("topic", events.TopicUserCreated).
 		Str("user_id", event.ID).
@@ -90,6 +92,40 @@ func (p *KafkaPublisher) PublishUserCreated(ctx context.Context, event events.Us
 	return nil
 }
 
+// Publish writes an event to the given topic. key is used for partitioning,
+// so events of one aggregate keep their order
+func (p *KafkaPublisher) Publish(ctx context.Context, topic, key string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.ComponentCtx(ctx, "auth.publisher").
+			Error().
+			Err(err).
+			Str("topic", topic).
+			Msg("failed to marshal event")
+		return err
+	}
+
+	if err := tracing.WriteKafkaMessage(ctx, p.writer, kafka.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: payload,
+	}); err != nil {
+		logger.ComponentCtx(ctx, "auth.publisher").
+			Error().
+			Err(err).
+			Str("topic", topic).
+			Msg("failed to publish event")
+		return err
+	}
+
+	logger.ComponentCtx(ctx, "auth.publisher").
+		Info().
+		Str("topic", topic).
+		Str("key", key).
+		Msg("published event")
+	return nil
+}
+
 func (p *KafkaPublisher) Close() error {
 	return p.writer.Close()
 }
@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+)
+
+// GDPRStepSubscriber subscribes to the completion reports of the GDPR sagas
+// (user.deletion.completed and user.export.completed)
+type GDPRStepSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
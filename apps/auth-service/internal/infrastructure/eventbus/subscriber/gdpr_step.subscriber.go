@@ -0,0 +1,198 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/segmentio/kafka-go"
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/apps/auth-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+)
+
+var _ contracts.GDPRStepSubscriber = (*GDPRStepSubscriber)(nil)
+
+const (
+	gdprStepRetryMin = 500 * time.Millisecond
+	gdprStepRetryMax = 30 * time.Second
+)
+
+// GDPRStepSubscriber records the steps reported by every service in the GDPR deletion / export sagas.
+// One subscriber reads one topic; the offset is committed only after the step is recorded,
+// so a report is retried instead of lost when the database is unavailable
+type GDPRStepSubscriber struct {
+	reader  *kafka.Reader
+	topic   string
+	decode  func([]byte) (commandcontracts.RecordGDPRStepCommandRequest, error)
+	handler commandcontracts.RecordGDPRStepCommand
+	metrics *metrics.KafkaConsumer
+	log     *zerolog.Logger
+}
+
+// NewUserDeletionCompletedSubscriber consumes user.deletion.completed (events.UserDataErased)
+func NewUserDeletionCompletedSubscriber(brokers []string, groupID string, handler commandcontracts.RecordGDPRStepCommand) (*GDPRStepSubscriber, error) {
+	return newGDPRStepSubscriber(brokers, groupID, events.TopicUserDeletionCompleted, handler, func(value []byte) (commandcontracts.RecordGDPRStepCommandRequest, error) {
+		var event events.UserDataErased
+		if err := json.Unmarshal(value, &event); err != nil {
+			return commandcontracts.RecordGDPRStepCommandRequest{}, err
+		}
+		return commandcontracts.RecordGDPRStepCommandRequest{
+			RequestID:   event.RequestID,
+			Service:     event.Service,
+			Records:     event.Records,
+			CompletedAt: event.ErasedAt,
+		}, nil
+	})
+}
+
+// NewUserExportCompletedSubscriber consumes user.export.completed (events.UserDataExported)
+func NewUserExportCompletedSubscriber(brokers []string, groupID string, handler commandcontracts.RecordGDPRStepCommand) (*GDPRStepSubscriber, error) {
+	return newGDPRStepSubscriber(brokers, groupID, events.TopicUserExportCompleted, handler, func(value []byte) (commandcontracts.RecordGDPRStepCommandRequest, error) {
+		var event events.UserDataExported
+		if err := json.Unmarshal(value, &event); err != nil {
+			return commandcontracts.RecordGDPRStepCommandRequest{}, err
+		}
+		return commandcontracts.RecordGDPRStepCommandRequest{
+			RequestID:   event.RequestID,
+			Service:     event.Service,
+			Document:    event.Document,
+			CompletedAt: event.ExportedAt,
+		}, nil
+	})
+}
+
+func newGDPRStepSubscriber(
+	brokers []string,
+	groupID string,
+	topic string,
+	handler commandcontracts.RecordGDPRStepCommand,
+	decode func([]byte) (commandcontracts.RecordGDPRStepCommandRequest, error),
+) (*GDPRStepSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	log := logger.Component("auth.subscriber.gdpr_step")
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: brokers,
+		GroupID: groupID,
+		Topic:   topic,
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 5 * time.Minute,
+		},
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		MinBytes:       10e3, // 10KB
+		MaxBytes:       10e6, // 10MB, export documents can be large
+	})
+
+	log.Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Str("topic", topic).
+		Msg("GDPR step subscriber configured")
+
+	return &GDPRStepSubscriber{
+		reader:  reader,
+		topic:   topic,
+		decode:  decode,
+		handler: handler,
+		metrics: metrics.NewKafkaConsumer(topic, groupID),
+		log:     log,
+	}, nil
+}
+
+func (s *GDPRStepSubscriber) Consume(ctx context.Context) {
+	s.log.Info().
+		Str("topic", s.topic).
+		Msg("starting GDPR step consumer")
+
+	for {
+		msg, err := s.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+				s.log.Info().
+					Str("topic", s.topic).
+					Msg("GDPR step consumer shutting down")
+				return
+			}
+			s.log.Error().
+				Err(err).
+				Str("topic", s.topic).
+				Msg("failed to fetch GDPR step message")
+			continue
+		}
+
+		s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+		start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+		req, err := s.decode(msg.Value)
+		if err != nil {
+			// A malformed report never succeeds, skip it
+			s.log.Error().
+				Err(err).
+				Str("topic", msg.Topic).
+				Int64("offset", msg.Offset).
+				Msg("failed to decode GDPR step event")
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+		} else if err := s.handle(msgCtx, req); err != nil {
+			// Only cancellation ends the retries; the offset stays uncommitted
+			tracing.End(span, err)
+			return
+		} else {
+			tracing.End(span, nil)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+		}
+
+		if err := s.reader.CommitMessages(ctx, msg); err != nil {
+			s.log.Error().
+				Err(err).
+				Str("topic", msg.Topic).
+				Int64("offset", msg.Offset).
+				Msg("failed to commit GDPR step message")
+		}
+	}
+}
+
+// handle records the step, retrying with exponential backoff until it succeeds or ctx is done
+func (s *GDPRStepSubscriber) handle(ctx context.Context, req commandcontracts.RecordGDPRStepCommandRequest) error {
+	delay := gdprStepRetryMin
+	for {
+		err := s.handler.Execute(ctx, req)
+		if err == nil {
+			return nil
+		}
+		s.log.Error().Ctx(ctx).
+			Err(err).
+			Str("request_id", req.RequestID).
+			Str("service", req.Service).
+			Dur("retry_in", delay).
+			Msg("failed to record GDPR step")
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > gdprStepRetryMax {
+			delay = gdprStepRetryMax
+		}
+	}
+}
+
+func (s *GDPRStepSubscriber) Close() error {
+	return s.reader.Close()
+}
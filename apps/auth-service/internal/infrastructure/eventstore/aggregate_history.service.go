@@ -0,0 +1,166 @@
+package eventstore
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+// aggregateHistory replays the event store into aggregates, reading it through the audit log port
+type aggregateHistory struct {
+	events  repository.AuditLogRepository
+	decoder *EventDecoder
+}
+
+// NewAggregateHistoryRepository creates a new AggregateHistoryRepository
+func NewAggregateHistoryRepository(events repository.AuditLogRepository, decoder *EventDecoder) repository.AggregateHistoryRepository {
+	return &aggregateHistory{
+		events:  events,
+		decoder: decoder,
+	}
+}
+
+// UserAsOf returns the user as it was at asOf, or nil if it did not exist yet
+func (h *aggregateHistory) UserAsOf(ctx context.Context, userID string, asOf time.Time) (*repository.UserHistory, error) {
+	var history *repository.UserHistory
+	filter := repository.AuditEventFilter{
+		AggregateID:   userID,
+		AggregateType: "User",
+		To:            inclusive(asOf),
+	}
+	err := h.events.Stream(ctx, filter, func(event repository.AuditEvent) error {
+		var err error
+		history, err = h.applyUser(history, event)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// RoleAsOf returns the role as it was at asOf, or nil if it did not exist yet
+func (h *aggregateHistory) RoleAsOf(ctx context.Context, roleID string, asOf time.Time) (*repository.RoleHistory, error) {
+	var history *repository.RoleHistory
+	filter := repository.AuditEventFilter{
+		AggregateID:   roleID,
+		AggregateType: "Role",
+		To:            inclusive(asOf),
+	}
+	err := h.events.Stream(ctx, filter, func(event repository.AuditEvent) error {
+		domainEvent, err := h.decode(event)
+		if err != nil || domainEvent == nil {
+			return err
+		}
+		roleEvent, ok := domainEvent.(role.DomainEvent)
+		if !ok {
+			return nil
+		}
+		if history == nil {
+			// Events recorded before the role was created cannot be replayed
+			if _, created := roleEvent.(role.RoleCreatedEvent); !created {
+				return nil
+			}
+			history = &repository.RoleHistory{}
+		}
+		if !history.Role.Apply(roleEvent, event.OccurredAt) {
+			return nil
+		}
+		if _, deleted := roleEvent.(role.RoleDeletedEvent); deleted {
+			history.Deleted = true
+		}
+		history.Version++
+		history.LastEventAt = event.OccurredAt
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+// ReplayUsers rebuilds every user that has a UserCreated event. The stream is ordered by time
+// across users, so all users are kept in memory until it ends; fn is then called oldest user first
+func (h *aggregateHistory) ReplayUsers(ctx context.Context, fn func(repository.UserHistory) error) error {
+	users := make(map[string]*repository.UserHistory)
+	filter := repository.AuditEventFilter{AggregateType: "User"}
+	err := h.events.Stream(ctx, filter, func(event repository.AuditEvent) error {
+		history, err := h.applyUser(users[event.AggregateID], event)
+		if err != nil {
+			return err
+		}
+		if history != nil {
+			users[event.AggregateID] = history
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	ordered := make([]*repository.UserHistory, 0, len(users))
+	for _, history := range users {
+		ordered = append(ordered, history)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		if ordered[i].CreatedAt.Equal(ordered[j].CreatedAt) {
+			return ordered[i].User.ID < ordered[j].User.ID
+		}
+		return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+	})
+	for _, history := range ordered {
+		if err := fn(*history); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyUser replays one stored event on the user; history is nil until UserCreated is seen
+func (h *aggregateHistory) applyUser(history *repository.UserHistory, event repository.AuditEvent) (*repository.UserHistory, error) {
+	domainEvent, err := h.decode(event)
+	if err != nil || domainEvent == nil {
+		return history, err
+	}
+	userEvent, ok := domainEvent.(user.DomainEvent)
+	if !ok {
+		return history, nil
+	}
+	if history == nil {
+		// Events recorded before the user was created cannot be replayed
+		if _, created := userEvent.(user.UserCreatedEvent); !created {
+			return nil, nil
+		}
+		history = &repository.UserHistory{CreatedAt: event.OccurredAt}
+	}
+	if !history.User.Apply(userEvent, event.OccurredAt) {
+		return history, nil
+	}
+	history.Version++
+	history.LastEventAt = event.OccurredAt
+	return history, nil
+}
+
+// decode returns the domain event stored in event, or nil for event types that do not change aggregate state
+func (h *aggregateHistory) decode(event repository.AuditEvent) (interface{}, error) {
+	domainEvent, ok, err := h.decoder.Decode(event.EventType, event.EventVersion, string(event.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("event %s: %w", event.ID, err)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return domainEvent, nil
+}
+
+// inclusive turns asOf into the exclusive upper bound of the filter, so events stored at asOf
+// itself count; Postgres keeps timestamps to the microsecond
+func inclusive(asOf time.Time) *time.Time {
+	to := asOf.Add(time.Microsecond)
+	return &to
+}
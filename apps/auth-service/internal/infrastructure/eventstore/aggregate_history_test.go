@@ -0,0 +1,165 @@
+package eventstore
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/memory"
+)
+
+var baseTime = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+type eventLog struct {
+	repo *memory.AuditLogRepository
+	seq  int
+}
+
+// append stores the event the way the unit of work does, with the version the event reports
+func (l *eventLog) append(t *testing.T, aggregateID, aggregateType string, event interface{ Type() string }, version int, at time.Time) {
+	t.Helper()
+	payload, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("marshal %s: %v", event.Type(), err)
+	}
+	l.appendRaw(aggregateID, aggregateType, event.Type(), version, string(payload), at)
+}
+
+func (l *eventLog) appendRaw(aggregateID, aggregateType, eventType string, version int, payload string, at time.Time) {
+	l.seq++
+	l.repo.Append(repository.AuditEvent{
+		ID:            fmt.Sprintf("event-%03d", l.seq),
+		AggregateID:   aggregateID,
+		AggregateType: aggregateType,
+		EventType:     eventType,
+		EventVersion:  version,
+		Payload:       json.RawMessage(payload),
+		OccurredAt:    at,
+	})
+}
+
+func newHistory() (*eventLog, repository.AggregateHistoryRepository) {
+	log := &eventLog{repo: memory.NewAuditLogRepository()}
+	return log, NewAggregateHistoryRepository(log.repo, DefaultEventDecoder())
+}
+
+func TestAggregateHistory_UserAsOf(t *testing.T) {
+	log, history := newHistory()
+	log.append(t, "user-1", "User", user.UserCreatedEvent{UserID: "user-1", Email: "alice@example.com", Name: "Alice"}, 1, baseTime)
+	log.appendRaw("user-1", "User", "UserRoleAssigned", 1, `{"UserID":"user-1","RoleID":"role-1"}`, baseTime.Add(time.Minute))
+	log.append(t, "user-1", "User", user.UserProfileUpdatedEvent{UserID: "user-1", OldName: "Alice", NewName: "Alice Smith"}, 1, baseTime.Add(time.Hour))
+	log.append(t, "user-1", "User", user.UserDeletedEvent{UserID: "user-1"}, 1, baseTime.Add(2*time.Hour))
+
+	tests := []struct {
+		name        string
+		asOf        time.Time
+		wantNil     bool
+		wantName    string
+		wantDeleted bool
+		wantVersion int
+	}{
+		{name: "before creation", asOf: baseTime.Add(-time.Second), wantNil: true},
+		{name: "at creation", asOf: baseTime, wantName: "Alice", wantVersion: 1},
+		{name: "after rename", asOf: baseTime.Add(90 * time.Minute), wantName: "Alice Smith", wantVersion: 2},
+		{name: "after deletion", asOf: baseTime.Add(3 * time.Hour), wantName: user.DeletedUserName, wantDeleted: true, wantVersion: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := history.UserAsOf(context.Background(), "user-1", tt.asOf)
+			if err != nil {
+				t.Fatalf("UserAsOf() error = %v", err)
+			}
+			if tt.wantNil {
+				if got != nil {
+					t.Fatalf("UserAsOf() = %+v, want nil", got)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatal("UserAsOf() = nil, want a user")
+			}
+			if got.User.Name != tt.wantName {
+				t.Errorf("Name = %v, want %v", got.User.Name, tt.wantName)
+			}
+			if (got.User.DeletedAt != nil) != tt.wantDeleted {
+				t.Errorf("DeletedAt = %v, want deleted %v", got.User.DeletedAt, tt.wantDeleted)
+			}
+			if got.Version != tt.wantVersion {
+				t.Errorf("Version = %v, want %v", got.Version, tt.wantVersion)
+			}
+			if !got.CreatedAt.Equal(baseTime) {
+				t.Errorf("CreatedAt = %v, want %v", got.CreatedAt, baseTime)
+			}
+		})
+	}
+}
+
+func TestAggregateHistory_RoleAsOf_UpcastsVersion1(t *testing.T) {
+	log, history := newHistory()
+	// Version 1 payloads, stored before the description was part of the events
+	log.appendRaw("role-1", "Role", "RoleCreated", 1, `{"RoleID":"role-1","Name":"Mod","CreatedAt":"2026-03-01T09:00:00Z"}`, baseTime)
+	log.appendRaw("role-1", "Role", "RoleUpdated", 1, `{"RoleID":"role-1","OldName":"Mod","NewName":"Moderator"}`, baseTime.Add(time.Hour))
+	description := "Moderates content"
+	log.append(t, "role-1", "Role", role.RoleUpdatedEvent{RoleID: "role-1", OldName: "Moderator", NewName: "Moderator", NewDescription: &description}, 2, baseTime.Add(2*time.Hour))
+	log.append(t, "role-1", "Role", role.RoleDeletedEvent{RoleID: "role-1", Name: "Moderator"}, 1, baseTime.Add(3*time.Hour))
+
+	got, err := history.RoleAsOf(context.Background(), "role-1", baseTime.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("RoleAsOf() error = %v", err)
+	}
+	if got == nil || got.Role.Name != "Moderator" || got.Role.Description != "" || got.Deleted {
+		t.Fatalf("RoleAsOf(v1 events) = %+v, want Moderator without description", got)
+	}
+
+	got, err = history.RoleAsOf(context.Background(), "role-1", baseTime.Add(4*time.Hour))
+	if err != nil {
+		t.Fatalf("RoleAsOf() error = %v", err)
+	}
+	if got.Role.Description != description || !got.Deleted || got.Version != 4 {
+		t.Errorf("RoleAsOf(after deletion) = %+v, want the last description, deleted, version 4", got)
+	}
+}
+
+func TestAggregateHistory_ReplayUsers(t *testing.T) {
+	log, history := newHistory()
+	log.append(t, "user-2", "User", user.UserCreatedEvent{UserID: "user-2", Email: "bob@example.com", Name: "Bob"}, 1, baseTime.Add(time.Minute))
+	log.append(t, "user-1", "User", user.UserCreatedEvent{UserID: "user-1", Email: "alice@example.com", Name: "Alice"}, 1, baseTime)
+	log.append(t, "user-1", "User", user.MFAEnabledEvent{UserID: "user-1"}, 1, baseTime.Add(time.Hour))
+	// Events of a user created before the event store existed cannot be replayed
+	log.append(t, "user-3", "User", user.UserEmailVerifiedEvent{UserID: "user-3"}, 1, baseTime)
+
+	var replayed []repository.UserHistory
+	err := history.ReplayUsers(context.Background(), func(h repository.UserHistory) error {
+		replayed = append(replayed, h)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ReplayUsers() error = %v", err)
+	}
+
+	if len(replayed) != 2 {
+		t.Fatalf("ReplayUsers() replayed %d users, want 2", len(replayed))
+	}
+	if replayed[0].User.ID != "user-1" || replayed[1].User.ID != "user-2" {
+		t.Errorf("ReplayUsers() order = %s, %s, want oldest user first", replayed[0].User.ID, replayed[1].User.ID)
+	}
+	if !replayed[0].User.MFAEnabled || replayed[0].Version != 2 {
+		t.Errorf("user-1 = %+v, want MFA enabled at version 2", replayed[0])
+	}
+}
+
+func TestDefaultVersioningStrategy_UnknownMigration(t *testing.T) {
+	decoder := DefaultEventDecoder()
+
+	if _, ok, err := decoder.Decode("SomethingElse", 1, `{}`); ok || err != nil {
+		t.Errorf("Decode(unknown type) = ok %v, err %v, want skipped", ok, err)
+	}
+	if _, _, err := decoder.Decode("RoleCreated", 0, `{"RoleID":"role-1"}`); err == nil {
+		t.Error("Decode(version without migration) should fail")
+	}
+}
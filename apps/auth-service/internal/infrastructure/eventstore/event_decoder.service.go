@@ -0,0 +1,104 @@
+package eventstore
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+// EventDecoder turns stored payloads back into domain events. Payloads written by an older
+// version of an event are upcast by the versioning strategy before they are decoded
+type EventDecoder struct {
+	versioning *EventVersioningStrategy
+	decoders   map[string]func(payload map[string]interface{}) (interface{}, error)
+}
+
+// NewEventDecoder creates an EventDecoder without any event type
+func NewEventDecoder(versioning *EventVersioningStrategy) *EventDecoder {
+	return &EventDecoder{
+		versioning: versioning,
+		decoders:   make(map[string]func(map[string]interface{}) (interface{}, error)),
+	}
+}
+
+// DefaultEventDecoder decodes the user and role events aggregates are rehydrated from
+func DefaultEventDecoder() *EventDecoder {
+	d := NewEventDecoder(DefaultVersioningStrategy())
+
+	registerDecoder[user.UserCreatedEvent](d)
+	registerDecoder[user.UserProfileUpdatedEvent](d)
+	registerDecoder[user.UserPasswordChangedEvent](d)
+	registerDecoder[user.UserEmailVerifiedEvent](d)
+	registerDecoder[user.UserPasswordResetEvent](d)
+	registerDecoder[user.UserPasswordRehashedEvent](d)
+	registerDecoder[user.MFAEnabledEvent](d)
+	registerDecoder[user.MFADisabledEvent](d)
+	registerDecoder[user.UserLockedOutEvent](d)
+	registerDecoder[user.UserDeletedEvent](d)
+
+	registerDecoder[role.RoleCreatedEvent](d)
+	registerDecoder[role.RoleUpdatedEvent](d)
+	registerDecoder[role.RoleParentChangedEvent](d)
+	registerDecoder[role.RoleDeletedEvent](d)
+
+	return d
+}
+
+// DefaultVersioningStrategy registers the current version of every event whose payload changed
+// shape, with the upcasters that bring older payloads to it
+func DefaultVersioningStrategy() *EventVersioningStrategy {
+	s := NewEventVersioningStrategy()
+
+	// v2 added the description; roles created before that are rebuilt without one
+	s.RegisterVersion(role.RoleCreatedEvent{}.Type(), role.RoleCreatedEvent{}.Version())
+	s.RegisterMigration(role.RoleCreatedEvent{}.Type(), 1, 2, func(payload map[string]interface{}) (map[string]interface{}, error) {
+		payload["Description"] = ""
+		return payload, nil
+	})
+
+	// v2 added the new description; v1 did not record it, so replay keeps the previous one
+	s.RegisterVersion(role.RoleUpdatedEvent{}.Type(), role.RoleUpdatedEvent{}.Version())
+	s.RegisterMigration(role.RoleUpdatedEvent{}.Type(), 1, 2, func(payload map[string]interface{}) (map[string]interface{}, error) {
+		payload["NewDescription"] = nil
+		return payload, nil
+	})
+
+	return s
+}
+
+// Decode upcasts the payload to the current version of the event type and decodes it.
+// ok is false for event types the decoder does not know, which do not change aggregate state
+func (d *EventDecoder) Decode(eventType string, version int, payload string) (event interface{}, ok bool, err error) {
+	decode, known := d.decoders[eventType]
+	if !known {
+		return nil, false, nil
+	}
+
+	upcast, err := d.versioning.UpgradeEvent(eventType, version, payload)
+	if err != nil {
+		return nil, true, err
+	}
+	event, err = decode(upcast)
+	if err != nil {
+		return nil, true, fmt.Errorf("decode %s v%d: %w", eventType, version, err)
+	}
+	return event, true, nil
+}
+
+// registerDecoder adds the domain event E; the event type is taken from E itself
+func registerDecoder[E interface{ Type() string }](d *EventDecoder) {
+	var zero E
+	d.decoders[zero.Type()] = func(payload map[string]interface{}) (interface{}, error) {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		var event E
+		if err := json.Unmarshal(data, &event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+}
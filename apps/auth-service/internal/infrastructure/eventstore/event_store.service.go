@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres"
+	"golang-social-media/apps/auth-service/internal/pkg/auditctx"
 	"golang-social-media/pkg/logger"
 
 	"github.com/rs/zerolog"
@@ -25,7 +26,8 @@ func NewEventStoreService(eventStoreRepo *postgres.EventStoreRepository) *EventS
 	}
 }
 
-// Append stores an event in the event store
+// Append stores an event in the event store.
+// The actor and request metadata in ctx are recorded as well; keys given in metadata take precedence
 func (s *EventStoreService) Append(ctx context.Context, event interface{}, metadata map[string]interface{}) error {
 	// Extract event information
 	var aggregateID, aggregateType, eventType string
@@ -37,6 +39,12 @@ func (s *EventStoreService) Append(ctx context.Context, event interface{}, metad
 	}); ok {
 		eventType = domainEvent.Type()
 	}
+	// Events whose payload changed shape report their version, so readers know how to upcast them
+	if versioned, ok := event.(interface {
+		Version() int
+	}); ok {
+		eventVersion = versioned.Version()
+	}
 
 	// Extract aggregate info from struct fields
 	eventMap, err := structToMap(event)
@@ -61,8 +69,13 @@ func (s *EventStoreService) Append(ctx context.Context, event interface{}, metad
 		eventType = inferEventType(event)
 	}
 
+	recorded := auditctx.FromContext(ctx).Map()
+	for key, value := range metadata {
+		recorded[key] = value
+	}
+
 	// Store in event store
-	err := s.eventStoreRepo.Append(ctx, aggregateID, aggregateType, eventType, eventVersion, event, metadata)
+	err = s.eventStoreRepo.Append(ctx, aggregateID, aggregateType, eventType, eventVersion, event, recorded)
 	if err != nil {
 		s.log.Error().
 			Err(err).
@@ -0,0 +1,188 @@
+package eventstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres"
+
+	"gorm.io/gorm"
+)
+
+// RebuiltPassword is stored for users inserted from events: hashes are never part of events,
+// so the password cannot be verified and the user has to reset it
+const RebuiltPassword = "!rebuilt-from-events"
+
+// UserDrift is a users column that differs from the state replayed from events
+type UserDrift struct {
+	UserID string
+	Column string
+	Table  string
+	Events string
+	// Repairable is false when the events alone cannot restore the column (enabling MFA needs the secret)
+	Repairable bool
+}
+
+// UserProjectionReport is the outcome of comparing the users table with the event store
+type UserProjectionReport struct {
+	Replayed  int         // Users rebuilt from events
+	Missing   []string    // Users with events but without a row
+	Drifts    []UserDrift // Columns that differ
+	Untracked []string    // Rows without a UserCreated event, e.g. seeded before the event store existed
+	Repaired  int         // Rows inserted or updated, only when applying
+}
+
+// UserProjection verifies and rebuilds the users table from the event store. Only columns
+// derived from events are compared: email, name, email_verified, mfa_enabled and deleted_at
+type UserProjection struct {
+	history repository.AggregateHistoryRepository
+	db      *gorm.DB
+}
+
+// NewUserProjection creates a new UserProjection
+func NewUserProjection(history repository.AggregateHistoryRepository, db *gorm.DB) *UserProjection {
+	return &UserProjection{
+		history: history,
+		db:      db,
+	}
+}
+
+// Verify reports the differences without changing the table
+func (p *UserProjection) Verify(ctx context.Context) (*UserProjectionReport, error) {
+	return p.run(ctx, false)
+}
+
+// Rebuild inserts the missing rows and repairs drifted columns in one transaction.
+// Rows without events are reported but left alone
+func (p *UserProjection) Rebuild(ctx context.Context) (*UserProjectionReport, error) {
+	return p.run(ctx, true)
+}
+
+func (p *UserProjection) run(ctx context.Context, apply bool) (*UserProjectionReport, error) {
+	report := &UserProjectionReport{}
+	seen := make(map[string]bool)
+
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := p.history.ReplayUsers(ctx, func(history repository.UserHistory) error {
+			report.Replayed++
+			seen[history.User.ID] = true
+
+			var row postgres.UserModel
+			err := tx.Where("id = ?", history.User.ID).Take(&row).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				report.Missing = append(report.Missing, history.User.ID)
+				if !apply {
+					return nil
+				}
+				report.Repaired++
+				return tx.Create(userModelFromHistory(history)).Error
+			}
+			if err != nil {
+				return err
+			}
+
+			drifts, updates := compareUser(row, history)
+			report.Drifts = append(report.Drifts, drifts...)
+			if !apply || len(updates) == 0 {
+				return nil
+			}
+			report.Repaired++
+			updates["updated_at"] = history.LastEventAt
+			return tx.Model(&postgres.UserModel{}).Where("id = ?", row.ID).Updates(updates).Error
+		})
+		if err != nil {
+			return err
+		}
+
+		var ids []string
+		if err := tx.Model(&postgres.UserModel{}).Order("created_at ASC").Pluck("id", &ids).Error; err != nil {
+			return err
+		}
+		for _, id := range ids {
+			if !seen[id] {
+				report.Untracked = append(report.Untracked, id)
+			}
+		}
+
+		if !apply {
+			// Verify never writes, rolling back keeps it that way even if the queries above change
+			return errVerifyRollback
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errVerifyRollback) {
+		return nil, err
+	}
+	return report, nil
+}
+
+var errVerifyRollback = errors.New("eventstore: verify rollback")
+
+// compareUser returns the drifted columns and the updates that repair them
+func compareUser(row postgres.UserModel, history repository.UserHistory) ([]UserDrift, map[string]interface{}) {
+	u := history.User
+	var drifts []UserDrift
+	updates := make(map[string]interface{})
+
+	drift := func(column string, table, events interface{}, repairable bool) {
+		drifts = append(drifts, UserDrift{
+			UserID:     u.ID,
+			Column:     column,
+			Table:      fmt.Sprint(table),
+			Events:     fmt.Sprint(events),
+			Repairable: repairable,
+		})
+	}
+
+	if row.Email != u.Email {
+		drift("email", row.Email, u.Email, true)
+		updates["email"] = u.Email
+	}
+	if row.Name != u.Name {
+		drift("name", row.Name, u.Name, true)
+		updates["name"] = u.Name
+	}
+	if row.EmailVerified != u.EmailVerified {
+		drift("email_verified", row.EmailVerified, u.EmailVerified, true)
+		updates["email_verified"] = u.EmailVerified
+	}
+	if row.MFAEnabled != u.MFAEnabled {
+		// Disabling can be replayed by clearing the secret; enabling cannot, the secret is not in the events
+		drift("mfa_enabled", row.MFAEnabled, u.MFAEnabled, !u.MFAEnabled)
+		if !u.MFAEnabled {
+			updates["mfa_enabled"] = false
+			updates["mfa_secret"] = ""
+			updates["mfa_recovery_codes"] = gorm.Expr("'[]'::jsonb")
+		}
+	}
+	if (row.DeletedAt == nil) != (u.DeletedAt == nil) {
+		drift("deleted_at", formatDeletedAt(row.DeletedAt != nil), formatDeletedAt(u.DeletedAt != nil), true)
+		updates["deleted_at"] = u.DeletedAt
+	}
+	return drifts, updates
+}
+
+func formatDeletedAt(deleted bool) string {
+	if deleted {
+		return "deleted"
+	}
+	return "active"
+}
+
+func userModelFromHistory(history repository.UserHistory) *postgres.UserModel {
+	u := history.User
+	return &postgres.UserModel{
+		ID:            u.ID,
+		Email:         u.Email,
+		Password:      RebuiltPassword,
+		Name:          u.Name,
+		EmailVerified: u.EmailVerified,
+		// MFA cannot be restored without the secret, the user enrolls again
+		MFAEnabled: false,
+		DeletedAt:  u.DeletedAt,
+		CreatedAt:  history.CreatedAt,
+		UpdatedAt:  history.LastEventAt,
+	}
+}
@@ -3,8 +3,12 @@ package grpc
 import (
 	"fmt"
 	"net"
+	"os"
 
+	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 )
@@ -16,8 +20,16 @@ func Start(addr string, registerFunc func(*grpc.Server)) error {
 		return fmt.Errorf("failed to listen: %w", err)
 	}
 
-	// Create gRPC server with optimizations
+	// Initialize error transformer
+	devMode := os.Getenv("ENV") == "development"
+	transformer := errors.NewTransformer(devMode)
+
+	// Create gRPC server with error interceptor and optimizations
 	server := grpc.NewServer(
+		// Metrics run outermost so they record the status code produced by the error interceptor
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), errors.GRPCErrorInterceptor(transformer)),
+		grpc.StreamInterceptor(metrics.StreamServerInterceptor()),
+		tracing.GRPCServerOption(),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			MaxConnectionIdle:     15 * 60, // 15 minutes
 			MaxConnectionAge:      30 * 60, // 30 minutes
@@ -5,10 +5,15 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
+// mfaChallengeExpiration bounds the time between the password step and the TOTP step of a login
+const mfaChallengeExpiration = 5 * time.Minute
+
 type Service struct {
-	secret            []byte
+	secret            []byte   // HS256 secret, kept to verify tokens issued before the key ring was enabled
+	keyRing           *KeyRing // Asymmetric signing keys; when set, new tokens are signed with RS256/ES256
 	accessExpiration  time.Duration
 	refreshExpiration time.Duration
 }
@@ -33,11 +38,31 @@ func NewService(secret string, accessExpirationHours int, refreshExpirationHours
 	}
 }
 
+// NewServiceWithKeyRing creates a Service that signs tokens with the key ring's current key.
+// legacySecret may be empty; when set, HS256 tokens issued before the switch keep validating until they expire.
+func NewServiceWithKeyRing(keyRing *KeyRing, legacySecret string, accessExpirationHours int, refreshExpirationHours int) *Service {
+	service := NewService(legacySecret, accessExpirationHours, refreshExpirationHours)
+	service.keyRing = keyRing
+	return service
+}
+
+// KeyRing returns the asymmetric key ring, or nil when the service signs with HS256
+func (s *Service) KeyRing() *KeyRing {
+	return s.keyRing
+}
+
+// RefreshExpiration returns the refresh token lifetime
+func (s *Service) RefreshExpiration() time.Duration {
+	return s.refreshExpiration
+}
+
 // TokenClaims represents JWT token claims with roles and permissions
 type TokenClaims struct {
 	UserID      string
+	SessionID   string // Refresh token family the token was issued for, empty for tokens without a session
 	Roles       []string
 	Permissions []string
+	ExpiresAt   time.Time
 }
 
 // GenerateTokenPair generates both access and refresh tokens
@@ -47,12 +72,17 @@ func (s *Service) GenerateTokenPair(userID string) (*TokenPair, error) {
 
 // GenerateTokenPairWithClaims generates both access and refresh tokens with roles and permissions
 func (s *Service) GenerateTokenPairWithClaims(userID string, roles []string, permissions []string) (*TokenPair, error) {
-	accessToken, err := s.generateTokenWithClaims(userID, roles, permissions, s.accessExpiration, "access")
+	return s.GenerateSessionTokenPair(userID, "", roles, permissions)
+}
+
+// GenerateSessionTokenPair generates both tokens bound to a session through the "sid" claim
+func (s *Service) GenerateSessionTokenPair(userID string, sessionID string, roles []string, permissions []string) (*TokenPair, error) {
+	accessToken, err := s.generateTokenWithClaims(userID, sessionID, roles, permissions, s.accessExpiration, "access")
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.generateToken(userID, s.refreshExpiration, "refresh")
+	refreshToken, err := s.generateTokenWithClaims(userID, sessionID, []string{}, []string{}, s.refreshExpiration, "refresh")
 	if err != nil {
 		return nil, err
 	}
@@ -71,13 +101,14 @@ func (s *Service) GenerateToken(userID string) (string, error) {
 
 // generateToken generates a JWT token with specified expiration and type
 func (s *Service) generateToken(userID string, expiration time.Duration, tokenType string) (string, error) {
-	return s.generateTokenWithClaims(userID, []string{}, []string{}, expiration, tokenType)
+	return s.generateTokenWithClaims(userID, "", []string{}, []string{}, expiration, tokenType)
 }
 
 // generateTokenWithClaims generates a JWT token with roles and permissions
-func (s *Service) generateTokenWithClaims(userID string, roles []string, permissions []string, expiration time.Duration, tokenType string) (string, error) {
+func (s *Service) generateTokenWithClaims(userID string, sessionID string, roles []string, permissions []string, expiration time.Duration, tokenType string) (string, error) {
 	now := time.Now()
 	claims := jwt.MapClaims{
+		"jti":         uuid.NewString(), // Unique per token so rotated tokens never collide
 		"user_id":     userID,
 		"type":        tokenType,
 		"exp":         now.Add(expiration).Unix(),
@@ -85,14 +116,69 @@ func (s *Service) generateTokenWithClaims(userID string, roles []string, permiss
 		"roles":       roles,
 		"permissions": permissions,
 	}
+	if sessionID != "" {
+		claims["sid"] = sessionID
+	}
+
+	return s.sign(claims)
+}
+
+// sign signs claims with the key ring's current key, or with the HS256 secret when no key ring is configured
+func (s *Service) sign(claims jwt.MapClaims) (string, error) {
+	if s.keyRing == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		return token.SignedString(s.secret)
+	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(s.secret)
+	key, err := s.keyRing.Current()
 	if err != nil {
 		return "", err
 	}
 
-	return tokenString, nil
+	token := jwt.NewWithClaims(key.signingMethod(), claims)
+	token.Header["kid"] = key.ID
+	return token.SignedString(key.PrivateKey)
+}
+
+// verificationKey resolves the key for a parsed token from its alg and kid headers
+func (s *Service) verificationKey(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		if len(s.secret) == 0 {
+			return nil, errors.New("invalid signing method")
+		}
+		return s.secret, nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodECDSA:
+		if s.keyRing == nil {
+			return nil, errors.New("invalid signing method")
+		}
+		kid, ok := token.Header["kid"].(string)
+		if !ok || kid == "" {
+			return nil, errors.New("missing kid header")
+		}
+		key, err := s.keyRing.Lookup(kid)
+		if err != nil {
+			return nil, err
+		}
+		// Reject tokens whose alg header doesn't match the key (algorithm confusion)
+		if token.Method.Alg() != key.Algorithm {
+			return nil, errors.New("invalid signing method")
+		}
+		return key.PublicKey(), nil
+	default:
+		return nil, errors.New("invalid signing method")
+	}
+}
+
+// GenerateMFAChallengeToken generates the short-lived token returned by the password step
+// of a login when the user has MFA enabled. It cannot be used as an access token.
+func (s *Service) GenerateMFAChallengeToken(userID string) (string, error) {
+	return s.generateToken(userID, mfaChallengeExpiration, "mfa_challenge")
+}
+
+// ValidateMFAChallengeToken validates an MFA challenge token and returns the user ID
+func (s *Service) ValidateMFAChallengeToken(tokenString string) (string, error) {
+	return s.validateToken(tokenString, "mfa_challenge")
 }
 
 // GenerateRefreshToken generates a refresh token
@@ -130,12 +216,7 @@ func (s *Service) validateToken(tokenString string, expectedType string) (string
 
 // validateTokenWithClaims validates a JWT token with specified type and returns full claims
 func (s *Service) validateTokenWithClaims(tokenString string, expectedType string) (*TokenClaims, error) {
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("invalid signing method")
-		}
-		return s.secret, nil
-	})
+	token, err := jwt.Parse(tokenString, s.verificationKey)
 
 	if err != nil {
 		return nil, err
@@ -161,6 +242,13 @@ func (s *Service) validateTokenWithClaims(tokenString string, expectedType strin
 		return nil, errors.New("missing user_id in token")
 	}
 
+	sessionID, _ := claims["sid"].(string)
+
+	var expiresAt time.Time
+	if exp, err := claims.GetExpirationTime(); err == nil && exp != nil {
+		expiresAt = exp.Time
+	}
+
 	// Extract roles
 	var roles []string
 	if rolesInterface, ok := claims["roles"].([]interface{}); ok {
@@ -185,8 +273,10 @@ func (s *Service) validateTokenWithClaims(tokenString string, expectedType strin
 
 	return &TokenClaims{
 		UserID:      userID,
+		SessionID:   sessionID,
 		Roles:       roles,
 		Permissions: permissions,
+		ExpiresAt:   expiresAt,
 	}, nil
 }
 
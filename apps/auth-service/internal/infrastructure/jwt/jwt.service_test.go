@@ -223,6 +223,34 @@ func TestService_ValidateRefreshToken_WithAccessToken(t *testing.T) {
 	}
 }
 
+func TestService_MFAChallengeToken(t *testing.T) {
+	service := NewService("test-secret", 1, 168)
+	userID := "user-123"
+
+	challenge, err := service.GenerateMFAChallengeToken(userID)
+	if err != nil {
+		t.Fatalf("GenerateMFAChallengeToken() error = %v", err)
+	}
+
+	validatedUserID, err := service.ValidateMFAChallengeToken(challenge)
+	if err != nil {
+		t.Fatalf("ValidateMFAChallengeToken() error = %v", err)
+	}
+	if validatedUserID != userID {
+		t.Errorf("ValidateMFAChallengeToken() userID = %v, want %v", validatedUserID, userID)
+	}
+
+	// A challenge token must never pass as an access token
+	if _, err := service.ValidateToken(challenge); err == nil {
+		t.Error("ValidateToken() should reject an MFA challenge token")
+	}
+
+	accessToken, _ := service.GenerateToken(userID)
+	if _, err := service.ValidateMFAChallengeToken(accessToken); err == nil {
+		t.Error("ValidateMFAChallengeToken() should reject an access token")
+	}
+}
+
 func TestService_GenerateToken(t *testing.T) {
 	service := NewService("test-secret", 1, 168)
 	userID := "user-123"
@@ -287,4 +315,3 @@ func TestService_DifferentSecrets(t *testing.T) {
 		t.Error("Token from different secret should not be valid")
 	}
 }
-
@@ -0,0 +1,440 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/rs/zerolog"
+	"golang-social-media/pkg/logger"
+)
+
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+
+	rsaKeyBits = 2048
+)
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+	ErrNoSigningKey         = errors.New("no active signing key")
+	ErrUnknownKeyID         = errors.New("unknown key id")
+)
+
+// KeyStatus describes what a key in the ring may be used for
+type KeyStatus string
+
+const (
+	// KeyStatusActive keys sign new tokens and verify existing ones
+	KeyStatusActive KeyStatus = "active"
+	// KeyStatusVerifyOnly keys were rotated out and only verify tokens issued before the rotation
+	KeyStatusVerifyOnly KeyStatus = "verify_only"
+)
+
+// SigningKey is an asymmetric key pair identified by its kid
+type SigningKey struct {
+	ID         string
+	Algorithm  string
+	Status     KeyStatus
+	PrivateKey crypto.Signer
+	CreatedAt  time.Time
+	RetiredAt  *time.Time
+}
+
+// PublicKey returns the public half of the key pair
+func (k *SigningKey) PublicKey() crypto.PublicKey {
+	return k.PrivateKey.Public()
+}
+
+// signingMethod returns the jwt signing method matching the key algorithm
+func (k *SigningKey) signingMethod() jwt.SigningMethod {
+	if k.Algorithm == AlgorithmES256 {
+		return jwt.SigningMethodES256
+	}
+	return jwt.SigningMethodRS256
+}
+
+// KeyRingConfig configures a KeyRing
+type KeyRingConfig struct {
+	// Algorithm used for newly generated keys (RS256 or ES256)
+	Algorithm string
+	// Dir optionally persists keys as <kid>.pem so replicas share the same ring
+	Dir string
+	// RotationInterval is how long a key signs tokens before it is rotated out
+	RotationInterval time.Duration
+	// VerifyRetention is how long a rotated key keeps verifying tokens.
+	// It must be at least the longest token lifetime (the refresh token expiration).
+	VerifyRetention time.Duration
+}
+
+// KeyRing holds the signing keys used by Service.
+// The newest key signs; older keys are kept for verification until their retention ends.
+type KeyRing struct {
+	mu     sync.RWMutex
+	keys   map[string]*SigningKey
+	config KeyRingConfig
+	now    func() time.Time
+	log    *zerolog.Logger
+}
+
+// NewKeyRing creates a KeyRing, loading persisted keys from config.Dir when set
+// and generating a first key if none exist.
+func NewKeyRing(config KeyRingConfig) (*KeyRing, error) {
+	if config.Algorithm == "" {
+		config.Algorithm = AlgorithmRS256
+	}
+	if config.Algorithm != AlgorithmRS256 && config.Algorithm != AlgorithmES256 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, config.Algorithm)
+	}
+	if config.RotationInterval <= 0 {
+		config.RotationInterval = 30 * 24 * time.Hour // Default 30 days
+	}
+	if config.VerifyRetention <= 0 {
+		config.VerifyRetention = 168 * time.Hour // Default 7 days (refresh token lifetime)
+	}
+
+	kr := &KeyRing{
+		keys:   make(map[string]*SigningKey),
+		config: config,
+		now:    time.Now,
+		log:    logger.Component("auth.jwt.key_ring"),
+	}
+
+	if config.Dir != "" {
+		if err := kr.loadFromDir(); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := kr.Current(); err != nil {
+		if _, err := kr.Rotate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return kr, nil
+}
+
+// Current returns the key used to sign new tokens
+func (kr *KeyRing) Current() (*SigningKey, error) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	var current *SigningKey
+	for _, key := range kr.keys {
+		if key.Status != KeyStatusActive {
+			continue
+		}
+		if current == nil || key.CreatedAt.After(current.CreatedAt) {
+			current = key
+		}
+	}
+	if current == nil {
+		return nil, ErrNoSigningKey
+	}
+	return current, nil
+}
+
+// Lookup returns the key with the given kid, including verify-only keys
+func (kr *KeyRing) Lookup(kid string) (*SigningKey, error) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	key, ok := kr.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKeyID
+	}
+	return key, nil
+}
+
+// Keys returns every key in the ring, newest first
+func (kr *KeyRing) Keys() []*SigningKey {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	keys := make([]*SigningKey, 0, len(kr.keys))
+	for _, key := range kr.keys {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+	return keys
+}
+
+// Rotate generates a new signing key and moves the previous active keys to verify-only
+func (kr *KeyRing) Rotate() (*SigningKey, error) {
+	privateKey, err := generatePrivateKey(kr.config.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	kid, err := keyID(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	now := kr.now().UTC()
+	key := &SigningKey{
+		ID:         kid,
+		Algorithm:  kr.config.Algorithm,
+		Status:     KeyStatusActive,
+		PrivateKey: privateKey,
+		CreatedAt:  now,
+	}
+
+	if kr.config.Dir != "" {
+		if err := writeKeyFile(kr.config.Dir, key); err != nil {
+			return nil, err
+		}
+	}
+
+	kr.mu.Lock()
+	for _, existing := range kr.keys {
+		if existing.Status == KeyStatusActive {
+			existing.Status = KeyStatusVerifyOnly
+			retiredAt := now
+			existing.RetiredAt = &retiredAt
+		}
+	}
+	kr.keys[key.ID] = key
+	kr.mu.Unlock()
+
+	kr.log.Info().
+		Str("kid", key.ID).
+		Str("algorithm", key.Algorithm).
+		Msg("signing key rotated")
+
+	return key, nil
+}
+
+// Prune removes verify-only keys whose retention window has passed
+func (kr *KeyRing) Prune() {
+	now := kr.now().UTC()
+
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	for kid, key := range kr.keys {
+		if key.Status != KeyStatusVerifyOnly || key.RetiredAt == nil {
+			continue
+		}
+		if now.Before(key.RetiredAt.Add(kr.config.VerifyRetention)) {
+			continue
+		}
+
+		delete(kr.keys, kid)
+		if kr.config.Dir != "" {
+			if err := os.Remove(filepath.Join(kr.config.Dir, kid+".pem")); err != nil && !os.IsNotExist(err) {
+				kr.log.Warn().
+					Err(err).
+					Str("kid", kid).
+					Msg("failed to remove expired key file")
+			}
+		}
+
+		kr.log.Info().
+			Str("kid", kid).
+			Msg("expired signing key pruned")
+	}
+}
+
+// StartRotation rotates the signing key on the configured interval until ctx is done
+func (kr *KeyRing) StartRotation(ctx context.Context) {
+	// Check more often than the interval so restarts don't postpone a due rotation
+	checkInterval := kr.config.RotationInterval / 10
+	if checkInterval < time.Minute {
+		checkInterval = time.Minute
+	}
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	kr.log.Info().
+		Dur("rotation_interval", kr.config.RotationInterval).
+		Dur("verify_retention", kr.config.VerifyRetention).
+		Msg("key rotation started")
+
+	for {
+		select {
+		case <-ctx.Done():
+			kr.log.Info().Msg("key rotation stopped")
+			return
+		case <-ticker.C:
+			if err := kr.rotateIfDue(); err != nil {
+				kr.log.Error().
+					Err(err).
+					Msg("failed to rotate signing key")
+			}
+		}
+	}
+}
+
+// rotateIfDue picks up keys rotated by other replicas, rotates when the current key is too old,
+// and prunes expired keys
+func (kr *KeyRing) rotateIfDue() error {
+	if kr.config.Dir != "" {
+		if err := kr.loadFromDir(); err != nil {
+			return err
+		}
+	}
+
+	current, err := kr.Current()
+	if err != nil || !kr.now().Before(current.CreatedAt.Add(kr.config.RotationInterval)) {
+		if _, err := kr.Rotate(); err != nil {
+			return err
+		}
+	}
+
+	kr.Prune()
+	return nil
+}
+
+// loadFromDir (re)loads <kid>.pem files. The newest key is active,
+// every other key is verify-only from the moment its successor was created.
+func (kr *KeyRing) loadFromDir() error {
+	if err := os.MkdirAll(kr.config.Dir, 0o700); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(kr.config.Dir)
+	if err != nil {
+		return err
+	}
+
+	loaded := make([]*SigningKey, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".pem") {
+			continue
+		}
+		key, err := readKeyFile(filepath.Join(kr.config.Dir, entry.Name()))
+		if err != nil {
+			kr.log.Warn().
+				Err(err).
+				Str("file", entry.Name()).
+				Msg("skipping unreadable key file")
+			continue
+		}
+		loaded = append(loaded, key)
+	}
+
+	sort.Slice(loaded, func(i, j int) bool {
+		return loaded[i].CreatedAt.After(loaded[j].CreatedAt)
+	})
+	for i, key := range loaded {
+		if i == 0 {
+			key.Status = KeyStatusActive
+			continue
+		}
+		retiredAt := loaded[i-1].CreatedAt
+		key.Status = KeyStatusVerifyOnly
+		key.RetiredAt = &retiredAt
+	}
+
+	kr.mu.Lock()
+	kr.keys = make(map[string]*SigningKey, len(loaded))
+	for _, key := range loaded {
+		kr.keys[key.ID] = key
+	}
+	kr.mu.Unlock()
+
+	return nil
+}
+
+// generatePrivateKey creates a new private key for the algorithm
+func generatePrivateKey(algorithm string) (crypto.Signer, error) {
+	switch algorithm {
+	case AlgorithmRS256:
+		return rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmES256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, algorithm)
+	}
+}
+
+// keyID derives a stable kid from the SHA-256 of the DER-encoded public key
+func keyID(publicKey crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(der)
+	return base64.RawURLEncoding.EncodeToString(sum[:16]), nil
+}
+
+// writeKeyFile persists the private key as PKCS#8 PEM named <kid>.pem
+func writeKeyFile(dir string, key *SigningKey) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, key.ID+".pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		return err
+	}
+	return os.Chtimes(path, key.CreatedAt, key.CreatedAt)
+}
+
+// readKeyFile loads a PKCS#8 PEM key; the file modification time is the key creation time
+func readKeyFile(path string) (*SigningKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM data")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	var algorithm string
+	switch parsed.(type) {
+	case *rsa.PrivateKey:
+		algorithm = AlgorithmRS256
+	case *ecdsa.PrivateKey:
+		algorithm = AlgorithmES256
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+	privateKey := parsed.(crypto.Signer)
+
+	kid, err := keyID(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigningKey{
+		ID:         kid,
+		Algorithm:  algorithm,
+		PrivateKey: privateKey,
+		CreatedAt:  info.ModTime().UTC(),
+	}, nil
+}
@@ -0,0 +1,129 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func TestNewKeyRing_UnsupportedAlgorithm(t *testing.T) {
+	_, err := NewKeyRing(KeyRingConfig{Algorithm: "HS512"})
+	if err == nil {
+		t.Fatal("NewKeyRing() should fail for unsupported algorithm")
+	}
+}
+
+func TestService_KeyRing_SignsWithKid(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmRS256, AlgorithmES256} {
+		t.Run(algorithm, func(t *testing.T) {
+			keyRing, err := NewKeyRing(KeyRingConfig{Algorithm: algorithm})
+			if err != nil {
+				t.Fatalf("NewKeyRing() error = %v", err)
+			}
+			service := NewServiceWithKeyRing(keyRing, "", 1, 168)
+
+			tokenString, err := service.GenerateToken("user-123")
+			if err != nil {
+				t.Fatalf("GenerateToken() error = %v", err)
+			}
+
+			token, _, err := jwt.NewParser().ParseUnverified(tokenString, jwt.MapClaims{})
+			if err != nil {
+				t.Fatalf("ParseUnverified() error = %v", err)
+			}
+			if token.Method.Alg() != algorithm {
+				t.Errorf("token alg = %v, want %v", token.Method.Alg(), algorithm)
+			}
+
+			current, _ := keyRing.Current()
+			if token.Header["kid"] != current.ID {
+				t.Errorf("token kid = %v, want %v", token.Header["kid"], current.ID)
+			}
+
+			userID, err := service.ValidateToken(tokenString)
+			if err != nil {
+				t.Fatalf("ValidateToken() error = %v", err)
+			}
+			if userID != "user-123" {
+				t.Errorf("ValidateToken() userID = %v, want user-123", userID)
+			}
+		})
+	}
+}
+
+func TestService_KeyRing_ValidatesAfterRotation(t *testing.T) {
+	keyRing, err := NewKeyRing(KeyRingConfig{Algorithm: AlgorithmRS256})
+	if err != nil {
+		t.Fatalf("NewKeyRing() error = %v", err)
+	}
+	service := NewServiceWithKeyRing(keyRing, "", 1, 168)
+
+	oldToken, err := service.GenerateToken("user-123")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+	oldKey, _ := keyRing.Current()
+
+	if _, err := keyRing.Rotate(); err != nil {
+		t.Fatalf("Rotate() error = %v", err)
+	}
+
+	if _, err := service.ValidateToken(oldToken); err != nil {
+		t.Errorf("ValidateToken() should accept token signed by verify-only key, got %v", err)
+	}
+
+	retired, err := keyRing.Lookup(oldKey.ID)
+	if err != nil {
+		t.Fatalf("Lookup() error = %v", err)
+	}
+	if retired.Status != KeyStatusVerifyOnly {
+		t.Errorf("rotated key status = %v, want %v", retired.Status, KeyStatusVerifyOnly)
+	}
+
+	jwks := keyRing.JWKS()
+	if len(jwks.Keys) != 2 {
+		t.Errorf("JWKS() returned %d keys, want 2", len(jwks.Keys))
+	}
+}
+
+func TestService_KeyRing_PersistsKeys(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := NewKeyRing(KeyRingConfig{Algorithm: AlgorithmES256, Dir: dir})
+	if err != nil {
+		t.Fatalf("NewKeyRing() error = %v", err)
+	}
+	tokenString, err := NewServiceWithKeyRing(first, "", 1, 168).GenerateToken("user-123")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	// A second replica sharing the directory must verify the same tokens
+	second, err := NewKeyRing(KeyRingConfig{Algorithm: AlgorithmES256, Dir: dir})
+	if err != nil {
+		t.Fatalf("NewKeyRing() error = %v", err)
+	}
+	if _, err := NewServiceWithKeyRing(second, "", 1, 168).ValidateToken(tokenString); err != nil {
+		t.Errorf("ValidateToken() on second replica error = %v", err)
+	}
+}
+
+func TestService_KeyRing_LegacyHS256(t *testing.T) {
+	legacyToken, err := NewService("legacy-secret", 1, 168).GenerateToken("user-123")
+	if err != nil {
+		t.Fatalf("GenerateToken() error = %v", err)
+	}
+
+	keyRing, err := NewKeyRing(KeyRingConfig{Algorithm: AlgorithmRS256, RotationInterval: time.Hour})
+	if err != nil {
+		t.Fatalf("NewKeyRing() error = %v", err)
+	}
+
+	if _, err := NewServiceWithKeyRing(keyRing, "legacy-secret", 1, 168).ValidateToken(legacyToken); err != nil {
+		t.Errorf("ValidateToken() should accept legacy HS256 token, got %v", err)
+	}
+	if _, err := NewServiceWithKeyRing(keyRing, "", 1, 168).ValidateToken(legacyToken); err == nil {
+		t.Error("ValidateToken() should reject HS256 token when legacy secret is disabled")
+	}
+}
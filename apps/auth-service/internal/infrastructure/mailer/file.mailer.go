@@ -0,0 +1,61 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	appmailer "golang-social-media/apps/auth-service/internal/application/mailer"
+	"golang-social-media/pkg/logger"
+)
+
+var _ appmailer.Mailer = (*FileMailer)(nil)
+
+// FileMailer is a development mailer that writes each email to a .eml file in dir.
+// With an empty dir the email is only logged.
+type FileMailer struct {
+	dir  string
+	from string
+	log  *zerolog.Logger
+}
+
+// NewFileMailer creates a new FileMailer
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{
+		dir:  dir,
+		from: from,
+		log:  logger.Component("auth.mailer.file"),
+	}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg appmailer.Message) error {
+	if m.dir == "" {
+		m.log.Info().
+			Str("to", msg.To).
+			Str("subject", msg.Subject).
+			Str("body", msg.Body).
+			Msg("email not delivered (log mailer)")
+		return nil
+	}
+
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return fmt.Errorf("create mail dir: %w", err)
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", time.Now().UTC().Format("20060102T150405"), uuid.NewString())
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, formatMessage(m.from, msg), 0o600); err != nil {
+		return fmt.Errorf("write mail file: %w", err)
+	}
+
+	m.log.Info().
+		Str("to", msg.To).
+		Str("subject", msg.Subject).
+		Str("path", path).
+		Msg("email written to file")
+	return nil
+}
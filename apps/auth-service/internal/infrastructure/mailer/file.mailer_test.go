@@ -0,0 +1,56 @@
+package mailer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appmailer "golang-social-media/apps/auth-service/internal/application/mailer"
+)
+
+func TestFileMailer_Send_WritesEmail(t *testing.T) {
+	dir := t.TempDir()
+	m := NewFileMailer(dir, "no-reply@example.com")
+
+	err := m.Send(context.Background(), appmailer.Message{
+		To:      "user@example.com",
+		Subject: "Verify your email",
+		Body:    "Open https://example.com/verify?token=abc",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	if err != nil {
+		t.Fatalf("Glob() error = %v", err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Send() wrote %d files, want 1", len(files))
+	}
+
+	content, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+	for _, want := range []string{
+		"From: no-reply@example.com",
+		"To: user@example.com",
+		"Subject: Verify your email",
+		"token=abc",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("email does not contain %q", want)
+		}
+	}
+}
+
+func TestFileMailer_Send_LogOnly(t *testing.T) {
+	m := NewFileMailer("", "no-reply@example.com")
+
+	if err := m.Send(context.Background(), appmailer.Message{To: "user@example.com"}); err != nil {
+		t.Errorf("Send() error = %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"context"
+
+	"golang-social-media/apps/auth-service/internal/infrastructure/eventbus/publisher"
+	pkgoutbox "golang-social-media/pkg/outbox"
+)
+
+var _ pkgoutbox.Publisher = (*EventPublisher)(nil)
+
+// EventPublisher publishes outbox events to the topic and payload contract the EventRegistry maps them to
+type EventPublisher struct {
+	registry  *EventRegistry
+	publisher *publisher.KafkaPublisher
+}
+
+// NewEventPublisher creates an EventPublisher with the routes of DefaultEventRegistry
+func NewEventPublisher(kafkaPublisher *publisher.KafkaPublisher) *EventPublisher {
+	return &EventPublisher{
+		registry:  DefaultEventRegistry(),
+		publisher: kafkaPublisher,
+	}
+}
+
+// EventTypes returns the event types this publisher can deliver
+func (p *EventPublisher) EventTypes() []string {
+	return p.registry.EventTypes()
+}
+
+// Publish sends an outbox event to Kafka and returns its topic
+func (p *EventPublisher) Publish(ctx context.Context, event pkgoutbox.Model) (string, error) {
+	// Unknown event types fail instead of being skipped, so they stay visible in the outbox
+	message, err := p.registry.Resolve(event.EventType, []byte(event.Payload), event.CreatedAt)
+	if err != nil {
+		return "", pkgoutbox.Permanent(err)
+	}
+
+	if err := p.publisher.Publish(ctx, message.Topic, message.Key, message.Event); err != nil {
+		return "", err
+	}
+	return message.Topic, nil
+}
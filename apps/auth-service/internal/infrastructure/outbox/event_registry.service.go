@@ -0,0 +1,94 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ErrUnknownEventType is returned for outbox events no route is registered for
+var ErrUnknownEventType = errors.New("unknown outbox event type")
+
+// Message is the Kafka message an outbox event is published as
+type Message struct {
+	Topic string
+	Key   string
+	Event interface{}
+}
+
+// EventRoute converts a stored domain event payload into its Kafka message.
+// occurredAt is the outbox creation time, used when the event carries no parsable timestamp
+type EventRoute func(payload []byte, occurredAt time.Time) (Message, error)
+
+// EventRegistry maps outbox event types to a topic and a pkg/events payload contract
+type EventRegistry struct {
+	routes map[string]EventRoute
+}
+
+// NewEventRegistry creates an empty EventRegistry
+func NewEventRegistry() *EventRegistry {
+	return &EventRegistry{
+		routes: make(map[string]EventRoute),
+	}
+}
+
+// Register sets the route for an event type, replacing any previous one
+func (r *EventRegistry) Register(eventType string, route EventRoute) {
+	r.routes[eventType] = route
+}
+
+// EventTypes returns the registered event types in alphabetical order
+func (r *EventRegistry) EventTypes() []string {
+	eventTypes := make([]string, 0, len(r.routes))
+	for eventType := range r.routes {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Strings(eventTypes)
+	return eventTypes
+}
+
+// Resolve builds the Kafka message for an outbox event
+func (r *EventRegistry) Resolve(eventType string, payload []byte, occurredAt time.Time) (Message, error) {
+	route, ok := r.routes[eventType]
+	if !ok {
+		return Message{}, fmt.Errorf("%w: %q", ErrUnknownEventType, eventType)
+	}
+
+	message, err := route(payload, occurredAt)
+	if err != nil {
+		return Message{}, fmt.Errorf("build %s message: %w", eventType, err)
+	}
+	return message, nil
+}
+
+// register adds a route for the domain event E. The event type is taken from E itself,
+// so the registry cannot drift from the names the domain emits
+func register[E interface{ Type() string }](
+	r *EventRegistry,
+	topic string,
+	build func(event E, occurredAt time.Time) (key string, message interface{}, err error),
+) {
+	var zero E
+	r.Register(zero.Type(), func(payload []byte, occurredAt time.Time) (Message, error) {
+		var event E
+		if err := json.Unmarshal(payload, &event); err != nil {
+			return Message{}, err
+		}
+		key, message, err := build(event, occurredAt)
+		if err != nil {
+			return Message{}, err
+		}
+		return Message{Topic: topic, Key: key, Event: message}, nil
+	})
+}
+
+// timestamp parses an RFC3339 domain event timestamp, falling back to the outbox creation time
+func timestamp(value string, fallback time.Time) time.Time {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
@@ -0,0 +1,151 @@
+package outbox
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/pkg/events"
+)
+
+func resolve(t *testing.T, registry *EventRegistry, domainEvent interface{ Type() string }, occurredAt time.Time) (Message, error) {
+	t.Helper()
+	// Outbox rows store the domain event as marshaled by the outbox repository
+	payload, err := json.Marshal(domainEvent)
+	if err != nil {
+		t.Fatalf("marshal %s: %v", domainEvent.Type(), err)
+	}
+	return registry.Resolve(domainEvent.Type(), payload, occurredAt)
+}
+
+func TestDefaultEventRegistry_CoversEveryDomainEvent(t *testing.T) {
+	registry := DefaultEventRegistry()
+	registered := make(map[string]bool)
+	for _, eventType := range registry.EventTypes() {
+		registered[eventType] = true
+	}
+
+	eventTypes := []string{
+		"UserCreated",
+		"UserProfileUpdated",
+		"UserPasswordChanged",
+		"UserEmailVerified",
+		"UserPasswordReset",
+		"UserPasswordRehashed",
+		"MFAEnabled",
+		"MFADisabled",
+		"UserLockedOut",
+		"RefreshTokenReuseDetected",
+		"UserDeleted",
+		"UserExportRequested",
+		"RoleCreated",
+		"RoleUpdated",
+		"RoleParentChanged",
+		"RoleDeleted",
+		"PermissionCreated",
+		"PermissionUpdated",
+		"PermissionDeleted",
+		"RolePermissionAssigned",
+		"RolePermissionRevoked",
+		"UserRoleAssigned",
+		"UserRoleRevoked",
+	}
+
+	for _, eventType := range eventTypes {
+		if !registered[eventType] {
+			t.Errorf("event type %s has no outbox route", eventType)
+		}
+	}
+}
+
+func TestEventRegistry_Resolve(t *testing.T) {
+	registry := DefaultEventRegistry()
+	occurredAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("user profile updated", func(t *testing.T) {
+		message, err := resolve(t, registry, user.UserProfileUpdatedEvent{
+			UserID:    "user-1",
+			OldName:   "Old",
+			NewName:   "New",
+			UpdatedAt: "2024-05-01T09:30:00Z",
+		}, occurredAt)
+		if err != nil {
+			t.Fatalf("Resolve() error = %v", err)
+		}
+		if message.Topic != events.TopicUserProfileUpdated {
+			t.Errorf("Topic = %q, want %q", message.Topic, events.TopicUserProfileUpdated)
+		}
+		if message.Key != "user-1" {
+			t.Errorf("Key = %q, want user-1", message.Key)
+		}
+		want := events.UserProfileUpdated{
+			UserID:    "user-1",
+			OldName:   "Old",
+			NewName:   "New",
+			UpdatedAt: time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC),
+		}
+		if got, ok := message.Event.(events.UserProfileUpdated); !ok || !got.UpdatedAt.Equal(want.UpdatedAt) || got.NewName != want.NewName || got.OldName != want.OldName {
+			t.Errorf("Event = %#v, want %#v", message.Event, want)
+		}
+	})
+
+	t.Run("user role assigned is keyed by user", func(t *testing.T) {
+		message, err := resolve(t, registry, user_role.UserRoleAssignedEvent{
+			UserID:    "user-1",
+			RoleID:    "role-1",
+			CreatedAt: "2024-05-01T09:30:00Z",
+		}, occurredAt)
+		if err != nil {
+			t.Fatalf("Resolve() error = %v", err)
+		}
+		if message.Topic != events.TopicUserRoleAssigned || message.Key != "user-1" {
+			t.Errorf("got topic %q key %q, want %q key user-1", message.Topic, message.Key, events.TopicUserRoleAssigned)
+		}
+	})
+
+	t.Run("unparsable timestamp falls back to outbox time", func(t *testing.T) {
+		message, err := resolve(t, registry, role.RoleDeletedEvent{
+			RoleID:    "role-1",
+			Name:      "editor",
+			DeletedAt: "not a time",
+		}, occurredAt)
+		if err != nil {
+			t.Fatalf("Resolve() error = %v", err)
+		}
+		got := message.Event.(events.RoleDeleted)
+		if !got.DeletedAt.Equal(occurredAt) {
+			t.Errorf("DeletedAt = %v, want %v", got.DeletedAt, occurredAt)
+		}
+		if message.Key != "role-1" {
+			t.Errorf("Key = %q, want role-1", message.Key)
+		}
+	})
+
+	t.Run("invalid lock end fails", func(t *testing.T) {
+		_, err := resolve(t, registry, user.UserLockedOutEvent{
+			UserID:      "user-1",
+			LockedUntil: "soon",
+		}, occurredAt)
+		if err == nil {
+			t.Fatal("Resolve() error = nil, want error")
+		}
+	})
+
+	t.Run("malformed payload fails", func(t *testing.T) {
+		_, err := registry.Resolve("UserCreated", []byte("{"), occurredAt)
+		if err == nil {
+			t.Fatal("Resolve() error = nil, want error")
+		}
+	})
+
+	t.Run("unknown event type fails", func(t *testing.T) {
+		_, err := registry.Resolve("SomethingHappened", []byte("{}"), occurredAt)
+		if !errors.Is(err, ErrUnknownEventType) {
+			t.Fatalf("Resolve() error = %v, want ErrUnknownEventType", err)
+		}
+	})
+}
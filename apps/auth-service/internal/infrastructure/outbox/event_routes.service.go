@@ -0,0 +1,210 @@
+package outbox
+
+import (
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/domain/role"
+	"golang-social-media/apps/auth-service/internal/domain/role_permission"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	"golang-social-media/apps/auth-service/internal/domain/user_role"
+	"golang-social-media/pkg/events"
+)
+
+// DefaultEventRegistry routes every auth-service domain event to its Kafka topic.
+// A new domain event must be registered here, otherwise its outbox rows are marked as failed
+func DefaultEventRegistry() *EventRegistry {
+	r := NewEventRegistry()
+
+	// User events
+	register(r, events.TopicUserCreated, func(e user.UserCreatedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserCreated{
+			ID:        e.UserID,
+			Email:     e.Email,
+			Name:      e.Name,
+			CreatedAt: timestamp(e.CreatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicUserProfileUpdated, func(e user.UserProfileUpdatedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserProfileUpdated{
+			UserID:    e.UserID,
+			OldName:   e.OldName,
+			NewName:   e.NewName,
+			UpdatedAt: timestamp(e.UpdatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicUserPasswordChanged, func(e user.UserPasswordChangedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserPasswordChanged{
+			UserID:    e.UserID,
+			ChangedAt: timestamp(e.UpdatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicUserEmailVerified, func(e user.UserEmailVerifiedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserEmailVerified{
+			UserID:     e.UserID,
+			Email:      e.Email,
+			VerifiedAt: timestamp(e.VerifiedAt, at),
+		}, nil
+	})
+	register(r, events.TopicUserPasswordReset, func(e user.UserPasswordResetEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserPasswordReset{
+			UserID:  e.UserID,
+			ResetAt: timestamp(e.ResetAt, at),
+		}, nil
+	})
+
+	// GDPR saga events, keyed by user so a service handles one user's requests in order
+	register(r, events.TopicUserDeleted, func(e user.UserDeletedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserDeleted{
+			RequestID: e.RequestID,
+			UserID:    e.UserID,
+			DeletedAt: timestamp(e.DeletedAt, at),
+		}, nil
+	})
+	register(r, events.TopicUserExportRequested, func(e gdpr_request.UserExportRequestedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserExportRequested{
+			RequestID:   e.RequestID,
+			UserID:      e.UserID,
+			RequestedAt: timestamp(e.RequestedAt, at),
+		}, nil
+	})
+
+	// Auth security events
+	register(r, events.TopicAuthRefreshTokenReused, func(e refresh_token.RefreshTokenReuseDetectedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.RefreshTokenReused{
+			UserID:     e.UserID,
+			FamilyID:   e.FamilyID,
+			TokenID:    e.TokenID,
+			DetectedAt: timestamp(e.DetectedAt, at),
+		}, nil
+	})
+	register(r, events.TopicAuthMFAEnabled, func(e user.MFAEnabledEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.MFAEnabled{
+			UserID:    e.UserID,
+			EnabledAt: timestamp(e.EnabledAt, at),
+		}, nil
+	})
+	register(r, events.TopicAuthMFADisabled, func(e user.MFADisabledEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.MFADisabled{
+			UserID:     e.UserID,
+			DisabledAt: timestamp(e.DisabledAt, at),
+		}, nil
+	})
+	register(r, events.TopicAuthUserLockedOut, func(e user.UserLockedOutEvent, at time.Time) (string, interface{}, error) {
+		// Consumers rely on the lock end, so it has no fallback
+		lockedUntil, err := time.Parse(time.RFC3339, e.LockedUntil)
+		if err != nil {
+			return "", nil, err
+		}
+		return e.UserID, events.UserLockedOut{
+			UserID:         e.UserID,
+			Email:          e.Email,
+			FailedAttempts: e.FailedAttempts,
+			LockedUntil:    lockedUntil,
+			LockedAt:       timestamp(e.LockedAt, at),
+		}, nil
+	})
+	register(r, events.TopicAuthPasswordRehashed, func(e user.UserPasswordRehashedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserPasswordRehashed{
+			UserID:     e.UserID,
+			Algorithm:  e.Algorithm,
+			RehashedAt: timestamp(e.RehashedAt, at),
+		}, nil
+	})
+
+	// User role events
+	register(r, events.TopicUserRoleAssigned, func(e user_role.UserRoleAssignedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserRoleAssigned{
+			UserID:     e.UserID,
+			RoleID:     e.RoleID,
+			AssignedAt: timestamp(e.CreatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicUserRoleRevoked, func(e user_role.UserRoleRevokedEvent, at time.Time) (string, interface{}, error) {
+		return e.UserID, events.UserRoleRevoked{
+			UserID:    e.UserID,
+			RoleID:    e.RoleID,
+			RevokedAt: timestamp(e.RevokedAt, at),
+		}, nil
+	})
+
+	// Role events
+	register(r, events.TopicRoleCreated, func(e role.RoleCreatedEvent, at time.Time) (string, interface{}, error) {
+		return e.RoleID, events.RoleCreated{
+			RoleID:    e.RoleID,
+			Name:      e.Name,
+			CreatedAt: timestamp(e.CreatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicRoleUpdated, func(e role.RoleUpdatedEvent, at time.Time) (string, interface{}, error) {
+		return e.RoleID, events.RoleUpdated{
+			RoleID:    e.RoleID,
+			OldName:   e.OldName,
+			NewName:   e.NewName,
+			UpdatedAt: timestamp(e.UpdatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicRoleParentChanged, func(e role.RoleParentChangedEvent, at time.Time) (string, interface{}, error) {
+		return e.RoleID, events.RoleParentChanged{
+			RoleID:      e.RoleID,
+			OldParentID: e.OldParentID,
+			NewParentID: e.NewParentID,
+			ChangedAt:   timestamp(e.ChangedAt, at),
+		}, nil
+	})
+	register(r, events.TopicRoleDeleted, func(e role.RoleDeletedEvent, at time.Time) (string, interface{}, error) {
+		return e.RoleID, events.RoleDeleted{
+			RoleID:    e.RoleID,
+			Name:      e.Name,
+			DeletedAt: timestamp(e.DeletedAt, at),
+		}, nil
+	})
+
+	// Permission events
+	register(r, events.TopicPermissionCreated, func(e permission.PermissionCreatedEvent, at time.Time) (string, interface{}, error) {
+		return e.PermissionID, events.PermissionCreated{
+			PermissionID: e.PermissionID,
+			Name:         e.Name,
+			Resource:     e.Resource,
+			Action:       e.Action,
+			CreatedAt:    timestamp(e.CreatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicPermissionUpdated, func(e permission.PermissionUpdatedEvent, at time.Time) (string, interface{}, error) {
+		return e.PermissionID, events.PermissionUpdated{
+			PermissionID: e.PermissionID,
+			Name:         e.Name,
+			Resource:     e.Resource,
+			Action:       e.Action,
+			UpdatedAt:    timestamp(e.UpdatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicPermissionDeleted, func(e permission.PermissionDeletedEvent, at time.Time) (string, interface{}, error) {
+		return e.PermissionID, events.PermissionDeleted{
+			PermissionID: e.PermissionID,
+			Resource:     e.Resource,
+			Action:       e.Action,
+			DeletedAt:    timestamp(e.DeletedAt, at),
+		}, nil
+	})
+
+	// Role permission events
+	register(r, events.TopicRolePermissionAssigned, func(e role_permission.RolePermissionAssignedEvent, at time.Time) (string, interface{}, error) {
+		return e.RoleID, events.RolePermissionAssigned{
+			RoleID:       e.RoleID,
+			PermissionID: e.PermissionID,
+			AssignedAt:   timestamp(e.CreatedAt, at),
+		}, nil
+	})
+	register(r, events.TopicRolePermissionRevoked, func(e role_permission.RolePermissionRevokedEvent, at time.Time) (string, interface{}, error) {
+		return e.RoleID, events.RolePermissionRevoked{
+			RoleID:       e.RoleID,
+			PermissionID: e.PermissionID,
+			RevokedAt:    timestamp(e.RevokedAt, at),
+		}, nil
+	})
+
+	return r
+}
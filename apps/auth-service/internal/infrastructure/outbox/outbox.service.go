@@ -4,20 +4,20 @@ import (
 	"context"
 	"encoding/json"
 
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres"
 	"golang-social-media/pkg/logger"
+	pkgoutbox "golang-social-media/pkg/outbox"
 
 	"github.com/rs/zerolog"
 )
 
 // OutboxService handles outbox operations
 type OutboxService struct {
-	outboxRepo *postgres.OutboxRepository
+	outboxRepo *pkgoutbox.Repository
 	log        *zerolog.Logger
 }
 
 // NewOutboxService creates a new OutboxService
-func NewOutboxService(outboxRepo *postgres.OutboxRepository) *OutboxService {
+func NewOutboxService(outboxRepo *pkgoutbox.Repository) *OutboxService {
 	return &OutboxService{
 		outboxRepo: outboxRepo,
 		log:        logger.Component("auth.outbox"),
@@ -61,7 +61,7 @@ func (s *OutboxService) SaveEvent(ctx context.Context, event interface{}) error
 	}
 
 	// Save to outbox
-	err := s.outboxRepo.Create(ctx, aggregateID, aggregateType, eventType, eventVersion, event)
+	err = s.outboxRepo.Create(ctx, aggregateID, aggregateType, eventType, eventVersion, event)
 	if err != nil {
 		s.log.Error().
 			Err(err).
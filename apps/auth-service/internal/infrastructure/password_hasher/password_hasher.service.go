@@ -0,0 +1,137 @@
+package password_hasher
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang-social-media/apps/auth-service/internal/application/password_hasher"
+)
+
+// Supported algorithms for new hashes
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+	// AlgorithmLegacySHA256 identifies the unsalted SHA-256 hex digests stored before
+	// hashes were self-describing. They are only verified, never created.
+	AlgorithmLegacySHA256 = "sha256"
+)
+
+var (
+	// ErrMalformedHash is returned when a stored hash cannot be parsed
+	ErrMalformedHash = errors.New("password_hasher: malformed hash")
+	// ErrUnsupportedHash is returned when a stored hash uses an unknown algorithm or version
+	ErrUnsupportedHash = errors.New("password_hasher: unsupported hash")
+)
+
+var _ password_hasher.PasswordHasher = (*Hasher)(nil)
+
+// Options holds the cost parameters of every supported algorithm
+type Options struct {
+	Argon2id   Argon2idParams
+	BcryptCost int
+}
+
+// DefaultOptions returns the recommended cost parameters
+func DefaultOptions() Options {
+	return Options{
+		Argon2id:   DefaultArgon2idParams(),
+		BcryptCost: 12,
+	}
+}
+
+// Hasher creates hashes with the configured algorithm and verifies hashes of every supported one,
+// so switching algorithm or raising costs only affects new hashes
+type Hasher struct {
+	algorithm string
+	argon2id  *Argon2idHasher
+	bcrypt    *BcryptHasher
+}
+
+// NewHasher creates a Hasher that creates new hashes with the given algorithm
+func NewHasher(algorithm string, options Options) (*Hasher, error) {
+	algorithm = strings.ToLower(strings.TrimSpace(algorithm))
+	if algorithm != AlgorithmArgon2id && algorithm != AlgorithmBcrypt {
+		return nil, fmt.Errorf("password_hasher: unknown algorithm %q (expected %s or %s)", algorithm, AlgorithmArgon2id, AlgorithmBcrypt)
+	}
+	return &Hasher{
+		algorithm: algorithm,
+		argon2id:  NewArgon2idHasher(options.Argon2id),
+		bcrypt:    NewBcryptHasher(options.BcryptCost),
+	}, nil
+}
+
+// Algorithm returns the algorithm new hashes are created with
+func (h *Hasher) Algorithm() string {
+	return h.algorithm
+}
+
+// Hash hashes the password with the configured algorithm
+func (h *Hasher) Hash(password string) (string, error) {
+	if h.algorithm == AlgorithmBcrypt {
+		return h.bcrypt.Hash(password)
+	}
+	return h.argon2id.Hash(password)
+}
+
+// Verify checks the password against a hash of any supported algorithm
+func (h *Hasher) Verify(password, encoded string) (bool, error) {
+	switch Identify(encoded) {
+	case AlgorithmArgon2id:
+		return h.argon2id.Verify(password, encoded)
+	case AlgorithmBcrypt:
+		return h.bcrypt.Verify(password, encoded)
+	case AlgorithmLegacySHA256:
+		return verifyLegacySHA256(password, encoded), nil
+	default:
+		return false, ErrUnsupportedHash
+	}
+}
+
+// NeedsRehash reports whether the hash uses another algorithm or other cost parameters
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	algorithm := Identify(encoded)
+	if algorithm != h.algorithm {
+		return true
+	}
+	if algorithm == AlgorithmBcrypt {
+		return h.bcrypt.NeedsRehash(encoded)
+	}
+	return h.argon2id.NeedsRehash(encoded)
+}
+
+// Identify returns the algorithm of an encoded hash, or an empty string when it is not recognised
+func Identify(encoded string) string {
+	switch {
+	case strings.HasPrefix(encoded, argon2idPrefix):
+		return AlgorithmArgon2id
+	case isBcryptHash(encoded):
+		return AlgorithmBcrypt
+	case isLegacySHA256(encoded):
+		return AlgorithmLegacySHA256
+	default:
+		return ""
+	}
+}
+
+// isLegacySHA256 recognises a bare lowercase SHA-256 hex digest
+func isLegacySHA256(encoded string) bool {
+	if len(encoded) != sha256.Size*2 {
+		return false
+	}
+	for _, c := range encoded {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
+
+// verifyLegacySHA256 compares the unsalted SHA-256 of the password with the stored digest
+func verifyLegacySHA256(password, encoded string) bool {
+	sum := sha256.Sum256([]byte(password))
+	return subtle.ConstantTimeCompare([]byte(hex.EncodeToString(sum[:])), []byte(encoded)) == 1
+}
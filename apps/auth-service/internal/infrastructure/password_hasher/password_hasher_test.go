@@ -0,0 +1,150 @@
+package password_hasher
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Cheap parameters keep the tests fast
+func testOptions() Options {
+	return Options{
+		Argon2id: Argon2idParams{
+			Memory:      64,
+			Iterations:  1,
+			Parallelism: 1,
+			SaltLength:  16,
+			KeyLength:   32,
+		},
+		BcryptCost: bcrypt.MinCost,
+	}
+}
+
+func newTestHasher(t *testing.T, algorithm string) *Hasher {
+	t.Helper()
+	hasher, err := NewHasher(algorithm, testOptions())
+	if err != nil {
+		t.Fatalf("NewHasher() error = %v", err)
+	}
+	return hasher
+}
+
+func TestNewHasher_UnknownAlgorithm(t *testing.T) {
+	if _, err := NewHasher("md5", testOptions()); err == nil {
+		t.Fatal("NewHasher() should reject unknown algorithms")
+	}
+}
+
+func TestHasher_HashAndVerify(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmArgon2id, AlgorithmBcrypt} {
+		t.Run(algorithm, func(t *testing.T) {
+			hasher := newTestHasher(t, algorithm)
+
+			encoded, err := hasher.Hash("password123")
+			if err != nil {
+				t.Fatalf("Hash() error = %v", err)
+			}
+			if Identify(encoded) != algorithm {
+				t.Errorf("Identify() = %q, want %q", Identify(encoded), algorithm)
+			}
+
+			ok, err := hasher.Verify("password123", encoded)
+			if err != nil || !ok {
+				t.Errorf("Verify(correct) = %v, %v, want true", ok, err)
+			}
+			ok, err = hasher.Verify("password124", encoded)
+			if err != nil || ok {
+				t.Errorf("Verify(wrong) = %v, %v, want false", ok, err)
+			}
+			if hasher.NeedsRehash(encoded) {
+				t.Error("NeedsRehash() should be false for a hash with the current parameters")
+			}
+		})
+	}
+}
+
+func TestHasher_Argon2idPHCFormat(t *testing.T) {
+	encoded, err := newTestHasher(t, AlgorithmArgon2id).Hash("password123")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Errorf("Hash() = %q, want PHC string with the configured parameters", encoded)
+	}
+
+	again, _ := newTestHasher(t, AlgorithmArgon2id).Hash("password123")
+	if again == encoded {
+		t.Error("Hash() should use a random salt")
+	}
+}
+
+func TestHasher_VerifiesOtherAlgorithms(t *testing.T) {
+	bcryptHash, err := newTestHasher(t, AlgorithmBcrypt).Hash("password123")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	hasher := newTestHasher(t, AlgorithmArgon2id)
+	ok, err := hasher.Verify("password123", bcryptHash)
+	if err != nil || !ok {
+		t.Errorf("Verify(bcrypt hash) = %v, %v, want true", ok, err)
+	}
+	if !hasher.NeedsRehash(bcryptHash) {
+		t.Error("NeedsRehash() should be true when the algorithm changed")
+	}
+}
+
+func TestHasher_LegacySHA256(t *testing.T) {
+	sum := sha256.Sum256([]byte("password123"))
+	legacy := hex.EncodeToString(sum[:])
+
+	hasher := newTestHasher(t, AlgorithmArgon2id)
+	if Identify(legacy) != AlgorithmLegacySHA256 {
+		t.Fatalf("Identify() = %q, want %q", Identify(legacy), AlgorithmLegacySHA256)
+	}
+	if ok, err := hasher.Verify("password123", legacy); err != nil || !ok {
+		t.Errorf("Verify(correct) = %v, %v, want true", ok, err)
+	}
+	if ok, _ := hasher.Verify("password124", legacy); ok {
+		t.Error("Verify(wrong) should be false")
+	}
+	if !hasher.NeedsRehash(legacy) {
+		t.Error("NeedsRehash() should always be true for legacy hashes")
+	}
+}
+
+func TestHasher_NeedsRehash_ParametersChanged(t *testing.T) {
+	weak := newTestHasher(t, AlgorithmArgon2id)
+	encoded, err := weak.Hash("password123")
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+
+	options := testOptions()
+	options.Argon2id.Iterations = 2
+	stronger, err := NewHasher(AlgorithmArgon2id, options)
+	if err != nil {
+		t.Fatalf("NewHasher() error = %v", err)
+	}
+	if !stronger.NeedsRehash(encoded) {
+		t.Error("NeedsRehash() should be true when the argon2id parameters changed")
+	}
+	// Old parameters are read from the hash, so it still verifies
+	if ok, err := stronger.Verify("password123", encoded); err != nil || !ok {
+		t.Errorf("Verify() = %v, %v, want true", ok, err)
+	}
+}
+
+func TestHasher_Verify_UnsupportedAndMalformed(t *testing.T) {
+	hasher := newTestHasher(t, AlgorithmArgon2id)
+
+	if _, err := hasher.Verify("password123", "password123"); err != ErrUnsupportedHash {
+		t.Errorf("Verify(unknown format) error = %v, want %v", err, ErrUnsupportedHash)
+	}
+	if _, err := hasher.Verify("password123", "$argon2id$v=19$m=64,t=1$c2FsdA$a2V5"); err != ErrMalformedHash {
+		t.Errorf("Verify(malformed) error = %v, want %v", err, ErrMalformedHash)
+	}
+}
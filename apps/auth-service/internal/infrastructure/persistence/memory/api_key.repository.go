@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+)
+
+var _ repository.APIKeyRepository = (*APIKeyRepository)(nil)
+
+type APIKeyRepository struct {
+	mu        sync.RWMutex
+	byID      map[string]api_key.Key
+	idsByHash map[string]string
+}
+
+func NewAPIKeyRepository() *APIKeyRepository {
+	return &APIKeyRepository{
+		byID:      make(map[string]api_key.Key),
+		idsByHash: make(map[string]string),
+	}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key api_key.Key) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byID[key.ID] = key
+	r.idsByHash[key.KeyHash] = key.ID
+	return nil
+}
+
+func (r *APIKeyRepository) GetByID(ctx context.Context, id string) (api_key.Key, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	key, ok := r.byID[id]
+	if !ok {
+		return api_key.Key{}, repository.ErrAPIKeyNotFound
+	}
+	return key, nil
+}
+
+func (r *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (api_key.Key, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.idsByHash[keyHash]
+	if !ok {
+		return api_key.Key{}, repository.ErrAPIKeyNotFound
+	}
+	return r.byID[id], nil
+}
+
+func (r *APIKeyRepository) ListByUser(ctx context.Context, userID string) ([]api_key.Key, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]api_key.Key, 0)
+	for _, key := range r.byID {
+		if key.UserID == userID && !key.IsRevoked() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, key api_key.Key) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.byID[key.ID]
+	if !ok {
+		return repository.ErrAPIKeyNotFound
+	}
+	stored.RevokedAt = key.RevokedAt
+	r.byID[key.ID] = stored
+	return nil
+}
+
+func (r *APIKeyRepository) UpdateLastUsed(ctx context.Context, key api_key.Key) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.byID[key.ID]
+	if !ok {
+		return repository.ErrAPIKeyNotFound
+	}
+	stored.LastUsedAt = key.LastUsedAt
+	r.byID[key.ID] = stored
+	return nil
+}
@@ -0,0 +1,108 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+)
+
+var _ repository.AuditLogRepository = (*AuditLogRepository)(nil)
+
+type AuditLogRepository struct {
+	mu     sync.RWMutex
+	events []repository.AuditEvent
+}
+
+func NewAuditLogRepository() *AuditLogRepository {
+	return &AuditLogRepository{}
+}
+
+// Append stores an audit event, in the event store this happens when a domain event is saved
+func (r *AuditLogRepository) Append(event repository.AuditEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+func (r *AuditLogRepository) List(ctx context.Context, filter repository.AuditEventFilter, after *repository.AuditCursor, limit int) ([]repository.AuditEvent, error) {
+	matched := r.matching(filter)
+	sort.Slice(matched, func(i, j int) bool {
+		return auditEventBefore(matched[j], matched[i].OccurredAt, matched[i].ID)
+	})
+
+	events := make([]repository.AuditEvent, 0, limit)
+	for _, event := range matched {
+		if after != nil && !auditEventBefore(event, after.OccurredAt, after.ID) {
+			continue
+		}
+		if len(events) == limit {
+			break
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *AuditLogRepository) Stream(ctx context.Context, filter repository.AuditEventFilter, fn func(repository.AuditEvent) error) error {
+	matched := r.matching(filter)
+	sort.Slice(matched, func(i, j int) bool {
+		return auditEventBefore(matched[i], matched[j].OccurredAt, matched[j].ID)
+	})
+
+	for _, event := range matched {
+		if err := fn(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *AuditLogRepository) matching(filter repository.AuditEventFilter) []repository.AuditEvent {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	matched := make([]repository.AuditEvent, 0)
+	for _, event := range r.events {
+		if filter.AggregateID != "" && event.AggregateID != filter.AggregateID {
+			continue
+		}
+		if filter.AggregateType != "" && event.AggregateType != filter.AggregateType {
+			continue
+		}
+		if len(filter.EventTypes) > 0 && !containsString(filter.EventTypes, event.EventType) {
+			continue
+		}
+		if filter.ActorID != "" && event.Metadata.ActorID != filter.ActorID {
+			continue
+		}
+		if filter.From != nil && event.OccurredAt.Before(*filter.From) {
+			continue
+		}
+		if filter.To != nil && !event.OccurredAt.Before(*filter.To) {
+			continue
+		}
+		matched = append(matched, event)
+	}
+	return matched
+}
+
+// auditEventBefore orders events by (OccurredAt, ID) like the event_store keyset
+func auditEventBefore(event repository.AuditEvent, occurredAt time.Time, id string) bool {
+	if event.OccurredAt.Equal(occurredAt) {
+		return event.ID < id
+	}
+	return event.OccurredAt.Before(occurredAt)
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,21 @@
+package memory
+
+import (
+	"testing"
+
+	"golang-social-media/apps/auth-service/internal/application/repository/contracttest"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	contracttest.Run(t, func(t *testing.T) contracttest.Repositories {
+		users := NewUserRepository(nil)
+		return contracttest.Repositories{
+			Users:           users,
+			Roles:           NewRoleRepository(),
+			Permissions:     NewPermissionRepository(),
+			RolePermissions: NewRolePermissionRepository(),
+			UserRoles:       NewUserRoleRepository(),
+			UnitOfWork:      NewUnitOfWorkFactory(users, NewRefreshTokenRepository()),
+		}
+	})
+}
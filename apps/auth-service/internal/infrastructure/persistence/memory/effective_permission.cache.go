@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+)
+
+var _ repository.EffectivePermissionCache = (*EffectivePermissionCache)(nil)
+
+type effectivePermissionEntry struct {
+	grants    []permission.Grant
+	expiresAt time.Time
+}
+
+type EffectivePermissionCache struct {
+	mu      sync.RWMutex
+	entries map[string]effectivePermissionEntry
+}
+
+func NewEffectivePermissionCache() *EffectivePermissionCache {
+	return &EffectivePermissionCache{
+		entries: make(map[string]effectivePermissionEntry),
+	}
+}
+
+func (c *EffectivePermissionCache) Get(ctx context.Context, userID string) ([]permission.Grant, bool, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[userID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false, nil
+	}
+	return entry.grants, true, nil
+}
+
+func (c *EffectivePermissionCache) Set(ctx context.Context, userID string, grants []permission.Grant, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[userID] = effectivePermissionEntry{
+		grants:    grants,
+		expiresAt: time.Now().Add(ttl),
+	}
+	return nil
+}
+
+func (c *EffectivePermissionCache) InvalidateUser(ctx context.Context, userID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, userID)
+	return nil
+}
+
+func (c *EffectivePermissionCache) InvalidateAll(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]effectivePermissionEntry)
+	return nil
+}
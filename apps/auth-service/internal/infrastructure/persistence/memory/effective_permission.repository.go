@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"sort"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+)
+
+var _ repository.EffectivePermissionRepository = (*EffectivePermissionRepository)(nil)
+
+// EffectivePermissionRepository resolves a user's permissions by walking the in-memory RBAC repositories
+type EffectivePermissionRepository struct {
+	roles           repository.RoleRepository
+	userRoles       repository.UserRoleRepository
+	rolePermissions repository.RolePermissionRepository
+	permissions     repository.PermissionRepository
+}
+
+func NewEffectivePermissionRepository(
+	roles repository.RoleRepository,
+	userRoles repository.UserRoleRepository,
+	rolePermissions repository.RolePermissionRepository,
+	permissions repository.PermissionRepository,
+) *EffectivePermissionRepository {
+	return &EffectivePermissionRepository{
+		roles:           roles,
+		userRoles:       userRoles,
+		rolePermissions: rolePermissions,
+		permissions:     permissions,
+	}
+}
+
+func (r *EffectivePermissionRepository) GetUserPermissions(userID string) ([]permission.Permission, error) {
+	roleIDs, err := r.userRoles.GetUserRoles(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk up the hierarchy, visiting each role once so cycles terminate
+	visited := make(map[string]bool)
+	for len(roleIDs) > 0 {
+		roleID := roleIDs[0]
+		roleIDs = roleIDs[1:]
+		if roleID == "" || visited[roleID] {
+			continue
+		}
+		visited[roleID] = true
+
+		roleEntity, err := r.roles.GetByID(roleID)
+		if err != nil {
+			if err == ErrRoleNotFound {
+				continue
+			}
+			return nil, err
+		}
+		roleIDs = append(roleIDs, roleEntity.ParentID)
+	}
+
+	seen := make(map[string]bool)
+	var permissions []permission.Permission
+	for roleID := range visited {
+		permissionIDs, err := r.rolePermissions.GetRolePermissions(roleID)
+		if err != nil {
+			return nil, err
+		}
+		for _, permissionID := range permissionIDs {
+			if seen[permissionID] {
+				continue
+			}
+			seen[permissionID] = true
+
+			perm, err := r.permissions.GetByID(permissionID)
+			if err != nil {
+				if err == ErrPermissionNotFound {
+					continue
+				}
+				return nil, err
+			}
+			permissions = append(permissions, perm)
+		}
+	}
+
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].Resource != permissions[j].Resource {
+			return permissions[i].Resource < permissions[j].Resource
+		}
+		return permissions[i].Action < permissions[j].Action
+	})
+	return permissions, nil
+}
@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+)
+
+var _ repository.GDPRRequestRepository = (*GDPRRequestRepository)(nil)
+
+type GDPRRequestRepository struct {
+	mu   sync.RWMutex
+	byID map[string]gdpr_request.Request
+}
+
+func NewGDPRRequestRepository() *GDPRRequestRepository {
+	return &GDPRRequestRepository{
+		byID: make(map[string]gdpr_request.Request),
+	}
+}
+
+func (r *GDPRRequestRepository) Create(ctx context.Context, request gdpr_request.Request) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	request.ClearEvents()
+	r.byID[request.ID] = copyGDPRRequest(request)
+	return nil
+}
+
+func (r *GDPRRequestRepository) GetByID(ctx context.Context, id string) (gdpr_request.Request, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	request, ok := r.byID[id]
+	if !ok {
+		return gdpr_request.Request{}, repository.ErrGDPRRequestNotFound
+	}
+	return copyGDPRRequest(request), nil
+}
+
+func (r *GDPRRequestRepository) Update(ctx context.Context, request gdpr_request.Request) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.byID[request.ID]; !ok {
+		return repository.ErrGDPRRequestNotFound
+	}
+	request.ClearEvents()
+	r.byID[request.ID] = copyGDPRRequest(request)
+	return nil
+}
+
+// copyGDPRRequest keeps callers from changing stored steps through the shared slice
+func copyGDPRRequest(request gdpr_request.Request) gdpr_request.Request {
+	request.Steps = append([]gdpr_request.Step(nil), request.Steps...)
+	return request
+}
@@ -0,0 +1,64 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+var _ repository.LoginAttemptRepository = (*LoginAttemptRepository)(nil)
+
+type loginAttemptEntry struct {
+	attempts  user.LoginAttempts
+	expiresAt time.Time
+}
+
+type LoginAttemptRepository struct {
+	mu      sync.RWMutex
+	entries map[string]loginAttemptEntry
+}
+
+func NewLoginAttemptRepository() *LoginAttemptRepository {
+	return &LoginAttemptRepository{
+		entries: make(map[string]loginAttemptEntry),
+	}
+}
+
+func (r *LoginAttemptRepository) Get(ctx context.Context, userID string) (user.LoginAttempts, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entry, ok := r.entries[userID]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return user.LoginAttempts{UserID: userID}, nil
+	}
+	return entry.attempts, nil
+}
+
+func (r *LoginAttemptRepository) RecordFailure(ctx context.Context, userID string, policy user.LockoutPolicy, email string, now time.Time) (user.LoginAttempts, user.UserLockedOutEvent, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts := user.LoginAttempts{UserID: userID}
+	if entry, ok := r.entries[userID]; ok && !time.Now().After(entry.expiresAt) {
+		attempts = entry.attempts
+	}
+	event, locked := attempts.RecordFailure(policy, email, now)
+
+	r.entries[userID] = loginAttemptEntry{
+		attempts:  attempts,
+		expiresAt: time.Now().Add(attempts.TTL(policy, now)),
+	}
+	return attempts, event, locked, nil
+}
+
+func (r *LoginAttemptRepository) Delete(ctx context.Context, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.entries, userID)
+	return nil
+}
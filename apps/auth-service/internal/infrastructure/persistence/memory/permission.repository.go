@@ -1,8 +1,10 @@
 package memory
 
 import (
+	"sort"
 	"sync"
 
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/permission"
 	pkgerrors "golang-social-media/pkg/errors"
 )
@@ -12,6 +14,8 @@ var (
 	ErrPermissionAlreadyExists  = pkgerrors.NewConflictError("permission_already_exists")
 )
 
+var _ repository.PermissionRepository = (*PermissionRepository)(nil)
+
 type PermissionRepository struct {
 	mu         sync.RWMutex
 	byID       map[string]permission.Permission
@@ -33,6 +37,9 @@ func (r *PermissionRepository) Create(perm permission.Permission) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	// Domain events are not persisted, like in the Postgres repository
+	perm.ClearEvents()
+
 	key := r.key(perm.Resource, perm.Action)
 	if _, exists := r.byResourceAction[key]; exists {
 		return ErrPermissionAlreadyExists
@@ -66,7 +73,48 @@ func (r *PermissionRepository) GetByResourceAction(resource, action string) (per
 	return perm, nil
 }
 
-func (r *PermissionRepository) List() ([]permission.Permission, error) {
+func (r *PermissionRepository) Update(perm permission.Permission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Domain events are not persisted, like in the Postgres repository
+	perm.ClearEvents()
+
+	oldPerm, exists := r.byID[perm.ID]
+	if !exists {
+		return ErrPermissionNotFound
+	}
+
+	// If resource/action changed, check the new pair is not taken
+	oldKey := r.key(oldPerm.Resource, oldPerm.Action)
+	newKey := r.key(perm.Resource, perm.Action)
+	if oldKey != newKey {
+		if _, exists := r.byResourceAction[newKey]; exists {
+			return ErrPermissionAlreadyExists
+		}
+		delete(r.byResourceAction, oldKey)
+	}
+
+	r.byID[perm.ID] = perm
+	r.byResourceAction[newKey] = perm
+	return nil
+}
+
+func (r *PermissionRepository) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	perm, exists := r.byID[id]
+	if !exists {
+		return ErrPermissionNotFound
+	}
+
+	delete(r.byID, id)
+	delete(r.byResourceAction, r.key(perm.Resource, perm.Action))
+	return nil
+}
+
+func (r *PermissionRepository) List(limit, offset int) ([]permission.Permission, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -74,6 +122,20 @@ func (r *PermissionRepository) List() ([]permission.Permission, error) {
 	for _, perm := range r.byID {
 		permissions = append(permissions, perm)
 	}
-	return permissions, nil
+	// Resource first, then action, like ORDER BY resource, action; comparing the joined key
+	// would put "chat-admin" before "chat"
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].Resource != permissions[j].Resource {
+			return permissions[i].Resource < permissions[j].Resource
+		}
+		return permissions[i].Action < permissions[j].Action
+	})
+	return page(permissions, limit, offset), nil
 }
 
+func (r *PermissionRepository) Count() (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.byID)), nil
+}
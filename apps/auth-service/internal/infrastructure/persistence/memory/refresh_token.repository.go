@@ -0,0 +1,146 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+)
+
+var _ repository.RefreshTokenRepository = (*RefreshTokenRepository)(nil)
+
+type RefreshTokenRepository struct {
+	mu         sync.RWMutex
+	families   map[string]refresh_token.Family
+	tokensByID map[string]refresh_token.Token
+	idsByHash  map[string]string
+}
+
+func NewRefreshTokenRepository() *RefreshTokenRepository {
+	return &RefreshTokenRepository{
+		families:   make(map[string]refresh_token.Family),
+		tokensByID: make(map[string]refresh_token.Token),
+		idsByHash:  make(map[string]string),
+	}
+}
+
+func (r *RefreshTokenRepository) CreateFamily(ctx context.Context, family refresh_token.Family) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	family.ClearEvents()
+	r.families[family.ID] = family
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetFamily(ctx context.Context, id string) (refresh_token.Family, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	family, ok := r.families[id]
+	if !ok {
+		return refresh_token.Family{}, repository.ErrRefreshTokenFamilyNotFound
+	}
+	return family, nil
+}
+
+func (r *RefreshTokenRepository) ListActiveFamilies(ctx context.Context, userID string) ([]refresh_token.Family, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	families := make([]refresh_token.Family, 0)
+	for _, family := range r.families {
+		if family.UserID == userID && !family.IsRevoked() {
+			families = append(families, family)
+		}
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].LastUsedAt.After(families[j].LastUsedAt)
+	})
+	return families, nil
+}
+
+func (r *RefreshTokenRepository) TouchFamily(ctx context.Context, family refresh_token.Family) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.families[family.ID]
+	if !ok {
+		return repository.ErrRefreshTokenFamilyNotFound
+	}
+	stored.LastUsedAt = family.LastUsedAt
+	r.families[family.ID] = stored
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, family refresh_token.Family) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.families[family.ID]
+	if !ok {
+		return repository.ErrRefreshTokenFamilyNotFound
+	}
+	if stored.IsRevoked() {
+		return nil
+	}
+	stored.RevokedAt = family.RevokedAt
+	stored.RevokeReason = family.RevokeReason
+	r.families[family.ID] = stored
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeAllFamilies(ctx context.Context, userID string, exceptFamilyID string, reason string) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var revoked int64
+	for id, family := range r.families {
+		if family.UserID != userID || id == exceptFamilyID || family.IsRevoked() {
+			continue
+		}
+		family.Revoke(reason)
+		r.families[id] = family
+		revoked++
+	}
+	return revoked, nil
+}
+
+func (r *RefreshTokenRepository) CreateToken(ctx context.Context, token refresh_token.Token) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokensByID[token.ID] = token
+	r.idsByHash[token.TokenHash] = token.ID
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetTokenByHash(ctx context.Context, tokenHash string) (refresh_token.Token, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.idsByHash[tokenHash]
+	if !ok {
+		return refresh_token.Token{}, repository.ErrRefreshTokenNotFound
+	}
+	return r.tokensByID[id], nil
+}
+
+func (r *RefreshTokenRepository) RotateToken(ctx context.Context, token refresh_token.Token) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.tokensByID[token.ID]
+	if !ok {
+		return repository.ErrRefreshTokenNotFound
+	}
+	if stored.IsRotated() {
+		return repository.ErrRefreshTokenAlreadyRotated
+	}
+	stored.RotatedAt = token.RotatedAt
+	stored.ReplacedBy = token.ReplacedBy
+	r.tokensByID[token.ID] = stored
+	return nil
+}
@@ -1,8 +1,10 @@
 package memory
 
 import (
+	"sort"
 	"sync"
 
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/role"
 	pkgerrors "golang-social-media/pkg/errors"
 )
@@ -12,6 +14,8 @@ var (
 	ErrRoleAlreadyExists = pkgerrors.NewConflictError("role_already_exists")
 )
 
+var _ repository.RoleRepository = (*RoleRepository)(nil)
+
 type RoleRepository struct {
 	mu    sync.RWMutex
 	byID  map[string]role.Role
@@ -29,6 +33,9 @@ func (r *RoleRepository) Create(roleEntity role.Role) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	// Domain events are not persisted, like in the Postgres repository
+	roleEntity.ClearEvents()
+
 	if _, exists := r.byName[roleEntity.Name]; exists {
 		return ErrRoleAlreadyExists
 	}
@@ -64,6 +71,9 @@ func (r *RoleRepository) Update(roleEntity role.Role) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	// Domain events are not persisted, like in the Postgres repository
+	roleEntity.ClearEvents()
+
 	oldRole, exists := r.byID[roleEntity.ID]
 	if !exists {
 		return ErrRoleNotFound
@@ -82,7 +92,30 @@ func (r *RoleRepository) Update(roleEntity role.Role) error {
 	return nil
 }
 
-func (r *RoleRepository) List() ([]role.Role, error) {
+func (r *RoleRepository) Delete(id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	roleEntity, exists := r.byID[id]
+	if !exists {
+		return ErrRoleNotFound
+	}
+
+	delete(r.byID, id)
+	delete(r.byName, roleEntity.Name)
+
+	// Children stop inheriting from the deleted role, like ON DELETE SET NULL on roles.parent_id
+	for childID, child := range r.byID {
+		if child.ParentID == id {
+			child.ParentID = ""
+			r.byID[childID] = child
+			r.byName[child.Name] = child
+		}
+	}
+	return nil
+}
+
+func (r *RoleRepository) List(limit, offset int) ([]role.Role, error) {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
@@ -90,6 +123,27 @@ func (r *RoleRepository) List() ([]role.Role, error) {
 	for _, roleEntity := range r.byID {
 		roles = append(roles, roleEntity)
 	}
-	return roles, nil
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i].Name < roles[j].Name
+	})
+	return page(roles, limit, offset), nil
+}
+
+func (r *RoleRepository) Count() (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return int64(len(r.byID)), nil
 }
 
+// page returns the [offset, offset+limit) window of items
+func page[T any](items []T, limit, offset int) []T {
+	if offset >= len(items) {
+		return []T{}
+	}
+	end := offset + limit
+	if end > len(items) {
+		end = len(items)
+	}
+	return items[offset:end]
+}
@@ -3,9 +3,12 @@ package memory
 import (
 	"sync"
 
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/role_permission"
 )
 
+var _ repository.RolePermissionRepository = (*RolePermissionRepository)(nil)
+
 type RolePermissionRepository struct {
 	mu              sync.RWMutex
 	byRoleID        map[string][]string // roleID -> []permissionID
@@ -49,7 +52,7 @@ func (r *RolePermissionRepository) GetRolePermissions(roleID string) ([]string,
 	if !ok {
 		return []string{}, nil
 	}
-	return permissionIDs, nil
+	return sortedIDs(permissionIDs), nil
 }
 
 func (r *RolePermissionRepository) GetPermissionRoles(permissionID string) ([]string, error) {
@@ -60,7 +63,7 @@ func (r *RolePermissionRepository) GetPermissionRoles(permissionID string) ([]st
 	if !ok {
 		return []string{}, nil
 	}
-	return roleIDs, nil
+	return sortedIDs(roleIDs), nil
 }
 
 func (r *RolePermissionRepository) Delete(roleID, permissionID string) error {
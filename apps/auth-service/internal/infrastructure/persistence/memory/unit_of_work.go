@@ -0,0 +1,242 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+var _ unit_of_work.UnitOfWork = (*unitOfWork)(nil)
+var _ unit_of_work.Factory = (*UnitOfWorkFactory)(nil)
+
+// UnitOfWorkFactory creates in-memory units of work.
+// Repository writes are applied immediately and events are held back until Commit;
+// Rollback restores the users written through the unit of work, like a Postgres transaction would;
+// RBAC writes are not restored.
+type UnitOfWorkFactory struct {
+	mu                 sync.Mutex
+	users              repository.UserRepository
+	refreshTokens      repository.RefreshTokenRepository
+	verificationTokens repository.VerificationTokenRepository
+	gdprRequests       repository.GDPRRequestRepository
+	roles              repository.RoleRepository
+	permissions        repository.PermissionRepository
+	rolePermissions    repository.RolePermissionRepository
+	userRoles          repository.UserRoleRepository
+	events             []interface{}
+}
+
+// NewUnitOfWorkFactory creates a new in-memory UnitOfWorkFactory
+func NewUnitOfWorkFactory(users repository.UserRepository, refreshTokens repository.RefreshTokenRepository) *UnitOfWorkFactory {
+	return &UnitOfWorkFactory{
+		users:              users,
+		refreshTokens:      refreshTokens,
+		verificationTokens: NewVerificationTokenRepository(),
+		gdprRequests:       NewGDPRRequestRepository(),
+		roles:              NewRoleRepository(),
+		permissions:        NewPermissionRepository(),
+		rolePermissions:    NewRolePermissionRepository(),
+		userRoles:          NewUserRoleRepository(),
+	}
+}
+
+// WithRBAC replaces the RBAC repositories shared by every unit of work, e.g. with ones a test seeded
+func (f *UnitOfWorkFactory) WithRBAC(
+	roles repository.RoleRepository,
+	permissions repository.PermissionRepository,
+	rolePermissions repository.RolePermissionRepository,
+	userRoles repository.UserRoleRepository,
+) *UnitOfWorkFactory {
+	f.roles = roles
+	f.permissions = permissions
+	f.rolePermissions = rolePermissions
+	f.userRoles = userRoles
+	return f
+}
+
+// New creates a new UnitOfWork
+func (f *UnitOfWorkFactory) New(ctx context.Context) (unit_of_work.UnitOfWork, error) {
+	return &unitOfWork{
+		factory: f,
+		users:   &txUserRepository{UserRepository: f.users, before: make(map[string]*user.User)},
+	}, nil
+}
+
+// GDPRRequests returns the GDPR request repository shared by every unit of work
+func (f *UnitOfWorkFactory) GDPRRequests() repository.GDPRRequestRepository {
+	return f.gdprRequests
+}
+
+// Events returns every event saved by committed units of work
+func (f *UnitOfWorkFactory) Events() []interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]interface{}(nil), f.events...)
+}
+
+type unitOfWork struct {
+	factory   *UnitOfWorkFactory
+	users     *txUserRepository
+	pending   []interface{}
+	redacted  []string // Users whose committed events are redacted on Commit
+	committed bool
+}
+
+func (u *unitOfWork) Users() repository.UserRepository {
+	return u.users
+}
+
+func (u *unitOfWork) RefreshTokens() repository.RefreshTokenRepository {
+	return u.factory.refreshTokens
+}
+
+func (u *unitOfWork) VerificationTokens() repository.VerificationTokenRepository {
+	return u.factory.verificationTokens
+}
+
+func (u *unitOfWork) GDPRRequests() repository.GDPRRequestRepository {
+	return u.factory.gdprRequests
+}
+
+func (u *unitOfWork) Roles() repository.RoleRepository {
+	return u.factory.roles
+}
+
+func (u *unitOfWork) Permissions() repository.PermissionRepository {
+	return u.factory.permissions
+}
+
+func (u *unitOfWork) RolePermissions() repository.RolePermissionRepository {
+	return u.factory.rolePermissions
+}
+
+func (u *unitOfWork) UserRoles() repository.UserRoleRepository {
+	return u.factory.userRoles
+}
+
+func (u *unitOfWork) SaveEvents(ctx context.Context, events []interface{}) error {
+	u.pending = append(u.pending, events...)
+	return nil
+}
+
+// RedactUserEvents redacts the committed events of the user on Commit, so it cannot report a count yet
+func (u *unitOfWork) RedactUserEvents(ctx context.Context, userID string) (int64, error) {
+	u.redacted = append(u.redacted, userID)
+	return 0, nil
+}
+
+func (u *unitOfWork) Commit() error {
+	if u.committed {
+		return nil
+	}
+	u.factory.mu.Lock()
+	for _, userID := range u.redacted {
+		redactUserEvents(u.factory.events, userID)
+	}
+	u.factory.events = append(u.factory.events, u.pending...)
+	u.factory.mu.Unlock()
+	u.pending = nil
+	u.redacted = nil
+	u.users.before = nil
+	u.committed = true
+	return nil
+}
+
+// Rollback drops the pending events and restores the users written through the unit of work.
+// It does nothing after Commit, so it can be deferred
+func (u *unitOfWork) Rollback() error {
+	if u.committed {
+		return nil
+	}
+	u.pending = nil
+	u.redacted = nil
+	return u.users.restore()
+}
+
+// redactUserEvents replaces the events of userID that hold personal data with their redacted copy
+func redactUserEvents(events []interface{}, userID string) {
+	for i, event := range events {
+		domainEvent, ok := event.(user.DomainEvent)
+		if !ok {
+			continue
+		}
+		if redacted, ok := user.Redact(domainEvent, userID); ok {
+			events[i] = redacted
+		}
+	}
+}
+
+// txUserRepository remembers the state of each user before its first write, so Rollback can restore it
+type txUserRepository struct {
+	repository.UserRepository
+	before map[string]*user.User // nil for users created in the unit of work
+}
+
+func (r *txUserRepository) Create(u user.User) error {
+	if err := r.UserRepository.Create(u); err != nil {
+		return err
+	}
+	if _, seen := r.before[u.ID]; !seen {
+		r.before[u.ID] = nil
+	}
+	return nil
+}
+
+func (r *txUserRepository) Update(u user.User) error {
+	return r.write(u.ID, func() error { return r.UserRepository.Update(u) })
+}
+
+func (r *txUserRepository) ConsumeTOTPStep(userID string, step int64) (bool, error) {
+	var consumed bool
+	err := r.write(userID, func() (err error) {
+		consumed, err = r.UserRepository.ConsumeTOTPStep(userID, step)
+		return err
+	})
+	return consumed, err
+}
+
+func (r *txUserRepository) ConsumeRecoveryCode(userID, codeHash string) (bool, error) {
+	var consumed bool
+	err := r.write(userID, func() (err error) {
+		consumed, err = r.UserRepository.ConsumeRecoveryCode(userID, codeHash)
+		return err
+	})
+	return consumed, err
+}
+
+// write runs a write to an existing user and remembers the user's state before the first one
+func (r *txUserRepository) write(id string, apply func() error) error {
+	previous, err := r.UserRepository.GetByID(id)
+	if err != nil {
+		return err
+	}
+	if err := apply(); err != nil {
+		return err
+	}
+	if _, seen := r.before[id]; !seen {
+		r.before[id] = &previous
+	}
+	return nil
+}
+
+func (r *txUserRepository) restore() error {
+	var errs []error
+	for id, previous := range r.before {
+		if previous != nil {
+			errs = append(errs, r.UserRepository.Update(*previous))
+			continue
+		}
+		remover, ok := r.UserRepository.(interface{ remove(id string) })
+		if !ok {
+			errs = append(errs, errors.New("memory: created users cannot be rolled back from this repository"))
+			continue
+		}
+		remover.remove(id)
+	}
+	r.before = make(map[string]*user.User)
+	return errors.Join(errs...)
+}
@@ -40,6 +40,9 @@ func (r *UserRepository) Create(u user.User) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	// Domain events are not persisted, like in the Postgres repository
+	u.ClearEvents()
+
 	if _, exists := r.byEmail[u.Email]; exists {
 		return ErrEmailExists
 	}
@@ -139,14 +142,39 @@ func (r *UserRepository) GetByID(id string) (user.User, error) {
 	return u, nil
 }
 
+func (r *UserRepository) GetByIDs(ids []string) ([]user.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	seen := make(map[string]bool, len(ids))
+	users := make([]user.User, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if u, ok := r.byID[id]; ok {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
 func (r *UserRepository) Update(u user.User) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	// Domain events are not persisted, like in the Postgres repository
+	u.ClearEvents()
+
 	oldUser, exists := r.byID[u.ID]
 	if !exists {
 		return ErrUserNotFound
 	}
+	// Emails are unique, like the users.email constraint
+	if other, taken := r.byEmail[u.Email]; taken && other.ID != u.ID {
+		return ErrEmailExists
+	}
 
 	// Invalidate cache before update
 	if r.cache != nil {
@@ -161,6 +189,9 @@ func (r *UserRepository) Update(u user.User) error {
 	}
 
 	// Update in-memory storage
+	if oldUser.Email != u.Email {
+		delete(r.byEmail, oldUser.Email)
+	}
 	r.byID[u.ID] = u
 	r.byEmail[u.Email] = u
 	if u.Password != "" {
@@ -180,3 +211,64 @@ func (r *UserRepository) Update(u user.User) error {
 
 	return nil
 }
+
+// ConsumeTOTPStep stores step as the last used TOTP time step unless it was used already
+func (r *UserRepository) ConsumeTOTPStep(userID string, step int64) (bool, error) {
+	return r.consumeMFA(userID, func(u *user.User) bool { return u.UseTOTPStep(step) })
+}
+
+// ConsumeRecoveryCode removes the recovery code with the given hash unless it was used already
+func (r *UserRepository) ConsumeRecoveryCode(userID, codeHash string) (bool, error) {
+	return r.consumeMFA(userID, func(u *user.User) bool { return u.UseRecoveryCode(codeHash) })
+}
+
+// consumeMFA checks and stores the consumed factor under the write lock, so it is accepted only once
+func (r *UserRepository) consumeMFA(userID string, consume func(u *user.User) bool) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, exists := r.byID[userID]
+	if !exists {
+		return false, ErrUserNotFound
+	}
+	if !consume(&u) {
+		return false, nil
+	}
+	r.byID[u.ID] = u
+	r.byEmail[u.Email] = u
+
+	if r.cache != nil {
+		if err := r.cache.DeleteUser(context.Background(), u.ID, u.Email); err != nil {
+			logger.Component("auth.persistence.user_repository").
+				Warn().
+				Err(err).
+				Str("user_id", u.ID).
+				Msg("failed to delete user from cache after consuming MFA code")
+		}
+	}
+
+	return true, nil
+}
+
+// remove deletes the user; only a rolled back unit of work removes users
+func (r *UserRepository) remove(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u, exists := r.byID[id]
+	if !exists {
+		return
+	}
+	if r.cache != nil {
+		if err := r.cache.DeleteUser(context.Background(), u.ID, u.Email); err != nil {
+			logger.Component("auth.persistence.user_repository").
+				Warn().
+				Err(err).
+				Str("user_id", u.ID).
+				Msg("failed to delete user from cache after rollback")
+		}
+	}
+	delete(r.byID, id)
+	delete(r.byEmail, u.Email)
+	delete(r.password, id)
+}
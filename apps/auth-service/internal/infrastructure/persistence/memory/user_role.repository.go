@@ -1,11 +1,15 @@
 package memory
 
 import (
+	"sort"
 	"sync"
 
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/user_role"
 )
 
+var _ repository.UserRoleRepository = (*UserRoleRepository)(nil)
+
 type UserRoleRepository struct {
 	mu        sync.RWMutex
 	byUserID  map[string][]string // userID -> []roleID
@@ -49,7 +53,7 @@ func (r *UserRoleRepository) GetUserRoles(userID string) ([]string, error) {
 	if !ok {
 		return []string{}, nil
 	}
-	return roleIDs, nil
+	return sortedIDs(roleIDs), nil
 }
 
 func (r *UserRoleRepository) GetRoleUsers(roleID string) ([]string, error) {
@@ -60,7 +64,7 @@ func (r *UserRoleRepository) GetRoleUsers(roleID string) ([]string, error) {
 	if !ok {
 		return []string{}, nil
 	}
-	return userIDs, nil
+	return sortedIDs(userIDs), nil
 }
 
 func (r *UserRoleRepository) Delete(userID, roleID string) error {
@@ -107,3 +111,9 @@ func (r *UserRoleRepository) HasRole(userID, roleID string) (bool, error) {
 	return exists, nil
 }
 
+// sortedIDs returns a sorted copy, ordered like the Postgres repositories and safe from later writes
+func sortedIDs(ids []string) []string {
+	sorted := append([]string{}, ids...)
+	sort.Strings(sorted)
+	return sorted
+}
@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+)
+
+var _ repository.VerificationTokenRepository = (*VerificationTokenRepository)(nil)
+
+type VerificationTokenRepository struct {
+	mu        sync.RWMutex
+	byID      map[string]verification_token.Token
+	idsByHash map[string]string
+}
+
+func NewVerificationTokenRepository() *VerificationTokenRepository {
+	return &VerificationTokenRepository{
+		byID:      make(map[string]verification_token.Token),
+		idsByHash: make(map[string]string),
+	}
+}
+
+func (r *VerificationTokenRepository) Create(ctx context.Context, token verification_token.Token) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.byID[token.ID] = token
+	r.idsByHash[token.TokenHash] = token.ID
+	return nil
+}
+
+func (r *VerificationTokenRepository) GetByHash(ctx context.Context, tokenHash string) (verification_token.Token, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.idsByHash[tokenHash]
+	if !ok {
+		return verification_token.Token{}, repository.ErrVerificationTokenNotFound
+	}
+	return r.byID[id], nil
+}
+
+func (r *VerificationTokenRepository) MarkUsed(ctx context.Context, token verification_token.Token) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.byID[token.ID]
+	if !ok {
+		return repository.ErrVerificationTokenNotFound
+	}
+	if stored.IsUsed() {
+		return repository.ErrVerificationTokenAlreadyUsed
+	}
+	stored.UsedAt = token.UsedAt
+	r.byID[token.ID] = stored
+	return nil
+}
+
+func (r *VerificationTokenRepository) InvalidateForUser(ctx context.Context, userID string, purpose verification_token.Purpose) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now().UTC()
+	for id, token := range r.byID {
+		if token.UserID == userID && token.Purpose == purpose && !token.IsUsed() {
+			token.UsedAt = &now
+			r.byID[id] = token
+		}
+	}
+	return nil
+}
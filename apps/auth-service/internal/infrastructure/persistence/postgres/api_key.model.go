@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+)
+
+// APIKeyModel represents a user's API key in the database
+type APIKeyModel struct {
+	ID         string     `gorm:"column:id;type:uuid;primaryKey"`
+	UserID     string     `gorm:"column:user_id;type:uuid;not null;index"`
+	Name       string     `gorm:"column:name;type:varchar(100);not null"`
+	Prefix     string     `gorm:"column:prefix;type:varchar(32);not null"`
+	KeyHash    string     `gorm:"column:key_hash;type:text;not null;uniqueIndex"`
+	Scopes     []string   `gorm:"column:scopes;type:jsonb;serializer:json;not null"`
+	ExpiresAt  *time.Time `gorm:"column:expires_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at;not null"`
+	LastUsedAt *time.Time `gorm:"column:last_used_at"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+}
+
+func (APIKeyModel) TableName() string {
+	return "api_keys"
+}
+
+func apiKeyToDomain(model APIKeyModel) api_key.Key {
+	return api_key.Key{
+		ID:         model.ID,
+		UserID:     model.UserID,
+		Name:       model.Name,
+		Prefix:     model.Prefix,
+		KeyHash:    model.KeyHash,
+		Scopes:     model.Scopes,
+		ExpiresAt:  model.ExpiresAt,
+		CreatedAt:  model.CreatedAt,
+		LastUsedAt: model.LastUsedAt,
+		RevokedAt:  model.RevokedAt,
+	}
+}
+
+func apiKeyFromDomain(key api_key.Key) APIKeyModel {
+	return APIKeyModel{
+		ID:         key.ID,
+		UserID:     key.UserID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		KeyHash:    key.KeyHash,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		CreatedAt:  key.CreatedAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+	}
+}
@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/api_key"
+	"golang-social-media/pkg/logger"
+	"gorm.io/gorm"
+)
+
+var _ repository.APIKeyRepository = (*APIKeyRepository)(nil)
+
+// APIKeyRepository persists users' API keys
+type APIKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewAPIKeyRepository creates a new APIKeyRepository
+func NewAPIKeyRepository(db *gorm.DB) *APIKeyRepository {
+	return &APIKeyRepository{db: db}
+}
+
+func (r *APIKeyRepository) Create(ctx context.Context, key api_key.Key) error {
+	model := apiKeyFromDomain(key)
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		logger.Component("auth.persistence.api_key_repository").
+			Error().
+			Err(err).
+			Str("user_id", key.UserID).
+			Msg("failed to create API key")
+		return err
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) GetByID(ctx context.Context, id string) (api_key.Key, error) {
+	var model APIKeyModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return api_key.Key{}, repository.ErrAPIKeyNotFound
+		}
+		logger.Component("auth.persistence.api_key_repository").
+			Error().
+			Err(err).
+			Str("api_key_id", id).
+			Msg("failed to get API key")
+		return api_key.Key{}, err
+	}
+	return apiKeyToDomain(model), nil
+}
+
+func (r *APIKeyRepository) GetByHash(ctx context.Context, keyHash string) (api_key.Key, error) {
+	var model APIKeyModel
+	if err := r.db.WithContext(ctx).Where("key_hash = ?", keyHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return api_key.Key{}, repository.ErrAPIKeyNotFound
+		}
+		logger.Component("auth.persistence.api_key_repository").
+			Error().
+			Err(err).
+			Msg("failed to get API key by hash")
+		return api_key.Key{}, err
+	}
+	return apiKeyToDomain(model), nil
+}
+
+func (r *APIKeyRepository) ListByUser(ctx context.Context, userID string) ([]api_key.Key, error) {
+	var models []APIKeyModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("created_at DESC").
+		Find(&models).Error; err != nil {
+		logger.Component("auth.persistence.api_key_repository").
+			Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to list API keys")
+		return nil, err
+	}
+
+	keys := make([]api_key.Key, len(models))
+	for i, model := range models {
+		keys[i] = apiKeyToDomain(model)
+	}
+	return keys, nil
+}
+
+func (r *APIKeyRepository) Revoke(ctx context.Context, key api_key.Key) error {
+	if err := r.db.WithContext(ctx).
+		Model(&APIKeyModel{}).
+		Where("id = ? AND revoked_at IS NULL", key.ID).
+		Update("revoked_at", key.RevokedAt).Error; err != nil {
+		logger.Component("auth.persistence.api_key_repository").
+			Error().
+			Err(err).
+			Str("api_key_id", key.ID).
+			Msg("failed to revoke API key")
+		return err
+	}
+	return nil
+}
+
+func (r *APIKeyRepository) UpdateLastUsed(ctx context.Context, key api_key.Key) error {
+	if err := r.db.WithContext(ctx).
+		Model(&APIKeyModel{}).
+		Where("id = ?", key.ID).
+		Update("last_used_at", key.LastUsedAt).Error; err != nil {
+		logger.Component("auth.persistence.api_key_repository").
+			Error().
+			Err(err).
+			Str("api_key_id", key.ID).
+			Msg("failed to update API key last used time")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/logger"
+	"gorm.io/gorm"
+)
+
+var _ repository.EffectivePermissionRepository = (*EffectivePermissionRepository)(nil)
+
+// effectivePermissionsQuery walks from the user's roles up through their parents and
+// collects every permission granted along the way. UNION (not UNION ALL) drops roles
+// already visited, so a cycle in the hierarchy terminates instead of looping.
+const effectivePermissionsQuery = `
+WITH RECURSIVE role_tree AS (
+    SELECT r.id, r.parent_id
+    FROM roles r
+    JOIN user_roles ur ON ur.role_id = r.id
+    WHERE ur.user_id = ?
+    UNION
+    SELECT parent.id, parent.parent_id
+    FROM roles parent
+    JOIN role_tree child ON child.parent_id = parent.id
+)
+SELECT DISTINCT p.*
+FROM role_tree rt
+JOIN role_permissions rp ON rp.role_id = rt.id
+JOIN permissions p ON p.id = rp.permission_id
+ORDER BY p.resource, p.action`
+
+// EffectivePermissionRepository resolves a user's permissions, including inherited ones, in a single query
+type EffectivePermissionRepository struct {
+	db     *gorm.DB
+	mapper *PermissionMapper
+}
+
+func NewEffectivePermissionRepository(db *gorm.DB) *EffectivePermissionRepository {
+	return &EffectivePermissionRepository{
+		db:     db,
+		mapper: NewPermissionMapper(),
+	}
+}
+
+func (r *EffectivePermissionRepository) GetUserPermissions(userID string) ([]permission.Permission, error) {
+	var models []PermissionModel
+	if err := r.db.Raw(effectivePermissionsQuery, userID).Scan(&models).Error; err != nil {
+		logger.Component("auth.persistence.effective_permission_repository").
+			Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to resolve effective permissions")
+		return nil, err
+	}
+
+	permissions := make([]permission.Permission, len(models))
+	for i, model := range models {
+		permissions[i] = r.mapper.ToDomain(model)
+	}
+	return permissions, nil
+}
@@ -5,10 +5,16 @@ import (
 	"encoding/json"
 	"time"
 
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/pkg/auditctx"
+	"golang-social-media/pkg/outbox"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
+var _ repository.AuditLogRepository = (*EventStoreRepository)(nil)
+
 // EventStoreRepository handles event store operations
 type EventStoreRepository struct {
 	db *gorm.DB
@@ -87,3 +93,109 @@ func (r *EventStoreRepository) GetEventsByTimeRange(ctx context.Context, startTi
 	return events, err
 }
 
+// Redact overwrites the given payload fields of every stored event of the aggregate and returns
+// the number of events changed
+func (r *EventStoreRepository) Redact(ctx context.Context, aggregateType, aggregateID string, fields map[string]string) (int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&EventStoreModel{}).
+		Where("aggregate_id = ? AND aggregate_type = ?", aggregateID, aggregateType)
+	return outbox.RedactPayloads(query, fields)
+}
+
+// ForgetActor drops the IP address and user agent recorded with the events caused by actorID;
+// the actor ID itself stays, so the audit trail still shows who made each change
+func (r *EventStoreRepository) ForgetActor(ctx context.Context, actorID string) (int64, error) {
+	result := r.db.WithContext(ctx).
+		Model(&EventStoreModel{}).
+		Where("metadata->>'"+auditctx.KeyActorID+"' = ?", actorID).
+		Update("metadata", gorm.Expr("metadata - ?::text - ?::text", auditctx.KeyIPAddress, auditctx.KeyUserAgent))
+	return result.RowsAffected, result.Error
+}
+
+// List returns a page of events matching the filter, newest first.
+// Pages are keyed on (occurred_at, id), so events appended meanwhile do not shift later pages
+func (r *EventStoreRepository) List(ctx context.Context, filter repository.AuditEventFilter, after *repository.AuditCursor, limit int) ([]repository.AuditEvent, error) {
+	query := r.filtered(ctx, filter)
+	if after != nil {
+		query = query.Where("(occurred_at, id) < (?, ?)", after.OccurredAt, after.ID)
+	}
+
+	var models []EventStoreModel
+	err := query.
+		Order("occurred_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]repository.AuditEvent, len(models))
+	for i, model := range models {
+		events[i] = auditEventFromModel(model)
+	}
+	return events, nil
+}
+
+// Stream calls fn for every event matching the filter, oldest first, reading rows one at a time
+func (r *EventStoreRepository) Stream(ctx context.Context, filter repository.AuditEventFilter, fn func(repository.AuditEvent) error) error {
+	rows, err := r.filtered(ctx, filter).
+		Model(&EventStoreModel{}).
+		Order("occurred_at ASC, id ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var model EventStoreModel
+		if err := r.db.ScanRows(rows, &model); err != nil {
+			return err
+		}
+		if err := fn(auditEventFromModel(model)); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// filtered applies the audit filter; the actor is read from the metadata recorded on append
+func (r *EventStoreRepository) filtered(ctx context.Context, filter repository.AuditEventFilter) *gorm.DB {
+	query := r.db.WithContext(ctx)
+	if filter.AggregateID != "" {
+		query = query.Where("aggregate_id = ?", filter.AggregateID)
+	}
+	if filter.AggregateType != "" {
+		query = query.Where("aggregate_type = ?", filter.AggregateType)
+	}
+	if len(filter.EventTypes) > 0 {
+		query = query.Where("event_type IN ?", filter.EventTypes)
+	}
+	if filter.ActorID != "" {
+		query = query.Where("metadata->>'"+auditctx.KeyActorID+"' = ?", filter.ActorID)
+	}
+	if filter.From != nil {
+		query = query.Where("occurred_at >= ?", filter.From.UTC())
+	}
+	if filter.To != nil {
+		query = query.Where("occurred_at < ?", filter.To.UTC())
+	}
+	return query
+}
+
+func auditEventFromModel(model EventStoreModel) repository.AuditEvent {
+	event := repository.AuditEvent{
+		ID:            model.ID,
+		AggregateID:   model.AggregateID,
+		AggregateType: model.AggregateType,
+		EventType:     model.EventType,
+		EventVersion:  model.EventVersion,
+		Payload:       json.RawMessage(model.Payload),
+		OccurredAt:    model.OccurredAt,
+	}
+	if model.Metadata != nil {
+		// Metadata is informational; an unreadable column leaves it empty instead of hiding the event
+		_ = json.Unmarshal([]byte(*model.Metadata), &event.Metadata)
+	}
+	return event
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+)
+
+// GDPRRequestModel represents a GDPR deletion or export request in the database
+type GDPRRequestModel struct {
+	ID          string                 `gorm:"column:id;type:uuid;primaryKey"`
+	UserID      string                 `gorm:"column:user_id;type:uuid;not null;index"`
+	Kind        string                 `gorm:"column:kind;type:text;not null"`
+	Status      string                 `gorm:"column:status;type:text;not null"`
+	CreatedAt   time.Time              `gorm:"column:created_at;not null"`
+	CompletedAt *time.Time             `gorm:"column:completed_at"`
+	Steps       []GDPRRequestStepModel `gorm:"foreignKey:RequestID"`
+}
+
+func (GDPRRequestModel) TableName() string {
+	return "gdpr_requests"
+}
+
+// GDPRRequestStepModel represents the step of one service in a GDPR request
+type GDPRRequestStepModel struct {
+	RequestID   string     `gorm:"column:request_id;type:uuid;primaryKey"`
+	Service     string     `gorm:"column:service;type:text;primaryKey"`
+	Position    int        `gorm:"column:position;not null"` // Keeps the configured order of services
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+	Records     int64      `gorm:"column:records;not null;default:0"`
+	Document    *string    `gorm:"column:document;type:jsonb"` // Export only, JSON document of the service
+}
+
+func (GDPRRequestStepModel) TableName() string {
+	return "gdpr_request_steps"
+}
+
+func gdprRequestToDomain(model GDPRRequestModel) gdpr_request.Request {
+	steps := make([]gdpr_request.Step, len(model.Steps))
+	for i, stepModel := range model.Steps {
+		step := gdpr_request.Step{
+			Service:     stepModel.Service,
+			CompletedAt: stepModel.CompletedAt,
+			Records:     stepModel.Records,
+		}
+		if stepModel.Document != nil {
+			step.Document = json.RawMessage(*stepModel.Document)
+		}
+		steps[i] = step
+	}
+	return gdpr_request.Request{
+		ID:          model.ID,
+		UserID:      model.UserID,
+		Kind:        gdpr_request.Kind(model.Kind),
+		Status:      gdpr_request.Status(model.Status),
+		Steps:       steps,
+		CreatedAt:   model.CreatedAt,
+		CompletedAt: model.CompletedAt,
+	}
+}
+
+func gdprRequestFromDomain(request gdpr_request.Request) GDPRRequestModel {
+	steps := make([]GDPRRequestStepModel, len(request.Steps))
+	for i, step := range request.Steps {
+		stepModel := GDPRRequestStepModel{
+			RequestID:   request.ID,
+			Service:     step.Service,
+			Position:    i,
+			CompletedAt: step.CompletedAt,
+			Records:     step.Records,
+		}
+		if len(step.Document) > 0 {
+			document := string(step.Document)
+			stepModel.Document = &document
+		}
+		steps[i] = stepModel
+	}
+	return GDPRRequestModel{
+		ID:          request.ID,
+		UserID:      request.UserID,
+		Kind:        string(request.Kind),
+		Status:      string(request.Status),
+		CreatedAt:   request.CreatedAt,
+		CompletedAt: request.CompletedAt,
+		Steps:       steps,
+	}
+}
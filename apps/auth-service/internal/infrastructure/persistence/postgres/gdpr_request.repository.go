@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/gdpr_request"
+	"golang-social-media/pkg/logger"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ repository.GDPRRequestRepository = (*GDPRRequestRepository)(nil)
+
+// GDPRRequestRepository persists GDPR deletion and export requests
+type GDPRRequestRepository struct {
+	db *gorm.DB
+}
+
+// NewGDPRRequestRepository creates a new GDPRRequestRepository
+func NewGDPRRequestRepository(db *gorm.DB) *GDPRRequestRepository {
+	return &GDPRRequestRepository{db: db}
+}
+
+// NewGDPRRequestRepositoryWithTx creates a GDPRRequestRepository with a specific transaction
+func NewGDPRRequestRepositoryWithTx(tx *gorm.DB) *GDPRRequestRepository {
+	return &GDPRRequestRepository{db: tx}
+}
+
+func (r *GDPRRequestRepository) Create(ctx context.Context, request gdpr_request.Request) error {
+	model := gdprRequestFromDomain(request)
+	// Steps are created with the request through the association
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		logger.Component("auth.persistence.gdpr_request_repository").
+			Error().
+			Err(err).
+			Str("user_id", request.UserID).
+			Str("kind", string(request.Kind)).
+			Msg("failed to create GDPR request")
+		return err
+	}
+	return nil
+}
+
+func (r *GDPRRequestRepository) GetByID(ctx context.Context, id string) (gdpr_request.Request, error) {
+	var model GDPRRequestModel
+	err := r.db.WithContext(ctx).
+		Preload("Steps", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Where("id = ?", id).
+		First(&model).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return gdpr_request.Request{}, repository.ErrGDPRRequestNotFound
+		}
+		logger.Component("auth.persistence.gdpr_request_repository").
+			Error().
+			Err(err).
+			Str("request_id", id).
+			Msg("failed to get GDPR request")
+		return gdpr_request.Request{}, err
+	}
+	return gdprRequestToDomain(model), nil
+}
+
+func (r *GDPRRequestRepository) Update(ctx context.Context, request gdpr_request.Request) error {
+	model := gdprRequestFromDomain(request)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&GDPRRequestModel{}).
+			Where("id = ?", request.ID).
+			Updates(map[string]interface{}{
+				"status":       model.Status,
+				"completed_at": model.CompletedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrGDPRRequestNotFound
+		}
+
+		if len(model.Steps) == 0 {
+			return nil
+		}
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "request_id"}, {Name: "service"}},
+			DoUpdates: clause.AssignmentColumns([]string{"completed_at", "records", "document"}),
+		}).Create(&model.Steps).Error
+	})
+	if err != nil && !errors.Is(err, repository.ErrGDPRRequestNotFound) {
+		logger.Component("auth.persistence.gdpr_request_repository").
+			Error().
+			Err(err).
+			Str("request_id", request.ID).
+			Msg("failed to update GDPR request")
+	}
+	return err
+}
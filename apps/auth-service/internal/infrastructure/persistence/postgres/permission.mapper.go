@@ -1,8 +1,7 @@
-package mappers
+package postgres
 
 import (
 	"golang-social-media/apps/auth-service/internal/domain/permission"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres"
 )
 
 // PermissionMapper maps between domain Permission and PostgreSQL PermissionModel
@@ -13,7 +12,7 @@ func NewPermissionMapper() *PermissionMapper {
 }
 
 // ToDomain converts PostgreSQL PermissionModel to domain Permission
-func (m *PermissionMapper) ToDomain(model postgres.PermissionModel) permission.Permission {
+func (m *PermissionMapper) ToDomain(model PermissionModel) permission.Permission {
 	return permission.Permission{
 		ID:        model.ID,
 		Name:      model.Name,
@@ -25,8 +24,8 @@ func (m *PermissionMapper) ToDomain(model postgres.PermissionModel) permission.P
 }
 
 // FromDomain converts domain Permission to PostgreSQL PermissionModel
-func (m *PermissionMapper) FromDomain(p permission.Permission) postgres.PermissionModel {
-	return postgres.PermissionModel{
+func (m *PermissionMapper) FromDomain(p permission.Permission) PermissionModel {
+	return PermissionModel{
 		ID:        p.ID,
 		Name:      p.Name,
 		Resource:  p.Resource,
@@ -3,8 +3,8 @@ package postgres
 import (
 	"errors"
 
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/permission"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres/mappers"
 	pkgerrors "golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 	"gorm.io/gorm"
@@ -15,15 +15,17 @@ var (
 	ErrPermissionAlreadyExists = pkgerrors.NewConflictError("permission_already_exists")
 )
 
+var _ repository.PermissionRepository = (*PermissionRepository)(nil)
+
 type PermissionRepository struct {
 	db     *gorm.DB
-	mapper *mappers.PermissionMapper
+	mapper *PermissionMapper
 }
 
 func NewPermissionRepository(db *gorm.DB) *PermissionRepository {
 	return &PermissionRepository{
 		db:     db,
-		mapper: mappers.NewPermissionMapper(),
+		mapper: NewPermissionMapper(),
 	}
 }
 
@@ -78,9 +80,49 @@ func (r *PermissionRepository) GetByResourceAction(resource, action string) (per
 	return r.mapper.ToDomain(model), nil
 }
 
-func (r *PermissionRepository) List() ([]permission.Permission, error) {
+func (r *PermissionRepository) Update(perm permission.Permission) error {
+	model := r.mapper.FromDomain(perm)
+	result := r.db.Model(&PermissionModel{}).Where("id = ?", perm.ID).
+		Select("name", "resource", "action", "updated_at").
+		Updates(model)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrPermissionAlreadyExists
+		}
+		logger.Component("auth.persistence.permission_repository").
+			Error().
+			Err(err).
+			Str("permission_id", perm.ID).
+			Msg("failed to update permission")
+		return err
+	}
+	// Updates does not report missing rows as gorm.ErrRecordNotFound
+	if result.RowsAffected == 0 {
+		return ErrPermissionNotFound
+	}
+	return nil
+}
+
+func (r *PermissionRepository) Delete(id string) error {
+	// role_permissions rows are removed by ON DELETE CASCADE
+	result := r.db.Where("id = ?", id).Delete(&PermissionModel{})
+	if result.Error != nil {
+		logger.Component("auth.persistence.permission_repository").
+			Error().
+			Err(result.Error).
+			Str("permission_id", id).
+			Msg("failed to delete permission")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrPermissionNotFound
+	}
+	return nil
+}
+
+func (r *PermissionRepository) List(limit, offset int) ([]permission.Permission, error) {
 	var models []PermissionModel
-	if err := r.db.Find(&models).Error; err != nil {
+	if err := r.db.Order("resource ASC, action ASC").Limit(limit).Offset(offset).Find(&models).Error; err != nil {
 		logger.Component("auth.persistence.permission_repository").
 			Error().
 			Err(err).
@@ -95,3 +137,14 @@ func (r *PermissionRepository) List() ([]permission.Permission, error) {
 	return permissions, nil
 }
 
+func (r *PermissionRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&PermissionModel{}).Count(&count).Error; err != nil {
+		logger.Component("auth.persistence.permission_repository").
+			Error().
+			Err(err).
+			Msg("failed to count permissions")
+		return 0, err
+	}
+	return count, nil
+}
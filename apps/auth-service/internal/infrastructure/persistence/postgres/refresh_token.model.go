@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+)
+
+// RefreshTokenFamilyModel represents a refresh token family in the database
+type RefreshTokenFamilyModel struct {
+	ID           string     `gorm:"column:id;type:uuid;primaryKey"`
+	UserID       string     `gorm:"column:user_id;type:uuid;not null;index"`
+	UserAgent    string     `gorm:"column:user_agent;type:text;not null;default:''"`
+	IPAddress    string     `gorm:"column:ip_address;type:text;not null;default:''"`
+	CreatedAt    time.Time  `gorm:"column:created_at;not null"`
+	LastUsedAt   time.Time  `gorm:"column:last_used_at;not null"`
+	RevokedAt    *time.Time `gorm:"column:revoked_at"`
+	RevokeReason *string    `gorm:"column:revoke_reason;type:text"`
+}
+
+func (RefreshTokenFamilyModel) TableName() string {
+	return "refresh_token_families"
+}
+
+// RefreshTokenModel represents a refresh token in the database
+type RefreshTokenModel struct {
+	ID         string     `gorm:"column:id;type:uuid;primaryKey"`
+	FamilyID   string     `gorm:"column:family_id;type:uuid;not null;index"`
+	UserID     string     `gorm:"column:user_id;type:uuid;not null"`
+	TokenHash  string     `gorm:"column:token_hash;type:text;not null;uniqueIndex"`
+	ParentID   *string    `gorm:"column:parent_id;type:uuid"`
+	ExpiresAt  time.Time  `gorm:"column:expires_at;not null"`
+	CreatedAt  time.Time  `gorm:"column:created_at;not null"`
+	RotatedAt  *time.Time `gorm:"column:rotated_at"`
+	ReplacedBy *string    `gorm:"column:replaced_by;type:uuid"`
+}
+
+func (RefreshTokenModel) TableName() string {
+	return "refresh_tokens"
+}
+
+func refreshTokenFamilyToDomain(model RefreshTokenFamilyModel) refresh_token.Family {
+	return refresh_token.Family{
+		ID:     model.ID,
+		UserID: model.UserID,
+		Device: refresh_token.Device{
+			UserAgent: model.UserAgent,
+			IPAddress: model.IPAddress,
+		},
+		CreatedAt:    model.CreatedAt,
+		LastUsedAt:   model.LastUsedAt,
+		RevokedAt:    model.RevokedAt,
+		RevokeReason: derefString(model.RevokeReason),
+	}
+}
+
+func refreshTokenFamilyFromDomain(f refresh_token.Family) RefreshTokenFamilyModel {
+	return RefreshTokenFamilyModel{
+		ID:           f.ID,
+		UserID:       f.UserID,
+		UserAgent:    f.Device.UserAgent,
+		IPAddress:    f.Device.IPAddress,
+		CreatedAt:    f.CreatedAt,
+		LastUsedAt:   f.LastUsedAt,
+		RevokedAt:    f.RevokedAt,
+		RevokeReason: optionalString(f.RevokeReason),
+	}
+}
+
+func refreshTokenToDomain(model RefreshTokenModel) refresh_token.Token {
+	return refresh_token.Token{
+		ID:         model.ID,
+		FamilyID:   model.FamilyID,
+		UserID:     model.UserID,
+		TokenHash:  model.TokenHash,
+		ParentID:   derefString(model.ParentID),
+		ExpiresAt:  model.ExpiresAt,
+		CreatedAt:  model.CreatedAt,
+		RotatedAt:  model.RotatedAt,
+		ReplacedBy: derefString(model.ReplacedBy),
+	}
+}
+
+func refreshTokenFromDomain(t refresh_token.Token) RefreshTokenModel {
+	return RefreshTokenModel{
+		ID:         t.ID,
+		FamilyID:   t.FamilyID,
+		UserID:     t.UserID,
+		TokenHash:  t.TokenHash,
+		ParentID:   optionalString(t.ParentID),
+		ExpiresAt:  t.ExpiresAt,
+		CreatedAt:  t.CreatedAt,
+		RotatedAt:  t.RotatedAt,
+		ReplacedBy: optionalString(t.ReplacedBy),
+	}
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
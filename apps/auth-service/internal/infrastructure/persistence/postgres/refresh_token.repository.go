@@ -0,0 +1,189 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/pkg/logger"
+	"gorm.io/gorm"
+)
+
+var _ repository.RefreshTokenRepository = (*RefreshTokenRepository)(nil)
+
+// RefreshTokenRepository persists refresh token families and their tokens
+type RefreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository creates a new RefreshTokenRepository
+func NewRefreshTokenRepository(db *gorm.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// NewRefreshTokenRepositoryWithTx creates a RefreshTokenRepository with a specific transaction
+func NewRefreshTokenRepositoryWithTx(tx *gorm.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: tx}
+}
+
+func (r *RefreshTokenRepository) CreateFamily(ctx context.Context, family refresh_token.Family) error {
+	model := refreshTokenFamilyFromDomain(family)
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(err).
+			Str("family_id", family.ID).
+			Str("user_id", family.UserID).
+			Msg("failed to create refresh token family")
+		return err
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetFamily(ctx context.Context, id string) (refresh_token.Family, error) {
+	var model RefreshTokenFamilyModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return refresh_token.Family{}, repository.ErrRefreshTokenFamilyNotFound
+		}
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(err).
+			Str("family_id", id).
+			Msg("failed to get refresh token family")
+		return refresh_token.Family{}, err
+	}
+	return refreshTokenFamilyToDomain(model), nil
+}
+
+func (r *RefreshTokenRepository) ListActiveFamilies(ctx context.Context, userID string) ([]refresh_token.Family, error) {
+	var models []RefreshTokenFamilyModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Order("last_used_at DESC").
+		Find(&models).Error; err != nil {
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to list refresh token families")
+		return nil, err
+	}
+
+	families := make([]refresh_token.Family, len(models))
+	for i, model := range models {
+		families[i] = refreshTokenFamilyToDomain(model)
+	}
+	return families, nil
+}
+
+func (r *RefreshTokenRepository) TouchFamily(ctx context.Context, family refresh_token.Family) error {
+	if err := r.db.WithContext(ctx).
+		Model(&RefreshTokenFamilyModel{}).
+		Where("id = ?", family.ID).
+		Update("last_used_at", family.LastUsedAt).Error; err != nil {
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(err).
+			Str("family_id", family.ID).
+			Msg("failed to touch refresh token family")
+		return err
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, family refresh_token.Family) error {
+	result := r.db.WithContext(ctx).
+		Model(&RefreshTokenFamilyModel{}).
+		Where("id = ? AND revoked_at IS NULL", family.ID).
+		Updates(map[string]interface{}{
+			"revoked_at":    family.RevokedAt,
+			"revoke_reason": family.RevokeReason,
+		})
+	if result.Error != nil {
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(result.Error).
+			Str("family_id", family.ID).
+			Msg("failed to revoke refresh token family")
+		return result.Error
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) RevokeAllFamilies(ctx context.Context, userID string, exceptFamilyID string, reason string) (int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&RefreshTokenFamilyModel{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID)
+	if exceptFamilyID != "" {
+		query = query.Where("id <> ?", exceptFamilyID)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"revoked_at":    time.Now().UTC(),
+		"revoke_reason": reason,
+	})
+	if result.Error != nil {
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(result.Error).
+			Str("user_id", userID).
+			Msg("failed to revoke refresh token families")
+		return 0, result.Error
+	}
+	return result.RowsAffected, nil
+}
+
+func (r *RefreshTokenRepository) CreateToken(ctx context.Context, token refresh_token.Token) error {
+	model := refreshTokenFromDomain(token)
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(err).
+			Str("token_id", token.ID).
+			Str("family_id", token.FamilyID).
+			Msg("failed to create refresh token")
+		return err
+	}
+	return nil
+}
+
+func (r *RefreshTokenRepository) GetTokenByHash(ctx context.Context, tokenHash string) (refresh_token.Token, error) {
+	var model RefreshTokenModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return refresh_token.Token{}, repository.ErrRefreshTokenNotFound
+		}
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(err).
+			Msg("failed to get refresh token by hash")
+		return refresh_token.Token{}, err
+	}
+	return refreshTokenToDomain(model), nil
+}
+
+func (r *RefreshTokenRepository) RotateToken(ctx context.Context, token refresh_token.Token) error {
+	// Conditional update so two concurrent refreshes cannot both rotate the same token
+	result := r.db.WithContext(ctx).
+		Model(&RefreshTokenModel{}).
+		Where("id = ? AND rotated_at IS NULL", token.ID).
+		Updates(map[string]interface{}{
+			"rotated_at":  token.RotatedAt,
+			"replaced_by": optionalString(token.ReplacedBy),
+		})
+	if result.Error != nil {
+		logger.Component("auth.persistence.refresh_token_repository").
+			Error().
+			Err(result.Error).
+			Str("token_id", token.ID).
+			Msg("failed to rotate refresh token")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrRefreshTokenAlreadyRotated
+	}
+	return nil
+}
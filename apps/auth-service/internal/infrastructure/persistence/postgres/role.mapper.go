@@ -1,8 +1,7 @@
-package mappers
+package postgres
 
 import (
 	"golang-social-media/apps/auth-service/internal/domain/role"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres"
 )
 
 // RoleMapper maps between domain Role and PostgreSQL RoleModel
@@ -13,22 +12,33 @@ func NewRoleMapper() *RoleMapper {
 }
 
 // ToDomain converts PostgreSQL RoleModel to domain Role
-func (m *RoleMapper) ToDomain(model postgres.RoleModel) role.Role {
+func (m *RoleMapper) ToDomain(model RoleModel) role.Role {
+	var parentID string
+	if model.ParentID != nil {
+		parentID = *model.ParentID
+	}
 	return role.Role{
 		ID:          model.ID,
 		Name:        model.Name,
 		Description: model.Description,
+		ParentID:    parentID,
 		CreatedAt:   model.CreatedAt,
 		UpdatedAt:   model.UpdatedAt,
 	}
 }
 
 // FromDomain converts domain Role to PostgreSQL RoleModel
-func (m *RoleMapper) FromDomain(r role.Role) postgres.RoleModel {
-	return postgres.RoleModel{
+func (m *RoleMapper) FromDomain(r role.Role) RoleModel {
+	// An empty parent is stored as NULL so the foreign key is not checked
+	var parentID *string
+	if r.ParentID != "" {
+		parentID = &r.ParentID
+	}
+	return RoleModel{
 		ID:          r.ID,
 		Name:        r.Name,
 		Description: r.Description,
+		ParentID:    parentID,
 		CreatedAt:   r.CreatedAt,
 		UpdatedAt:   r.UpdatedAt,
 	}
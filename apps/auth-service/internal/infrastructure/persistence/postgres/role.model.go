@@ -8,6 +8,7 @@ type RoleModel struct {
 	ID          string    `gorm:"column:id;type:uuid;primaryKey"`
 	Name        string    `gorm:"column:name;type:text;not null;uniqueIndex"`
 	Description string    `gorm:"column:description;type:text"`
+	ParentID    *string   `gorm:"column:parent_id;type:uuid"`
 	CreatedAt   time.Time `gorm:"column:created_at;not null"`
 	UpdatedAt   time.Time `gorm:"column:updated_at;not null"`
 }
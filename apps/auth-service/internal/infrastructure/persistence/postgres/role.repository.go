@@ -3,8 +3,8 @@ package postgres
 import (
 	"errors"
 
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/role"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres/mappers"
 	pkgerrors "golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 	"gorm.io/gorm"
@@ -15,15 +15,17 @@ var (
 	ErrRoleAlreadyExists = pkgerrors.NewConflictError("role_already_exists")
 )
 
+var _ repository.RoleRepository = (*RoleRepository)(nil)
+
 type RoleRepository struct {
 	db     *gorm.DB
-	mapper *mappers.RoleMapper
+	mapper *RoleMapper
 }
 
 func NewRoleRepository(db *gorm.DB) *RoleRepository {
 	return &RoleRepository{
 		db:     db,
-		mapper: mappers.NewRoleMapper(),
+		mapper: NewRoleMapper(),
 	}
 }
 
@@ -78,10 +80,11 @@ func (r *RoleRepository) GetByName(name string) (role.Role, error) {
 
 func (r *RoleRepository) Update(roleEntity role.Role) error {
 	model := r.mapper.FromDomain(roleEntity)
-	if err := r.db.Model(&RoleModel{}).Where("id = ?", roleEntity.ID).Updates(model).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return ErrRoleNotFound
-		}
+	// Select the columns explicitly so an emptied description is written too
+	result := r.db.Model(&RoleModel{}).Where("id = ?", roleEntity.ID).
+		Select("name", "description", "parent_id", "updated_at").
+		Updates(model)
+	if err := result.Error; err != nil {
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return ErrRoleAlreadyExists
 		}
@@ -92,12 +95,33 @@ func (r *RoleRepository) Update(roleEntity role.Role) error {
 			Msg("failed to update role")
 		return err
 	}
+	// Updates does not report missing rows as gorm.ErrRecordNotFound
+	if result.RowsAffected == 0 {
+		return ErrRoleNotFound
+	}
 	return nil
 }
 
-func (r *RoleRepository) List() ([]role.Role, error) {
+func (r *RoleRepository) Delete(id string) error {
+	// user_roles and role_permissions rows are removed by ON DELETE CASCADE
+	result := r.db.Where("id = ?", id).Delete(&RoleModel{})
+	if result.Error != nil {
+		logger.Component("auth.persistence.role_repository").
+			Error().
+			Err(result.Error).
+			Str("role_id", id).
+			Msg("failed to delete role")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrRoleNotFound
+	}
+	return nil
+}
+
+func (r *RoleRepository) List(limit, offset int) ([]role.Role, error) {
 	var models []RoleModel
-	if err := r.db.Find(&models).Error; err != nil {
+	if err := r.db.Order("name ASC").Limit(limit).Offset(offset).Find(&models).Error; err != nil {
 		logger.Component("auth.persistence.role_repository").
 			Error().
 			Err(err).
@@ -112,3 +136,14 @@ func (r *RoleRepository) List() ([]role.Role, error) {
 	return roles, nil
 }
 
+func (r *RoleRepository) Count() (int64, error) {
+	var count int64
+	if err := r.db.Model(&RoleModel{}).Count(&count).Error; err != nil {
+		logger.Component("auth.persistence.role_repository").
+			Error().
+			Err(err).
+			Msg("failed to count roles")
+		return 0, err
+	}
+	return count, nil
+}
@@ -1,11 +1,14 @@
 package postgres
 
 import (
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/role_permission"
 	"golang-social-media/pkg/logger"
 	"gorm.io/gorm"
 )
 
+var _ repository.RolePermissionRepository = (*RolePermissionRepository)(nil)
+
 type RolePermissionRepository struct {
 	db *gorm.DB
 }
@@ -38,7 +41,7 @@ func (r *RolePermissionRepository) Create(rp role_permission.RolePermission) err
 
 func (r *RolePermissionRepository) GetRolePermissions(roleID string) ([]string, error) {
 	var models []RolePermissionModel
-	if err := r.db.Where("role_id = ?", roleID).Find(&models).Error; err != nil {
+	if err := r.db.Where("role_id = ?", roleID).Order("permission_id ASC").Find(&models).Error; err != nil {
 		logger.Component("auth.persistence.role_permission_repository").
 			Error().
 			Err(err).
@@ -56,7 +59,7 @@ func (r *RolePermissionRepository) GetRolePermissions(roleID string) ([]string,
 
 func (r *RolePermissionRepository) GetPermissionRoles(permissionID string) ([]string, error) {
 	var models []RolePermissionModel
-	if err := r.db.Where("permission_id = ?", permissionID).Find(&models).Error; err != nil {
+	if err := r.db.Where("permission_id = ?", permissionID).Order("role_id ASC").Find(&models).Error; err != nil {
 		logger.Component("auth.persistence.role_permission_repository").
 			Error().
 			Err(err).
@@ -6,7 +6,11 @@ import (
 
 	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/application/unit_of_work"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres/mappers"
+	"golang-social-media/apps/auth-service/internal/domain/user"
+	authcache "golang-social-media/apps/auth-service/internal/infrastructure/cache"
+	"golang-social-media/apps/auth-service/internal/pkg/auditctx"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/outbox"
 
 	"gorm.io/gorm"
 )
@@ -16,29 +20,41 @@ var _ unit_of_work.Factory = (*UnitOfWorkFactory)(nil)
 
 // unitOfWork implements UnitOfWork interface
 type unitOfWork struct {
-	db          *gorm.DB
-	tx          *gorm.DB
-	userRepo    repository.UserRepository
-	outboxRepo  *OutboxRepository
-	eventStoreRepo *EventStoreRepository
-	committed   bool
-	rolledBack  bool
+	db                    *gorm.DB
+	tx                    *gorm.DB
+	userRepo              *UserRepository
+	userCache             *authcache.UserCache
+	refreshTokenRepo      repository.RefreshTokenRepository
+	verificationTokenRepo repository.VerificationTokenRepository
+	gdprRequestRepo       repository.GDPRRequestRepository
+	roleRepo              repository.RoleRepository
+	permissionRepo        repository.PermissionRepository
+	rolePermissionRepo    repository.RolePermissionRepository
+	userRoleRepo          repository.UserRoleRepository
+	outboxRepo            *outbox.Repository
+	eventStoreRepo        *EventStoreRepository
+	committed             bool
+	rolledBack            bool
 }
 
 // UnitOfWorkFactory creates new UnitOfWork instances
 type UnitOfWorkFactory struct {
-	db            *gorm.DB
-	userMapper    mappers.UserMapper
+	db         *gorm.DB
+	userMapper UserMapper
+	userCache  *authcache.UserCache
 }
 
-// NewUnitOfWorkFactory creates a new UnitOfWorkFactory
+// NewUnitOfWorkFactory creates a new UnitOfWorkFactory.
+// userCache may be nil; when set, users updated in a unit of work are evicted from it after commit.
 func NewUnitOfWorkFactory(
 	db *gorm.DB,
-	userMapper mappers.UserMapper,
+	userMapper UserMapper,
+	userCache *authcache.UserCache,
 ) *UnitOfWorkFactory {
 	return &UnitOfWorkFactory{
 		db:         db,
 		userMapper: userMapper,
+		userCache:  userCache,
 	}
 }
 
@@ -52,13 +68,21 @@ func (f *UnitOfWorkFactory) New(ctx context.Context) (unit_of_work.UnitOfWork, e
 	uow := &unitOfWork{
 		db:         f.db,
 		tx:         tx,
+		userCache:  f.userCache,
 		committed:  false,
 		rolledBack: false,
 	}
 
 	// Create repositories with transaction
 	uow.userRepo = NewUserRepositoryWithTx(tx, f.userMapper, nil)
-	uow.outboxRepo = NewOutboxRepositoryWithTx(tx)
+	uow.refreshTokenRepo = NewRefreshTokenRepositoryWithTx(tx)
+	uow.verificationTokenRepo = NewVerificationTokenRepositoryWithTx(tx)
+	uow.gdprRequestRepo = NewGDPRRequestRepositoryWithTx(tx)
+	uow.roleRepo = NewRoleRepository(tx)
+	uow.permissionRepo = NewPermissionRepository(tx)
+	uow.rolePermissionRepo = NewRolePermissionRepository(tx)
+	uow.userRoleRepo = NewUserRoleRepository(tx)
+	uow.outboxRepo = outbox.NewRepository(tx)
 	uow.eventStoreRepo = NewEventStoreRepositoryWithTx(tx)
 
 	return uow, nil
@@ -69,6 +93,41 @@ func (u *unitOfWork) Users() repository.UserRepository {
 	return u.userRepo
 }
 
+// RefreshTokens returns the refresh token repository within this unit of work
+func (u *unitOfWork) RefreshTokens() repository.RefreshTokenRepository {
+	return u.refreshTokenRepo
+}
+
+// VerificationTokens returns the verification token repository within this unit of work
+func (u *unitOfWork) VerificationTokens() repository.VerificationTokenRepository {
+	return u.verificationTokenRepo
+}
+
+// GDPRRequests returns the GDPR request repository within this unit of work
+func (u *unitOfWork) GDPRRequests() repository.GDPRRequestRepository {
+	return u.gdprRequestRepo
+}
+
+// Roles returns the role repository within this unit of work
+func (u *unitOfWork) Roles() repository.RoleRepository {
+	return u.roleRepo
+}
+
+// Permissions returns the permission repository within this unit of work
+func (u *unitOfWork) Permissions() repository.PermissionRepository {
+	return u.permissionRepo
+}
+
+// RolePermissions returns the role -> permission grant repository within this unit of work
+func (u *unitOfWork) RolePermissions() repository.RolePermissionRepository {
+	return u.rolePermissionRepo
+}
+
+// UserRoles returns the user -> role assignment repository within this unit of work
+func (u *unitOfWork) UserRoles() repository.UserRoleRepository {
+	return u.userRoleRepo
+}
+
 // SaveEvents saves domain events to outbox and event store within the transaction
 func (u *unitOfWork) SaveEvents(ctx context.Context, events []interface{}) error {
 	for _, event := range events {
@@ -82,6 +141,12 @@ func (u *unitOfWork) SaveEvents(ctx context.Context, events []interface{}) error
 		}); ok {
 			eventType = domainEvent.Type()
 		}
+		// Events whose payload changed shape report their version, so readers know how to upcast them
+		if versioned, ok := event.(interface {
+			Version() int
+		}); ok {
+			eventVersion = versioned.Version()
+		}
 
 		// Extract aggregate info from struct fields
 		eventMap, err := structToMap(event)
@@ -106,8 +171,8 @@ func (u *unitOfWork) SaveEvents(ctx context.Context, events []interface{}) error
 			return err
 		}
 
-		// Save to event store
-		metadata := map[string]interface{}{}
+		// Save to event store, with the actor and request taken from the context for the audit trail
+		metadata := auditctx.FromContext(ctx).Map()
 		if err := u.eventStoreRepo.Append(ctx, aggregateID, aggregateType, eventType, eventVersion, event, metadata); err != nil {
 			return err
 		}
@@ -116,6 +181,26 @@ func (u *unitOfWork) SaveEvents(ctx context.Context, events []interface{}) error
 	return nil
 }
 
+// RedactUserEvents redacts the user's events in the outbox and event store within the transaction,
+// and drops the IP address and user agent recorded with the changes the user made
+func (u *unitOfWork) RedactUserEvents(ctx context.Context, userID string) (int64, error) {
+	fields := user.ErasedEventFields(userID)
+
+	outboxRedacted, err := u.outboxRepo.Redact(ctx, "User", userID, fields)
+	if err != nil {
+		return 0, err
+	}
+	storeRedacted, err := u.eventStoreRepo.Redact(ctx, "User", userID, fields)
+	if err != nil {
+		return 0, err
+	}
+	forgotten, err := u.eventStoreRepo.ForgetActor(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+	return outboxRedacted + storeRedacted + forgotten, nil
+}
+
 // structToMap converts a struct to a map
 func structToMap(v interface{}) (map[string]interface{}, error) {
 	data, err := json.Marshal(v)
@@ -141,9 +226,27 @@ func (u *unitOfWork) Commit() error {
 	}
 
 	u.committed = true
+	u.evictWrittenUsers()
 	return nil
 }
 
+// evictWrittenUsers drops cached copies of users updated in this transaction,
+// so the next read sees committed state (e.g. MFA just enabled) instead of a stale entry
+func (u *unitOfWork) evictWrittenUsers() {
+	if u.userCache == nil {
+		return
+	}
+	for _, written := range u.userRepo.written {
+		if err := u.userCache.DeleteUser(context.Background(), written.ID, written.Email); err != nil {
+			logger.Component("auth.persistence.unit_of_work").
+				Warn().
+				Err(err).
+				Str("user_id", written.ID).
+				Msg("failed to evict user from cache after commit")
+		}
+	}
+}
+
 // Rollback rolls back the transaction
 func (u *unitOfWork) Rollback() error {
 	if u.rolledBack {
@@ -160,4 +263,3 @@ func (u *unitOfWork) Rollback() error {
 	u.rolledBack = true
 	return nil
 }
-
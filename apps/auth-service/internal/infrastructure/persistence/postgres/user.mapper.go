@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"golang-social-media/apps/auth-service/internal/domain/user"
+)
+
+// UserMapper maps between domain User and PostgreSQL UserModel
+type UserMapper struct{}
+
+func NewUserMapper() *UserMapper {
+	return &UserMapper{}
+}
+
+// ToDomain converts PostgreSQL UserModel to domain User
+func (m *UserMapper) ToDomain(model UserModel) user.User {
+	return user.User{
+		ID:        model.ID,
+		Email:     model.Email,
+		Password:  model.Password,
+		Name:      model.Name,
+		UpdatedAt: model.UpdatedAt,
+
+		PasswordHistory: model.PasswordHistory,
+
+		EmailVerified: model.EmailVerified,
+
+		MFAEnabled:       model.MFAEnabled,
+		MFASecret:        model.MFASecret,
+		MFARecoveryCodes: model.MFARecoveryCodes,
+		MFALastUsedStep:  model.MFALastUsedStep,
+
+		DeletedAt: model.DeletedAt,
+	}
+}
+
+// FromDomain converts domain User to PostgreSQL UserModel
+func (m *UserMapper) FromDomain(u user.User) UserModel {
+	return UserModel{
+		ID:        u.ID,
+		Email:     u.Email,
+		Password:  u.Password,
+		Name:      u.Name,
+		UpdatedAt: u.UpdatedAt,
+
+		PasswordHistory: u.PasswordHistory,
+
+		EmailVerified: u.EmailVerified,
+
+		MFAEnabled:       u.MFAEnabled,
+		MFASecret:        u.MFASecret,
+		MFARecoveryCodes: u.MFARecoveryCodes,
+		MFALastUsedStep:  u.MFALastUsedStep,
+
+		DeletedAt: u.DeletedAt,
+	}
+}
+
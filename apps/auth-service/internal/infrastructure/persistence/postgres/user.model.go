@@ -5,15 +5,23 @@ import (
 )
 
 type UserModel struct {
-	ID        string    `gorm:"column:id;type:uuid;primaryKey"`
-	Email     string    `gorm:"column:email;type:text;not null;uniqueIndex"`
-	Password  string    `gorm:"column:password;type:text;not null"`
-	Name      string    `gorm:"column:name;type:text;not null"`
-	CreatedAt time.Time `gorm:"column:created_at;not null"`
-	UpdatedAt time.Time `gorm:"column:updated_at;not null"`
+	ID            string `gorm:"column:id;type:uuid;primaryKey"`
+	Email         string `gorm:"column:email;type:text;not null;uniqueIndex"`
+	Password      string `gorm:"column:password;type:text;not null"`
+	Name          string `gorm:"column:name;type:text;not null"`
+	EmailVerified bool   `gorm:"column:email_verified;not null;default:false"`
+	// Hashes of previous passwords, newest first, as a JSON array
+	PasswordHistory []string `gorm:"column:password_history;type:jsonb;serializer:json"`
+	// MFA (TOTP); recovery codes are stored as a JSON array of SHA256 hashes
+	MFAEnabled       bool      `gorm:"column:mfa_enabled;not null;default:false"`
+	MFASecret        string    `gorm:"column:mfa_secret;type:text"`
+	MFARecoveryCodes []string   `gorm:"column:mfa_recovery_codes;type:jsonb;serializer:json"`
+	MFALastUsedStep  int64      `gorm:"column:mfa_last_used_step;not null;default:0"` // Time step of the last accepted TOTP code
+	DeletedAt        *time.Time `gorm:"column:deleted_at"` // Set for deleted (anonymized) users; not gorm.DeletedAt, the rows stay visible
+	CreatedAt        time.Time  `gorm:"column:created_at;not null"`
+	UpdatedAt        time.Time  `gorm:"column:updated_at;not null"`
 }
 
 func (UserModel) TableName() string {
 	return "users"
 }
-
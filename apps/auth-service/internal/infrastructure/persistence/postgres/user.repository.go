@@ -3,14 +3,15 @@ package postgres
 import (
 	"context"
 	"errors"
+	"time"
 
 	"golang-social-media/apps/auth-service/internal/domain/user"
 	authcache "golang-social-media/apps/auth-service/internal/infrastructure/cache"
-	"golang-social-media/apps/auth-service/internal/infrastructure/persistence/postgres/mappers"
 	pkgcache "golang-social-media/pkg/cache"
 	pkgerrors "golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
@@ -21,25 +22,30 @@ var (
 
 type UserRepository struct {
 	db     *gorm.DB
-	mapper *mappers.UserMapper
+	mapper *UserMapper
 	cache  *authcache.UserCache
+
+	// Users updated through a transactional repository, evicted from cache by the UnitOfWork after commit
+	trackWrites bool
+	written     []user.User
 }
 
 func NewUserRepository(db *gorm.DB, cache *authcache.UserCache) *UserRepository {
 	return &UserRepository{
 		db:     db,
-		mapper: mappers.NewUserMapper(),
+		mapper: NewUserMapper(),
 		cache:  cache,
 	}
 }
 
 // NewUserRepositoryWithTx creates a repository that uses a specific transaction
 // This is used within UnitOfWork to ensure all operations share the same transaction
-func NewUserRepositoryWithTx(tx *gorm.DB, mapper mappers.UserMapper, cache *authcache.UserCache) *UserRepository {
+func NewUserRepositoryWithTx(tx *gorm.DB, mapper UserMapper, cache *authcache.UserCache) *UserRepository {
 	return &UserRepository{
-		db:     tx,
-		mapper: &mapper,
-		cache:  cache, // Cache is typically not used in transactions
+		db:          tx,
+		mapper:      &mapper,
+		cache:       cache, // Cache is typically not used in transactions
+		trackWrites: true,
 	}
 }
 
@@ -173,6 +179,36 @@ func (r *UserRepository) GetByID(id string) (user.User, error) {
 	return u, nil
 }
 
+func (r *UserRepository) GetByIDs(ids []string) ([]user.User, error) {
+	if len(ids) == 0 {
+		return []user.User{}, nil
+	}
+
+	var models []UserModel
+	if err := r.db.Where("id IN ?", ids).Find(&models).Error; err != nil {
+		logger.Component("auth.persistence.user_repository").
+			Error().
+			Err(err).
+			Int("count", len(ids)).
+			Msg("failed to get users by IDs")
+		return nil, err
+	}
+
+	byID := make(map[string]user.User, len(models))
+	for _, model := range models {
+		byID[model.ID] = r.mapper.ToDomain(model)
+	}
+
+	users := make([]user.User, 0, len(models))
+	for _, id := range ids {
+		if u, ok := byID[id]; ok {
+			users = append(users, u)
+			delete(byID, id)
+		}
+	}
+	return users, nil
+}
+
 func (r *UserRepository) Update(u user.User) error {
 	// Invalidate cache before update
 	if r.cache != nil {
@@ -190,10 +226,21 @@ func (r *UserRepository) Update(u user.User) error {
 		}
 	}
 
+	// When the email changes (e.g. a deleted user is anonymized) the entry cached under the old email must go too
+	if r.trackWrites {
+		var previous UserModel
+		if err := r.db.Select("email").Where("id = ?", u.ID).First(&previous).Error; err == nil && previous.Email != u.Email {
+			r.written = append(r.written, user.User{ID: u.ID, Email: previous.Email})
+		}
+	}
+
 	model := r.mapper.FromDomain(u)
-	if err := r.db.Model(&UserModel{}).Where("id = ?", u.ID).Updates(model).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return ErrUserNotFound
+	// Select every column so cleared fields (e.g. MFA turned off) are written as well;
+	// Updates with a struct would otherwise skip zero values
+	result := r.db.Model(&UserModel{}).Where("id = ?", u.ID).Select("*").Omit("id", "created_at").Updates(model)
+	if err := result.Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrEmailExists
 		}
 		logger.Component("auth.persistence.user_repository").
 			Error().
@@ -202,6 +249,14 @@ func (r *UserRepository) Update(u user.User) error {
 			Msg("failed to update user")
 		return err
 	}
+	// Updates does not report missing rows as gorm.ErrRecordNotFound
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	if r.trackWrites {
+		r.written = append(r.written, u)
+	}
 
 	// Update cache with new data
 	if r.cache != nil {
@@ -217,3 +272,56 @@ func (r *UserRepository) Update(u user.User) error {
 	return nil
 }
 
+// ConsumeTOTPStep stores step as the last used TOTP time step with a single conditional UPDATE,
+// so two logins racing with the same code cannot both see the step as unused
+func (r *UserRepository) ConsumeTOTPStep(userID string, step int64) (bool, error) {
+	return r.consumeMFA(userID, "mfa_last_used_step < ?", step, map[string]interface{}{
+		"mfa_last_used_step": step,
+	})
+}
+
+// ConsumeRecoveryCode removes the recovery code from the jsonb array with a single conditional UPDATE
+func (r *UserRepository) ConsumeRecoveryCode(userID, codeHash string) (bool, error) {
+	return r.consumeMFA(userID, "mfa_recovery_codes @> jsonb_build_array(?::text)", codeHash, map[string]interface{}{
+		"mfa_recovery_codes": gorm.Expr("mfa_recovery_codes - ?::text", codeHash),
+	})
+}
+
+// consumeMFA applies updates to the user only while condition holds; no affected row means the factor was used already
+func (r *UserRepository) consumeMFA(userID, condition string, arg interface{}, updates map[string]interface{}) (bool, error) {
+	updates["updated_at"] = time.Now().UTC()
+
+	var model UserModel
+	result := r.db.Model(&model).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "email"}}}).
+		Where("id = ?", userID).
+		Where(condition, arg).
+		Updates(updates)
+	if err := result.Error; err != nil {
+		logger.Component("auth.persistence.user_repository").
+			Error().
+			Err(err).
+			Str("user_id", userID).
+			Msg("failed to consume MFA code")
+		return false, err
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	// Cached copies still hold the consumed code; a later Update from one would bring it back
+	if r.trackWrites {
+		r.written = append(r.written, user.User{ID: userID, Email: model.Email})
+	}
+	if r.cache != nil {
+		if err := r.cache.DeleteUser(context.Background(), userID, model.Email); err != nil {
+			logger.Component("auth.persistence.user_repository").
+				Warn().
+				Err(err).
+				Str("user_id", userID).
+				Msg("failed to delete user from cache after consuming MFA code")
+		}
+	}
+
+	return true, nil
+}
@@ -1,11 +1,14 @@
 package postgres
 
 import (
+	"golang-social-media/apps/auth-service/internal/application/repository"
 	"golang-social-media/apps/auth-service/internal/domain/user_role"
 	"golang-social-media/pkg/logger"
 	"gorm.io/gorm"
 )
 
+var _ repository.UserRoleRepository = (*UserRoleRepository)(nil)
+
 type UserRoleRepository struct {
 	db *gorm.DB
 }
@@ -38,7 +41,7 @@ func (r *UserRoleRepository) Create(userRole user_role.UserRole) error {
 
 func (r *UserRoleRepository) GetUserRoles(userID string) ([]string, error) {
 	var models []UserRoleModel
-	if err := r.db.Where("user_id = ?", userID).Find(&models).Error; err != nil {
+	if err := r.db.Where("user_id = ?", userID).Order("role_id ASC").Find(&models).Error; err != nil {
 		logger.Component("auth.persistence.user_role_repository").
 			Error().
 			Err(err).
@@ -56,7 +59,7 @@ func (r *UserRoleRepository) GetUserRoles(userID string) ([]string, error) {
 
 func (r *UserRoleRepository) GetRoleUsers(roleID string) ([]string, error) {
 	var models []UserRoleModel
-	if err := r.db.Where("role_id = ?", roleID).Find(&models).Error; err != nil {
+	if err := r.db.Where("role_id = ?", roleID).Order("user_id ASC").Find(&models).Error; err != nil {
 		logger.Component("auth.persistence.user_role_repository").
 			Error().
 			Err(err).
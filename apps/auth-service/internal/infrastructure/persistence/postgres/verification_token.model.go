@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+)
+
+// VerificationTokenModel represents an email verification or password reset token in the database
+type VerificationTokenModel struct {
+	ID        string     `gorm:"column:id;type:uuid;primaryKey"`
+	UserID    string     `gorm:"column:user_id;type:uuid;not null;index"`
+	Purpose   string     `gorm:"column:purpose;type:text;not null"`
+	TokenHash string     `gorm:"column:token_hash;type:text;not null;uniqueIndex"`
+	ExpiresAt time.Time  `gorm:"column:expires_at;not null"`
+	CreatedAt time.Time  `gorm:"column:created_at;not null"`
+	UsedAt    *time.Time `gorm:"column:used_at"`
+}
+
+func (VerificationTokenModel) TableName() string {
+	return "verification_tokens"
+}
+
+func verificationTokenToDomain(model VerificationTokenModel) verification_token.Token {
+	return verification_token.Token{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		Purpose:   verification_token.Purpose(model.Purpose),
+		TokenHash: model.TokenHash,
+		ExpiresAt: model.ExpiresAt,
+		CreatedAt: model.CreatedAt,
+		UsedAt:    model.UsedAt,
+	}
+}
+
+func verificationTokenFromDomain(token verification_token.Token) VerificationTokenModel {
+	return VerificationTokenModel{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		Purpose:   string(token.Purpose),
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+		UsedAt:    token.UsedAt,
+	}
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang-social-media/apps/auth-service/internal/application/repository"
+	"golang-social-media/apps/auth-service/internal/domain/verification_token"
+	"golang-social-media/pkg/logger"
+	"gorm.io/gorm"
+)
+
+var _ repository.VerificationTokenRepository = (*VerificationTokenRepository)(nil)
+
+// VerificationTokenRepository persists email verification and password reset tokens
+type VerificationTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewVerificationTokenRepository creates a new VerificationTokenRepository
+func NewVerificationTokenRepository(db *gorm.DB) *VerificationTokenRepository {
+	return &VerificationTokenRepository{db: db}
+}
+
+// NewVerificationTokenRepositoryWithTx creates a VerificationTokenRepository with a specific transaction
+func NewVerificationTokenRepositoryWithTx(tx *gorm.DB) *VerificationTokenRepository {
+	return &VerificationTokenRepository{db: tx}
+}
+
+func (r *VerificationTokenRepository) Create(ctx context.Context, token verification_token.Token) error {
+	model := verificationTokenFromDomain(token)
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		logger.Component("auth.persistence.verification_token_repository").
+			Error().
+			Err(err).
+			Str("user_id", token.UserID).
+			Str("purpose", string(token.Purpose)).
+			Msg("failed to create verification token")
+		return err
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) GetByHash(ctx context.Context, tokenHash string) (verification_token.Token, error) {
+	var model VerificationTokenModel
+	if err := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return verification_token.Token{}, repository.ErrVerificationTokenNotFound
+		}
+		logger.Component("auth.persistence.verification_token_repository").
+			Error().
+			Err(err).
+			Msg("failed to get verification token by hash")
+		return verification_token.Token{}, err
+	}
+	return verificationTokenToDomain(model), nil
+}
+
+func (r *VerificationTokenRepository) MarkUsed(ctx context.Context, token verification_token.Token) error {
+	// Conditional update so a link cannot be consumed twice by concurrent requests
+	result := r.db.WithContext(ctx).
+		Model(&VerificationTokenModel{}).
+		Where("id = ? AND used_at IS NULL", token.ID).
+		Update("used_at", token.UsedAt)
+	if result.Error != nil {
+		logger.Component("auth.persistence.verification_token_repository").
+			Error().
+			Err(result.Error).
+			Str("token_id", token.ID).
+			Msg("failed to mark verification token as used")
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return repository.ErrVerificationTokenAlreadyUsed
+	}
+	return nil
+}
+
+func (r *VerificationTokenRepository) InvalidateForUser(ctx context.Context, userID string, purpose verification_token.Purpose) error {
+	if err := r.db.WithContext(ctx).
+		Model(&VerificationTokenModel{}).
+		Where("user_id = ? AND purpose = ? AND used_at IS NULL", userID, string(purpose)).
+		Update("used_at", time.Now().UTC()).Error; err != nil {
+		logger.Component("auth.persistence.verification_token_repository").
+			Error().
+			Err(err).
+			Str("user_id", userID).
+			Str("purpose", string(purpose)).
+			Msg("failed to invalidate verification tokens")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	secretSize        = 20 // 160-bit secret, as recommended by RFC 4226
+	recoveryCodeBytes = 5  // 8 base32 characters per recovery code
+)
+
+var base32NoPadding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Service generates and verifies RFC 6238 time-based one-time passwords
+// (HMAC-SHA1, compatible with Google Authenticator, 1Password, Authy...)
+type Service struct {
+	issuer string
+	period time.Duration
+	digits int
+	skew   int // Number of periods accepted before and after the current one (clock drift)
+}
+
+// NewService creates a TOTP service with the standard 30 second period and 6 digits
+func NewService(issuer string) *Service {
+	return &Service{
+		issuer: issuer,
+		period: 30 * time.Second,
+		digits: 6,
+		skew:   1,
+	}
+}
+
+// GenerateSecret generates a random base32 encoded secret
+func (s *Service) GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base32NoPadding.EncodeToString(secret), nil
+}
+
+// ProvisioningURI builds the otpauth:// URI rendered as a QR code by authenticator apps
+func (s *Service) ProvisioningURI(secret string, accountName string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", s.issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprintf("%d", s.digits))
+	params.Set("period", fmt.Sprintf("%d", int(s.period.Seconds())))
+
+	label := url.PathEscape(s.issuer + ":" + accountName)
+	return "otpauth://totp/" + label + "?" + params.Encode()
+}
+
+// GenerateCode returns the code for the period containing t
+func (s *Service) GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return s.hotp(key, uint64(t.Unix())/uint64(s.period.Seconds())), nil
+}
+
+// Validate checks a code against the current period and the allowed skew around it
+func (s *Service) Validate(secret string, code string, t time.Time) bool {
+	_, ok := s.ValidateAfter(secret, code, t, -1)
+	return ok
+}
+
+// ValidateAfter is Validate limited to the time steps after lastStep, the step of the
+// last code accepted for the secret, so a code cannot be replayed within the skew.
+// It returns the time step of the matching code, to be stored as the new lastStep.
+func (s *Service) ValidateAfter(secret string, code string, t time.Time, lastStep int64) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != s.digits {
+		return 0, false
+	}
+
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return 0, false
+	}
+
+	counter := int64(t.Unix()) / int64(s.period.Seconds())
+	for offset := -s.skew; offset <= s.skew; offset++ {
+		step := counter + int64(offset)
+		if step < 0 || step <= lastStep {
+			continue
+		}
+		expected := s.hotp(key, uint64(step))
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes generates n single-use recovery codes formatted as xxxx-xxxx
+func (s *Service) GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		raw := make([]byte, recoveryCodeBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, err
+		}
+		encoded := strings.ToLower(base32NoPadding.EncodeToString(raw))
+		codes = append(codes, encoded[:4]+"-"+encoded[4:])
+	}
+	return codes, nil
+}
+
+// NormalizeRecoveryCode strips formatting so codes typed with or without the dash match
+func NormalizeRecoveryCode(code string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+}
+
+// hotp computes an RFC 4226 HMAC-based one-time password for the counter
+func (s *Service) hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < s.digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", s.digits, value%mod)
+}
+
+// decodeSecret decodes a base32 secret, tolerating lowercase, spaces and padding
+func decodeSecret(secret string) ([]byte, error) {
+	normalized := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	normalized = strings.TrimRight(normalized, "=")
+	return base32NoPadding.DecodeString(normalized)
+}
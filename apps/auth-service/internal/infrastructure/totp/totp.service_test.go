@@ -0,0 +1,127 @@
+package totp
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// RFC 6238 Appendix B test secret ("12345678901234567890") in base32
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestService_GenerateCode_RFC6238Vectors(t *testing.T) {
+	service := NewService("golang-social-media")
+
+	// 6-digit truncations of the SHA1 vectors from RFC 6238 Appendix B
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{unix: 59, want: "287082"},
+		{unix: 1111111109, want: "081804"},
+		{unix: 1111111111, want: "050471"},
+		{unix: 1234567890, want: "005924"},
+		{unix: 2000000000, want: "279037"},
+	}
+
+	for _, tt := range tests {
+		code, err := service.GenerateCode(rfcSecret, time.Unix(tt.unix, 0))
+		if err != nil {
+			t.Fatalf("GenerateCode() error = %v", err)
+		}
+		if code != tt.want {
+			t.Errorf("GenerateCode(%d) = %v, want %v", tt.unix, code, tt.want)
+		}
+	}
+}
+
+func TestService_Validate(t *testing.T) {
+	service := NewService("golang-social-media")
+	secret, err := service.GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret() error = %v", err)
+	}
+
+	now := time.Now()
+	code, _ := service.GenerateCode(secret, now)
+
+	if !service.Validate(secret, code, now) {
+		t.Error("Validate() should accept the current code")
+	}
+	if !service.Validate(secret, code, now.Add(30*time.Second)) {
+		t.Error("Validate() should accept a code from the previous period")
+	}
+	if service.Validate(secret, code, now.Add(5*time.Minute)) {
+		t.Error("Validate() should reject an old code")
+	}
+	if service.Validate(secret, "12345", now) {
+		t.Error("Validate() should reject a code with the wrong length")
+	}
+}
+
+func TestService_ValidateAfter(t *testing.T) {
+	service := NewService("golang-social-media")
+
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / 30
+	code, _ := service.GenerateCode(rfcSecret, now)
+
+	got, ok := service.ValidateAfter(rfcSecret, code, now, 0)
+	if !ok || got != step {
+		t.Fatalf("ValidateAfter() = %d, %v, want %d, true", got, ok, step)
+	}
+
+	// Once its step is recorded, the code is rejected for the rest of the skew
+	if _, ok := service.ValidateAfter(rfcSecret, code, now, step); ok {
+		t.Error("ValidateAfter() should reject a code of the last used step")
+	}
+	if _, ok := service.ValidateAfter(rfcSecret, code, now.Add(30*time.Second), step); ok {
+		t.Error("ValidateAfter() should reject a replayed code from the previous period")
+	}
+
+	// The code of the next period is still accepted
+	next, _ := service.GenerateCode(rfcSecret, now.Add(30*time.Second))
+	if got, ok := service.ValidateAfter(rfcSecret, next, now.Add(30*time.Second), step); !ok || got != step+1 {
+		t.Errorf("ValidateAfter() = %d, %v, want %d, true", got, ok, step+1)
+	}
+}
+
+func TestService_ProvisioningURI(t *testing.T) {
+	service := NewService("Social Media")
+
+	uri := service.ProvisioningURI("JBSWY3DPEHPK3PXP", "alice@example.com")
+
+	if !strings.HasPrefix(uri, "otpauth://totp/Social%20Media:alice@example.com?") {
+		t.Errorf("ProvisioningURI() = %v, unexpected label", uri)
+	}
+	if !strings.Contains(uri, "secret=JBSWY3DPEHPK3PXP") || !strings.Contains(uri, "issuer=Social+Media") {
+		t.Errorf("ProvisioningURI() = %v, missing secret or issuer", uri)
+	}
+}
+
+func TestService_GenerateRecoveryCodes(t *testing.T) {
+	service := NewService("golang-social-media")
+
+	codes, err := service.GenerateRecoveryCodes(10)
+	if err != nil {
+		t.Fatalf("GenerateRecoveryCodes() error = %v", err)
+	}
+	if len(codes) != 10 {
+		t.Fatalf("GenerateRecoveryCodes() returned %d codes, want 10", len(codes))
+	}
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		if len(code) != 9 || code[4] != '-' {
+			t.Errorf("recovery code %q should be formatted as xxxx-xxxx", code)
+		}
+		if seen[code] {
+			t.Errorf("recovery code %q generated twice", code)
+		}
+		seen[code] = true
+	}
+
+	if NormalizeRecoveryCode(" ABCD-efgh ") != "abcdefgh" {
+		t.Error("NormalizeRecoveryCode() should ignore case, spaces and dashes")
+	}
+}
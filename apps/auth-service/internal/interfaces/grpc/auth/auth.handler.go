@@ -2,23 +2,136 @@ package auth
 
 import (
 	"context"
+	stderrors "errors"
 	"time"
 
-	bootstrap "golang-social-media/apps/auth-service/internal/infrastructure/bootstrap"
+	appcommand "golang-social-media/apps/auth-service/internal/application/command"
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
 	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
-	"golang-social-media/pkg/logger"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	bootstrap "golang-social-media/apps/auth-service/internal/infrastructure/bootstrap"
+	"golang-social-media/pkg/contracts/auth"
+	pkgerrors "golang-social-media/pkg/errors"
 	authv1 "golang-social-media/pkg/gen/auth/v1"
+	"golang-social-media/pkg/logger"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Handler struct {
-	validateTokenQuery querycontracts.ValidateTokenQuery
+	registerUserCmd       commandcontracts.RegisterUserCommand
+	loginUserCmd          *appcommand.LoginUserHandler
+	refreshTokenCmd       commandcontracts.RefreshTokenCommand
+	authenticateAPIKeyCmd commandcontracts.AuthenticateAPIKeyCommand
+	validateTokenQuery    querycontracts.ValidateTokenQuery
+	getUserProfileQuery   querycontracts.GetUserProfileQuery
+	batchGetUsersQuery    querycontracts.BatchGetUsersQuery
+	checkPermissionQuery  querycontracts.CheckPermissionQuery
 	authv1.UnimplementedAuthServiceServer
 }
 
 func NewHandler(deps *bootstrap.Dependencies) *Handler {
 	return &Handler{
-		validateTokenQuery: deps.ValidateTokenQuery,
+		registerUserCmd:       deps.RegisterUserCmd,
+		loginUserCmd:          deps.LoginUserCmd,
+		refreshTokenCmd:       deps.RefreshTokenCmd,
+		authenticateAPIKeyCmd: deps.AuthenticateAPIKeyCmd,
+		validateTokenQuery:    deps.ValidateTokenQuery,
+		getUserProfileQuery:   deps.GetUserProfileQuery,
+		batchGetUsersQuery:    deps.BatchGetUsersQuery,
+		checkPermissionQuery:  deps.CheckPermissionQuery,
+	}
+}
+
+// Errors returned by the handlers below are AppErrors; the server's error interceptor maps them to gRPC status codes
+
+func (h *Handler) Register(ctx context.Context, req *authv1.RegisterRequest) (*authv1.RegisterResponse, error) {
+	resp, err := h.registerUserCmd.Execute(ctx, auth.RegisterRequest{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+		Name:     req.GetName(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.RegisterResponse{
+		User: &authv1.User{Id: resp.ID, Email: resp.Email, Name: resp.Name},
+	}, nil
+}
+
+func (h *Handler) Login(ctx context.Context, req *authv1.LoginRequest) (*authv1.LoginResponse, error) {
+	resp, err := h.loginUserCmd.Handle(ctx, auth.LoginRequest{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	}, refresh_token.Device{
+		UserAgent: req.GetUserAgent(),
+		IPAddress: req.GetIpAddress(),
+	})
+	if err != nil {
+		return nil, err
 	}
+
+	return &authv1.LoginResponse{
+		UserId:       resp.UserID,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		ExpiresIn:    resp.ExpiresIn,
+		MfaRequired:  resp.MFARequired,
+		MfaToken:     resp.MFAToken,
+	}, nil
+}
+
+func (h *Handler) Refresh(ctx context.Context, req *authv1.RefreshRequest) (*authv1.RefreshResponse, error) {
+	resp, err := h.refreshTokenCmd.Execute(ctx, commandcontracts.RefreshTokenCommandRequest{
+		RefreshToken: req.GetRefreshToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.RefreshResponse{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		ExpiresIn:    resp.ExpiresIn,
+	}, nil
+}
+
+func (h *Handler) GetUser(ctx context.Context, req *authv1.GetUserRequest) (*authv1.GetUserResponse, error) {
+	resp, err := h.getUserProfileQuery.Execute(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.GetUserResponse{
+		User: &authv1.User{Id: resp.ID, Email: resp.Email, Name: resp.Name},
+	}, nil
+}
+
+func (h *Handler) BatchGetUsers(ctx context.Context, req *authv1.BatchGetUsersRequest) (*authv1.BatchGetUsersResponse, error) {
+	profiles, err := h.batchGetUsersQuery.Execute(ctx, req.GetUserIds())
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*authv1.User, len(profiles))
+	for i, profile := range profiles {
+		users[i] = &authv1.User{Id: profile.ID, Email: profile.Email, Name: profile.Name}
+	}
+
+	return &authv1.BatchGetUsersResponse{Users: users}, nil
+}
+
+func (h *Handler) CheckPermission(ctx context.Context, req *authv1.CheckPermissionRequest) (*authv1.CheckPermissionResponse, error) {
+	resp, err := h.checkPermissionQuery.Execute(ctx, querycontracts.CheckPermissionQueryRequest{
+		UserID:   req.GetUserId(),
+		Resource: req.GetResource(),
+		Action:   req.GetAction(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authv1.CheckPermissionResponse{HasPermission: resp.HasPermission}, nil
 }
 
 func (h *Handler) ValidateToken(ctx context.Context, req *authv1.ValidateTokenRequest) (*authv1.ValidateTokenResponse, error) {
@@ -64,9 +177,38 @@ func (h *Handler) ValidateToken(ctx context.Context, req *authv1.ValidateTokenRe
 		Dur("total_ms", totalDuration).
 		Msg("gRPC request completed")
 
-	return &authv1.ValidateTokenResponse{
-		Valid:  resp.Valid,
-		UserId: resp.UserID,
-	}, nil
+	validateResp := &authv1.ValidateTokenResponse{
+		Valid:       resp.Valid,
+		UserId:      resp.UserID,
+		Roles:       resp.Roles,
+		Permissions: resp.Permissions,
+	}
+	if !resp.ExpiresAt.IsZero() {
+		validateResp.ExpiresAt = timestamppb.New(resp.ExpiresAt)
+	}
+	return validateResp, nil
 }
 
+// ValidateAPIKey resolves an X-API-Key header for other services; unknown, expired and revoked keys return valid=false
+func (h *Handler) ValidateAPIKey(ctx context.Context, req *authv1.ValidateAPIKeyRequest) (*authv1.ValidateAPIKeyResponse, error) {
+	resp, err := h.authenticateAPIKeyCmd.Execute(ctx, req.GetApiKey())
+	if err != nil {
+		var appErr *pkgerrors.AppError
+		if stderrors.As(err, &appErr) && appErr.Code == pkgerrors.CodeAPIKeyInvalid {
+			return &authv1.ValidateAPIKeyResponse{Valid: false}, nil
+		}
+		return nil, err
+	}
+
+	scopes := make([]string, len(resp.Scopes))
+	for i, scope := range resp.Scopes {
+		scopes[i] = scope.String()
+	}
+
+	return &authv1.ValidateAPIKeyResponse{
+		Valid:    true,
+		UserId:   resp.UserID,
+		ApiKeyId: resp.APIKeyID,
+		Scopes:   scopes,
+	}, nil
+}
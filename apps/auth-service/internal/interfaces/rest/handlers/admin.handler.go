@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminHandler handles administrative user endpoints
+type AdminHandler struct {
+	unlockUser commandcontracts.UnlockUserCommand
+}
+
+// NewAdminHandler creates a new AdminHandler
+func NewAdminHandler(unlockUser commandcontracts.UnlockUserCommand) *AdminHandler {
+	return &AdminHandler{
+		unlockUser: unlockUser,
+	}
+}
+
+// MountProtected mounts admin user routes; the group must require the users:unlock permission
+func (h *AdminHandler) MountProtected(group *gin.RouterGroup) {
+	group.POST("/users/:id/unlock", h.unlock)
+}
+
+// unlock handles POST /auth/admin/users/:id/unlock
+func (h *AdminHandler) unlock(c *gin.Context) {
+	err := h.unlockUser.Execute(c.Request.Context(), commandcontracts.UnlockUserCommandRequest{
+		UserID: c.Param("id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "User unlocked"})
+}
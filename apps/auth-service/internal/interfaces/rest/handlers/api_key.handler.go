@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHandler handles the user's API key endpoints
+type APIKeyHandler struct {
+	createAPIKey commandcontracts.CreateAPIKeyCommand
+	revokeAPIKey commandcontracts.RevokeAPIKeyCommand
+	listAPIKeys  querycontracts.ListAPIKeysQuery
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler
+func NewAPIKeyHandler(
+	createAPIKey commandcontracts.CreateAPIKeyCommand,
+	revokeAPIKey commandcontracts.RevokeAPIKeyCommand,
+	listAPIKeys querycontracts.ListAPIKeysQuery,
+) *APIKeyHandler {
+	return &APIKeyHandler{
+		createAPIKey: createAPIKey,
+		revokeAPIKey: revokeAPIKey,
+		listAPIKeys:  listAPIKeys,
+	}
+}
+
+// MountProtected mounts protected API key routes (require JWT middleware)
+func (h *APIKeyHandler) MountProtected(group *gin.RouterGroup) {
+	group.GET("/api-keys", h.list)
+	group.POST("/api-keys", h.create)
+	group.DELETE("/api-keys/:id", h.revoke)
+}
+
+// list handles GET /auth/api-keys
+func (h *APIKeyHandler) list(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	resp, err := h.listAPIKeys.Execute(c.Request.Context(), userID.(string))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// create handles POST /auth/api-keys
+func (h *APIKeyHandler) create(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	var req auth.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	resp, err := h.createAPIKey.Execute(c.Request.Context(), commandcontracts.CreateAPIKeyCommandRequest{
+		UserID:    userID.(string),
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, resp)
+}
+
+// revoke handles DELETE /auth/api-keys/:id
+func (h *APIKeyHandler) revoke(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	err := h.revokeAPIKey.Execute(c.Request.Context(), commandcontracts.RevokeAPIKeyCommandRequest{
+		UserID:   userID.(string),
+		APIKeyID: c.Param("id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "API key revoked successfully"})
+}
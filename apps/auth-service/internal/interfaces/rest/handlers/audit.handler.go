@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AuditHandler serves the audit trail recorded in the event store
+type AuditHandler struct {
+	listAuditEvents   querycontracts.ListAuditEventsQuery
+	exportAuditEvents querycontracts.ExportAuditEventsQuery
+	getUserAsOf       querycontracts.GetUserAsOfQuery
+	getRoleAsOf       querycontracts.GetRoleAsOfQuery
+}
+
+// NewAuditHandler creates a new AuditHandler
+func NewAuditHandler(
+	listAuditEvents querycontracts.ListAuditEventsQuery,
+	exportAuditEvents querycontracts.ExportAuditEventsQuery,
+	getUserAsOf querycontracts.GetUserAsOfQuery,
+	getRoleAsOf querycontracts.GetRoleAsOfQuery,
+) *AuditHandler {
+	return &AuditHandler{
+		listAuditEvents:   listAuditEvents,
+		exportAuditEvents: exportAuditEvents,
+		getUserAsOf:       getUserAsOf,
+		getRoleAsOf:       getRoleAsOf,
+	}
+}
+
+// MountProtected mounts audit routes; the group must require the audit:read permission
+func (h *AuditHandler) MountProtected(group *gin.RouterGroup) {
+	group.GET("/events", h.list)
+	group.GET("/events/export", h.export)
+	group.GET("/users/:id/state", h.userState)
+	group.GET("/roles/:id/state", h.roleState)
+}
+
+// list handles GET /auth/admin/audit/events
+func (h *AuditHandler) list(c *gin.Context) {
+	filter, ok := auditFilter(c)
+	if !ok {
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		c.Error(errors.NewInvalidRequestError("limit must be an integer"))
+		return
+	}
+
+	resp, err := h.listAuditEvents.Execute(c.Request.Context(), querycontracts.ListAuditEventsQueryRequest{
+		Filter: filter,
+		Cursor: c.Query("cursor"),
+		Limit:  limit,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// export handles GET /auth/admin/audit/events/export, one JSON event per line (NDJSON), oldest first
+func (h *AuditHandler) export(c *gin.Context) {
+	filter, ok := auditFilter(c)
+	if !ok {
+		return
+	}
+
+	c.Header("Content-Type", "application/x-ndjson")
+	c.Header("Content-Disposition", `attachment; filename="audit-events.ndjson"`)
+
+	encoder := json.NewEncoder(c.Writer)
+	err := h.exportAuditEvents.Execute(c.Request.Context(), filter, func(event auth.AuditEventResponse) error {
+		if err := encoder.Encode(event); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		if !c.Writer.Written() {
+			c.Error(err)
+			return
+		}
+		// Headers are already sent, the client sees a truncated export
+		c.Abort()
+		return
+	}
+
+	if !c.Writer.Written() {
+		c.Status(http.StatusOK)
+	}
+}
+
+// userState handles GET /auth/admin/audit/users/:id/state?at=RFC3339, the user replayed from its events
+func (h *AuditHandler) userState(c *gin.Context) {
+	asOf, ok := stateAsOf(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.getUserAsOf.Execute(c.Request.Context(), querycontracts.GetUserAsOfQueryRequest{
+		UserID: c.Param("id"),
+		AsOf:   asOf,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// roleState handles GET /auth/admin/audit/roles/:id/state?at=RFC3339, the role replayed from its events
+func (h *AuditHandler) roleState(c *gin.Context) {
+	asOf, ok := stateAsOf(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.getRoleAsOf.Execute(c.Request.Context(), querycontracts.GetRoleAsOfQueryRequest{
+		RoleID: c.Param("id"),
+		AsOf:   asOf,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// stateAsOf reads the optional at parameter; zero means now
+func stateAsOf(c *gin.Context) (time.Time, bool) {
+	value := c.Query("at")
+	if value == "" {
+		return time.Time{}, true
+	}
+	asOf, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		c.Error(errors.NewInvalidRequestError("at must be an RFC3339 timestamp"))
+		return time.Time{}, false
+	}
+	return asOf, true
+}
+
+// auditFilter reads the filter query parameters; it reports the error itself when they are invalid.
+// eventType may be repeated or comma separated, from/to are RFC3339 timestamps
+func auditFilter(c *gin.Context) (querycontracts.AuditEventFilter, bool) {
+	filter := querycontracts.AuditEventFilter{
+		AggregateID:   c.Query("aggregateId"),
+		AggregateType: c.Query("aggregateType"),
+		ActorID:       c.Query("actorId"),
+	}
+	for _, value := range c.QueryArray("eventType") {
+		for _, eventType := range strings.Split(value, ",") {
+			if eventType = strings.TrimSpace(eventType); eventType != "" {
+				filter.EventTypes = append(filter.EventTypes, eventType)
+			}
+		}
+	}
+
+	for param, target := range map[string]**time.Time{"from": &filter.From, "to": &filter.To} {
+		value := c.Query(param)
+		if value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			c.Error(errors.NewInvalidRequestError(param + " must be an RFC3339 timestamp"))
+			return querycontracts.AuditEventFilter{}, false
+		}
+		*target = &parsed
+	}
+	return filter, true
+}
@@ -5,6 +5,8 @@ import (
 
 	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
 	appcommand "golang-social-media/apps/auth-service/internal/application/command"
+	"golang-social-media/apps/auth-service/internal/domain/refresh_token"
+	"golang-social-media/apps/auth-service/internal/interfaces/rest/middleware"
 	"golang-social-media/pkg/contracts/auth"
 	"golang-social-media/pkg/errors"
 
@@ -32,6 +34,7 @@ func NewAuthHandler(
 func (h *AuthHandler) Mount(group *gin.RouterGroup) {
 	group.POST("/register", h.register)
 	group.POST("/login", h.login)
+	group.POST("/login/mfa", h.loginMFA)
 }
 
 // Register handles user registration (exported for direct use)
@@ -44,6 +47,11 @@ func (h *AuthHandler) Login(c *gin.Context) {
 	h.login(c)
 }
 
+// LoginMFA handles the second step of an MFA login (exported for direct use)
+func (h *AuthHandler) LoginMFA(c *gin.Context) {
+	h.loginMFA(c)
+}
+
 // register handles user registration
 func (h *AuthHandler) register(c *gin.Context) {
 	var req auth.RegisterRequest
@@ -69,7 +77,25 @@ func (h *AuthHandler) login(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.loginUser.Handle(c.Request.Context(), req)
+	resp, err := h.loginUser.Handle(c.Request.Context(), req, requestDevice(c))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+
+// loginMFA completes a login with the MFA challenge token and a TOTP or recovery code
+func (h *AuthHandler) loginMFA(c *gin.Context) {
+	var req auth.MFALoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	resp, err := h.loginUser.HandleMFA(c.Request.Context(), req, requestDevice(c))
 	if err != nil {
 		c.Error(err)
 		return
@@ -78,3 +104,10 @@ func (h *AuthHandler) login(c *gin.Context) {
 	c.JSON(http.StatusOK, resp)
 }
 
+// requestDevice describes the client a session is started for
+func requestDevice(c *gin.Context) refresh_token.Device {
+	return refresh_token.Device{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: middleware.GetClientIP(c),
+	}
+}
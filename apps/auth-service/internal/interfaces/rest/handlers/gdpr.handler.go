@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GDPRHandler handles account deletion and data export (GDPR) endpoints
+type GDPRHandler struct {
+	deleteUser         commandcontracts.DeleteUserCommand
+	requestDataExport  commandcontracts.RequestDataExportCommand
+	getGDPRRequest     querycontracts.GetGDPRRequestQuery
+	downloadDataExport querycontracts.DownloadDataExportQuery
+}
+
+// NewGDPRHandler creates a new GDPRHandler
+func NewGDPRHandler(
+	deleteUser commandcontracts.DeleteUserCommand,
+	requestDataExport commandcontracts.RequestDataExportCommand,
+	getGDPRRequest querycontracts.GetGDPRRequestQuery,
+	downloadDataExport querycontracts.DownloadDataExportQuery,
+) *GDPRHandler {
+	return &GDPRHandler{
+		deleteUser:         deleteUser,
+		requestDataExport:  requestDataExport,
+		getGDPRRequest:     getGDPRRequest,
+		downloadDataExport: downloadDataExport,
+	}
+}
+
+// MountProtected mounts the caller's deletion / export routes; the group must require an interactive login
+func (h *GDPRHandler) MountProtected(group *gin.RouterGroup) {
+	group.DELETE("/me", h.deleteMe)
+	group.POST("/me/export", h.exportMe)
+	group.GET("/me/gdpr/:id", h.getMyRequest)
+	group.GET("/me/export/:id/download", h.download)
+}
+
+// MountAdmin mounts admin deletion routes; the group must require the users:delete permission
+func (h *GDPRHandler) MountAdmin(group *gin.RouterGroup) {
+	group.DELETE("/users/:id", h.deleteUserByID)
+	group.GET("/gdpr/:id", h.getRequest)
+}
+
+// deleteMe handles DELETE /auth/me, the password confirms the deletion
+func (h *GDPRHandler) deleteMe(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	var req auth.DeleteAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Password == "" {
+		c.Error(errors.NewInvalidRequestError("Password is required to delete the account"))
+		return
+	}
+
+	resp, err := h.deleteUser.Execute(c.Request.Context(), commandcontracts.DeleteUserCommandRequest{
+		UserID:         userID.(string),
+		VerifyPassword: true,
+		Password:       req.Password,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// The other services erase their copies asynchronously, progress is at /auth/me/gdpr/:id
+	c.JSON(http.StatusAccepted, gin.H{"requestId": resp.RequestID})
+}
+
+// exportMe handles POST /auth/me/export
+func (h *GDPRHandler) exportMe(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	resp, err := h.requestDataExport.Execute(c.Request.Context(), commandcontracts.RequestDataExportCommandRequest{
+		UserID: userID.(string),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"requestId": resp.RequestID})
+}
+
+// getMyRequest handles GET /auth/me/gdpr/:id
+func (h *GDPRHandler) getMyRequest(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	resp, err := h.getGDPRRequest.Execute(c.Request.Context(), querycontracts.GetGDPRRequestQueryRequest{
+		RequestID: c.Param("id"),
+		UserID:    userID.(string),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// download handles GET /auth/me/export/:id/download, a zip with one JSON document per service
+func (h *GDPRHandler) download(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	archive, err := h.downloadDataExport.Execute(c.Request.Context(), querycontracts.DownloadDataExportQueryRequest{
+		RequestID: c.Param("id"),
+		UserID:    userID.(string),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="data-export-`+c.Param("id")+`.zip"`)
+	c.Data(http.StatusOK, "application/zip", archive)
+}
+
+// deleteUserByID handles DELETE /auth/admin/users/:id
+func (h *GDPRHandler) deleteUserByID(c *gin.Context) {
+	resp, err := h.deleteUser.Execute(c.Request.Context(), commandcontracts.DeleteUserCommandRequest{
+		UserID: c.Param("id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{"requestId": resp.RequestID})
+}
+
+// getRequest handles GET /auth/admin/gdpr/:id
+func (h *GDPRHandler) getRequest(c *gin.Context) {
+	resp, err := h.getGDPRRequest.Execute(c.Request.Context(), querycontracts.GetGDPRRequestQueryRequest{
+		RequestID: c.Param("id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+
+	"github.com/gin-gonic/gin"
+)
+
+// JWKSHandler serves the public signing keys
+type JWKSHandler struct {
+	getJWKS querycontracts.GetJWKSQuery
+}
+
+// NewJWKSHandler creates a new JWKSHandler
+func NewJWKSHandler(getJWKS querycontracts.GetJWKSQuery) *JWKSHandler {
+	return &JWKSHandler{
+		getJWKS: getJWKS,
+	}
+}
+
+// Mount mounts the JWKS route on the root router (outside /auth, per RFC 8615)
+func (h *JWKSHandler) Mount(router gin.IRoutes) {
+	router.GET("/.well-known/jwks.json", h.getKeySet)
+}
+
+// getKeySet handles GET /.well-known/jwks.json
+func (h *JWKSHandler) getKeySet(c *gin.Context) {
+	resp, err := h.getJWKS.Execute(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// Verifiers cache the key set; keep it short so rotations propagate quickly
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, resp)
+}
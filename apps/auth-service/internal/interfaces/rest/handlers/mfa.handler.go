@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// MFAHandler handles multi-factor authentication enrollment endpoints
+type MFAHandler struct {
+	enrollMFA  commandcontracts.EnrollMFACommand
+	confirmMFA commandcontracts.ConfirmMFACommand
+	disableMFA commandcontracts.DisableMFACommand
+}
+
+// NewMFAHandler creates a new MFAHandler
+func NewMFAHandler(
+	enrollMFA commandcontracts.EnrollMFACommand,
+	confirmMFA commandcontracts.ConfirmMFACommand,
+	disableMFA commandcontracts.DisableMFACommand,
+) *MFAHandler {
+	return &MFAHandler{
+		enrollMFA:  enrollMFA,
+		confirmMFA: confirmMFA,
+		disableMFA: disableMFA,
+	}
+}
+
+// MountProtected mounts protected MFA routes (require JWT middleware)
+func (h *MFAHandler) MountProtected(group *gin.RouterGroup) {
+	group.POST("/mfa/enroll", h.enroll)
+	group.POST("/mfa/enroll/confirm", h.confirm)
+	group.POST("/mfa/disable", h.disable)
+}
+
+// enroll handles POST /auth/mfa/enroll
+func (h *MFAHandler) enroll(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	resp, err := h.enrollMFA.Execute(c.Request.Context(), commandcontracts.EnrollMFACommandRequest{
+		UserID: userID.(string),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// confirm handles POST /auth/mfa/enroll/confirm
+func (h *MFAHandler) confirm(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	var req auth.ConfirmMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	err := h.confirmMFA.Execute(c.Request.Context(), commandcontracts.ConfirmMFACommandRequest{
+		UserID: userID.(string),
+		Code:   req.Code,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Multi-factor authentication enabled"})
+}
+
+// disable handles POST /auth/mfa/disable
+func (h *MFAHandler) disable(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	var req auth.DisableMFARequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	err := h.disableMFA.Execute(c.Request.Context(), commandcontracts.DisableMFACommandRequest{
+		UserID: userID.(string),
+		Code:   req.Code,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Multi-factor authentication disabled"})
+}
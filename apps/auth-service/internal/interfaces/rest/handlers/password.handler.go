@@ -31,7 +31,7 @@ func (h *PasswordHandler) Mount(group *gin.RouterGroup) {
 func (h *PasswordHandler) changeUserPassword(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
-		c.Error(errors.NewUnauthorizedError("User ID not found in context"))
+		c.Error(errors.NewUnauthorizedError())
 		return
 	}
 
@@ -59,7 +59,7 @@ func (h *ProfileHandler) getProfileByID(c *gin.Context) {
 func (h *ProfileHandler) getCurrentUserProfile(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
-		c.Error(errors.NewUnauthorizedError("User ID not found in context"))
+		c.Error(errors.NewUnauthorizedError())
 		return
 	}
 
@@ -80,7 +80,7 @@ func (h *ProfileHandler) getCurrentUserProfile(c *gin.Context) {
 func (h *ProfileHandler) updateUserProfile(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
-		c.Error(errors.NewUnauthorizedError("User ID not found in context"))
+		c.Error(errors.NewUnauthorizedError())
 		return
 	}
 
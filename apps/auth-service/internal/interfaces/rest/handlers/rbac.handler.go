@@ -0,0 +1,418 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RBACHandler handles role and permission administration endpoints
+type RBACHandler struct {
+	createRole         commandcontracts.CreateRoleCommand
+	updateRole         commandcontracts.UpdateRoleCommand
+	deleteRole         commandcontracts.DeleteRoleCommand
+	setRoleParent      commandcontracts.SetRoleParentCommand
+	createPermission   commandcontracts.CreatePermissionCommand
+	updatePermission   commandcontracts.UpdatePermissionCommand
+	deletePermission   commandcontracts.DeletePermissionCommand
+	grantPermission    commandcontracts.AssignPermissionToRoleCommand
+	revokePermission   commandcontracts.RevokePermissionFromRoleCommand
+	assignRole         commandcontracts.AssignRoleCommand
+	revokeRole         commandcontracts.RevokeRoleCommand
+	listRoles          querycontracts.ListRolesQuery
+	listPermissions    querycontracts.ListPermissionsQuery
+	getRolePermissions querycontracts.GetRolePermissionsQuery
+	getUserRoles       querycontracts.GetUserRolesQuery
+	getUserPermissions querycontracts.GetUserPermissionsQuery
+}
+
+// NewRBACHandler creates a new RBACHandler
+func NewRBACHandler(
+	createRole commandcontracts.CreateRoleCommand,
+	updateRole commandcontracts.UpdateRoleCommand,
+	deleteRole commandcontracts.DeleteRoleCommand,
+	setRoleParent commandcontracts.SetRoleParentCommand,
+	createPermission commandcontracts.CreatePermissionCommand,
+	updatePermission commandcontracts.UpdatePermissionCommand,
+	deletePermission commandcontracts.DeletePermissionCommand,
+	grantPermission commandcontracts.AssignPermissionToRoleCommand,
+	revokePermission commandcontracts.RevokePermissionFromRoleCommand,
+	assignRole commandcontracts.AssignRoleCommand,
+	revokeRole commandcontracts.RevokeRoleCommand,
+	listRoles querycontracts.ListRolesQuery,
+	listPermissions querycontracts.ListPermissionsQuery,
+	getRolePermissions querycontracts.GetRolePermissionsQuery,
+	getUserRoles querycontracts.GetUserRolesQuery,
+	getUserPermissions querycontracts.GetUserPermissionsQuery,
+) *RBACHandler {
+	return &RBACHandler{
+		createRole:         createRole,
+		updateRole:         updateRole,
+		deleteRole:         deleteRole,
+		setRoleParent:      setRoleParent,
+		createPermission:   createPermission,
+		updatePermission:   updatePermission,
+		deletePermission:   deletePermission,
+		grantPermission:    grantPermission,
+		revokePermission:   revokePermission,
+		assignRole:         assignRole,
+		revokeRole:         revokeRole,
+		listRoles:          listRoles,
+		listPermissions:    listPermissions,
+		getRolePermissions: getRolePermissions,
+		getUserRoles:       getUserRoles,
+		getUserPermissions: getUserPermissions,
+	}
+}
+
+// MountProtected mounts RBAC admin routes; the group must require the rbac:manage permission
+func (h *RBACHandler) MountProtected(group *gin.RouterGroup) {
+	group.GET("/roles", h.getRoles)
+	group.POST("/roles", h.postRole)
+	group.PUT("/roles/:id", h.putRole)
+	group.DELETE("/roles/:id", h.deleteRoleByID)
+	group.PUT("/roles/:id/parent", h.putRoleParent)
+	group.GET("/roles/:id/permissions", h.getRolePermissionsByID)
+	group.POST("/roles/:id/permissions", h.postRolePermission)
+	group.DELETE("/roles/:id/permissions/:permissionId", h.deleteRolePermission)
+
+	group.GET("/permissions", h.getPermissions)
+	group.POST("/permissions", h.postPermission)
+	group.PUT("/permissions/:id", h.putPermission)
+	group.DELETE("/permissions/:id", h.deletePermissionByID)
+
+	group.GET("/users/:id/roles", h.getUserRolesByID)
+	group.POST("/users/:id/roles", h.postUserRole)
+	group.DELETE("/users/:id/roles/:roleId", h.deleteUserRole)
+	group.GET("/users/:id/permissions", h.getUserPermissionsByID)
+}
+
+// getRoles handles GET /auth/admin/rbac/roles?limit=&offset=
+func (h *RBACHandler) getRoles(c *gin.Context) {
+	limit, offset, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.listRoles.Execute(c.Request.Context(), querycontracts.ListRolesQueryRequest{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// postRole handles POST /auth/admin/rbac/roles
+func (h *RBACHandler) postRole(c *gin.Context) {
+	var req auth.CreateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	resp, err := h.createRole.Execute(c.Request.Context(), commandcontracts.CreateRoleCommandRequest{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, auth.RoleResponse{
+		ID:          resp.ID,
+		Name:        resp.Name,
+		Description: resp.Description,
+	})
+}
+
+// putRole handles PUT /auth/admin/rbac/roles/:id
+func (h *RBACHandler) putRole(c *gin.Context) {
+	var req auth.UpdateRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	resp, err := h.updateRole.Execute(c.Request.Context(), commandcontracts.UpdateRoleCommandRequest{
+		RoleID:      c.Param("id"),
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, auth.RoleResponse{
+		ID:          resp.ID,
+		Name:        resp.Name,
+		Description: resp.Description,
+	})
+}
+
+// deleteRoleByID handles DELETE /auth/admin/rbac/roles/:id
+func (h *RBACHandler) deleteRoleByID(c *gin.Context) {
+	err := h.deleteRole.Execute(c.Request.Context(), commandcontracts.DeleteRoleCommandRequest{
+		RoleID: c.Param("id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Role deleted"})
+}
+
+// putRoleParent handles PUT /auth/admin/rbac/roles/:id/parent
+func (h *RBACHandler) putRoleParent(c *gin.Context) {
+	var req auth.SetRoleParentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	err := h.setRoleParent.Execute(c.Request.Context(), commandcontracts.SetRoleParentCommandRequest{
+		RoleID:   c.Param("id"),
+		ParentID: req.ParentID,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Role parent updated"})
+}
+
+// getRolePermissionsByID handles GET /auth/admin/rbac/roles/:id/permissions
+func (h *RBACHandler) getRolePermissionsByID(c *gin.Context) {
+	resp, err := h.getRolePermissions.Execute(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	permissions := make([]auth.PermissionResponse, 0, len(resp.Permissions))
+	for _, perm := range resp.Permissions {
+		permissions = append(permissions, auth.PermissionResponse{
+			ID:       perm.ID,
+			Name:     perm.Name,
+			Resource: perm.Resource,
+			Action:   perm.Action,
+		})
+	}
+	c.JSON(http.StatusOK, auth.RolePermissionsResponse{
+		RoleID:      resp.RoleID,
+		Permissions: permissions,
+	})
+}
+
+// postRolePermission handles POST /auth/admin/rbac/roles/:id/permissions
+func (h *RBACHandler) postRolePermission(c *gin.Context) {
+	var req auth.GrantPermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.PermissionID == "" {
+		c.Error(errors.NewInvalidRequestError("permissionId is required"))
+		return
+	}
+
+	err := h.grantPermission.Execute(c.Request.Context(), commandcontracts.AssignPermissionToRoleCommandRequest{
+		RoleID:       c.Param("id"),
+		PermissionID: req.PermissionID,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Permission granted"})
+}
+
+// deleteRolePermission handles DELETE /auth/admin/rbac/roles/:id/permissions/:permissionId
+func (h *RBACHandler) deleteRolePermission(c *gin.Context) {
+	err := h.revokePermission.Execute(c.Request.Context(), commandcontracts.RevokePermissionFromRoleCommandRequest{
+		RoleID:       c.Param("id"),
+		PermissionID: c.Param("permissionId"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Permission revoked"})
+}
+
+// getPermissions handles GET /auth/admin/rbac/permissions?limit=&offset=
+func (h *RBACHandler) getPermissions(c *gin.Context) {
+	limit, offset, ok := pageParams(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.listPermissions.Execute(c.Request.Context(), querycontracts.ListPermissionsQueryRequest{
+		Limit:  limit,
+		Offset: offset,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// postPermission handles POST /auth/admin/rbac/permissions
+func (h *RBACHandler) postPermission(c *gin.Context) {
+	var req auth.CreatePermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	resp, err := h.createPermission.Execute(c.Request.Context(), commandcontracts.CreatePermissionCommandRequest{
+		Name:     req.Name,
+		Resource: req.Resource,
+		Action:   req.Action,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, auth.PermissionResponse{
+		ID:       resp.ID,
+		Name:     resp.Name,
+		Resource: resp.Resource,
+		Action:   resp.Action,
+	})
+}
+
+// putPermission handles PUT /auth/admin/rbac/permissions/:id
+func (h *RBACHandler) putPermission(c *gin.Context) {
+	var req auth.UpdatePermissionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	resp, err := h.updatePermission.Execute(c.Request.Context(), commandcontracts.UpdatePermissionCommandRequest{
+		PermissionID: c.Param("id"),
+		Name:         req.Name,
+		Resource:     req.Resource,
+		Action:       req.Action,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, auth.PermissionResponse{
+		ID:       resp.ID,
+		Name:     resp.Name,
+		Resource: resp.Resource,
+		Action:   resp.Action,
+	})
+}
+
+// deletePermissionByID handles DELETE /auth/admin/rbac/permissions/:id
+func (h *RBACHandler) deletePermissionByID(c *gin.Context) {
+	err := h.deletePermission.Execute(c.Request.Context(), commandcontracts.DeletePermissionCommandRequest{
+		PermissionID: c.Param("id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Permission deleted"})
+}
+
+// getUserRolesByID handles GET /auth/admin/rbac/users/:id/roles
+func (h *RBACHandler) getUserRolesByID(c *gin.Context) {
+	resp, err := h.getUserRoles.Execute(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	roles := make([]auth.RoleResponse, 0, len(resp.RoleIDs))
+	for i, roleID := range resp.RoleIDs {
+		roles = append(roles, auth.RoleResponse{
+			ID:   roleID,
+			Name: resp.RoleNames[i],
+		})
+	}
+	c.JSON(http.StatusOK, auth.UserRolesResponse{
+		UserID: resp.UserID,
+		Roles:  roles,
+	})
+}
+
+// postUserRole handles POST /auth/admin/rbac/users/:id/roles
+func (h *RBACHandler) postUserRole(c *gin.Context) {
+	var req auth.AssignRoleRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.RoleID == "" {
+		c.Error(errors.NewInvalidRequestError("roleId is required"))
+		return
+	}
+
+	err := h.assignRole.Execute(c.Request.Context(), commandcontracts.AssignRoleCommandRequest{
+		UserID: c.Param("id"),
+		RoleID: req.RoleID,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Role assigned"})
+}
+
+// deleteUserRole handles DELETE /auth/admin/rbac/users/:id/roles/:roleId
+func (h *RBACHandler) deleteUserRole(c *gin.Context) {
+	err := h.revokeRole.Execute(c.Request.Context(), commandcontracts.RevokeRoleCommandRequest{
+		UserID: c.Param("id"),
+		RoleID: c.Param("roleId"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Role revoked"})
+}
+
+// getUserPermissionsByID handles GET /auth/admin/rbac/users/:id/permissions
+func (h *RBACHandler) getUserPermissionsByID(c *gin.Context) {
+	resp, err := h.getUserPermissions.Execute(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// pageParams reads the optional limit/offset query parameters; it reports the error itself when they are not integers
+func pageParams(c *gin.Context) (int, int, bool) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "0"))
+	if err != nil {
+		c.Error(errors.NewInvalidRequestError("limit must be an integer"))
+		return 0, 0, false
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil {
+		c.Error(errors.NewInvalidRequestError("offset must be an integer"))
+		return 0, 0, false
+	}
+	return limit, offset, true
+}
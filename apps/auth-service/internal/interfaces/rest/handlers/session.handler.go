@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// SessionHandler handles session (logged-in device) endpoints
+type SessionHandler struct {
+	listSessions        querycontracts.ListSessionsQuery
+	revokeSession       commandcontracts.RevokeSessionCommand
+	revokeOtherSessions commandcontracts.RevokeOtherSessionsCommand
+}
+
+// NewSessionHandler creates a new SessionHandler
+func NewSessionHandler(
+	listSessions querycontracts.ListSessionsQuery,
+	revokeSession commandcontracts.RevokeSessionCommand,
+	revokeOtherSessions commandcontracts.RevokeOtherSessionsCommand,
+) *SessionHandler {
+	return &SessionHandler{
+		listSessions:        listSessions,
+		revokeSession:       revokeSession,
+		revokeOtherSessions: revokeOtherSessions,
+	}
+}
+
+// MountProtected mounts protected session routes (require JWT middleware)
+func (h *SessionHandler) MountProtected(group *gin.RouterGroup) {
+	group.GET("/sessions", h.getSessions)
+	group.DELETE("/sessions/:id", h.deleteSession)
+	group.POST("/sessions/revoke-others", h.revokeOthers)
+}
+
+// getSessions handles GET /auth/sessions
+func (h *SessionHandler) getSessions(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	resp, err := h.listSessions.Execute(c.Request.Context(), querycontracts.ListSessionsQueryRequest{
+		UserID:           userID.(string),
+		CurrentSessionID: c.GetString("session_id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// deleteSession handles DELETE /auth/sessions/:id
+func (h *SessionHandler) deleteSession(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	err := h.revokeSession.Execute(c.Request.Context(), commandcontracts.RevokeSessionCommandRequest{
+		UserID:    userID.(string),
+		SessionID: c.Param("id"),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Session revoked successfully"})
+}
+
+// revokeOthers handles POST /auth/sessions/revoke-others ("log out everywhere else")
+func (h *SessionHandler) revokeOthers(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	sessionID := c.GetString("session_id")
+	if sessionID == "" {
+		c.Error(errors.NewInvalidRequestError("Token is not bound to a session"))
+		return
+	}
+
+	resp, err := h.revokeOtherSessions.Execute(c.Request.Context(), commandcontracts.RevokeOtherSessionsCommandRequest{
+		UserID:           userID.(string),
+		CurrentSessionID: sessionID,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, auth.RevokeOtherSessionsResponse{Revoked: resp.Revoked})
+}
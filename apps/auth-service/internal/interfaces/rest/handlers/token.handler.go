@@ -51,7 +51,7 @@ func (h *TokenHandler) MountProtected(group *gin.RouterGroup) {
 func (h *TokenHandler) logoutUser(c *gin.Context) {
 	userID, exists := c.Get("user_id")
 	if !exists {
-		c.Error(errors.NewUnauthorizedError("User ID not found in context"))
+		c.Error(errors.NewUnauthorizedError())
 		return
 	}
 
@@ -62,8 +62,9 @@ func (h *TokenHandler) logoutUser(c *gin.Context) {
 	}
 
 	err := h.logout.Execute(c.Request.Context(), commandcontracts.LogoutUserCommandRequest{
-		UserID: userID.(string),
-		Token:  token,
+		UserID:    userID.(string),
+		Token:     token,
+		SessionID: c.GetString("session_id"),
 	})
 	if err != nil {
 		c.Error(err)
@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"net/http"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	"golang-social-media/pkg/contracts/auth"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// VerificationHandler handles email verification and forgot-password endpoints
+type VerificationHandler struct {
+	sendVerificationEmail commandcontracts.SendVerificationEmailCommand
+	verifyEmail           commandcontracts.VerifyEmailCommand
+	requestPasswordReset  commandcontracts.RequestPasswordResetCommand
+	resetPassword         commandcontracts.ResetPasswordCommand
+}
+
+// NewVerificationHandler creates a new VerificationHandler
+func NewVerificationHandler(
+	sendVerificationEmail commandcontracts.SendVerificationEmailCommand,
+	verifyEmail commandcontracts.VerifyEmailCommand,
+	requestPasswordReset commandcontracts.RequestPasswordResetCommand,
+	resetPassword commandcontracts.ResetPasswordCommand,
+) *VerificationHandler {
+	return &VerificationHandler{
+		sendVerificationEmail: sendVerificationEmail,
+		verifyEmail:           verifyEmail,
+		requestPasswordReset:  requestPasswordReset,
+		resetPassword:         resetPassword,
+	}
+}
+
+// Mount mounts public routes; the links in the emails are the only credential
+func (h *VerificationHandler) Mount(group *gin.RouterGroup) {
+	group.POST("/verify-email", h.verify)
+	group.POST("/password/forgot", h.forgotPassword)
+	group.POST("/password/reset", h.reset)
+}
+
+// MountProtected mounts protected routes (require JWT middleware)
+func (h *VerificationHandler) MountProtected(group *gin.RouterGroup) {
+	group.POST("/verify-email/resend", h.resend)
+}
+
+// verify handles POST /auth/verify-email
+func (h *VerificationHandler) verify(c *gin.Context) {
+	var req auth.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	err := h.verifyEmail.Execute(c.Request.Context(), commandcontracts.VerifyEmailCommandRequest{
+		Token: req.Token,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Email verified"})
+}
+
+// resend handles POST /auth/verify-email/resend
+func (h *VerificationHandler) resend(c *gin.Context) {
+	userID, exists := c.Get("user_id")
+	if !exists {
+		c.Error(errors.NewUnauthorizedError())
+		return
+	}
+
+	err := h.sendVerificationEmail.Execute(c.Request.Context(), commandcontracts.SendVerificationEmailCommandRequest{
+		UserID: userID.(string),
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Verification email sent"})
+}
+
+// forgotPassword handles POST /auth/password/forgot
+func (h *VerificationHandler) forgotPassword(c *gin.Context) {
+	var req auth.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	err := h.requestPasswordReset.Execute(c.Request.Context(), commandcontracts.RequestPasswordResetCommandRequest{
+		Email: req.Email,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// Same response whether or not the email belongs to an account
+	c.JSON(http.StatusAccepted, gin.H{"message": "If the email is registered, a password reset link has been sent"})
+}
+
+// reset handles POST /auth/password/reset
+func (h *VerificationHandler) reset(c *gin.Context) {
+	var req auth.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(errors.NewInvalidRequestError("Invalid request body"))
+		return
+	}
+
+	err := h.resetPassword.Execute(c.Request.Context(), commandcontracts.ResetPasswordCommandRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Password reset successfully"})
+}
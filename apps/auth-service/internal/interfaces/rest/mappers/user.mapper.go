@@ -36,8 +36,8 @@ func (m *UserDTOMapper) ToProfileResponse(u user.User) auth.ProfileResponse {
 // ToLoginResponse converts user ID and token to LoginResponse DTO
 func (m *UserDTOMapper) ToLoginResponse(userID, token string) auth.LoginResponse {
 	return auth.LoginResponse{
-		UserID: userID,
-		Token:  token,
+		UserID:      userID,
+		AccessToken: token,
 	}
 }
 
@@ -2,7 +2,6 @@ package middleware
 
 import (
 	"net"
-	"net/http"
 	"strings"
 
 	"golang-social-media/pkg/errors"
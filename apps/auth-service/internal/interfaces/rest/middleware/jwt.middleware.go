@@ -1,35 +1,90 @@
 package middleware
 
 import (
-	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
+	"net/http"
+
+	commandcontracts "golang-social-media/apps/auth-service/internal/application/command/contracts"
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/pkg/auditctx"
 	"golang-social-media/pkg/errors"
 
 	"github.com/gin-gonic/gin"
 )
 
-// JWTAuthMiddleware validates JWT token and extracts user ID, roles, and permissions
-func JWTAuthMiddleware(jwtService *jwt.Service) gin.HandlerFunc {
+const (
+	// APIKeyHeader carries an API key as an alternative to a bearer JWT
+	APIKeyHeader = "X-API-Key"
+	// APIKeyIDKey is set in the context when the request was authenticated with an API key
+	APIKeyIDKey = "api_key_id"
+	// APIKeyScopesKey holds the []permission.Grant the API key is limited to
+	APIKeyScopesKey = "api_key_scopes"
+)
+
+// JWTAuthMiddleware validates the bearer JWT, or the X-API-Key header when no bearer token is sent,
+// and extracts user ID, roles, and permissions. Bearer tokens go through validateToken, so a
+// blacklisted token or one of a revoked session is rejected before it expires.
+func JWTAuthMiddleware(validateToken querycontracts.ValidateTokenQuery, authenticateAPIKey commandcontracts.AuthenticateAPIKeyCommand) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		token := extractTokenFromHeader(c)
 		if token == "" {
-			c.Error(errors.NewUnauthorizedError("Missing Authorization header"))
+			if apiKey := c.GetHeader(APIKeyHeader); apiKey != "" {
+				authenticateWithAPIKey(c, authenticateAPIKey, apiKey)
+				return
+			}
+			c.Error(errors.NewAppErrorWithMessage(errors.CodeUnauthorized, http.StatusUnauthorized, "Missing Authorization header"))
 			c.Abort()
 			return
 		}
 
-		// Validate token and get full claims
-		claims, err := jwtService.ValidateTokenWithClaims(token)
+		// Validate signature and expiry, blacklist and session family
+		resp, err := validateToken.Execute(c.Request.Context(), token)
 		if err != nil {
-			c.Error(errors.NewUnauthorizedError("Invalid or expired token"))
+			c.Error(err)
+			c.Abort()
+			return
+		}
+		if !resp.Valid {
+			c.Error(errors.NewAppErrorWithMessage(errors.CodeUnauthorized, http.StatusUnauthorized, "Invalid or expired token"))
 			c.Abort()
 			return
 		}
 
 		// Set user info in context
-		c.Set("user_id", claims.UserID)
-		c.Set("roles", claims.Roles)
-		c.Set("permissions", claims.Permissions)
+		c.Set("user_id", resp.UserID)
+		c.Set("session_id", resp.SessionID)
+		c.Set("roles", resp.Roles)
+		c.Set("permissions", resp.Permissions)
 		c.Set("token", token)
+		c.Request = c.Request.WithContext(auditctx.WithActor(c.Request.Context(), resp.UserID, ""))
+		c.Next()
+	}
+}
+
+// authenticateWithAPIKey resolves the key's owner; RequirePermission limits the request to the key's scopes
+func authenticateWithAPIKey(c *gin.Context, authenticateAPIKey commandcontracts.AuthenticateAPIKeyCommand, apiKey string) {
+	resp, err := authenticateAPIKey.Execute(c.Request.Context(), apiKey)
+	if err != nil {
+		c.Error(err)
+		c.Abort()
+		return
+	}
+
+	c.Set("user_id", resp.UserID)
+	c.Set(APIKeyIDKey, resp.APIKeyID)
+	c.Set(APIKeyScopesKey, resp.Scopes)
+	c.Request = c.Request.WithContext(auditctx.WithActor(c.Request.Context(), resp.UserID, resp.APIKeyID))
+	c.Next()
+}
+
+// DenyAPIKey rejects requests authenticated with an API key, for account management
+// endpoints that need an interactive login. Must run after JWTAuthMiddleware.
+func DenyAPIKey() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString(APIKeyIDKey) != "" {
+			c.Error(errors.NewForbiddenError())
+			c.Abort()
+			return
+		}
 		c.Next()
 	}
 }
@@ -46,4 +101,3 @@ func extractTokenFromHeader(c *gin.Context) string {
 	}
 	return ""
 }
-
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
+	"golang-social-media/apps/auth-service/internal/domain/permission"
+	"golang-social-media/pkg/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission allows the request only if the authenticated user has the permission
+// through one of their roles and, for API key requests, the key's scopes cover it. Must run after JWTAuthMiddleware.
+func RequirePermission(checkPermission querycontracts.CheckPermissionQuery, resource, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID := c.GetString("user_id")
+		if userID == "" {
+			c.Error(errors.NewUnauthorizedError())
+			c.Abort()
+			return
+		}
+
+		if scopes, ok := c.Get(APIKeyScopesKey); ok {
+			if grants, _ := scopes.([]permission.Grant); !permission.AnyMatches(grants, resource, action) {
+				c.Error(errors.NewForbiddenError())
+				c.Abort()
+				return
+			}
+		}
+
+		resp, err := checkPermission.Execute(c.Request.Context(), querycontracts.CheckPermissionQueryRequest{
+			UserID:   userID,
+			Resource: resource,
+			Action:   action,
+		})
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+		if !resp.HasPermission {
+			c.Error(errors.NewForbiddenError())
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
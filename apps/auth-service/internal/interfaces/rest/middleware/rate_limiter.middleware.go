@@ -186,7 +186,7 @@ func GetClientIP(c *gin.Context) string {
 	ip := c.GetHeader("X-Forwarded-For")
 	if ip != "" {
 		// X-Forwarded-For can contain multiple IPs, take the first one
-		if idx := 0; idx < len(ip); idx++ {
+		for idx := 0; idx < len(ip); idx++ {
 			if ip[idx] == ',' {
 				ip = ip[:idx]
 				break
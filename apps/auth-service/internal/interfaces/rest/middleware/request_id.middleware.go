@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"golang-social-media/apps/auth-service/internal/pkg/auditctx"
+
 	"github.com/google/uuid"
 	"github.com/gin-gonic/gin"
 )
@@ -9,7 +11,8 @@ const RequestIDKey = "request_id"
 const RequestIDHeader = "X-Request-ID"
 
 // RequestIDMiddleware creates a middleware that generates a unique request ID
-// and adds it to the context and response headers
+// and adds it to the context and response headers.
+// The request ID and client IP are also stored in the request context, so commands record them in the event store
 func RequestIDMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Check if request ID already exists in header
@@ -21,6 +24,7 @@ func RequestIDMiddleware() gin.HandlerFunc {
 
 		// Set in context
 		c.Set(RequestIDKey, requestID)
+		c.Request = c.Request.WithContext(auditctx.WithRequest(c.Request.Context(), requestID, GetClientIP(c), c.Request.UserAgent()))
 
 		// Set in response header
 		c.Header(RequestIDHeader, requestID)
@@ -32,7 +32,7 @@ func RequestLogMiddleware() gin.HandlerFunc {
 		bodySize := c.Writer.Size()
 
 		// Build log entry
-		logEvent := logger.Component("auth.middleware.request_log").
+		logEvent := logger.ComponentCtx(c.Request.Context(), "auth.middleware.request_log").
 			Info().
 			Str("method", method).
 			Str("path", path).
@@ -55,7 +55,7 @@ func RequestLogMiddleware() gin.HandlerFunc {
 		// Log error if any
 		if len(c.Errors) > 0 {
 			for _, err := range c.Errors {
-				logEvent = logEvent.Err(err).Str("error_type", err.Type.String())
+				logEvent = logEvent.Err(err).Uint64("error_type", uint64(err.Type))
 			}
 		}
 
@@ -10,19 +10,29 @@ import (
 	querycontracts "golang-social-media/apps/auth-service/internal/application/query/contracts"
 	"golang-social-media/apps/auth-service/internal/interfaces/rest/handlers"
 	"golang-social-media/apps/auth-service/internal/interfaces/rest/middleware"
-	"golang-social-media/apps/auth-service/internal/infrastructure/jwt"
 	"golang-social-media/pkg/cache"
 	"golang-social-media/pkg/errors"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/gin-gonic/gin"
 )
 
 // Handlers holds all HTTP handlers
 type Handlers struct {
-	Auth     *handlers.AuthHandler
-	Profile  *handlers.ProfileHandler
-	Password *handlers.PasswordHandler
-	Token    *handlers.TokenHandler
+	Auth         *handlers.AuthHandler
+	Profile      *handlers.ProfileHandler
+	Password     *handlers.PasswordHandler
+	Token        *handlers.TokenHandler
+	JWKS         *handlers.JWKSHandler
+	Session      *handlers.SessionHandler
+	MFA          *handlers.MFAHandler
+	Verification *handlers.VerificationHandler
+	Admin        *handlers.AdminHandler
+	RBAC         *handlers.RBACHandler
+	APIKey       *handlers.APIKeyHandler
+	Audit        *handlers.AuditHandler
+	GDPR         *handlers.GDPRHandler
 }
 
 // NewAuthHandler creates a new AuthHandler
@@ -57,23 +67,135 @@ func NewTokenHandler(
 	return handlers.NewTokenHandler(logout, refresh, revoke, validate)
 }
 
+// NewJWKSHandler creates a new JWKSHandler
+func NewJWKSHandler(getJWKS querycontracts.GetJWKSQuery) *handlers.JWKSHandler {
+	return handlers.NewJWKSHandler(getJWKS)
+}
+
+// NewSessionHandler creates a new SessionHandler
+func NewSessionHandler(
+	listSessions querycontracts.ListSessionsQuery,
+	revokeSession commandcontracts.RevokeSessionCommand,
+	revokeOtherSessions commandcontracts.RevokeOtherSessionsCommand,
+) *handlers.SessionHandler {
+	return handlers.NewSessionHandler(listSessions, revokeSession, revokeOtherSessions)
+}
+
+// NewMFAHandler creates a new MFAHandler
+func NewMFAHandler(
+	enrollMFA commandcontracts.EnrollMFACommand,
+	confirmMFA commandcontracts.ConfirmMFACommand,
+	disableMFA commandcontracts.DisableMFACommand,
+) *handlers.MFAHandler {
+	return handlers.NewMFAHandler(enrollMFA, confirmMFA, disableMFA)
+}
+
+// NewVerificationHandler creates a new VerificationHandler
+func NewVerificationHandler(
+	sendVerificationEmail commandcontracts.SendVerificationEmailCommand,
+	verifyEmail commandcontracts.VerifyEmailCommand,
+	requestPasswordReset commandcontracts.RequestPasswordResetCommand,
+	resetPassword commandcontracts.ResetPasswordCommand,
+) *handlers.VerificationHandler {
+	return handlers.NewVerificationHandler(sendVerificationEmail, verifyEmail, requestPasswordReset, resetPassword)
+}
+
+// NewAdminHandler creates a new AdminHandler
+func NewAdminHandler(unlockUser commandcontracts.UnlockUserCommand) *handlers.AdminHandler {
+	return handlers.NewAdminHandler(unlockUser)
+}
+
+// NewRBACHandler creates a new RBACHandler
+func NewRBACHandler(
+	createRole commandcontracts.CreateRoleCommand,
+	updateRole commandcontracts.UpdateRoleCommand,
+	deleteRole commandcontracts.DeleteRoleCommand,
+	setRoleParent commandcontracts.SetRoleParentCommand,
+	createPermission commandcontracts.CreatePermissionCommand,
+	updatePermission commandcontracts.UpdatePermissionCommand,
+	deletePermission commandcontracts.DeletePermissionCommand,
+	grantPermission commandcontracts.AssignPermissionToRoleCommand,
+	revokePermission commandcontracts.RevokePermissionFromRoleCommand,
+	assignRole commandcontracts.AssignRoleCommand,
+	revokeRole commandcontracts.RevokeRoleCommand,
+	listRoles querycontracts.ListRolesQuery,
+	listPermissions querycontracts.ListPermissionsQuery,
+	getRolePermissions querycontracts.GetRolePermissionsQuery,
+	getUserRoles querycontracts.GetUserRolesQuery,
+	getUserPermissions querycontracts.GetUserPermissionsQuery,
+) *handlers.RBACHandler {
+	return handlers.NewRBACHandler(
+		createRole, updateRole, deleteRole, setRoleParent,
+		createPermission, updatePermission, deletePermission,
+		grantPermission, revokePermission, assignRole, revokeRole,
+		listRoles, listPermissions, getRolePermissions, getUserRoles, getUserPermissions,
+	)
+}
+
+// NewAPIKeyHandler creates a new APIKeyHandler
+func NewAPIKeyHandler(
+	createAPIKey commandcontracts.CreateAPIKeyCommand,
+	revokeAPIKey commandcontracts.RevokeAPIKeyCommand,
+	listAPIKeys querycontracts.ListAPIKeysQuery,
+) *handlers.APIKeyHandler {
+	return handlers.NewAPIKeyHandler(createAPIKey, revokeAPIKey, listAPIKeys)
+}
+
+// NewAuditHandler creates a new AuditHandler
+func NewAuditHandler(
+	listAuditEvents querycontracts.ListAuditEventsQuery,
+	exportAuditEvents querycontracts.ExportAuditEventsQuery,
+	getUserAsOf querycontracts.GetUserAsOfQuery,
+	getRoleAsOf querycontracts.GetRoleAsOfQuery,
+) *handlers.AuditHandler {
+	return handlers.NewAuditHandler(listAuditEvents, exportAuditEvents, getUserAsOf, getRoleAsOf)
+}
+
+// NewGDPRHandler creates a new GDPRHandler
+func NewGDPRHandler(
+	deleteUser commandcontracts.DeleteUserCommand,
+	requestDataExport commandcontracts.RequestDataExportCommand,
+	getGDPRRequest querycontracts.GetGDPRRequestQuery,
+	downloadDataExport querycontracts.DownloadDataExportQuery,
+) *handlers.GDPRHandler {
+	return handlers.NewGDPRHandler(deleteUser, requestDataExport, getGDPRRequest, downloadDataExport)
+}
+
 // NewHandlers creates all HTTP handlers
 func NewHandlers(
 	authHandler *handlers.AuthHandler,
 	profileHandler *handlers.ProfileHandler,
 	passwordHandler *handlers.PasswordHandler,
 	tokenHandler *handlers.TokenHandler,
+	jwksHandler *handlers.JWKSHandler,
+	sessionHandler *handlers.SessionHandler,
+	mfaHandler *handlers.MFAHandler,
+	verificationHandler *handlers.VerificationHandler,
+	adminHandler *handlers.AdminHandler,
+	rbacHandler *handlers.RBACHandler,
+	apiKeyHandler *handlers.APIKeyHandler,
+	auditHandler *handlers.AuditHandler,
+	gdprHandler *handlers.GDPRHandler,
 ) *Handlers {
 	return &Handlers{
-		Auth:     authHandler,
-		Profile:  profileHandler,
-		Password: passwordHandler,
-		Token:    tokenHandler,
+		Auth:         authHandler,
+		Profile:      profileHandler,
+		Password:     passwordHandler,
+		Token:        tokenHandler,
+		JWKS:         jwksHandler,
+		Session:      sessionHandler,
+		MFA:          mfaHandler,
+		Verification: verificationHandler,
+		Admin:        adminHandler,
+		RBAC:         rbacHandler,
+		APIKey:       apiKeyHandler,
+		Audit:        auditHandler,
+		GDPR:         gdprHandler,
 	}
 }
 
 // NewRouter creates and configures the HTTP router
-func NewRouter(h *Handlers, jwtService *jwt.Service, cache cache.Cache) *gin.Engine {
+func NewRouter(h *Handlers, validateToken querycontracts.ValidateTokenQuery, authenticateAPIKey commandcontracts.AuthenticateAPIKeyCommand, cache cache.Cache, checkPermission querycontracts.CheckPermissionQuery) *gin.Engine {
 	router := gin.New()
 
 	// Initialize error transformer
@@ -81,14 +203,16 @@ func NewRouter(h *Handlers, jwtService *jwt.Service, cache cache.Cache) *gin.Eng
 	transformer := errors.NewTransformer(devMode)
 
 	// Global middlewares (applied to all routes)
-	// Order matters: RequestID -> IP Filter -> Size Limiter -> Compression -> Cache Control -> Metrics -> Request Log -> Error -> Recovery
+	// Order matters: Tracing -> RequestID -> IP Filter -> Size Limiter -> Compression -> Cache Control -> Metrics -> Request Log -> Error -> Recovery
 	router.Use(
+		tracing.GinMiddleware("auth-service"),               // Server span per request (first, so every later middleware runs inside it)
+		tracing.TraceIDMiddleware(),                         // X-Trace-ID response header
 		middleware.RequestIDMiddleware(),                    // Request ID tracking (first)
 		middleware.IPFilterMiddleware(middleware.DefaultIPFilterConfig()), // IP whitelist/blacklist
 		middleware.SizeLimiterMiddleware(middleware.DefaultSizeLimiterConfig()), // Request size limit
 		middleware.CompressionMiddleware(),                  // Gzip compression (based on Accept-Encoding)
 		middleware.CacheControlMiddleware(middleware.DefaultCacheControlConfig()), // Cache control headers
-		middleware.MetricsMiddleware(nil),                  // Metrics collection (simple in-memory)
+		middleware.MetricsMiddleware(metrics.NewHTTPCollector()), // Prometheus metrics (scraped from /metrics)
 		middleware.RequestLogMiddleware(),                  // Structured request logging
 		gin.Recovery(),                                      // Panic recovery
 		errors.ErrorMiddleware(transformer),                 // Error handling
@@ -101,6 +225,12 @@ func NewRouter(h *Handlers, jwtService *jwt.Service, cache cache.Cache) *gin.Eng
 		c.JSON(http.StatusOK, gin.H{"status": "auth-service OK"})
 	})
 
+	// Prometheus scrape endpoint (no rate limiting)
+	router.GET(metrics.Path, metrics.GinHandler())
+
+	// Public signing keys for local token verification (no rate limiting)
+	h.JWKS.Mount(router)
+
 	// Auth routes group
 	authGroup := router.Group("/auth")
 
@@ -119,31 +249,78 @@ func NewRouter(h *Handlers, jwtService *jwt.Service, cache cache.Cache) *gin.Eng
 		Window:       1 * time.Minute,  // per minute
 		KeyFunc:      middleware.GetClientIP,
 		SkipFunc: func(c *gin.Context) bool {
-			// Only apply to login/register endpoints
-			return c.Request.URL.Path != "/auth/login" && c.Request.URL.Path != "/auth/register"
+			// Only apply to login/register endpoints (including the MFA step, to slow down code guessing)
+			// and to endpoints that send emails or consume emailed tokens
+			switch c.Request.URL.Path {
+			case "/auth/login", "/auth/login/mfa", "/auth/register",
+				"/auth/verify-email", "/auth/password/forgot", "/auth/password/reset":
+				return false
+			}
+			return true
 		},
 		ErrorMessage: "Too many login/register attempts. Please try again in a minute.",
 	}))
 	// Mount login/register with stricter rate limiting
 	loginRegisterGroup.POST("/login", h.Auth.Login)
+	loginRegisterGroup.POST("/login/mfa", h.Auth.LoginMFA)
 	loginRegisterGroup.POST("/register", h.Auth.Register)
+	h.Verification.Mount(loginRegisterGroup)
 
 	// Other public routes (with general rate limiting only)
 	h.Profile.Mount(authGroup)
 	h.Token.Mount(authGroup)
 
-	// Protected routes (require JWT)
+	// Protected routes (require JWT or API key)
 	protected := authGroup.Group("")
-	protected.Use(middleware.JWTAuthMiddleware(jwtService))
+	protected.Use(middleware.JWTAuthMiddleware(validateToken, authenticateAPIKey))
 	{
+		// Account management routes need an interactive login, API keys are rejected
+		interactive := protected.Group("")
+		interactive.Use(middleware.DenyAPIKey())
+
 		// Profile protected routes
-		h.Profile.MountProtected(protected)
+		h.Profile.MountProtected(interactive)
 
 		// Password protected routes
-		h.Password.Mount(protected)
+		h.Password.Mount(interactive)
 
 		// Token protected routes
-		h.Token.MountProtected(protected)
+		h.Token.MountProtected(interactive)
+
+		// Session (device) management routes
+		h.Session.MountProtected(interactive)
+
+		// MFA enrollment routes
+		h.MFA.MountProtected(interactive)
+
+		// Resend email verification link
+		h.Verification.MountProtected(interactive)
+
+		// API key management routes
+		h.APIKey.MountProtected(interactive)
+
+		// Account deletion and data export
+		h.GDPR.MountProtected(interactive)
+
+		// Admin routes (require the users:unlock permission)
+		admin := protected.Group("/admin")
+		admin.Use(middleware.RequirePermission(checkPermission, "users", "unlock"))
+		h.Admin.MountProtected(admin)
+
+		// Role and permission administration (require the rbac:manage permission)
+		rbac := protected.Group("/admin/rbac")
+		rbac.Use(middleware.RequirePermission(checkPermission, "rbac", "manage"))
+		h.RBAC.MountProtected(rbac)
+
+		// Audit trail of the event store (require the audit:read permission)
+		audit := protected.Group("/admin/audit")
+		audit.Use(middleware.RequirePermission(checkPermission, "audit", "read"))
+		h.Audit.MountProtected(audit)
+
+		// User deletion on behalf of users (require the users:delete permission)
+		gdprAdmin := protected.Group("/admin")
+		gdprAdmin.Use(middleware.RequirePermission(checkPermission, "users", "delete"))
+		h.GDPR.MountAdmin(gdprAdmin)
 	}
 
 	return router
@@ -0,0 +1,67 @@
+package auditctx
+
+import (
+	"context"
+)
+
+// Keys of the metadata recorded with every event_store entry
+const (
+	KeyActorID   = "actor_id"
+	KeyAPIKeyID  = "api_key_id"
+	KeyIPAddress = "ip_address"
+	KeyUserAgent = "user_agent"
+	KeyRequestID = "request_id"
+)
+
+// Metadata describes who caused a change and through which request
+type Metadata struct {
+	ActorID   string `json:"actor_id,omitempty"`
+	APIKeyID  string `json:"api_key_id,omitempty"` // Set when the actor authenticated with an API key
+	IPAddress string `json:"ip_address,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+type contextKey struct{}
+
+// FromContext returns the metadata stored in ctx, or an empty Metadata
+func FromContext(ctx context.Context) Metadata {
+	if metadata, ok := ctx.Value(contextKey{}).(Metadata); ok {
+		return metadata
+	}
+	return Metadata{}
+}
+
+// WithRequest stores the request ID and client of the current request in ctx
+func WithRequest(ctx context.Context, requestID, ipAddress, userAgent string) context.Context {
+	metadata := FromContext(ctx)
+	metadata.RequestID = requestID
+	metadata.IPAddress = ipAddress
+	metadata.UserAgent = userAgent
+	return context.WithValue(ctx, contextKey{}, metadata)
+}
+
+// WithActor stores the authenticated user in ctx, keeping the request metadata already there
+func WithActor(ctx context.Context, actorID, apiKeyID string) context.Context {
+	metadata := FromContext(ctx)
+	metadata.ActorID = actorID
+	metadata.APIKeyID = apiKeyID
+	return context.WithValue(ctx, contextKey{}, metadata)
+}
+
+// Map returns the non-empty fields keyed like the event_store metadata column
+func (m Metadata) Map() map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, value := range map[string]string{
+		KeyActorID:   m.ActorID,
+		KeyAPIKeyID:  m.APIKeyID,
+		KeyIPAddress: m.IPAddress,
+		KeyUserAgent: m.UserAgent,
+		KeyRequestID: m.RequestID,
+	} {
+		if value != "" {
+			result[key] = value
+		}
+	}
+	return result
+}
@@ -0,0 +1,6 @@
+-- Drop session columns
+DROP INDEX IF EXISTS idx_refresh_token_families_user_active;
+ALTER TABLE refresh_token_families
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS ip_address,
+    DROP COLUMN IF EXISTS user_agent;
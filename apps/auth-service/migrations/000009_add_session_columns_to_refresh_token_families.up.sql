@@ -0,0 +1,10 @@
+-- Migration: Track device and usage per refresh token family (user session)
+ALTER TABLE refresh_token_families
+    ADD COLUMN IF NOT EXISTS user_agent TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS ip_address TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+-- Listing active sessions of a user
+CREATE INDEX IF NOT EXISTS idx_refresh_token_families_user_active
+    ON refresh_token_families(user_id, last_used_at DESC)
+    WHERE revoked_at IS NULL;
@@ -0,0 +1,5 @@
+-- Drop MFA columns
+ALTER TABLE users
+    DROP COLUMN IF EXISTS mfa_recovery_codes,
+    DROP COLUMN IF EXISTS mfa_secret,
+    DROP COLUMN IF EXISTS mfa_enabled;
@@ -0,0 +1,3 @@
+-- Drop email verification column
+ALTER TABLE users
+    DROP COLUMN IF EXISTS email_verified;
@@ -0,0 +1,6 @@
+-- Migration: Track email verification on users
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- Users registered before verification existed are grandfathered in
+UPDATE users SET email_verified = TRUE;
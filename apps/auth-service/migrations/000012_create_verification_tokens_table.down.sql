@@ -0,0 +1,4 @@
+-- Drop verification tokens table
+DROP INDEX IF EXISTS idx_verification_tokens_expires_at;
+DROP INDEX IF EXISTS idx_verification_tokens_user_purpose;
+DROP TABLE IF EXISTS verification_tokens;
@@ -0,0 +1,2 @@
+-- Remove the users:unlock permission (the admin role may have other grants and is kept)
+DELETE FROM permissions WHERE resource = 'users' AND action = 'unlock';
@@ -0,0 +1,14 @@
+-- Migration: Seed the admin role with the permission to unlock accounts
+INSERT INTO roles (id, name, description)
+VALUES (gen_random_uuid(), 'admin', 'Administrator role')
+ON CONFLICT (name) DO NOTHING;
+
+INSERT INTO permissions (id, name, resource, action)
+VALUES (gen_random_uuid(), 'Unlock users', 'users', 'unlock')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.name = 'admin' AND p.resource = 'users' AND p.action = 'unlock'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+-- Remove the rbac:manage permission (grants go with it via ON DELETE CASCADE)
+DELETE FROM permissions WHERE resource = 'rbac' AND action = 'manage';
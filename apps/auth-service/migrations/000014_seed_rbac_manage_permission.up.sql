@@ -0,0 +1,10 @@
+-- Migration: Allow the admin role to manage roles and permissions
+INSERT INTO permissions (id, name, resource, action)
+VALUES (gen_random_uuid(), 'Manage roles and permissions', 'rbac', 'manage')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.name = 'admin' AND p.resource = 'rbac' AND p.action = 'manage'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+-- Role inheritance: a role gets every permission of its parent (and the parent's ancestors)
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS parent_id UUID NULL REFERENCES roles(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_roles_parent_id ON roles(parent_id);
@@ -0,0 +1,3 @@
+-- Drop API keys table
+DROP INDEX IF EXISTS idx_api_keys_user_id;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,2 @@
+-- Hashing cannot be undone; the legacy digests keep working after a rollback of this migration
+SELECT 1;
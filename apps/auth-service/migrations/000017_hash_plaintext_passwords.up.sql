@@ -0,0 +1,7 @@
+-- Migration: Hash passwords that were stored in plain text
+-- They become legacy unsalted SHA-256 digests, which the password hasher still verifies
+-- and upgrades to the current algorithm (argon2id / bcrypt) on the next successful login.
+UPDATE users
+SET password = encode(sha256(convert_to(password, 'UTF8')), 'hex')
+WHERE password NOT LIKE '$%'
+  AND password !~ '^[0-9a-f]{64}$';
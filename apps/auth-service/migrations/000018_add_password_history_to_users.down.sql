@@ -0,0 +1,3 @@
+-- Drop password history
+ALTER TABLE users
+    DROP COLUMN IF EXISTS password_history;
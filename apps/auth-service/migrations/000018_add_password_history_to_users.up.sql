@@ -0,0 +1,3 @@
+-- Migration: Keep hashes of previous passwords for the password reuse check
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS password_history JSONB;
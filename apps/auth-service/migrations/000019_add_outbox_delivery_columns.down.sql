@@ -0,0 +1,14 @@
+-- Drop outbox delivery columns, index and notify trigger
+DROP TRIGGER IF EXISTS outbox_pending_notify ON outbox;
+DROP FUNCTION IF EXISTS notify_outbox_pending();
+DROP INDEX IF EXISTS idx_outbox_pending_due;
+
+UPDATE outbox
+SET status = 'failed'
+WHERE status = 'dead_letter';
+
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS dead_lettered_at,
+    DROP COLUMN IF EXISTS locked_by,
+    DROP COLUMN IF EXISTS locked_until,
+    DROP COLUMN IF EXISTS next_attempt_at;
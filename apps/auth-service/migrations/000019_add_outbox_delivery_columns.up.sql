@@ -0,0 +1,30 @@
+-- Migration: Lease-based claiming, retry backoff, dead-lettering and LISTEN/NOTIFY wake-up for the outbox
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    ADD COLUMN IF NOT EXISTS locked_until TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS locked_by TEXT,
+    ADD COLUMN IF NOT EXISTS dead_lettered_at TIMESTAMP;
+
+-- Events that failed before dead-lettering existed are parked for manual replay
+UPDATE outbox
+SET status = 'dead_letter', dead_lettered_at = NOW()
+WHERE status = 'failed';
+
+-- Claim query: due pending events, oldest first
+CREATE INDEX IF NOT EXISTS idx_outbox_pending_due ON outbox(next_attempt_at, created_at) WHERE status = 'pending';
+
+-- Wake outbox processors as soon as an event becomes pending (insert or replay).
+-- The payload is empty so a transaction inserting many events sends a single notification
+CREATE OR REPLACE FUNCTION notify_outbox_pending() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('outbox_pending', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS outbox_pending_notify ON outbox;
+CREATE TRIGGER outbox_pending_notify
+    AFTER INSERT OR UPDATE OF status ON outbox
+    FOR EACH ROW
+    WHEN (NEW.status = 'pending')
+    EXECUTE FUNCTION notify_outbox_pending();
@@ -0,0 +1,6 @@
+-- Remove the audit:read permission (grants go with it via ON DELETE CASCADE)
+DELETE FROM permissions WHERE resource = 'audit' AND action = 'read';
+
+DROP INDEX IF EXISTS idx_event_store_actor;
+DROP INDEX IF EXISTS idx_event_store_event_type;
+DROP INDEX IF EXISTS idx_event_store_occurred_at_id;
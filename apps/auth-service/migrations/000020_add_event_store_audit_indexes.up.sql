@@ -0,0 +1,16 @@
+-- Migration: Indexes for the audit trail over event_store and the audit:read permission
+-- Pages are ordered by (occurred_at, id), newest first
+CREATE INDEX IF NOT EXISTS idx_event_store_occurred_at_id ON event_store(occurred_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_event_store_event_type ON event_store(event_type, occurred_at DESC);
+-- Actor recorded from the request context ("who changed this user's roles")
+CREATE INDEX IF NOT EXISTS idx_event_store_actor ON event_store((metadata->>'actor_id'), occurred_at DESC);
+
+INSERT INTO permissions (id, name, resource, action)
+VALUES (gen_random_uuid(), 'Read the audit trail', 'audit', 'read')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.name = 'admin' AND p.resource = 'audit' AND p.action = 'read'
+ON CONFLICT DO NOTHING;
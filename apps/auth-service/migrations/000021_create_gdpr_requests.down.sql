@@ -0,0 +1,7 @@
+-- Remove the users:delete permission (grants go with it via ON DELETE CASCADE)
+DELETE FROM permissions WHERE resource = 'users' AND action = 'delete';
+
+DROP TABLE IF EXISTS gdpr_request_steps;
+DROP TABLE IF EXISTS gdpr_requests;
+
+ALTER TABLE users DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,35 @@
+-- Migration: GDPR deletion / export requests and soft deletion of users
+-- Deleted users keep an anonymized row so IDs referenced by other services remain valid
+ALTER TABLE users ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE TABLE IF NOT EXISTS gdpr_requests (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    kind VARCHAR(20) NOT NULL,   -- deletion | export
+    status VARCHAR(20) NOT NULL, -- in_progress | completed
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_gdpr_requests_user_id ON gdpr_requests(user_id, created_at DESC);
+
+-- One step per service taking part in the saga
+CREATE TABLE IF NOT EXISTS gdpr_request_steps (
+    request_id UUID NOT NULL REFERENCES gdpr_requests(id) ON DELETE CASCADE,
+    service VARCHAR(50) NOT NULL,
+    position INT NOT NULL,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    records BIGINT NOT NULL DEFAULT 0,
+    document JSONB, -- Export: the service's document about the user
+    PRIMARY KEY (request_id, service)
+);
+
+INSERT INTO permissions (id, name, resource, action)
+VALUES (gen_random_uuid(), 'Delete users', 'users', 'delete')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.name = 'admin' AND p.resource = 'users' AND p.action = 'delete'
+ON CONFLICT DO NOTHING;
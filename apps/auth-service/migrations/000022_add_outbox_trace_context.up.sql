@@ -0,0 +1,4 @@
+-- Migration: Keep the trace context of the request that wrote an outbox event,
+-- so the Kafka message published later continues the same trace
+ALTER TABLE outbox
+    ADD COLUMN IF NOT EXISTS trace_context JSONB;
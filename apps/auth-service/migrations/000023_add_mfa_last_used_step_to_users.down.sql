@@ -0,0 +1,3 @@
+-- Drop the last accepted TOTP time step
+ALTER TABLE users
+    DROP COLUMN IF EXISTS mfa_last_used_step;
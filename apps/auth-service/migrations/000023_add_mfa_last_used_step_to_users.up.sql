@@ -0,0 +1,3 @@
+-- Migration: Remember the time step of the last accepted TOTP code, so codes cannot be replayed
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS mfa_last_used_step BIGINT NOT NULL DEFAULT 0;
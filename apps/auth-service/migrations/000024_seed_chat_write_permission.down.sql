@@ -0,0 +1,2 @@
+-- Remove the chat:write permission (grants go with it via ON DELETE CASCADE)
+DELETE FROM permissions WHERE resource = 'chat' AND action = 'write';
@@ -0,0 +1,10 @@
+-- Migration: Permission behind the chat:write API key scope, required by the gateway to send messages with an API key
+INSERT INTO permissions (id, name, resource, action)
+VALUES (gen_random_uuid(), 'Send chat messages', 'chat', 'write')
+ON CONFLICT (resource, action) DO NOTHING;
+
+INSERT INTO role_permissions (role_id, permission_id)
+SELECT r.id, p.id
+FROM roles r, permissions p
+WHERE r.name = 'admin' AND p.resource = 'chat' AND p.action = 'write'
+ON CONFLICT DO NOTHING;
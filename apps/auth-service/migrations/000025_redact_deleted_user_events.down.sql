@@ -0,0 +1,2 @@
+-- Redacted personal data cannot be restored; nothing to undo
+SELECT 1;
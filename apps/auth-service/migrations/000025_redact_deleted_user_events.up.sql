@@ -0,0 +1,42 @@
+-- Migration: Redact the personal data of users deleted before the erasure covered their events.
+-- The email and name in stored and outboxed payloads get the placeholders of the users row
+-- (see user.ErasedEventFields), and the client details recorded with their own changes are dropped
+UPDATE event_store e
+SET payload = e.payload || (
+    SELECT jsonb_object_agg(f.key, f.value)
+    FROM jsonb_each(jsonb_build_object(
+        'Email', 'deleted-' || e.aggregate_id || '@users.invalid',
+        'Name', 'Deleted user',
+        'OldName', 'Deleted user',
+        'NewName', 'Deleted user'
+    )) AS f
+    WHERE jsonb_exists(e.payload, f.key)
+)
+FROM users u
+WHERE u.deleted_at IS NOT NULL
+  AND e.aggregate_type = 'User'
+  AND e.aggregate_id = u.id::text
+  AND jsonb_exists_any(e.payload, ARRAY['Email', 'Name', 'OldName', 'NewName']);
+
+UPDATE outbox o
+SET payload = o.payload || (
+    SELECT jsonb_object_agg(f.key, f.value)
+    FROM jsonb_each(jsonb_build_object(
+        'Email', 'deleted-' || o.aggregate_id || '@users.invalid',
+        'Name', 'Deleted user',
+        'OldName', 'Deleted user',
+        'NewName', 'Deleted user'
+    )) AS f
+    WHERE jsonb_exists(o.payload, f.key)
+)
+FROM users u
+WHERE u.deleted_at IS NOT NULL
+  AND o.aggregate_type = 'User'
+  AND o.aggregate_id = u.id::text
+  AND jsonb_exists_any(o.payload, ARRAY['Email', 'Name', 'OldName', 'NewName']);
+
+UPDATE event_store e
+SET metadata = e.metadata - 'ip_address' - 'user_agent'
+FROM users u
+WHERE u.deleted_at IS NOT NULL
+  AND e.metadata->>'actor_id' = u.id::text;
@@ -15,6 +15,8 @@ import (
 	"golang-social-media/pkg/config"
 	chatv1 "golang-social-media/pkg/gen/chat/v1"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -25,6 +27,24 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Setup tracing first so the clients created by the bootstrap report to the configured exporter
+	shutdownTracing, err := tracing.Init(ctx, "chat-service")
+	if err != nil {
+		logger.Component("chat.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to setup tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Component("chat.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to flush traces")
+		}
+	}()
+
 	// Setup all dependencies
 	deps, err := bootstrap.SetupDependencies(ctx)
 	if err != nil {
@@ -43,14 +63,18 @@ func main() {
 	// Start event subscribers in background (non-blocking)
 	startSubscribers(ctx, deps)
 
+	// Expose Prometheus metrics on their own port, the service itself only speaks gRPC
+	go serveMetrics(ctx)
+
 	// Start gRPC server
 	port := config.GetEnvInt("CHAT_SERVICE_PORT", 9000)
 	addr := fmt.Sprintf(":%d", port)
 
 	if err := grpcserver.Start(addr, func(server *grpc.Server) {
 		// Setup DTO mapper
-		messageDTOMapper := grpcmappers.NewMessageDTOMapper()
-		handler := chatgrpc.NewHandler(deps, messageDTOMapper)
+		conversationDTOMapper := grpcmappers.NewConversationDTOMapper()
+		messageDTOMapper := grpcmappers.NewMessageDTOMapper(conversationDTOMapper)
+		handler := chatgrpc.NewHandler(deps, messageDTOMapper, conversationDTOMapper)
 		chatv1.RegisterChatServiceServer(server, handler)
 		interfaces.RegisterServices(server, deps)
 	}); err != nil {
@@ -62,11 +86,37 @@ func main() {
 	}
 }
 
+// serveMetrics exposes /metrics for Prometheus until ctx is cancelled
+func serveMetrics(ctx context.Context) {
+	addr := fmt.Sprintf(":%d", config.GetEnvInt("CHAT_METRICS_PORT", 9001))
+
+	logger.Component("chat.metrics").
+		Info().
+		Str("addr", addr).
+		Msg("metrics server starting")
+
+	if err := metrics.Serve(ctx, addr); err != nil {
+		logger.Component("chat.metrics").
+			Error().
+			Err(err).
+			Msg("metrics server stopped")
+	}
+}
+
 // startSubscribers starts all event subscribers
 func startSubscribers(ctx context.Context, deps *bootstrap.Dependencies) {
 	if deps.UserSubscriber != nil {
 		go deps.UserSubscriber.Consume(ctx)
 	}
+	if deps.UserProfileSubscriber != nil {
+		go deps.UserProfileSubscriber.Consume(ctx)
+	}
+	if deps.UserDeletedSubscriber != nil {
+		go deps.UserDeletedSubscriber.Consume(ctx)
+	}
+	if deps.UserExportRequestedSubscriber != nil {
+		go deps.UserExportRequestedSubscriber.Consume(ctx)
+	}
 }
 
 // cleanup closes all resources
@@ -87,6 +137,30 @@ func cleanup(deps *bootstrap.Dependencies) {
 				Msg("failed to close user subscriber")
 		}
 	}
+	if deps.UserProfileSubscriber != nil {
+		if err := deps.UserProfileSubscriber.Close(); err != nil {
+			logger.Component("chat.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user profile subscriber")
+		}
+	}
+	if deps.UserDeletedSubscriber != nil {
+		if err := deps.UserDeletedSubscriber.Close(); err != nil {
+			logger.Component("chat.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user deleted subscriber")
+		}
+	}
+	if deps.UserExportRequestedSubscriber != nil {
+		if err := deps.UserExportRequestedSubscriber.Close(); err != nil {
+			logger.Component("chat.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user export requested subscriber")
+		}
+	}
 	if deps.Cache != nil {
 		if err := deps.Cache.Close(); err != nil {
 			logger.Component("chat.bootstrap").
@@ -0,0 +1,47 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ChangeConversationMemberRoleCommand = (*changeConversationMemberRoleCommand)(nil)
+
+type changeConversationMemberRoleCommand struct {
+	repo            conversations.Repository
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewChangeConversationMemberRoleCommand(repo conversations.Repository, eventDispatcher *event_dispatcher.Dispatcher) contracts.ChangeConversationMemberRoleCommand {
+	return &changeConversationMemberRoleCommand{
+		repo:            repo,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("chat.command.change_conversation_member_role"),
+	}
+}
+
+func (c *changeConversationMemberRoleCommand) Execute(ctx context.Context, req contracts.ChangeConversationMemberRoleCommandRequest) (conversation.Conversation, error) {
+	conv, err := updateConversation(ctx, c.repo, c.eventDispatcher, c.log, req.ConversationID, func(conv *conversation.Conversation) error {
+		return conv.ChangeRole(req.ActorID, req.UserID, req.Role, time.Now().UTC())
+	})
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("actor_id", req.ActorID).
+		Str("user_id", req.UserID).
+		Str("role", string(req.Role)).
+		Msg("conversation member role changed")
+
+	return conv, nil
+}
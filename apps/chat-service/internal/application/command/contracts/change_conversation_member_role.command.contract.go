@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// ChangeConversationMemberRoleCommand changes the role of a group member
+type ChangeConversationMemberRoleCommand interface {
+	Execute(ctx context.Context, req ChangeConversationMemberRoleCommandRequest) (conversation.Conversation, error)
+}
+
+// ChangeConversationMemberRoleCommandRequest represents the request for giving UserID a role, made by the owner ActorID
+type ChangeConversationMemberRoleCommandRequest struct {
+	ConversationID string
+	ActorID        string
+	UserID         string
+	Role           conversation.Role
+}
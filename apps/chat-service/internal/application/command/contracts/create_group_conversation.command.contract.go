@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// CreateGroupConversationCommand creates a group owned by its creator
+type CreateGroupConversationCommand interface {
+	Execute(ctx context.Context, req CreateGroupConversationCommandRequest) (conversation.Conversation, error)
+}
+
+// CreateGroupConversationCommandRequest represents the request for creating a group
+type CreateGroupConversationCommandRequest struct {
+	CreatorID string
+	Title     string
+	AvatarURL string
+	MemberIDs []string // Added as members, besides the creator
+}
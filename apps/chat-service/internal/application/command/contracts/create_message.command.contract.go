@@ -13,8 +13,10 @@ type CreateMessageCommand interface {
 
 // CreateMessageCommandRequest represents the request for creating a message
 type CreateMessageCommandRequest struct {
-	SenderID   string
-	ReceiverID string
-	Content    string
+	ConversationID string
+	SenderID       string
+	ReceiverID     string // Addresses the direct conversation with this user when ConversationID is empty
+	Content        string
+	// ParentMessageID makes the message a reply in the thread of this message of the conversation
+	ParentMessageID string
 }
-
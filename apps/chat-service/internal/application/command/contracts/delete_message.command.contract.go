@@ -0,0 +1,21 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/message"
+)
+
+// DeleteMessageCommand deletes a message for the actor only, or for every member
+type DeleteMessageCommand interface {
+	Execute(ctx context.Context, req DeleteMessageCommandRequest) (message.Message, error)
+}
+
+// DeleteMessageCommandRequest represents the request for deleting a message.
+// ForEveryone is reserved to the sender, within the configured window after sending
+type DeleteMessageCommandRequest struct {
+	ConversationID string
+	MessageID      string
+	ActorID        string
+	ForEveryone    bool
+}
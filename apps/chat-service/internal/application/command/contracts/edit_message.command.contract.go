@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/message"
+)
+
+// EditMessageCommand replaces the content of a message, keeping the previous version in its edit history
+type EditMessageCommand interface {
+	Execute(ctx context.Context, req EditMessageCommandRequest) (message.Message, error)
+}
+
+// EditMessageCommandRequest represents the request for editing a message, by its sender only
+type EditMessageCommandRequest struct {
+	ConversationID string
+	MessageID      string
+	ActorID        string
+	Content        string
+}
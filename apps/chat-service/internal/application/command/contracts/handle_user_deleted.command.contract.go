@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleUserDeletedCommand erases the chat data of a user deleted in auth-service
+type HandleUserDeletedCommand interface {
+	Execute(ctx context.Context, event events.UserDeleted) error
+}
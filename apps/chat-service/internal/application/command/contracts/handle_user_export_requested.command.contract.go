@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleUserExportRequestedCommand sends the chat data of a user who asked for a data export
+type HandleUserExportRequestedCommand interface {
+	Execute(ctx context.Context, event events.UserExportRequested) error
+}
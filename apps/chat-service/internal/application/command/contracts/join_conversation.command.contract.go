@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// JoinConversationCommand adds a user to a group
+type JoinConversationCommand interface {
+	Execute(ctx context.Context, req JoinConversationCommandRequest) (conversation.Conversation, error)
+}
+
+// JoinConversationCommandRequest represents the request for adding UserID to a group, made by ActorID (an owner or admin)
+type JoinConversationCommandRequest struct {
+	ConversationID string
+	ActorID        string
+	UserID         string
+}
@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// KickConversationMemberCommand removes a member from a group
+type KickConversationMemberCommand interface {
+	Execute(ctx context.Context, req KickConversationMemberCommandRequest) (conversation.Conversation, error)
+}
+
+// KickConversationMemberCommandRequest represents the request for removing UserID from a group, made by ActorID
+type KickConversationMemberCommandRequest struct {
+	ConversationID string
+	ActorID        string
+	UserID         string
+}
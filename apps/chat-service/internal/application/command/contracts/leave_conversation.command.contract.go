@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// LeaveConversationCommand removes a user from a group at their own request
+type LeaveConversationCommand interface {
+	Execute(ctx context.Context, req LeaveConversationCommandRequest) (conversation.Conversation, error)
+}
+
+// LeaveConversationCommandRequest represents the request for leaving a group
+type LeaveConversationCommandRequest struct {
+	ConversationID string
+	UserID         string
+}
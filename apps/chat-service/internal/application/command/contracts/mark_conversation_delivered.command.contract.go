@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// MarkConversationDeliveredCommand moves the delivered watermark of a member up to a message of the conversation
+type MarkConversationDeliveredCommand interface {
+	Execute(ctx context.Context, req MarkConversationDeliveredCommandRequest) (conversation.Conversation, error)
+}
+
+// MarkConversationDeliveredCommandRequest represents the request for acknowledging the delivery of messages
+// to a device of UserID
+type MarkConversationDeliveredCommandRequest struct {
+	ConversationID string
+	UserID         string
+	MessageID      string // Last message received, empty for the last message of the conversation
+}
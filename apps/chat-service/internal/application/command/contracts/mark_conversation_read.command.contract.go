@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// MarkConversationReadCommand moves the read watermark of a member up to a message of the conversation
+type MarkConversationReadCommand interface {
+	Execute(ctx context.Context, req MarkConversationReadCommandRequest) (conversation.Conversation, error)
+}
+
+// MarkConversationReadCommandRequest represents the request for marking a conversation read by UserID
+type MarkConversationReadCommandRequest struct {
+	ConversationID string
+	UserID         string
+	MessageID      string // Last message read, empty for the last message of the conversation
+}
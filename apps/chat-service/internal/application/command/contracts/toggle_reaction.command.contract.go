@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/message"
+)
+
+// ToggleReactionCommand adds the emoji reaction of a member to a message, or takes it back if they already reacted with it
+type ToggleReactionCommand interface {
+	Execute(ctx context.Context, req ToggleReactionCommandRequest) (message.Message, error)
+}
+
+// ToggleReactionCommandRequest represents the request for toggling a reaction, by a member of the conversation
+type ToggleReactionCommandRequest struct {
+	ConversationID string
+	MessageID      string
+	ActorID        string
+	Emoji          string
+}
@@ -0,0 +1,20 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// UpdateConversationCommand changes the title and avatar of a group
+type UpdateConversationCommand interface {
+	Execute(ctx context.Context, req UpdateConversationCommandRequest) (conversation.Conversation, error)
+}
+
+// UpdateConversationCommandRequest represents the request for changing the details of a group
+type UpdateConversationCommandRequest struct {
+	ConversationID string
+	ActorID        string
+	Title          string
+	AvatarURL      string
+}
@@ -0,0 +1,50 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+// updateConversation applies a change to the stored conversation and dispatches the events it produced
+func updateConversation(
+	ctx context.Context,
+	repo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+	log *zerolog.Logger,
+	conversationID string,
+	change func(conv *conversation.Conversation) error,
+) (conversation.Conversation, error) {
+	if strings.TrimSpace(conversationID) == "" {
+		return conversation.Conversation{}, pkgerrors.NewValidationError(pkgerrors.CodeConversationIDRequired, nil)
+	}
+
+	conv, err := repo.Update(ctx, conversationID, change)
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+	dispatchConversationEvents(ctx, eventDispatcher, log, conv)
+	return *conv, nil
+}
+
+// dispatchConversationEvents dispatches the pending events of a stored conversation.
+// As for messages, a failed dispatch is logged and does not fail the command
+func dispatchConversationEvents(ctx context.Context, eventDispatcher *event_dispatcher.Dispatcher, log *zerolog.Logger, conv *conversation.Conversation) {
+	domainEvents := conv.Events()
+	conv.ClearEvents()
+
+	for _, domainEvent := range domainEvents {
+		if err := eventDispatcher.Dispatch(ctx, domainEvent); err != nil {
+			log.Error().Ctx(ctx).
+				Err(err).
+				Str("event_type", domainEvent.Type()).
+				Str("conversation_id", conv.ID).
+				Msg("failed to dispatch domain event")
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/factories"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.CreateGroupConversationCommand = (*createGroupConversationCommand)(nil)
+
+type createGroupConversationCommand struct {
+	repo                conversations.Repository
+	conversationFactory factories.ConversationFactory
+	eventDispatcher     *event_dispatcher.Dispatcher
+	log                 *zerolog.Logger
+}
+
+func NewCreateGroupConversationCommand(
+	repo conversations.Repository,
+	conversationFactory factories.ConversationFactory,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.CreateGroupConversationCommand {
+	return &createGroupConversationCommand{
+		repo:                repo,
+		conversationFactory: conversationFactory,
+		eventDispatcher:     eventDispatcher,
+		log:                 logger.Component("chat.command.create_group_conversation"),
+	}
+}
+
+func (c *createGroupConversationCommand) Execute(ctx context.Context, req contracts.CreateGroupConversationCommandRequest) (conversation.Conversation, error) {
+	conv, err := c.conversationFactory.CreateGroup(req.CreatorID, req.Title, req.AvatarURL, req.MemberIDs)
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	if err := c.repo.Create(ctx, conv); err != nil {
+		c.log.Error().Ctx(ctx).
+			Err(err).
+			Str("creator_id", req.CreatorID).
+			Msg("failed to persist group conversation")
+		return conversation.Conversation{}, err
+	}
+	dispatchConversationEvents(ctx, c.eventDispatcher, c.log, conv)
+
+	c.log.Info().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("creator_id", conv.CreatedBy).
+		Int("member_count", len(conv.Members)).
+		Msg("group conversation created")
+
+	return *conv, nil
+}
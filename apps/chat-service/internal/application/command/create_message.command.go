@@ -6,48 +6,82 @@ import (
 
 	"github.com/rs/zerolog"
 	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
 	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
 	"golang-social-media/apps/chat-service/internal/domain/factories"
 	"golang-social-media/apps/chat-service/internal/domain/message"
 	"golang-social-media/apps/chat-service/internal/infrastructure/persistence"
+	pkgerrors "golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
 )
 
 var _ contracts.CreateMessageCommand = (*createMessageCommand)(nil)
 
 type createMessageCommand struct {
-	repo            *persistence.MessageRepository
-	messageFactory  factories.MessageFactory
-	eventDispatcher *event_dispatcher.Dispatcher
-	log             *zerolog.Logger
+	repo                *persistence.MessageRepository
+	conversationRepo    conversations.Repository
+	userRepo            *persistence.UserRepository
+	messageFactory      factories.MessageFactory
+	conversationFactory factories.ConversationFactory
+	eventDispatcher     *event_dispatcher.Dispatcher
+	log                 *zerolog.Logger
 }
 
 func NewCreateMessageCommand(
 	repo *persistence.MessageRepository,
+	conversationRepo conversations.Repository,
+	userRepo *persistence.UserRepository,
 	messageFactory factories.MessageFactory,
+	conversationFactory factories.ConversationFactory,
 	eventDispatcher *event_dispatcher.Dispatcher,
 ) contracts.CreateMessageCommand {
 	return &createMessageCommand{
-		repo:            repo,
-		messageFactory:  messageFactory,
-		eventDispatcher: eventDispatcher,
-		log:             logger.Component("chat.command.create_message"),
+		repo:                repo,
+		conversationRepo:    conversationRepo,
+		userRepo:            userRepo,
+		messageFactory:      messageFactory,
+		conversationFactory: conversationFactory,
+		eventDispatcher:     eventDispatcher,
+		log:                 logger.Component("chat.command.create_message"),
 	}
 }
 
 func (c *createMessageCommand) Execute(ctx context.Context, req contracts.CreateMessageCommandRequest) (message.Message, error) {
 	startTime := time.Now()
 
+	conv, err := c.resolveConversation(ctx, req)
+	if err != nil {
+		c.log.Error().Ctx(ctx).
+			Err(err).
+			Str("sender_id", req.SenderID).
+			Str("conversation_id", req.ConversationID).
+			Str("receiver_id", req.ReceiverID).
+			Msg("failed to resolve conversation")
+		return message.Message{}, err
+	}
+
+	parent, mentionIDs, err := c.resolveThreadAndMentions(ctx, conv, req)
+	if err != nil {
+		c.log.Error().Ctx(ctx).
+			Err(err).
+			Str("sender_id", req.SenderID).
+			Str("conversation_id", conv.ID).
+			Str("parent_message_id", req.ParentMessageID).
+			Msg("failed to resolve parent message and mentions")
+		return message.Message{}, err
+	}
+
 	// Use factory to create message
 	modelStart := time.Now()
-	messageModel, err := c.messageFactory.CreateMessage(req.SenderID, req.ReceiverID, req.Content)
+	messageModel, err := c.messageFactory.CreateMessage(*conv, req.SenderID, req.Content, parent, mentionIDs)
 	if err != nil {
 		modelDuration := time.Since(modelStart)
 		totalDuration := time.Since(startTime)
-		c.log.Error().
+		c.log.Error().Ctx(ctx).
 			Err(err).
 			Str("sender_id", req.SenderID).
-			Str("receiver_id", req.ReceiverID).
+			Str("conversation_id", conv.ID).
 			Dur("model_create_ms", modelDuration).
 			Dur("total_ms", totalDuration).
 			Msg("failed to create message using factory")
@@ -63,10 +97,10 @@ func (c *createMessageCommand) Execute(ctx context.Context, req contracts.Create
 	if err := c.repo.Create(ctx, messageModel); err != nil {
 		dbDuration := time.Since(dbStart)
 		totalDuration := time.Since(startTime)
-		c.log.Error().
+		c.log.Error().Ctx(ctx).
 			Err(err).
 			Str("sender_id", req.SenderID).
-			Str("receiver_id", req.ReceiverID).
+			Str("conversation_id", conv.ID).
 			Dur("model_create_ms", modelDuration).
 			Dur("db_persist_ms", dbDuration).
 			Dur("total_ms", totalDuration).
@@ -79,13 +113,13 @@ func (c *createMessageCommand) Execute(ctx context.Context, req contracts.Create
 	messageModel.ClearEvents() // Clear events after dispatch
 
 	dispatchStart := time.Now()
-	c.log.Info().
+	c.log.Info().Ctx(ctx).
 		Int("event_count", len(domainEvents)).
 		Str("message_id", messageModel.ID).
 		Msg("dispatching domain events")
 
 	for _, domainEvent := range domainEvents {
-		c.log.Info().
+		c.log.Info().Ctx(ctx).
 			Str("event_type", domainEvent.Type()).
 			Str("message_id", messageModel.ID).
 			Msg("dispatching domain event")
@@ -93,13 +127,13 @@ func (c *createMessageCommand) Execute(ctx context.Context, req contracts.Create
 		if err := c.eventDispatcher.Dispatch(ctx, domainEvent); err != nil {
 			// Log error but don't fail the command
 			// Events can be retried via outbox pattern in production
-			c.log.Error().
+			c.log.Error().Ctx(ctx).
 				Err(err).
 				Str("event_type", domainEvent.Type()).
 				Str("message_id", messageModel.ID).
 				Msg("failed to dispatch domain event")
 		} else {
-			c.log.Debug().
+			c.log.Debug().Ctx(ctx).
 				Str("event_type", domainEvent.Type()).
 				Str("message_id", messageModel.ID).
 				Msg("domain event dispatched successfully")
@@ -109,10 +143,10 @@ func (c *createMessageCommand) Execute(ctx context.Context, req contracts.Create
 
 	totalDuration := time.Since(startTime)
 
-	c.log.Info().
+	c.log.Info().Ctx(ctx).
 		Str("message_id", messageModel.ID).
+		Str("conversation_id", messageModel.ConversationID).
 		Str("sender_id", messageModel.SenderID).
-		Str("receiver_id", messageModel.ReceiverID).
 		Dur("model_create_ms", modelDuration).
 		Dur("db_persist_ms", dbDuration).
 		Dur("event_dispatch_ms", dispatchDuration).
@@ -122,3 +156,49 @@ func (c *createMessageCommand) Execute(ctx context.Context, req contracts.Create
 	return *messageModel, nil
 }
 
+// resolveConversation returns the conversation the message is addressed to. A message to a receiver goes to
+// their direct conversation with the sender, created on the first message
+func (c *createMessageCommand) resolveConversation(ctx context.Context, req contracts.CreateMessageCommandRequest) (*conversation.Conversation, error) {
+	if req.ConversationID != "" {
+		return c.conversationRepo.FindByID(ctx, req.ConversationID)
+	}
+	if req.ReceiverID == "" {
+		return nil, pkgerrors.NewValidationError(pkgerrors.CodeConversationIDRequired, nil)
+	}
+
+	direct, err := c.conversationFactory.CreateDirect(req.SenderID, req.ReceiverID)
+	if err != nil {
+		return nil, err
+	}
+	conv, err := c.conversationRepo.GetOrCreateDirect(ctx, direct)
+	if err != nil {
+		return nil, err
+	}
+	// Only a conversation created here has events pending
+	dispatchConversationEvents(ctx, c.eventDispatcher, c.log, conv)
+	return conv, nil
+}
+
+// resolveThreadAndMentions loads the message the new one replies to, if any, and resolves its @handles
+// against the replicated users who are members of the conversation
+func (c *createMessageCommand) resolveThreadAndMentions(ctx context.Context, conv *conversation.Conversation, req contracts.CreateMessageCommandRequest) (*message.Message, []string, error) {
+	// Non-members learn nothing about the messages of the conversation
+	if err := conv.EnsureMember(req.SenderID); err != nil {
+		return nil, nil, err
+	}
+
+	var parent *message.Message
+	if req.ParentMessageID != "" {
+		found, err := c.repo.FindByID(ctx, conv.ID, req.ParentMessageID)
+		if err != nil {
+			return nil, nil, err
+		}
+		parent = found
+	}
+
+	mentionIDs, err := c.userRepo.ResolveMentions(ctx, message.ParseMentions(req.Content), conv.RecipientIDs(req.SenderID))
+	if err != nil {
+		return nil, nil, err
+	}
+	return parent, mentionIDs, nil
+}
@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.DeleteMessageCommand = (*deleteMessageCommand)(nil)
+
+type deleteMessageCommand struct {
+	repo                    messages.Repository
+	conversationRepo        conversations.Repository
+	eventDispatcher         *event_dispatcher.Dispatcher
+	deleteForEveryoneWindow time.Duration
+	log                     *zerolog.Logger
+}
+
+// NewDeleteMessageCommand creates the command; senders can delete for everyone up to deleteForEveryoneWindow after sending
+func NewDeleteMessageCommand(
+	repo messages.Repository,
+	conversationRepo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+	deleteForEveryoneWindow time.Duration,
+) contracts.DeleteMessageCommand {
+	return &deleteMessageCommand{
+		repo:                    repo,
+		conversationRepo:        conversationRepo,
+		eventDispatcher:         eventDispatcher,
+		deleteForEveryoneWindow: deleteForEveryoneWindow,
+		log:                     logger.Component("chat.command.delete_message"),
+	}
+}
+
+func (c *deleteMessageCommand) Execute(ctx context.Context, req contracts.DeleteMessageCommandRequest) (message.Message, error) {
+	msg, err := updateMessage(ctx, c.repo, c.conversationRepo, c.eventDispatcher, c.log, req.ConversationID, req.MessageID, req.ActorID,
+		func(conv *conversation.Conversation, msg *message.Message) error {
+			now := time.Now().UTC()
+			if req.ForEveryone {
+				return msg.DeleteForEveryone(req.ActorID, c.deleteForEveryoneWindow, conv.RecipientIDs(msg.SenderID), now)
+			}
+			msg.DeleteForMe(req.ActorID, now)
+			return nil
+		})
+	if err != nil {
+		return message.Message{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("message_id", msg.ID).
+		Str("conversation_id", msg.ConversationID).
+		Str("actor_id", req.ActorID).
+		Bool("for_everyone", req.ForEveryone).
+		Msg("message deleted")
+
+	return msg, nil
+}
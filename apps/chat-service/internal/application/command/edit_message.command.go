@@ -0,0 +1,54 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.EditMessageCommand = (*editMessageCommand)(nil)
+
+type editMessageCommand struct {
+	repo             messages.Repository
+	conversationRepo conversations.Repository
+	eventDispatcher  *event_dispatcher.Dispatcher
+	log              *zerolog.Logger
+}
+
+func NewEditMessageCommand(
+	repo messages.Repository,
+	conversationRepo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.EditMessageCommand {
+	return &editMessageCommand{
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		eventDispatcher:  eventDispatcher,
+		log:              logger.Component("chat.command.edit_message"),
+	}
+}
+
+func (c *editMessageCommand) Execute(ctx context.Context, req contracts.EditMessageCommandRequest) (message.Message, error) {
+	msg, err := updateMessage(ctx, c.repo, c.conversationRepo, c.eventDispatcher, c.log, req.ConversationID, req.MessageID, req.ActorID,
+		func(conv *conversation.Conversation, msg *message.Message) error {
+			return msg.Edit(req.ActorID, req.Content, conv.RecipientIDs(msg.SenderID), time.Now().UTC())
+		})
+	if err != nil {
+		return message.Message{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("message_id", msg.ID).
+		Str("conversation_id", msg.ConversationID).
+		Msg("message edited")
+
+	return msg, nil
+}
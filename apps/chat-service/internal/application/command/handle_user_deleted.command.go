@@ -0,0 +1,74 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	eventhandlercontracts "golang-social-media/apps/chat-service/internal/application/event_handler/contracts"
+	"golang-social-media/apps/chat-service/internal/infrastructure/persistence"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.HandleUserDeletedCommand = (*HandleUserDeletedCommandHandler)(nil)
+
+type HandleUserDeletedCommandHandler struct {
+	userRepo    *persistence.UserRepository
+	messageRepo *persistence.MessageRepository
+	eventBroker eventhandlercontracts.EventBrokerPublisher
+	log         *zerolog.Logger
+}
+
+func NewHandleUserDeletedCommand(
+	userRepo *persistence.UserRepository,
+	messageRepo *persistence.MessageRepository,
+	eventBroker eventhandlercontracts.EventBrokerPublisher,
+) *HandleUserDeletedCommandHandler {
+	return &HandleUserDeletedCommandHandler{
+		userRepo:    userRepo,
+		messageRepo: messageRepo,
+		eventBroker: eventBroker,
+		log:         logger.Component("chat.command.handle_user_deleted"),
+	}
+}
+
+// Execute redacts the messages the user sent and removes the replicated user.
+// Both steps are idempotent, so a redelivered event reports again with zero records
+func (c *HandleUserDeletedCommandHandler) Execute(ctx context.Context, event events.UserDeleted) error {
+	redacted, err := c.messageRepo.RedactBySender(ctx, event.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to redact messages of deleted user")
+		return err
+	}
+
+	deleted, err := c.userRepo.Delete(ctx, event.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to delete replicated user")
+		return err
+	}
+
+	if err := c.eventBroker.PublishUserDataErased(ctx, eventhandlercontracts.UserDataErasedPayload{
+		RequestID: event.RequestID,
+		UserID:    event.UserID,
+		Records:   redacted + deleted,
+		ErasedAt:  time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", event.UserID).
+		Str("request_id", event.RequestID).
+		Int64("redacted_messages", redacted).
+		Msg("chat data of deleted user erased")
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	eventhandlercontracts "golang-social-media/apps/chat-service/internal/application/event_handler/contracts"
+	"golang-social-media/apps/chat-service/internal/infrastructure/persistence"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"gorm.io/gorm"
+)
+
+var _ contracts.HandleUserExportRequestedCommand = (*HandleUserExportRequestedCommandHandler)(nil)
+
+// chatDataExport is the chat-service document of a data export
+type chatDataExport struct {
+	User     *chatExportUser     `json:"user"` // Nil when the user was never replicated
+	Messages []chatExportMessage `json:"messages"`
+}
+
+type chatExportUser struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type chatExportMessage struct {
+	ID         string    `json:"id"`
+	SenderID   string    `json:"senderId"`
+	ReceiverID string    `json:"receiverId"`
+	Content    string    `json:"content"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type HandleUserExportRequestedCommandHandler struct {
+	userRepo    *persistence.UserRepository
+	messageRepo *persistence.MessageRepository
+	eventBroker eventhandlercontracts.EventBrokerPublisher
+	log         *zerolog.Logger
+}
+
+func NewHandleUserExportRequestedCommand(
+	userRepo *persistence.UserRepository,
+	messageRepo *persistence.MessageRepository,
+	eventBroker eventhandlercontracts.EventBrokerPublisher,
+) *HandleUserExportRequestedCommandHandler {
+	return &HandleUserExportRequestedCommandHandler{
+		userRepo:    userRepo,
+		messageRepo: messageRepo,
+		eventBroker: eventBroker,
+		log:         logger.Component("chat.command.handle_user_export_requested"),
+	}
+}
+
+// Execute collects the replicated user and every message the user sent or received
+func (c *HandleUserExportRequestedCommandHandler) Execute(ctx context.Context, event events.UserExportRequested) error {
+	export := chatDataExport{Messages: []chatExportMessage{}}
+
+	userModel, err := c.userRepo.FindByID(ctx, event.UserID)
+	switch {
+	case err == nil:
+		export.User = &chatExportUser{
+			ID:        userModel.ID,
+			Email:     userModel.Email,
+			Name:      userModel.Name,
+			CreatedAt: userModel.CreatedAt,
+		}
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to get replicated user")
+		return err
+	}
+
+	messages, err := c.messageRepo.ListByParticipant(ctx, event.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to list messages for export")
+		return err
+	}
+	for _, msg := range messages {
+		export.Messages = append(export.Messages, chatExportMessage{
+			ID:         msg.ID,
+			SenderID:   msg.SenderID,
+			ReceiverID: msg.ReceiverID,
+			Content:    msg.Content,
+			CreatedAt:  msg.CreatedAt,
+		})
+	}
+
+	document, err := json.Marshal(export)
+	if err != nil {
+		return err
+	}
+
+	if err := c.eventBroker.PublishUserDataExported(ctx, eventhandlercontracts.UserDataExportedPayload{
+		RequestID:  event.RequestID,
+		UserID:     event.UserID,
+		Document:   document,
+		ExportedAt: time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", event.UserID).
+		Str("request_id", event.RequestID).
+		Int("messages", len(export.Messages)).
+		Msg("chat data exported")
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/infrastructure/persistence"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"gorm.io/gorm"
+)
+
+var _ contracts.HandleUserProfileUpdatedCommand = (*HandleUserProfileUpdatedCommandHandler)(nil)
+
+type HandleUserProfileUpdatedCommandHandler struct {
+	userRepo *persistence.UserRepository
+	log      *zerolog.Logger
+}
+
+func NewHandleUserProfileUpdatedCommand(userRepo *persistence.UserRepository) *HandleUserProfileUpdatedCommandHandler {
+	return &HandleUserProfileUpdatedCommandHandler{
+		userRepo: userRepo,
+		log:      logger.Component("chat.command.handle_user_profile_updated"),
+	}
+}
+
+func (c *HandleUserProfileUpdatedCommandHandler) Execute(ctx context.Context, event events.UserProfileUpdated) error {
+	if err := c.userRepo.UpdateName(ctx, event.UserID, event.NewName, event.UpdatedAt); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Nothing to update until the user.created event has been replicated
+			c.log.Warn().
+				Str("user_id", event.UserID).
+				Msg("user not replicated yet, skipping profile update")
+			return nil
+		}
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to update replicated user")
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", event.UserID).
+		Str("name", event.NewName).
+		Msg("user profile replicated successfully")
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.JoinConversationCommand = (*joinConversationCommand)(nil)
+
+type joinConversationCommand struct {
+	repo            conversations.Repository
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewJoinConversationCommand(repo conversations.Repository, eventDispatcher *event_dispatcher.Dispatcher) contracts.JoinConversationCommand {
+	return &joinConversationCommand{
+		repo:            repo,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("chat.command.join_conversation"),
+	}
+}
+
+func (c *joinConversationCommand) Execute(ctx context.Context, req contracts.JoinConversationCommandRequest) (conversation.Conversation, error) {
+	conv, err := updateConversation(ctx, c.repo, c.eventDispatcher, c.log, req.ConversationID, func(conv *conversation.Conversation) error {
+		return conv.Join(req.ActorID, req.UserID, time.Now().UTC())
+	})
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("actor_id", req.ActorID).
+		Str("user_id", req.UserID).
+		Msg("member joined conversation")
+
+	return conv, nil
+}
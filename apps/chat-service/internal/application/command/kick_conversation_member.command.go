@@ -0,0 +1,46 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.KickConversationMemberCommand = (*kickConversationMemberCommand)(nil)
+
+type kickConversationMemberCommand struct {
+	repo            conversations.Repository
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewKickConversationMemberCommand(repo conversations.Repository, eventDispatcher *event_dispatcher.Dispatcher) contracts.KickConversationMemberCommand {
+	return &kickConversationMemberCommand{
+		repo:            repo,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("chat.command.kick_conversation_member"),
+	}
+}
+
+func (c *kickConversationMemberCommand) Execute(ctx context.Context, req contracts.KickConversationMemberCommandRequest) (conversation.Conversation, error) {
+	conv, err := updateConversation(ctx, c.repo, c.eventDispatcher, c.log, req.ConversationID, func(conv *conversation.Conversation) error {
+		return conv.Kick(req.ActorID, req.UserID, time.Now().UTC())
+	})
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("actor_id", req.ActorID).
+		Str("user_id", req.UserID).
+		Msg("member removed from conversation")
+
+	return conv, nil
+}
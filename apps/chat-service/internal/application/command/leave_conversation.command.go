@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.LeaveConversationCommand = (*leaveConversationCommand)(nil)
+
+type leaveConversationCommand struct {
+	repo            conversations.Repository
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewLeaveConversationCommand(repo conversations.Repository, eventDispatcher *event_dispatcher.Dispatcher) contracts.LeaveConversationCommand {
+	return &leaveConversationCommand{
+		repo:            repo,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("chat.command.leave_conversation"),
+	}
+}
+
+func (c *leaveConversationCommand) Execute(ctx context.Context, req contracts.LeaveConversationCommandRequest) (conversation.Conversation, error) {
+	conv, err := updateConversation(ctx, c.repo, c.eventDispatcher, c.log, req.ConversationID, func(conv *conversation.Conversation) error {
+		return conv.Leave(req.UserID, time.Now().UTC())
+	})
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("user_id", req.UserID).
+		Msg("member left conversation")
+
+	return conv, nil
+}
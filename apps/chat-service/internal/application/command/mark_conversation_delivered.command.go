@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.MarkConversationDeliveredCommand = (*markConversationDeliveredCommand)(nil)
+
+type markConversationDeliveredCommand struct {
+	repo             messages.Repository
+	conversationRepo conversations.Repository
+	eventDispatcher  *event_dispatcher.Dispatcher
+	log              *zerolog.Logger
+}
+
+func NewMarkConversationDeliveredCommand(
+	repo messages.Repository,
+	conversationRepo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.MarkConversationDeliveredCommand {
+	return &markConversationDeliveredCommand{
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		eventDispatcher:  eventDispatcher,
+		log:              logger.Component("chat.command.mark_conversation_delivered"),
+	}
+}
+
+func (c *markConversationDeliveredCommand) Execute(ctx context.Context, req contracts.MarkConversationDeliveredCommandRequest) (conversation.Conversation, error) {
+	conv, err := markReceipt(ctx, c.repo, c.conversationRepo, c.eventDispatcher, c.log, req.ConversationID, req.UserID, req.MessageID,
+		func(conv *conversation.Conversation, up conversation.Watermark) error {
+			return conv.MarkDelivered(req.UserID, up)
+		})
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	c.log.Debug().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("user_id", req.UserID).
+		Msg("conversation marked delivered")
+
+	return conv, nil
+}
@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.MarkConversationReadCommand = (*markConversationReadCommand)(nil)
+
+type markConversationReadCommand struct {
+	repo             messages.Repository
+	conversationRepo conversations.Repository
+	eventDispatcher  *event_dispatcher.Dispatcher
+	log              *zerolog.Logger
+}
+
+func NewMarkConversationReadCommand(
+	repo messages.Repository,
+	conversationRepo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.MarkConversationReadCommand {
+	return &markConversationReadCommand{
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		eventDispatcher:  eventDispatcher,
+		log:              logger.Component("chat.command.mark_conversation_read"),
+	}
+}
+
+func (c *markConversationReadCommand) Execute(ctx context.Context, req contracts.MarkConversationReadCommandRequest) (conversation.Conversation, error) {
+	conv, err := markReceipt(ctx, c.repo, c.conversationRepo, c.eventDispatcher, c.log, req.ConversationID, req.UserID, req.MessageID,
+		func(conv *conversation.Conversation, up conversation.Watermark) error {
+			return conv.MarkRead(req.UserID, up)
+		})
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	c.log.Debug().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("user_id", req.UserID).
+		Msg("conversation marked read")
+
+	return conv, nil
+}
@@ -0,0 +1,67 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+// updateMessage applies a change by actorID, who must be a member of the conversation, to one of its
+// stored messages and dispatches the events it produced
+func updateMessage(
+	ctx context.Context,
+	repo messages.Repository,
+	conversationRepo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+	log *zerolog.Logger,
+	conversationID, messageID, actorID string,
+	change func(conv *conversation.Conversation, msg *message.Message) error,
+) (message.Message, error) {
+	if strings.TrimSpace(conversationID) == "" {
+		return message.Message{}, pkgerrors.NewValidationError(pkgerrors.CodeConversationIDRequired, nil)
+	}
+	if strings.TrimSpace(messageID) == "" {
+		return message.Message{}, pkgerrors.NewValidationError(pkgerrors.CodeMessageIDRequired, nil)
+	}
+
+	conv, err := conversationRepo.FindByID(ctx, conversationID)
+	if err != nil {
+		return message.Message{}, err
+	}
+	if err := conv.EnsureMember(actorID); err != nil {
+		return message.Message{}, err
+	}
+
+	msg, err := repo.Update(ctx, conversationID, messageID, func(msg *message.Message) error {
+		return change(conv, msg)
+	})
+	if err != nil {
+		return message.Message{}, err
+	}
+	dispatchMessageEvents(ctx, eventDispatcher, log, msg)
+	return *msg, nil
+}
+
+// dispatchMessageEvents dispatches the pending events of a stored message.
+// A failed dispatch is logged and does not fail the command
+func dispatchMessageEvents(ctx context.Context, eventDispatcher *event_dispatcher.Dispatcher, log *zerolog.Logger, msg *message.Message) {
+	domainEvents := msg.Events()
+	msg.ClearEvents()
+
+	for _, domainEvent := range domainEvents {
+		if err := eventDispatcher.Dispatch(ctx, domainEvent); err != nil {
+			log.Error().Ctx(ctx).
+				Err(err).
+				Str("event_type", domainEvent.Type()).
+				Str("message_id", msg.ID).
+				Msg("failed to dispatch domain event")
+		}
+	}
+}
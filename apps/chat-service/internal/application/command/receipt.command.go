@@ -0,0 +1,91 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	pkgerrors "golang-social-media/pkg/errors"
+)
+
+// markReceipt moves a watermark of userID up to a message of the conversation, its last message when
+// messageID is empty, and dispatches the receipt events once the watermark is stored.
+// Receipts do not lock the conversation: the stored watermarks only move forward on their own
+func markReceipt(
+	ctx context.Context,
+	repo messages.Repository,
+	conversationRepo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+	log *zerolog.Logger,
+	conversationID, userID, messageID string,
+	mark func(conv *conversation.Conversation, up conversation.Watermark) error,
+) (conversation.Conversation, error) {
+	if strings.TrimSpace(conversationID) == "" {
+		return conversation.Conversation{}, pkgerrors.NewValidationError(pkgerrors.CodeConversationIDRequired, nil)
+	}
+	if strings.TrimSpace(userID) == "" {
+		return conversation.Conversation{}, pkgerrors.NewInvalidRequestError("user_id is required")
+	}
+
+	conv, err := conversationRepo.FindByID(ctx, conversationID)
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+	if err := conv.EnsureMember(userID); err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	var msg *message.Message
+	if strings.TrimSpace(messageID) != "" {
+		msg, err = repo.FindByID(ctx, conversationID, messageID)
+		if err != nil {
+			return conversation.Conversation{}, err
+		}
+	} else {
+		last, err := repo.ListConversation(ctx, conversationID, userID, nil, 1)
+		if err != nil {
+			return conversation.Conversation{}, err
+		}
+		if len(last) == 0 {
+			// Nothing was sent yet
+			return *conv, nil
+		}
+		msg = &last[0]
+	}
+
+	up := conversation.Watermark{MessageID: msg.ID, MessageCreatedAt: msg.CreatedAt, At: time.Now().UTC()}
+	if err := mark(conv, up); err != nil {
+		return conversation.Conversation{}, err
+	}
+	if len(conv.Events()) == 0 {
+		return *conv, nil
+	}
+
+	member, _ := conv.Member(userID)
+	moved, err := conversationRepo.SaveWatermarks(ctx, conversationID, member)
+	if err != nil {
+		log.Error().Ctx(ctx).
+			Err(err).
+			Str("conversation_id", conversationID).
+			Str("user_id", userID).
+			Msg("failed to save receipt watermarks")
+		return conversation.Conversation{}, err
+	}
+	if !moved {
+		// A concurrent receipt got further first, it has published its own events
+		stored, err := conversationRepo.FindByID(ctx, conversationID)
+		if err != nil {
+			return conversation.Conversation{}, err
+		}
+		return *stored, nil
+	}
+
+	dispatchConversationEvents(ctx, eventDispatcher, log, conv)
+	return *conv, nil
+}
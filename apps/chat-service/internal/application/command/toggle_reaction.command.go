@@ -0,0 +1,56 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ToggleReactionCommand = (*toggleReactionCommand)(nil)
+
+type toggleReactionCommand struct {
+	repo             messages.Repository
+	conversationRepo conversations.Repository
+	eventDispatcher  *event_dispatcher.Dispatcher
+	log              *zerolog.Logger
+}
+
+func NewToggleReactionCommand(
+	repo messages.Repository,
+	conversationRepo conversations.Repository,
+	eventDispatcher *event_dispatcher.Dispatcher,
+) contracts.ToggleReactionCommand {
+	return &toggleReactionCommand{
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		eventDispatcher:  eventDispatcher,
+		log:              logger.Component("chat.command.toggle_reaction"),
+	}
+}
+
+func (c *toggleReactionCommand) Execute(ctx context.Context, req contracts.ToggleReactionCommandRequest) (message.Message, error) {
+	msg, err := updateMessage(ctx, c.repo, c.conversationRepo, c.eventDispatcher, c.log, req.ConversationID, req.MessageID, req.ActorID,
+		func(conv *conversation.Conversation, msg *message.Message) error {
+			return msg.ToggleReaction(req.ActorID, req.Emoji, conv.RecipientIDs(req.ActorID), time.Now().UTC())
+		})
+	if err != nil {
+		return message.Message{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("message_id", msg.ID).
+		Str("conversation_id", msg.ConversationID).
+		Str("user_id", req.ActorID).
+		Str("emoji", req.Emoji).
+		Msg("message reaction toggled")
+
+	return msg, nil
+}
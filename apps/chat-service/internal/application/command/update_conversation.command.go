@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/command/contracts"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.UpdateConversationCommand = (*updateConversationCommand)(nil)
+
+type updateConversationCommand struct {
+	repo            conversations.Repository
+	eventDispatcher *event_dispatcher.Dispatcher
+	log             *zerolog.Logger
+}
+
+func NewUpdateConversationCommand(repo conversations.Repository, eventDispatcher *event_dispatcher.Dispatcher) contracts.UpdateConversationCommand {
+	return &updateConversationCommand{
+		repo:            repo,
+		eventDispatcher: eventDispatcher,
+		log:             logger.Component("chat.command.update_conversation"),
+	}
+}
+
+func (c *updateConversationCommand) Execute(ctx context.Context, req contracts.UpdateConversationCommandRequest) (conversation.Conversation, error) {
+	conv, err := updateConversation(ctx, c.repo, c.eventDispatcher, c.log, req.ConversationID, func(conv *conversation.Conversation) error {
+		return conv.UpdateDetails(req.ActorID, req.Title, req.AvatarURL, time.Now().UTC())
+	})
+	if err != nil {
+		return conversation.Conversation{}, err
+	}
+
+	c.log.Info().Ctx(ctx).
+		Str("conversation_id", conv.ID).
+		Str("actor_id", req.ActorID).
+		Msg("conversation updated")
+
+	return conv, nil
+}
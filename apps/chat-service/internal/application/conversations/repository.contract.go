@@ -0,0 +1,25 @@
+package conversations
+
+import (
+	"context"
+
+	domain "golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+type Repository interface {
+	// Create stores a new conversation with its members
+	Create(ctx context.Context, conv *domain.Conversation) error
+	// GetOrCreateDirect returns the direct conversation of the two members of conv, storing conv if the pair
+	// has none yet. The stored conv keeps its domain events; an existing conversation is returned without any
+	GetOrCreateDirect(ctx context.Context, conv *domain.Conversation) (*domain.Conversation, error)
+	// FindByID returns the conversation with its members, or a not found error
+	FindByID(ctx context.Context, id string) (*domain.Conversation, error)
+	// FindDirect returns the direct conversation of two users, or a not found error
+	FindDirect(ctx context.Context, userID, peerID string) (*domain.Conversation, error)
+	// Update applies change to the conversation and stores the result. The conversation is locked for
+	// the duration, so concurrent membership changes see each other (the member cap holds)
+	Update(ctx context.Context, id string, change func(conv *domain.Conversation) error) (*domain.Conversation, error)
+	// SaveWatermarks stores the receipt watermarks of a member without locking the conversation. Each one only
+	// moves forward: one the stored watermark already covers is skipped. Reports whether any was stored
+	SaveWatermarks(ctx context.Context, conversationID string, member domain.Member) (bool, error)
+}
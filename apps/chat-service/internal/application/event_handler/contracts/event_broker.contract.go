@@ -2,6 +2,8 @@ package contracts
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 )
 
 // EventBrokerPublisher publishes events to the event broker
@@ -9,14 +11,174 @@ import (
 type EventBrokerPublisher interface {
 	// PublishMessageCreated publishes a message created event
 	PublishMessageCreated(ctx context.Context, payload MessageCreatedPayload) error
+	// PublishMessageEdited publishes a message edited event
+	PublishMessageEdited(ctx context.Context, payload MessageEditedPayload) error
+	// PublishMessageDeleted publishes a message deleted for everyone event
+	PublishMessageDeleted(ctx context.Context, payload MessageDeletedPayload) error
+	// PublishMessageDeletedForMe publishes a message deleted for one member event
+	PublishMessageDeletedForMe(ctx context.Context, payload MessageDeletedForMePayload) error
+	// PublishConversationCreated publishes a conversation created event
+	PublishConversationCreated(ctx context.Context, payload ConversationPayload) error
+	// PublishConversationUpdated publishes a group details change
+	PublishConversationUpdated(ctx context.Context, payload ConversationUpdatedPayload) error
+	// PublishConversationMemberChanged publishes a membership change
+	PublishConversationMemberChanged(ctx context.Context, payload ConversationMemberChangedPayload) error
+	// PublishMessageReceipt publishes the move of a member's delivered or read watermark
+	PublishMessageReceipt(ctx context.Context, payload MessageReceiptPayload) error
+	// PublishMessageReaction publishes a reaction added to, or taken back from, a message
+	PublishMessageReaction(ctx context.Context, payload MessageReactionPayload) error
+	// PublishMentionCreated publishes the mentions of a new message
+	PublishMentionCreated(ctx context.Context, payload MentionCreatedPayload) error
+	// PublishUserDataErased reports the chat step of a GDPR deletion to auth-service
+	PublishUserDataErased(ctx context.Context, payload UserDataErasedPayload) error
+	// PublishUserDataExported sends the chat document of a GDPR data export to auth-service
+	PublishUserDataExported(ctx context.Context, payload UserDataExportedPayload) error
 }
 
 // MessageCreatedPayload represents the payload for message created event
 type MessageCreatedPayload struct {
-	MessageID  string
-	SenderID   string
-	ReceiverID string
-	Content    string
-	CreatedAt  string
+	MessageID       string
+	ConversationID  string
+	ParentMessageID string
+	SenderID        string
+	ReceiverID      string
+	RecipientIDs    []string
+	Content         string
+	CreatedAt       string
 }
 
+// MessageEditedPayload represents the payload for message edited event
+type MessageEditedPayload struct {
+	MessageID      string
+	ConversationID string
+	SenderID       string
+	RecipientIDs   []string
+	Content        string
+	EditedAt       time.Time
+}
+
+// MessageDeletedPayload represents the payload for message deleted for everyone event
+type MessageDeletedPayload struct {
+	MessageID      string
+	ConversationID string
+	SenderID       string
+	RecipientIDs   []string
+	DeletedAt      time.Time
+}
+
+// MessageDeletedForMePayload represents the payload for message deleted for one member event
+type MessageDeletedForMePayload struct {
+	MessageID      string
+	ConversationID string
+	UserID         string
+	DeletedAt      time.Time
+}
+
+// ConversationPayload represents a conversation and its members after a change
+type ConversationPayload struct {
+	ID        string
+	Kind      string
+	Title     string
+	AvatarURL string
+	CreatedBy string
+	Members   []ConversationMemberPayload
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ConversationMemberPayload struct {
+	UserID   string
+	Role     string
+	JoinedAt time.Time
+}
+
+// ConversationUpdatedPayload represents the payload for conversation updated event
+type ConversationUpdatedPayload struct {
+	Conversation ConversationPayload
+	ActorID      string
+}
+
+// MemberChange is the kind of membership change a ConversationMemberChangedPayload reports
+type MemberChange string
+
+const (
+	MemberJoined      MemberChange = "joined"
+	MemberLeft        MemberChange = "left"
+	MemberRemoved     MemberChange = "removed"
+	MemberRoleChanged MemberChange = "role_changed"
+)
+
+// ConversationMemberChangedPayload represents the payload for membership change events
+type ConversationMemberChangedPayload struct {
+	Change       MemberChange
+	Conversation ConversationPayload
+	ActorID      string
+	UserID       string
+	Role         string
+}
+
+// Receipt is the watermark a MessageReceiptPayload reports
+type Receipt string
+
+const (
+	ReceiptDelivered Receipt = "delivered"
+	ReceiptRead      Receipt = "read"
+)
+
+// MessageReceiptPayload represents the payload for delivery and read receipt events
+type MessageReceiptPayload struct {
+	Receipt          Receipt
+	ConversationID   string
+	UserID           string
+	MessageID        string
+	MessageCreatedAt time.Time
+	RecipientIDs     []string
+	At               time.Time
+}
+
+// ReactionChange is the toggle a MessageReactionPayload reports
+type ReactionChange string
+
+const (
+	ReactionAdded   ReactionChange = "added"
+	ReactionRemoved ReactionChange = "removed"
+)
+
+// MessageReactionPayload represents the payload for reaction events
+type MessageReactionPayload struct {
+	Change         ReactionChange
+	MessageID      string
+	ConversationID string
+	UserID         string
+	Emoji          string
+	Count          int
+	RecipientIDs   []string
+	At             time.Time
+}
+
+// MentionCreatedPayload represents the payload for mention created event
+type MentionCreatedPayload struct {
+	MessageID        string
+	ConversationID   string
+	ParentMessageID  string
+	SenderID         string
+	MentionedUserIDs []string
+	Content          string
+	CreatedAt        time.Time
+}
+
+// UserDataErasedPayload represents the payload for user data erased event
+type UserDataErasedPayload struct {
+	RequestID string
+	UserID    string
+	Records   int64
+	ErasedAt  time.Time
+}
+
+// UserDataExportedPayload represents the payload for user data exported event
+type UserDataExportedPayload struct {
+	RequestID  string
+	UserID     string
+	Document   json.RawMessage
+	ExportedAt time.Time
+}
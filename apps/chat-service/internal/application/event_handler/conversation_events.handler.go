@@ -0,0 +1,149 @@
+package event_handler
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/event_handler/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/logger"
+)
+
+// ConversationEventsHandler publishes every conversation domain event to the event broker
+type ConversationEventsHandler struct {
+	eventBroker contracts.EventBrokerPublisher
+	log         *zerolog.Logger
+}
+
+func NewConversationEventsHandler(eventBroker contracts.EventBrokerPublisher) *ConversationEventsHandler {
+	return &ConversationEventsHandler{
+		eventBroker: eventBroker,
+		log:         logger.Component("chat.event_handler.conversation_events"),
+	}
+}
+
+// EventTypes lists the domain events the handler is registered for
+func (h *ConversationEventsHandler) EventTypes() []string {
+	return []string{
+		conversation.ConversationCreatedEvent{}.Type(),
+		conversation.ConversationUpdatedEvent{}.Type(),
+		conversation.MemberJoinedEvent{}.Type(),
+		conversation.MemberLeftEvent{}.Type(),
+		conversation.MemberRemovedEvent{}.Type(),
+		conversation.MemberRoleChangedEvent{}.Type(),
+		conversation.MessagesDeliveredEvent{}.Type(),
+		conversation.MessagesReadEvent{}.Type(),
+	}
+}
+
+func (h *ConversationEventsHandler) Handle(ctx context.Context, domainEvent message.DomainEvent) error {
+	var (
+		conversationID string
+		err            error
+	)
+	switch event := domainEvent.(type) {
+	case conversation.ConversationCreatedEvent:
+		conversationID = event.Conversation.ID
+		err = h.eventBroker.PublishConversationCreated(ctx, toConversationPayload(event.Conversation))
+	case conversation.ConversationUpdatedEvent:
+		conversationID = event.Conversation.ID
+		err = h.eventBroker.PublishConversationUpdated(ctx, contracts.ConversationUpdatedPayload{
+			Conversation: toConversationPayload(event.Conversation),
+			ActorID:      event.ActorID,
+		})
+	case conversation.MemberJoinedEvent:
+		conversationID = event.Conversation.ID
+		err = h.publishMemberChanged(ctx, contracts.MemberJoined, event.Conversation, event.ActorID, event.UserID, event.Role)
+	case conversation.MemberLeftEvent:
+		conversationID = event.Conversation.ID
+		err = h.publishMemberChanged(ctx, contracts.MemberLeft, event.Conversation, event.UserID, event.UserID, "")
+	case conversation.MemberRemovedEvent:
+		conversationID = event.Conversation.ID
+		err = h.publishMemberChanged(ctx, contracts.MemberRemoved, event.Conversation, event.ActorID, event.UserID, "")
+	case conversation.MemberRoleChangedEvent:
+		conversationID = event.Conversation.ID
+		err = h.publishMemberChanged(ctx, contracts.MemberRoleChanged, event.Conversation, event.ActorID, event.UserID, event.Role)
+	case conversation.MessagesDeliveredEvent:
+		conversationID = event.ConversationID
+		err = h.publishReceipt(ctx, contracts.ReceiptDelivered, event.ConversationID, event.UserID, event.Watermark, event.RecipientIDs)
+	case conversation.MessagesReadEvent:
+		conversationID = event.ConversationID
+		err = h.publishReceipt(ctx, contracts.ReceiptRead, event.ConversationID, event.UserID, event.Watermark, event.RecipientIDs)
+	default:
+		h.log.Error().Ctx(ctx).
+			Str("event_type", domainEvent.Type()).
+			Msg("unexpected event type in ConversationEventsHandler")
+		return nil // Ignore unexpected events
+	}
+
+	if err != nil {
+		h.log.Error().Ctx(ctx).
+			Err(err).
+			Str("event_type", domainEvent.Type()).
+			Str("conversation_id", conversationID).
+			Msg("failed to publish conversation event")
+		return err
+	}
+
+	h.log.Info().Ctx(ctx).
+		Str("event_type", domainEvent.Type()).
+		Str("conversation_id", conversationID).
+		Msg("conversation event published")
+	return nil
+}
+
+func (h *ConversationEventsHandler) publishMemberChanged(
+	ctx context.Context,
+	change contracts.MemberChange,
+	conv conversation.Conversation,
+	actorID, userID string,
+	role conversation.Role,
+) error {
+	return h.eventBroker.PublishConversationMemberChanged(ctx, contracts.ConversationMemberChangedPayload{
+		Change:       change,
+		Conversation: toConversationPayload(conv),
+		ActorID:      actorID,
+		UserID:       userID,
+		Role:         string(role),
+	})
+}
+
+func (h *ConversationEventsHandler) publishReceipt(
+	ctx context.Context,
+	receipt contracts.Receipt,
+	conversationID, userID string,
+	watermark conversation.Watermark,
+	recipientIDs []string,
+) error {
+	return h.eventBroker.PublishMessageReceipt(ctx, contracts.MessageReceiptPayload{
+		Receipt:          receipt,
+		ConversationID:   conversationID,
+		UserID:           userID,
+		MessageID:        watermark.MessageID,
+		MessageCreatedAt: watermark.MessageCreatedAt,
+		RecipientIDs:     recipientIDs,
+		At:               watermark.At,
+	})
+}
+
+func toConversationPayload(conv conversation.Conversation) contracts.ConversationPayload {
+	members := make([]contracts.ConversationMemberPayload, len(conv.Members))
+	for i, member := range conv.Members {
+		members[i] = contracts.ConversationMemberPayload{
+			UserID:   member.UserID,
+			Role:     string(member.Role),
+			JoinedAt: member.JoinedAt,
+		}
+	}
+	return contracts.ConversationPayload{
+		ID:        conv.ID,
+		Kind:      string(conv.Kind),
+		Title:     conv.Title,
+		AvatarURL: conv.AvatarURL,
+		CreatedBy: conv.CreatedBy,
+		Members:   members,
+		CreatedAt: conv.CreatedAt,
+		UpdatedAt: conv.UpdatedAt,
+	}
+}
@@ -0,0 +1,59 @@
+package event_handler
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/event_handler/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/logger"
+)
+
+// MentionCreatedHandler publishes the mentions of new messages to the event broker
+type MentionCreatedHandler struct {
+	eventBroker contracts.EventBrokerPublisher
+	log         *zerolog.Logger
+}
+
+func NewMentionCreatedHandler(eventBroker contracts.EventBrokerPublisher) *MentionCreatedHandler {
+	return &MentionCreatedHandler{
+		eventBroker: eventBroker,
+		log:         logger.Component("chat.event_handler.mention_created"),
+	}
+}
+
+func (h *MentionCreatedHandler) Handle(ctx context.Context, domainEvent message.DomainEvent) error {
+	mentionCreatedEvent, ok := domainEvent.(message.MentionCreatedEvent)
+	if !ok {
+		h.log.Error().Ctx(ctx).
+			Str("event_type", domainEvent.Type()).
+			Msg("unexpected event type in MentionCreatedHandler")
+		return nil // Ignore unexpected events
+	}
+
+	payload := contracts.MentionCreatedPayload{
+		MessageID:        mentionCreatedEvent.MessageID,
+		ConversationID:   mentionCreatedEvent.ConversationID,
+		ParentMessageID:  mentionCreatedEvent.ParentID,
+		SenderID:         mentionCreatedEvent.SenderID,
+		MentionedUserIDs: mentionCreatedEvent.MentionedUserIDs,
+		Content:          mentionCreatedEvent.Content,
+		CreatedAt:        mentionCreatedEvent.CreatedAt,
+	}
+
+	if err := h.eventBroker.PublishMentionCreated(ctx, payload); err != nil {
+		h.log.Error().Ctx(ctx).
+			Err(err).
+			Str("message_id", mentionCreatedEvent.MessageID).
+			Msg("failed to publish MentionCreated event")
+		return err
+	}
+
+	h.log.Info().Ctx(ctx).
+		Str("message_id", mentionCreatedEvent.MessageID).
+		Str("conversation_id", mentionCreatedEvent.ConversationID).
+		Int("mention_count", len(mentionCreatedEvent.MentionedUserIDs)).
+		Msg("MentionCreated event published")
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package event_handler
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/event_handler/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/logger"
+)
+
+// MessageChangesHandler publishes the edits, deletions and reactions of messages to the event broker
+type MessageChangesHandler struct {
+	eventBroker contracts.EventBrokerPublisher
+	log         *zerolog.Logger
+}
+
+func NewMessageChangesHandler(eventBroker contracts.EventBrokerPublisher) *MessageChangesHandler {
+	return &MessageChangesHandler{
+		eventBroker: eventBroker,
+		log:         logger.Component("chat.event_handler.message_changes"),
+	}
+}
+
+// EventTypes lists the domain events the handler is registered for
+func (h *MessageChangesHandler) EventTypes() []string {
+	return []string{
+		message.MessageEditedEvent{}.Type(),
+		message.MessageDeletedEvent{}.Type(),
+		message.MessageDeletedForMeEvent{}.Type(),
+		message.MessageReactionAddedEvent{}.Type(),
+		message.MessageReactionRemovedEvent{}.Type(),
+	}
+}
+
+func (h *MessageChangesHandler) Handle(ctx context.Context, domainEvent message.DomainEvent) error {
+	var (
+		messageID string
+		err       error
+	)
+	switch event := domainEvent.(type) {
+	case message.MessageEditedEvent:
+		messageID = event.MessageID
+		// The previous version stays in the edit history, it is not published
+		err = h.eventBroker.PublishMessageEdited(ctx, contracts.MessageEditedPayload{
+			MessageID:      event.MessageID,
+			ConversationID: event.ConversationID,
+			SenderID:       event.SenderID,
+			RecipientIDs:   event.RecipientIDs,
+			Content:        event.Content,
+			EditedAt:       event.EditedAt,
+		})
+	case message.MessageDeletedEvent:
+		messageID = event.MessageID
+		err = h.eventBroker.PublishMessageDeleted(ctx, contracts.MessageDeletedPayload{
+			MessageID:      event.MessageID,
+			ConversationID: event.ConversationID,
+			SenderID:       event.SenderID,
+			RecipientIDs:   event.RecipientIDs,
+			DeletedAt:      event.DeletedAt,
+		})
+	case message.MessageDeletedForMeEvent:
+		messageID = event.MessageID
+		err = h.eventBroker.PublishMessageDeletedForMe(ctx, contracts.MessageDeletedForMePayload{
+			MessageID:      event.MessageID,
+			ConversationID: event.ConversationID,
+			UserID:         event.UserID,
+			DeletedAt:      event.DeletedAt,
+		})
+	case message.MessageReactionAddedEvent:
+		messageID = event.MessageID
+		err = h.eventBroker.PublishMessageReaction(ctx, contracts.MessageReactionPayload{
+			Change:         contracts.ReactionAdded,
+			MessageID:      event.MessageID,
+			ConversationID: event.ConversationID,
+			UserID:         event.UserID,
+			Emoji:          event.Emoji,
+			Count:          event.Count,
+			RecipientIDs:   event.RecipientIDs,
+			At:             event.At,
+		})
+	case message.MessageReactionRemovedEvent:
+		messageID = event.MessageID
+		err = h.eventBroker.PublishMessageReaction(ctx, contracts.MessageReactionPayload{
+			Change:         contracts.ReactionRemoved,
+			MessageID:      event.MessageID,
+			ConversationID: event.ConversationID,
+			UserID:         event.UserID,
+			Emoji:          event.Emoji,
+			Count:          event.Count,
+			RecipientIDs:   event.RecipientIDs,
+			At:             event.At,
+		})
+	default:
+		h.log.Error().Ctx(ctx).
+			Str("event_type", domainEvent.Type()).
+			Msg("unexpected event type in MessageChangesHandler")
+		return nil // Ignore unexpected events
+	}
+
+	if err != nil {
+		h.log.Error().Ctx(ctx).
+			Err(err).
+			Str("event_type", domainEvent.Type()).
+			Str("message_id", messageID).
+			Msg("failed to publish message change event")
+		return err
+	}
+
+	h.log.Info().Ctx(ctx).
+		Str("event_type", domainEvent.Type()).
+		Str("message_id", messageID).
+		Msg("message change event published")
+	return nil
+}
@@ -25,7 +25,7 @@ func (h *MessageCreatedHandler) Handle(ctx context.Context, domainEvent message.
 	// Type assert to MessageCreatedEvent
 	messageCreatedEvent, ok := domainEvent.(message.MessageCreatedEvent)
 	if !ok {
-		h.log.Error().
+		h.log.Error().Ctx(ctx).
 			Str("event_type", domainEvent.Type()).
 			Msg("unexpected event type in MessageCreatedHandler")
 		return nil // Ignore unexpected events
@@ -33,25 +33,28 @@ func (h *MessageCreatedHandler) Handle(ctx context.Context, domainEvent message.
 
 	// Transform domain event to event broker payload
 	payload := contracts.MessageCreatedPayload{
-		MessageID:  messageCreatedEvent.MessageID,
-		SenderID:   messageCreatedEvent.SenderID,
-		ReceiverID: messageCreatedEvent.ReceiverID,
-		Content:    messageCreatedEvent.Content,
-		CreatedAt:  messageCreatedEvent.CreatedAt,
+		MessageID:       messageCreatedEvent.MessageID,
+		ConversationID:  messageCreatedEvent.ConversationID,
+		ParentMessageID: messageCreatedEvent.ParentID,
+		SenderID:        messageCreatedEvent.SenderID,
+		ReceiverID:      messageCreatedEvent.ReceiverID,
+		RecipientIDs:    messageCreatedEvent.RecipientIDs,
+		Content:         messageCreatedEvent.Content,
+		CreatedAt:       messageCreatedEvent.CreatedAt,
 	}
 
 	if err := h.eventBroker.PublishMessageCreated(ctx, payload); err != nil {
-		h.log.Error().
+		h.log.Error().Ctx(ctx).
 			Err(err).
 			Str("message_id", messageCreatedEvent.MessageID).
 			Msg("failed to publish MessageCreated event")
 		return err
 	}
 
-	h.log.Info().
+	h.log.Info().Ctx(ctx).
 		Str("message_id", messageCreatedEvent.MessageID).
+		Str("conversation_id", messageCreatedEvent.ConversationID).
 		Str("sender_id", messageCreatedEvent.SenderID).
-		Str("receiver_id", messageCreatedEvent.ReceiverID).
 		Msg("MessageCreated event published")
 
 	return nil
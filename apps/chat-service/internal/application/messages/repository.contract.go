@@ -2,10 +2,60 @@ package messages
 
 import (
 	"context"
+	"time"
 
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
 	domain "golang-social-media/apps/chat-service/internal/domain/message"
 )
 
+// Cursor is the position of the last item of a page; pages are ordered newest first on (time, id).
+// Message pages use the message creation time and ID, inbox pages the conversation activity time and ID
+type Cursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// Conversation is an inbox entry: a conversation of the user and its last message, nil if nothing was sent yet
+type Conversation struct {
+	Conversation   conversation.Conversation
+	LastMessage    *domain.Message
+	LastActivityAt time.Time
+}
+
+// UnreadCount is the number of messages of a conversation the user has not read, up to the limit it was counted to
+type UnreadCount struct {
+	ConversationID string
+	Count          int
+}
+
 type Repository interface {
+	// Create stores the message with its mentions, counts it in the thread of its parent message if it replies
+	// to one, and moves its conversation to the top of the members' inboxes
 	Create(ctx context.Context, msg *domain.Message) error
+	// Update applies change to the stored message, with its reactions, under a row lock and saves what its events report.
+	// Returns a not found error if the conversation has no such message
+	Update(ctx context.Context, conversationID, messageID string, change func(msg *domain.Message) error) (*domain.Message, error)
+	// FindByID returns a message of the conversation with its reactions and mentions, or a not found error
+	FindByID(ctx context.Context, conversationID, messageID string) (*domain.Message, error)
+	// ListEdits returns the previous versions of an edited message, most recent first
+	ListEdits(ctx context.Context, conversationID, messageID string) ([]domain.Edit, error)
+	// ListConversation returns up to limit messages of the conversation, newest first,
+	// starting after the cursor when one is given. Messages userID deleted for themselves are left out
+	ListConversation(ctx context.Context, conversationID, userID string, after *Cursor, limit int) ([]domain.Message, error)
+	// ListReplies returns up to limit replies to the parent message, newest first, starting after the cursor
+	// when one is given. Messages userID deleted for themselves are left out
+	ListReplies(ctx context.Context, conversationID, parentID, userID string, after *Cursor, limit int) ([]domain.Message, error)
+	// ListConversations returns up to limit inbox entries of the user, most recently active first,
+	// starting after the cursor when one is given
+	ListConversations(ctx context.Context, userID string, after *Cursor, limit int) ([]Conversation, error)
+	// UnreadCounts counts, in each conversation of the user, the messages of other members past their read
+	// watermark, or since they joined when they read nothing yet. Messages deleted for everyone or for the user
+	// are left out. Counting stops at limit; only conversations with unread messages are returned, optionally
+	// restricted to conversationIDs
+	UnreadCounts(ctx context.Context, userID string, conversationIDs []string, limit int) ([]UnreadCount, error)
+	// ListByParticipant returns every message the user sent or that was sent to a conversation they are in, oldest first
+	ListByParticipant(ctx context.Context, userID string) ([]domain.Message, error)
+	// RedactBySender clears the content and edit history of every message the user sent and returns how many were redacted.
+	// The rows stay so the other participants' conversations keep their shape
+	RedactBySender(ctx context.Context, senderID string) (int64, error)
 }
@@ -0,0 +1,18 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// GetConversationQuery returns a conversation with its members, to one of them
+type GetConversationQuery interface {
+	Execute(ctx context.Context, req GetConversationQueryRequest) (conversation.Conversation, error)
+}
+
+// GetConversationQueryRequest represents the request for a conversation by the member UserID
+type GetConversationQueryRequest struct {
+	ConversationID string
+	UserID         string
+}
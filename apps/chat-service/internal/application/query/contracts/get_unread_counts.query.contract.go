@@ -0,0 +1,28 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/application/messages"
+)
+
+// GetUnreadCountsQuery counts the unread messages of a user's conversations
+type GetUnreadCountsQuery interface {
+	Execute(ctx context.Context, req GetUnreadCountsQueryRequest) (GetUnreadCountsQueryResponse, error)
+}
+
+// GetUnreadCountsQueryRequest represents the request for the unread counts of a user
+type GetUnreadCountsQueryRequest struct {
+	UserID          string
+	ConversationIDs []string // Empty for every conversation of the user
+}
+
+// GetUnreadCountsQueryResponse lists the conversations with unread messages, most recently active first.
+// Counts stop at MaxUnreadCount, for a "99+" badge
+type GetUnreadCountsQueryResponse struct {
+	Counts []messages.UnreadCount
+	Total  int
+}
+
+// MaxUnreadCount is where the unread messages of a conversation stop being counted
+const MaxUnreadCount = 100
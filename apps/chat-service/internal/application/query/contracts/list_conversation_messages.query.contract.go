@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/message"
+)
+
+// ListConversationMessagesQuery pages through the messages of a conversation, newest first
+type ListConversationMessagesQuery interface {
+	Execute(ctx context.Context, req ListConversationMessagesQueryRequest) (ListConversationMessagesQueryResponse, error)
+}
+
+// ListConversationMessagesQueryRequest represents the request for a page of conversation history
+type ListConversationMessagesQueryRequest struct {
+	UserID         string
+	ConversationID string
+	PeerID         string // Addresses the direct conversation with this user when ConversationID is empty
+	Cursor         string // NextCursor of the previous page, empty for the newest messages
+	Limit          int
+}
+
+// ListConversationMessagesQueryResponse is a page of conversation history
+type ListConversationMessagesQueryResponse struct {
+	Messages   []message.Message
+	NextCursor string // Empty on the last page
+}
@@ -0,0 +1,25 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/application/messages"
+)
+
+// ListConversationsQuery pages through a user's inbox, most recently active conversation first
+type ListConversationsQuery interface {
+	Execute(ctx context.Context, req ListConversationsQueryRequest) (ListConversationsQueryResponse, error)
+}
+
+// ListConversationsQueryRequest represents the request for a page of a user's inbox
+type ListConversationsQueryRequest struct {
+	UserID string
+	Cursor string // NextCursor of the previous page, empty for the most recent conversations
+	Limit  int
+}
+
+// ListConversationsQueryResponse is a page of a user's inbox
+type ListConversationsQueryResponse struct {
+	Conversations []messages.Conversation
+	NextCursor    string // Empty on the last page
+}
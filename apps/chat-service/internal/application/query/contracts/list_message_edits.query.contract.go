@@ -0,0 +1,19 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/message"
+)
+
+// ListMessageEditsQuery returns the previous versions of an edited message, most recent first
+type ListMessageEditsQuery interface {
+	Execute(ctx context.Context, req ListMessageEditsQueryRequest) ([]message.Edit, error)
+}
+
+// ListMessageEditsQueryRequest represents the request for the edit history of a message by the member UserID
+type ListMessageEditsQueryRequest struct {
+	ConversationID string
+	MessageID      string
+	UserID         string
+}
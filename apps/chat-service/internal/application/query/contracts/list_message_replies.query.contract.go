@@ -0,0 +1,28 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/apps/chat-service/internal/domain/message"
+)
+
+// ListMessageRepliesQuery pages through the thread of a message, newest reply first
+type ListMessageRepliesQuery interface {
+	Execute(ctx context.Context, req ListMessageRepliesQueryRequest) (ListMessageRepliesQueryResponse, error)
+}
+
+// ListMessageRepliesQueryRequest represents the request for a page of a thread, by a member of the conversation
+type ListMessageRepliesQueryRequest struct {
+	UserID         string
+	ConversationID string
+	MessageID      string // The parent message of the thread
+	Cursor         string // NextCursor of the previous page, empty for the newest replies
+	Limit          int
+}
+
+// ListMessageRepliesQueryResponse is the parent message and a page of its replies
+type ListMessageRepliesQueryResponse struct {
+	Parent     message.Message
+	Replies    []message.Message
+	NextCursor string // Empty on the last page
+}
@@ -0,0 +1,48 @@
+package query
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+)
+
+// encodeCursor makes an opaque page token from the position of the last item of a page
+func encodeCursor(cursor messages.Cursor) string {
+	raw := strconv.FormatInt(cursor.CreatedAt.UnixNano(), 10) + ":" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(token string) (messages.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return messages.Cursor{}, err
+	}
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return messages.Cursor{}, strconv.ErrSyntax
+	}
+	// Message and conversation IDs are UUIDs, anything else would fail in the database instead
+	if _, err := uuid.Parse(id); err != nil {
+		return messages.Cursor{}, err
+	}
+	unixNano, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return messages.Cursor{}, err
+	}
+	return messages.Cursor{CreatedAt: time.Unix(0, unixNano).UTC(), ID: id}, nil
+}
+
+// pageLimit applies the default page size and caps it
+func pageLimit(limit, defaultSize, maxSize int) int {
+	if limit <= 0 {
+		return defaultSize
+	}
+	if limit > maxSize {
+		return maxSize
+	}
+	return limit
+}
@@ -0,0 +1,50 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	"golang-social-media/apps/chat-service/internal/application/query/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.GetConversationQuery = (*getConversationQuery)(nil)
+
+type getConversationQuery struct {
+	repo conversations.Repository
+	log  *zerolog.Logger
+}
+
+func NewGetConversationQuery(repo conversations.Repository) contracts.GetConversationQuery {
+	return &getConversationQuery{
+		repo: repo,
+		log:  logger.Component("chat.query.get_conversation"),
+	}
+}
+
+func (q *getConversationQuery) Execute(ctx context.Context, req contracts.GetConversationQueryRequest) (conversation.Conversation, error) {
+	if strings.TrimSpace(req.ConversationID) == "" {
+		return conversation.Conversation{}, pkgerrors.NewValidationError(pkgerrors.CodeConversationIDRequired, nil)
+	}
+	if strings.TrimSpace(req.UserID) == "" {
+		return conversation.Conversation{}, pkgerrors.NewInvalidRequestError("user_id is required")
+	}
+
+	conv, err := q.repo.FindByID(ctx, req.ConversationID)
+	if err != nil {
+		q.log.Debug().Ctx(ctx).
+			Err(err).
+			Str("conversation_id", req.ConversationID).
+			Msg("failed to load conversation")
+		return conversation.Conversation{}, err
+	}
+	// Non-members are not told the conversation exists
+	if !conv.IsMember(req.UserID) {
+		return conversation.Conversation{}, pkgerrors.NewNotFoundError(pkgerrors.CodeConversationNotFound)
+	}
+	return *conv, nil
+}
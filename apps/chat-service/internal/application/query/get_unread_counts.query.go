@@ -0,0 +1,54 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/application/query/contracts"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+// maxUnreadCountConversations bounds the conversation_ids of one request
+const maxUnreadCountConversations = 100
+
+var _ contracts.GetUnreadCountsQuery = (*getUnreadCountsQuery)(nil)
+
+type getUnreadCountsQuery struct {
+	repo messages.Repository
+	log  *zerolog.Logger
+}
+
+func NewGetUnreadCountsQuery(repo messages.Repository) contracts.GetUnreadCountsQuery {
+	return &getUnreadCountsQuery{
+		repo: repo,
+		log:  logger.Component("chat.query.get_unread_counts"),
+	}
+}
+
+func (q *getUnreadCountsQuery) Execute(ctx context.Context, req contracts.GetUnreadCountsQueryRequest) (contracts.GetUnreadCountsQueryResponse, error) {
+	if strings.TrimSpace(req.UserID) == "" {
+		return contracts.GetUnreadCountsQueryResponse{}, pkgerrors.NewInvalidRequestError("user_id is required")
+	}
+	if len(req.ConversationIDs) > maxUnreadCountConversations {
+		return contracts.GetUnreadCountsQueryResponse{}, pkgerrors.NewInvalidRequestError("too many conversation_ids")
+	}
+
+	// Conversations the user is not a member of match no membership row and are left out
+	counts, err := q.repo.UnreadCounts(ctx, req.UserID, req.ConversationIDs, contracts.MaxUnreadCount)
+	if err != nil {
+		q.log.Error().Ctx(ctx).
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to count unread messages")
+		return contracts.GetUnreadCountsQueryResponse{}, err
+	}
+
+	resp := contracts.GetUnreadCountsQueryResponse{Counts: counts}
+	for _, count := range counts {
+		resp.Total += count.Count
+	}
+	return resp, nil
+}
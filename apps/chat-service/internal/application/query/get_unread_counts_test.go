@@ -0,0 +1,118 @@
+package query
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/application/query/contracts"
+	"golang-social-media/pkg/errors"
+)
+
+// fakeUnreadRepository serves UnreadCounts from a fixed result and records how it was called;
+// the other repository methods are not used by the query
+type fakeUnreadRepository struct {
+	messages.Repository
+
+	counts []messages.UnreadCount
+	err    error
+
+	calls           int
+	userID          string
+	conversationIDs []string
+	limit           int
+}
+
+func (r *fakeUnreadRepository) UnreadCounts(ctx context.Context, userID string, conversationIDs []string, limit int) ([]messages.UnreadCount, error) {
+	r.calls++
+	r.userID = userID
+	r.conversationIDs = conversationIDs
+	r.limit = limit
+	return r.counts, r.err
+}
+
+func TestGetUnreadCountsQuery_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sums the counts of the conversations", func(t *testing.T) {
+		repo := &fakeUnreadRepository{counts: []messages.UnreadCount{
+			{ConversationID: "conversation-1", Count: 3},
+			{ConversationID: "conversation-2", Count: contracts.MaxUnreadCount},
+		}}
+		resp, err := NewGetUnreadCountsQuery(repo).Execute(ctx, contracts.GetUnreadCountsQueryRequest{
+			UserID:          "user-1",
+			ConversationIDs: []string{"conversation-1", "conversation-2", "conversation-3"},
+		})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if resp.Total != 3+contracts.MaxUnreadCount {
+			t.Errorf("Total = %d, want %d", resp.Total, 3+contracts.MaxUnreadCount)
+		}
+		if len(resp.Counts) != 2 {
+			t.Errorf("Counts = %+v, want the 2 conversations with unread messages", resp.Counts)
+		}
+		if repo.userID != "user-1" || len(repo.conversationIDs) != 3 {
+			t.Errorf("UnreadCounts(%s, %v), want user-1 and the 3 requested conversations", repo.userID, repo.conversationIDs)
+		}
+		if repo.limit != contracts.MaxUnreadCount {
+			t.Errorf("limit = %d, want %d", repo.limit, contracts.MaxUnreadCount)
+		}
+	})
+
+	t.Run("nothing unread", func(t *testing.T) {
+		resp, err := NewGetUnreadCountsQuery(&fakeUnreadRepository{}).Execute(ctx, contracts.GetUnreadCountsQueryRequest{UserID: "user-1"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v", err)
+		}
+		if resp.Total != 0 || len(resp.Counts) != 0 {
+			t.Errorf("response = %+v, want no counts", resp)
+		}
+	})
+
+	t.Run("user_id is required", func(t *testing.T) {
+		repo := &fakeUnreadRepository{}
+		_, err := NewGetUnreadCountsQuery(repo).Execute(ctx, contracts.GetUnreadCountsQueryRequest{UserID: "  "})
+		assertInvalidRequest(t, err)
+		if repo.calls != 0 {
+			t.Error("an invalid request should not reach the repository")
+		}
+	})
+
+	t.Run("too many conversation_ids", func(t *testing.T) {
+		ids := make([]string, 101)
+		for i := range ids {
+			ids[i] = fmt.Sprintf("conversation-%d", i)
+		}
+		repo := &fakeUnreadRepository{}
+		_, err := NewGetUnreadCountsQuery(repo).Execute(ctx, contracts.GetUnreadCountsQueryRequest{UserID: "user-1", ConversationIDs: ids})
+		assertInvalidRequest(t, err)
+		if repo.calls != 0 {
+			t.Error("an invalid request should not reach the repository")
+		}
+
+		if _, err := NewGetUnreadCountsQuery(repo).Execute(ctx, contracts.GetUnreadCountsQueryRequest{UserID: "user-1", ConversationIDs: ids[:100]}); err != nil {
+			t.Errorf("Execute() with 100 conversation_ids error = %v", err)
+		}
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		repoErr := fmt.Errorf("connection refused")
+		_, err := NewGetUnreadCountsQuery(&fakeUnreadRepository{err: repoErr}).Execute(ctx, contracts.GetUnreadCountsQueryRequest{UserID: "user-1"})
+		if err != repoErr {
+			t.Errorf("Execute() error = %v, want %v", err, repoErr)
+		}
+	})
+}
+
+func assertInvalidRequest(t *testing.T, err error) {
+	t.Helper()
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("error = %v (%T), want *errors.AppError", err, err)
+	}
+	if appErr.Code != errors.CodeInvalidRequest {
+		t.Errorf("error code = %v, want %v", appErr.Code, errors.CodeInvalidRequest)
+	}
+}
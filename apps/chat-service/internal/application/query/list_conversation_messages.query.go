@@ -0,0 +1,110 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/application/query/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+const (
+	defaultMessagePageSize = 50
+	maxMessagePageSize     = 200
+)
+
+var _ contracts.ListConversationMessagesQuery = (*listConversationMessagesQuery)(nil)
+
+type listConversationMessagesQuery struct {
+	repo             messages.Repository
+	conversationRepo conversations.Repository
+	log              *zerolog.Logger
+}
+
+func NewListConversationMessagesQuery(repo messages.Repository, conversationRepo conversations.Repository) contracts.ListConversationMessagesQuery {
+	return &listConversationMessagesQuery{
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		log:              logger.Component("chat.query.list_conversation_messages"),
+	}
+}
+
+func (q *listConversationMessagesQuery) Execute(ctx context.Context, req contracts.ListConversationMessagesQueryRequest) (contracts.ListConversationMessagesQueryResponse, error) {
+	if strings.TrimSpace(req.UserID) == "" {
+		return contracts.ListConversationMessagesQueryResponse{}, pkgerrors.NewInvalidRequestError("user_id is required")
+	}
+	if strings.TrimSpace(req.ConversationID) == "" && strings.TrimSpace(req.PeerID) == "" {
+		return contracts.ListConversationMessagesQueryResponse{}, pkgerrors.NewInvalidRequestError("conversation_id or peer_id is required")
+	}
+	limit := pageLimit(req.Limit, defaultMessagePageSize, maxMessagePageSize)
+
+	var after *messages.Cursor
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return contracts.ListConversationMessagesQueryResponse{}, pkgerrors.NewInvalidRequestError("invalid cursor")
+		}
+		after = &cursor
+	}
+
+	conversationID, err := q.resolveConversation(ctx, req)
+	if err != nil {
+		return contracts.ListConversationMessagesQueryResponse{}, err
+	}
+	if conversationID == "" {
+		// Two users who never exchanged a message have an empty history
+		return contracts.ListConversationMessagesQueryResponse{Messages: []message.Message{}}, nil
+	}
+
+	// One extra message tells whether there is a next page
+	history, err := q.repo.ListConversation(ctx, conversationID, req.UserID, after, limit+1)
+	if err != nil {
+		q.log.Error().Ctx(ctx).
+			Err(err).
+			Str("user_id", req.UserID).
+			Str("conversation_id", conversationID).
+			Msg("failed to list conversation messages")
+		return contracts.ListConversationMessagesQueryResponse{}, err
+	}
+
+	resp := contracts.ListConversationMessagesQueryResponse{Messages: history}
+	if len(history) > limit {
+		resp.Messages = history[:limit]
+		last := resp.Messages[limit-1]
+		resp.NextCursor = encodeCursor(messages.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return resp, nil
+}
+
+// resolveConversation returns the conversation to read, addressed by ID or, for a direct conversation,
+// by the other user. Only members read a conversation; an empty ID means the direct conversation does not exist yet
+func (q *listConversationMessagesQuery) resolveConversation(ctx context.Context, req contracts.ListConversationMessagesQueryRequest) (string, error) {
+	var (
+		conv *conversation.Conversation
+		err  error
+	)
+	if req.ConversationID != "" {
+		conv, err = q.conversationRepo.FindByID(ctx, req.ConversationID)
+	} else {
+		conv, err = q.conversationRepo.FindDirect(ctx, req.UserID, req.PeerID)
+		var appErr *pkgerrors.AppError
+		if errors.As(err, &appErr) && appErr.Code == pkgerrors.CodeConversationNotFound {
+			return "", nil
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	// Non-members are not told the conversation exists
+	if !conv.IsMember(req.UserID) {
+		return "", pkgerrors.NewNotFoundError(pkgerrors.CodeConversationNotFound)
+	}
+	return conv.ID, nil
+}
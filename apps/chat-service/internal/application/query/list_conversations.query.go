@@ -0,0 +1,65 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/application/query/contracts"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+const (
+	defaultConversationPageSize = 20
+	maxConversationPageSize     = 100
+)
+
+var _ contracts.ListConversationsQuery = (*listConversationsQuery)(nil)
+
+type listConversationsQuery struct {
+	repo messages.Repository
+	log  *zerolog.Logger
+}
+
+func NewListConversationsQuery(repo messages.Repository) contracts.ListConversationsQuery {
+	return &listConversationsQuery{
+		repo: repo,
+		log:  logger.Component("chat.query.list_conversations"),
+	}
+}
+
+func (q *listConversationsQuery) Execute(ctx context.Context, req contracts.ListConversationsQueryRequest) (contracts.ListConversationsQueryResponse, error) {
+	if strings.TrimSpace(req.UserID) == "" {
+		return contracts.ListConversationsQueryResponse{}, pkgerrors.NewInvalidRequestError("user_id is required")
+	}
+	limit := pageLimit(req.Limit, defaultConversationPageSize, maxConversationPageSize)
+
+	var after *messages.Cursor
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return contracts.ListConversationsQueryResponse{}, pkgerrors.NewInvalidRequestError("invalid cursor")
+		}
+		after = &cursor
+	}
+
+	// One extra conversation tells whether there is a next page
+	conversations, err := q.repo.ListConversations(ctx, req.UserID, after, limit+1)
+	if err != nil {
+		q.log.Error().Ctx(ctx).
+			Err(err).
+			Str("user_id", req.UserID).
+			Msg("failed to list conversations")
+		return contracts.ListConversationsQueryResponse{}, err
+	}
+
+	resp := contracts.ListConversationsQueryResponse{Conversations: conversations}
+	if len(conversations) > limit {
+		resp.Conversations = conversations[:limit]
+		last := resp.Conversations[limit-1]
+		resp.NextCursor = encodeCursor(messages.Cursor{CreatedAt: last.LastActivityAt, ID: last.Conversation.ID})
+	}
+	return resp, nil
+}
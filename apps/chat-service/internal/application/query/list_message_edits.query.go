@@ -0,0 +1,62 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/application/query/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ListMessageEditsQuery = (*listMessageEditsQuery)(nil)
+
+type listMessageEditsQuery struct {
+	repo             messages.Repository
+	conversationRepo conversations.Repository
+	log              *zerolog.Logger
+}
+
+func NewListMessageEditsQuery(repo messages.Repository, conversationRepo conversations.Repository) contracts.ListMessageEditsQuery {
+	return &listMessageEditsQuery{
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		log:              logger.Component("chat.query.list_message_edits"),
+	}
+}
+
+func (q *listMessageEditsQuery) Execute(ctx context.Context, req contracts.ListMessageEditsQueryRequest) ([]message.Edit, error) {
+	if strings.TrimSpace(req.ConversationID) == "" {
+		return nil, pkgerrors.NewValidationError(pkgerrors.CodeConversationIDRequired, nil)
+	}
+	if strings.TrimSpace(req.MessageID) == "" {
+		return nil, pkgerrors.NewValidationError(pkgerrors.CodeMessageIDRequired, nil)
+	}
+	if strings.TrimSpace(req.UserID) == "" {
+		return nil, pkgerrors.NewInvalidRequestError("user_id is required")
+	}
+
+	conv, err := q.conversationRepo.FindByID(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	// Non-members are not told the conversation exists
+	if !conv.IsMember(req.UserID) {
+		return nil, pkgerrors.NewNotFoundError(pkgerrors.CodeConversationNotFound)
+	}
+
+	edits, err := q.repo.ListEdits(ctx, req.ConversationID, req.MessageID)
+	if err != nil {
+		q.log.Error().Ctx(ctx).
+			Err(err).
+			Str("conversation_id", req.ConversationID).
+			Str("message_id", req.MessageID).
+			Msg("failed to list message edits")
+		return nil, err
+	}
+	return edits, nil
+}
@@ -0,0 +1,84 @@
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	"golang-social-media/apps/chat-service/internal/application/messages"
+	"golang-social-media/apps/chat-service/internal/application/query/contracts"
+	pkgerrors "golang-social-media/pkg/errors"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.ListMessageRepliesQuery = (*listMessageRepliesQuery)(nil)
+
+type listMessageRepliesQuery struct {
+	repo             messages.Repository
+	conversationRepo conversations.Repository
+	log              *zerolog.Logger
+}
+
+func NewListMessageRepliesQuery(repo messages.Repository, conversationRepo conversations.Repository) contracts.ListMessageRepliesQuery {
+	return &listMessageRepliesQuery{
+		repo:             repo,
+		conversationRepo: conversationRepo,
+		log:              logger.Component("chat.query.list_message_replies"),
+	}
+}
+
+func (q *listMessageRepliesQuery) Execute(ctx context.Context, req contracts.ListMessageRepliesQueryRequest) (contracts.ListMessageRepliesQueryResponse, error) {
+	if strings.TrimSpace(req.ConversationID) == "" {
+		return contracts.ListMessageRepliesQueryResponse{}, pkgerrors.NewValidationError(pkgerrors.CodeConversationIDRequired, nil)
+	}
+	if strings.TrimSpace(req.MessageID) == "" {
+		return contracts.ListMessageRepliesQueryResponse{}, pkgerrors.NewValidationError(pkgerrors.CodeMessageIDRequired, nil)
+	}
+	if strings.TrimSpace(req.UserID) == "" {
+		return contracts.ListMessageRepliesQueryResponse{}, pkgerrors.NewInvalidRequestError("user_id is required")
+	}
+	limit := pageLimit(req.Limit, defaultMessagePageSize, maxMessagePageSize)
+
+	var after *messages.Cursor
+	if req.Cursor != "" {
+		cursor, err := decodeCursor(req.Cursor)
+		if err != nil {
+			return contracts.ListMessageRepliesQueryResponse{}, pkgerrors.NewInvalidRequestError("invalid cursor")
+		}
+		after = &cursor
+	}
+
+	conv, err := q.conversationRepo.FindByID(ctx, req.ConversationID)
+	if err != nil {
+		return contracts.ListMessageRepliesQueryResponse{}, err
+	}
+	// Non-members are not told the conversation exists
+	if !conv.IsMember(req.UserID) {
+		return contracts.ListMessageRepliesQueryResponse{}, pkgerrors.NewNotFoundError(pkgerrors.CodeConversationNotFound)
+	}
+
+	parent, err := q.repo.FindByID(ctx, req.ConversationID, req.MessageID)
+	if err != nil {
+		return contracts.ListMessageRepliesQueryResponse{}, err
+	}
+
+	// One extra reply tells whether there is a next page
+	replies, err := q.repo.ListReplies(ctx, req.ConversationID, req.MessageID, req.UserID, after, limit+1)
+	if err != nil {
+		q.log.Error().Ctx(ctx).
+			Err(err).
+			Str("conversation_id", req.ConversationID).
+			Str("message_id", req.MessageID).
+			Msg("failed to list message replies")
+		return contracts.ListMessageRepliesQueryResponse{}, err
+	}
+
+	resp := contracts.ListMessageRepliesQueryResponse{Parent: *parent, Replies: replies}
+	if len(replies) > limit {
+		resp.Replies = replies[:limit]
+		last := resp.Replies[limit-1]
+		resp.NextCursor = encodeCursor(messages.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return resp, nil
+}
@@ -0,0 +1,455 @@
+package conversation
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"golang-social-media/pkg/errors"
+)
+
+type Kind string
+
+const (
+	// KindDirect is the conversation of exactly two users, created by their first message
+	KindDirect Kind = "direct"
+	KindGroup  Kind = "group"
+)
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+const (
+	// MaxGroupMembers caps the size of a group: every message is fanned out to all of its members
+	MaxGroupMembers = 256
+	maxTitleLength  = 100
+)
+
+// rank orders roles by what they allow: a member may only manage members ranked below them
+func (r Role) rank() int {
+	switch r {
+	case RoleOwner:
+		return 3
+	case RoleAdmin:
+		return 2
+	case RoleMember:
+		return 1
+	}
+	return 0
+}
+
+type Member struct {
+	UserID   string
+	Role     Role
+	JoinedAt time.Time
+	// Receipts, nil until a message was delivered to or read by the member
+	Delivered *Watermark
+	Read      *Watermark
+}
+
+// Watermark is how far a member got in a conversation: every message up to MessageID, in (created_at, id)
+// order, was delivered or read. One watermark per member keeps receipts off the partitioned messages table,
+// where a row per message and recipient would grow with the size of every group
+type Watermark struct {
+	MessageID        string
+	MessageCreatedAt time.Time
+	At               time.Time // When the member got there
+}
+
+// Covers reports whether the message is at or before the watermark
+func (w Watermark) Covers(messageID string, createdAt time.Time) bool {
+	if !createdAt.Equal(w.MessageCreatedAt) {
+		return createdAt.Before(w.MessageCreatedAt)
+	}
+	return messageID <= w.MessageID
+}
+
+type Conversation struct {
+	ID        string
+	Kind      Kind
+	Title     string // Groups only
+	AvatarURL string // Groups only
+	CreatedBy string
+	Members   []Member
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Domain events (internal, not persisted)
+	events []DomainEvent
+}
+
+// DirectKey identifies the direct conversation of two users whatever the order they are given in
+func DirectKey(userID, peerID string) string {
+	if peerID < userID {
+		userID, peerID = peerID, userID
+	}
+	return userID + ":" + peerID
+}
+
+// Validate validates business rules for the conversation
+func (c Conversation) Validate() error {
+	if strings.TrimSpace(c.CreatedBy) == "" {
+		return errors.NewInvalidRequestError("created_by is required")
+	}
+	seen := make(map[string]bool, len(c.Members))
+	owners := 0
+	for _, member := range c.Members {
+		if strings.TrimSpace(member.UserID) == "" || seen[member.UserID] {
+			return errors.NewInvalidRequestError("member IDs must be unique and not empty")
+		}
+		if member.Role.rank() == 0 {
+			return errors.NewValidationError(errors.CodeConversationRoleInvalid, nil)
+		}
+		if member.Role == RoleOwner {
+			owners++
+		}
+		seen[member.UserID] = true
+	}
+
+	switch c.Kind {
+	case KindDirect:
+		if len(c.Members) != 2 {
+			return errors.NewValidationError(errors.CodeInvalidRequest, map[string]interface{}{
+				"reason": "a direct conversation has exactly two members",
+			})
+		}
+	case KindGroup:
+		if strings.TrimSpace(c.Title) == "" {
+			return errors.NewValidationError(errors.CodeConversationTitleRequired, nil)
+		}
+		if len(c.Title) > maxTitleLength {
+			return errors.NewValidationError(errors.CodeConversationTitleTooLong, nil)
+		}
+		if len(c.Members) > MaxGroupMembers {
+			return errors.NewConflictError(errors.CodeConversationFull)
+		}
+		if owners != 1 {
+			return errors.NewValidationError(errors.CodeInvalidRequest, map[string]interface{}{
+				"reason": "a group has exactly one owner",
+			})
+		}
+	default:
+		return errors.NewInvalidRequestError("conversation kind must be direct or group")
+	}
+	return nil
+}
+
+// Create is a domain method that creates a conversation and adds a domain event
+func (c *Conversation) Create() {
+	c.addEvent(ConversationCreatedEvent{Conversation: c.snapshot()})
+}
+
+// Member returns the membership of the user
+func (c Conversation) Member(userID string) (Member, bool) {
+	for _, member := range c.Members {
+		if member.UserID == userID {
+			return member, true
+		}
+	}
+	return Member{}, false
+}
+
+// IsMember reports whether the user belongs to the conversation
+func (c Conversation) IsMember(userID string) bool {
+	_, ok := c.Member(userID)
+	return ok
+}
+
+// EnsureMember returns a forbidden error unless the user belongs to the conversation
+func (c Conversation) EnsureMember(userID string) error {
+	if !c.IsMember(userID) {
+		return errors.NewAppError(errors.CodeNotConversationMember, http.StatusForbidden)
+	}
+	return nil
+}
+
+// MemberIDs returns the user IDs of every member
+func (c Conversation) MemberIDs() []string {
+	ids := make([]string, len(c.Members))
+	for i, member := range c.Members {
+		ids[i] = member.UserID
+	}
+	return ids
+}
+
+// RecipientIDs returns the members a message of senderID is delivered to
+func (c Conversation) RecipientIDs(senderID string) []string {
+	ids := make([]string, 0, len(c.Members))
+	for _, member := range c.Members {
+		if member.UserID != senderID {
+			ids = append(ids, member.UserID)
+		}
+	}
+	return ids
+}
+
+// Peer returns the other member of a direct conversation, empty for groups
+func (c Conversation) Peer(userID string) string {
+	if c.Kind != KindDirect {
+		return ""
+	}
+	for _, member := range c.Members {
+		if member.UserID != userID {
+			return member.UserID
+		}
+	}
+	return ""
+}
+
+// MarkDelivered moves the delivered watermark of the member up to a message. Watermarks only move forward:
+// a message already covered changes nothing
+func (c *Conversation) MarkDelivered(userID string, up Watermark) error {
+	member, err := c.memberForUpdate(userID)
+	if err != nil {
+		return err
+	}
+	if !advance(&member.Delivered, up) {
+		return nil
+	}
+	c.addEvent(MessagesDeliveredEvent{ConversationID: c.ID, UserID: userID, Watermark: up, RecipientIDs: c.RecipientIDs(userID)})
+	return nil
+}
+
+// MarkRead moves the read watermark of the member up to a message. What is read was delivered too,
+// so the delivered watermark follows without an event of its own
+func (c *Conversation) MarkRead(userID string, up Watermark) error {
+	member, err := c.memberForUpdate(userID)
+	if err != nil {
+		return err
+	}
+	advance(&member.Delivered, up)
+	if !advance(&member.Read, up) {
+		return nil
+	}
+	c.addEvent(MessagesReadEvent{ConversationID: c.ID, UserID: userID, Watermark: up, RecipientIDs: c.RecipientIDs(userID)})
+	return nil
+}
+
+// Join adds userID to the group as a member. Members are added by an owner or admin
+func (c *Conversation) Join(actorID, userID string, now time.Time) error {
+	if err := c.requireRole(actorID, RoleAdmin); err != nil {
+		return err
+	}
+	if strings.TrimSpace(userID) == "" {
+		return errors.NewInvalidRequestError("user_id is required")
+	}
+	if c.IsMember(userID) {
+		return errors.NewConflictError(errors.CodeAlreadyConversationMember)
+	}
+	if len(c.Members) >= MaxGroupMembers {
+		return errors.NewConflictError(errors.CodeConversationFull)
+	}
+
+	c.Members = append(c.Members, Member{UserID: userID, Role: RoleMember, JoinedAt: now})
+	c.UpdatedAt = now
+	c.addEvent(MemberJoinedEvent{Conversation: c.snapshot(), ActorID: actorID, UserID: userID, Role: RoleMember})
+	return nil
+}
+
+// Leave removes userID from the group. When the owner leaves, ownership passes to the longest-standing
+// admin, or the longest-standing member when there is no admin
+func (c *Conversation) Leave(userID string, now time.Time) error {
+	if err := c.requireGroup(); err != nil {
+		return err
+	}
+	member, ok := c.Member(userID)
+	if !ok {
+		return errors.NewAppError(errors.CodeNotConversationMember, http.StatusForbidden)
+	}
+
+	c.removeMember(userID)
+	c.UpdatedAt = now
+	c.addEvent(MemberLeftEvent{Conversation: c.snapshot(), UserID: userID})
+
+	if member.Role == RoleOwner && len(c.Members) > 0 {
+		successor := c.successor()
+		c.setRole(successor, RoleOwner)
+		c.addEvent(MemberRoleChangedEvent{Conversation: c.snapshot(), ActorID: userID, UserID: successor, Role: RoleOwner})
+	}
+	return nil
+}
+
+// Kick removes userID from the group. The actor must be an owner or admin ranked above the removed member
+func (c *Conversation) Kick(actorID, userID string, now time.Time) error {
+	if err := c.requireRole(actorID, RoleAdmin); err != nil {
+		return err
+	}
+	if actorID == userID {
+		return errors.NewInvalidRequestError("use leave to remove yourself from a conversation")
+	}
+	target, ok := c.Member(userID)
+	if !ok {
+		return errors.NewNotFoundError(errors.CodeNotConversationMember)
+	}
+	if err := c.requireOutranks(actorID, target); err != nil {
+		return err
+	}
+
+	c.removeMember(userID)
+	c.UpdatedAt = now
+	c.addEvent(MemberRemovedEvent{Conversation: c.snapshot(), ActorID: actorID, UserID: userID})
+	return nil
+}
+
+// ChangeRole sets the role of userID. Only the owner changes roles; making another member owner
+// transfers ownership and the previous owner becomes an admin
+func (c *Conversation) ChangeRole(actorID, userID string, role Role, now time.Time) error {
+	if err := c.requireRole(actorID, RoleOwner); err != nil {
+		return err
+	}
+	if role.rank() == 0 {
+		return errors.NewValidationError(errors.CodeConversationRoleInvalid, nil)
+	}
+	if actorID == userID {
+		return errors.NewInvalidRequestError("the owner cannot change their own role, transfer ownership instead")
+	}
+	target, ok := c.Member(userID)
+	if !ok {
+		return errors.NewNotFoundError(errors.CodeNotConversationMember)
+	}
+	if target.Role == role {
+		return nil
+	}
+
+	c.setRole(userID, role)
+	c.UpdatedAt = now
+	c.addEvent(MemberRoleChangedEvent{Conversation: c.snapshot(), ActorID: actorID, UserID: userID, Role: role})
+
+	if role == RoleOwner {
+		c.setRole(actorID, RoleAdmin)
+		c.addEvent(MemberRoleChangedEvent{Conversation: c.snapshot(), ActorID: actorID, UserID: actorID, Role: RoleAdmin})
+	}
+	return nil
+}
+
+// UpdateDetails changes the title and avatar of the group, by an owner or admin
+func (c *Conversation) UpdateDetails(actorID, title, avatarURL string, now time.Time) error {
+	if err := c.requireRole(actorID, RoleAdmin); err != nil {
+		return err
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return errors.NewValidationError(errors.CodeConversationTitleRequired, nil)
+	}
+	if len(title) > maxTitleLength {
+		return errors.NewValidationError(errors.CodeConversationTitleTooLong, nil)
+	}
+	avatarURL = strings.TrimSpace(avatarURL)
+	if title == c.Title && avatarURL == c.AvatarURL {
+		return nil
+	}
+
+	c.Title = title
+	c.AvatarURL = avatarURL
+	c.UpdatedAt = now
+	c.addEvent(ConversationUpdatedEvent{Conversation: c.snapshot(), ActorID: actorID})
+	return nil
+}
+
+// Events returns all domain events
+func (c Conversation) Events() []DomainEvent {
+	return c.events
+}
+
+// ClearEvents clears all domain events
+func (c *Conversation) ClearEvents() {
+	c.events = nil
+}
+
+// addEvent adds a domain event (internal method)
+func (c *Conversation) addEvent(event DomainEvent) {
+	c.events = append(c.events, event)
+}
+
+// snapshot copies the conversation state into an event, without its pending events
+func (c Conversation) snapshot() Conversation {
+	c.Members = append([]Member(nil), c.Members...)
+	c.events = nil
+	return c
+}
+
+// requireGroup rejects membership changes on a direct conversation
+func (c Conversation) requireGroup() error {
+	if c.Kind != KindGroup {
+		return errors.NewValidationError(errors.CodeDirectConversationImmutable, nil)
+	}
+	return nil
+}
+
+// requireRole checks that actorID is a member of the group with at least the given role
+func (c Conversation) requireRole(actorID string, role Role) error {
+	if err := c.requireGroup(); err != nil {
+		return err
+	}
+	actor, ok := c.Member(actorID)
+	if !ok {
+		return errors.NewAppError(errors.CodeNotConversationMember, http.StatusForbidden)
+	}
+	if actor.Role.rank() < role.rank() {
+		return errors.NewAppError(errors.CodeConversationPermissionDenied, http.StatusForbidden)
+	}
+	return nil
+}
+
+func (c Conversation) requireOutranks(actorID string, target Member) error {
+	actor, _ := c.Member(actorID)
+	if actor.Role.rank() <= target.Role.rank() {
+		return errors.NewAppError(errors.CodeConversationPermissionDenied, http.StatusForbidden)
+	}
+	return nil
+}
+
+// successor picks the next owner: the earliest admin to join, otherwise the earliest member
+func (c Conversation) successor() string {
+	candidates := append([]Member(nil), c.Members...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Role.rank() != candidates[j].Role.rank() {
+			return candidates[i].Role.rank() > candidates[j].Role.rank()
+		}
+		return candidates[i].JoinedAt.Before(candidates[j].JoinedAt)
+	})
+	return candidates[0].UserID
+}
+
+// memberForUpdate returns the membership of userID to change in place, or a forbidden error
+func (c *Conversation) memberForUpdate(userID string) (*Member, error) {
+	for i := range c.Members {
+		if c.Members[i].UserID == userID {
+			return &c.Members[i], nil
+		}
+	}
+	return nil, errors.NewAppError(errors.CodeNotConversationMember, http.StatusForbidden)
+}
+
+// advance moves the watermark to up unless it already covers it
+func advance(watermark **Watermark, up Watermark) bool {
+	if *watermark != nil && (*watermark).Covers(up.MessageID, up.MessageCreatedAt) {
+		return false
+	}
+	*watermark = &up
+	return true
+}
+
+func (c *Conversation) removeMember(userID string) {
+	members := make([]Member, 0, len(c.Members))
+	for _, member := range c.Members {
+		if member.UserID != userID {
+			members = append(members, member)
+		}
+	}
+	c.Members = members
+}
+
+func (c *Conversation) setRole(userID string, role Role) {
+	for i := range c.Members {
+		if c.Members[i].UserID == userID {
+			c.Members[i].Role = role
+		}
+	}
+}
@@ -0,0 +1,376 @@
+package conversation
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"golang-social-media/pkg/errors"
+)
+
+var testJoinedAt = time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+// newTestGroup returns a group of owner-1, admin-1 and member-1, who joined in that order
+func newTestGroup() Conversation {
+	return Conversation{
+		ID:        "conversation-1",
+		Kind:      KindGroup,
+		Title:     "Team",
+		CreatedBy: "owner-1",
+		Members: []Member{
+			{UserID: "owner-1", Role: RoleOwner, JoinedAt: testJoinedAt},
+			{UserID: "admin-1", Role: RoleAdmin, JoinedAt: testJoinedAt.Add(time.Minute)},
+			{UserID: "member-1", Role: RoleMember, JoinedAt: testJoinedAt.Add(2 * time.Minute)},
+		},
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, code errors.ErrorCode) {
+	t.Helper()
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("error = %v (%T), want *errors.AppError with code %v", err, err, code)
+	}
+	if appErr.Code != code {
+		t.Errorf("error code = %v, want %v", appErr.Code, code)
+	}
+}
+
+func roleOf(t *testing.T, c Conversation, userID string) Role {
+	t.Helper()
+	member, ok := c.Member(userID)
+	if !ok {
+		t.Fatalf("%s is not a member", userID)
+	}
+	return member.Role
+}
+
+func TestConversation_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Conversation)
+		errCode errors.ErrorCode
+	}{
+		{
+			name:   "valid group",
+			modify: func(c *Conversation) {},
+		},
+		{
+			name:    "group without title",
+			modify:  func(c *Conversation) { c.Title = " " },
+			errCode: errors.CodeConversationTitleRequired,
+		},
+		{
+			name:    "group with two owners",
+			modify:  func(c *Conversation) { c.Members[1].Role = RoleOwner },
+			errCode: errors.CodeInvalidRequest,
+		},
+		{
+			name:    "duplicate member",
+			modify:  func(c *Conversation) { c.Members[2].UserID = "admin-1" },
+			errCode: errors.CodeInvalidRequest,
+		},
+		{
+			name:    "unknown role",
+			modify:  func(c *Conversation) { c.Members[2].Role = "guest" },
+			errCode: errors.CodeConversationRoleInvalid,
+		},
+		{
+			name:    "direct conversation of three",
+			modify:  func(c *Conversation) { c.Kind = KindDirect },
+			errCode: errors.CodeInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestGroup()
+			tt.modify(&c)
+			err := c.Validate()
+			if tt.errCode == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			assertErrorCode(t, err, tt.errCode)
+		})
+	}
+}
+
+func TestConversation_Join(t *testing.T) {
+	now := testJoinedAt.Add(time.Hour)
+
+	t.Run("admin adds a member", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.Join("admin-1", "user-2", now); err != nil {
+			t.Fatalf("Join() error = %v", err)
+		}
+		if roleOf(t, c, "user-2") != RoleMember {
+			t.Error("a joined user should be a plain member")
+		}
+		if !c.UpdatedAt.Equal(now) {
+			t.Errorf("UpdatedAt = %v, want %v", c.UpdatedAt, now)
+		}
+		events := c.Events()
+		if len(events) != 1 {
+			t.Fatalf("Join() should add 1 event, got %d", len(events))
+		}
+		event, ok := events[0].(MemberJoinedEvent)
+		if !ok || event.ActorID != "admin-1" || event.UserID != "user-2" || len(event.Conversation.Members) != 4 {
+			t.Errorf("event = %+v, want MemberJoinedEvent of user-2 by admin-1 with 4 members", events[0])
+		}
+	})
+
+	t.Run("member cannot add members", func(t *testing.T) {
+		c := newTestGroup()
+		assertErrorCode(t, c.Join("member-1", "user-2", now), errors.CodeConversationPermissionDenied)
+	})
+
+	t.Run("outsider cannot add members", func(t *testing.T) {
+		c := newTestGroup()
+		assertErrorCode(t, c.Join("stranger", "user-2", now), errors.CodeNotConversationMember)
+	})
+
+	t.Run("already a member", func(t *testing.T) {
+		c := newTestGroup()
+		assertErrorCode(t, c.Join("owner-1", "member-1", now), errors.CodeAlreadyConversationMember)
+	})
+
+	t.Run("full group", func(t *testing.T) {
+		c := newTestGroup()
+		for i := len(c.Members); i < MaxGroupMembers; i++ {
+			c.Members = append(c.Members, Member{UserID: fmt.Sprintf("user-%d", i), Role: RoleMember})
+		}
+		assertErrorCode(t, c.Join("owner-1", "user-2", now), errors.CodeConversationFull)
+	})
+
+	t.Run("direct conversations are immutable", func(t *testing.T) {
+		c := Conversation{Kind: KindDirect, Members: []Member{{UserID: "a", Role: RoleOwner}, {UserID: "b", Role: RoleMember}}}
+		assertErrorCode(t, c.Join("a", "user-2", now), errors.CodeDirectConversationImmutable)
+	})
+}
+
+func TestConversation_Leave(t *testing.T) {
+	now := testJoinedAt.Add(time.Hour)
+
+	t.Run("member leaves", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.Leave("member-1", now); err != nil {
+			t.Fatalf("Leave() error = %v", err)
+		}
+		if c.IsMember("member-1") {
+			t.Error("member-1 should have left")
+		}
+		events := c.Events()
+		if len(events) != 1 {
+			t.Fatalf("Leave() should add 1 event, got %d", len(events))
+		}
+		if event, ok := events[0].(MemberLeftEvent); !ok || event.UserID != "member-1" {
+			t.Errorf("event = %+v, want MemberLeftEvent of member-1", events[0])
+		}
+		if roleOf(t, c, "owner-1") != RoleOwner {
+			t.Error("the owner should not change when a member leaves")
+		}
+	})
+
+	t.Run("owner passes ownership to the earliest admin", func(t *testing.T) {
+		c := newTestGroup()
+		// A later admin does not take precedence over the one who joined first
+		c.Members = append(c.Members, Member{UserID: "admin-2", Role: RoleAdmin, JoinedAt: testJoinedAt.Add(3 * time.Minute)})
+
+		if err := c.Leave("owner-1", now); err != nil {
+			t.Fatalf("Leave() error = %v", err)
+		}
+		if roleOf(t, c, "admin-1") != RoleOwner {
+			t.Errorf("admin-1 role = %s, want owner", roleOf(t, c, "admin-1"))
+		}
+		if roleOf(t, c, "admin-2") != RoleAdmin {
+			t.Errorf("admin-2 role = %s, want admin", roleOf(t, c, "admin-2"))
+		}
+		if err := c.Validate(); err != nil {
+			t.Errorf("Validate() after succession error = %v, want exactly one owner", err)
+		}
+
+		events := c.Events()
+		if len(events) != 2 {
+			t.Fatalf("Leave() should add 2 events, got %d", len(events))
+		}
+		if _, ok := events[0].(MemberLeftEvent); !ok {
+			t.Errorf("events[0] = %T, want MemberLeftEvent", events[0])
+		}
+		changed, ok := events[1].(MemberRoleChangedEvent)
+		if !ok || changed.UserID != "admin-1" || changed.Role != RoleOwner || changed.ActorID != "owner-1" {
+			t.Errorf("events[1] = %+v, want admin-1 made owner by owner-1", events[1])
+		}
+	})
+
+	t.Run("owner passes ownership to the earliest member without admins", func(t *testing.T) {
+		c := newTestGroup()
+		c.Members[1].Role = RoleMember
+		c.Members = append(c.Members, Member{UserID: "member-0", Role: RoleMember, JoinedAt: testJoinedAt.Add(-time.Minute)})
+
+		if err := c.Leave("owner-1", now); err != nil {
+			t.Fatalf("Leave() error = %v", err)
+		}
+		if roleOf(t, c, "member-0") != RoleOwner {
+			t.Errorf("member-0 role = %s, want owner", roleOf(t, c, "member-0"))
+		}
+	})
+
+	t.Run("last member leaves", func(t *testing.T) {
+		c := newTestGroup()
+		c.Members = c.Members[:1]
+
+		if err := c.Leave("owner-1", now); err != nil {
+			t.Fatalf("Leave() error = %v", err)
+		}
+		if len(c.Members) != 0 {
+			t.Errorf("Members = %v, want none", c.Members)
+		}
+		if len(c.Events()) != 1 {
+			t.Errorf("Leave() should add only MemberLeftEvent without a successor, got %d events", len(c.Events()))
+		}
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		c := newTestGroup()
+		assertErrorCode(t, c.Leave("stranger", now), errors.CodeNotConversationMember)
+	})
+
+	t.Run("direct conversations are immutable", func(t *testing.T) {
+		c := Conversation{Kind: KindDirect, Members: []Member{{UserID: "a", Role: RoleOwner}, {UserID: "b", Role: RoleMember}}}
+		assertErrorCode(t, c.Leave("a", now), errors.CodeDirectConversationImmutable)
+	})
+}
+
+func TestConversation_Kick(t *testing.T) {
+	now := testJoinedAt.Add(time.Hour)
+
+	t.Run("admin removes a member", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.Kick("admin-1", "member-1", now); err != nil {
+			t.Fatalf("Kick() error = %v", err)
+		}
+		if c.IsMember("member-1") {
+			t.Error("member-1 should have been removed")
+		}
+		events := c.Events()
+		if len(events) != 1 {
+			t.Fatalf("Kick() should add 1 event, got %d", len(events))
+		}
+		event, ok := events[0].(MemberRemovedEvent)
+		if !ok || event.ActorID != "admin-1" || event.UserID != "member-1" {
+			t.Errorf("event = %+v, want MemberRemovedEvent of member-1 by admin-1", events[0])
+		}
+	})
+
+	t.Run("owner removes an admin", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.Kick("owner-1", "admin-1", now); err != nil {
+			t.Fatalf("Kick() error = %v", err)
+		}
+		if c.IsMember("admin-1") {
+			t.Error("admin-1 should have been removed")
+		}
+	})
+
+	tests := []struct {
+		name    string
+		actorID string
+		userID  string
+		errCode errors.ErrorCode
+	}{
+		{"admin cannot remove the owner", "admin-1", "owner-1", errors.CodeConversationPermissionDenied},
+		{"admin cannot remove another admin", "admin-1", "admin-2", errors.CodeConversationPermissionDenied},
+		{"member cannot remove members", "member-1", "member-2", errors.CodeConversationPermissionDenied},
+		{"outsider cannot remove members", "stranger", "member-1", errors.CodeNotConversationMember},
+		{"removing yourself", "admin-1", "admin-1", errors.CodeInvalidRequest},
+		{"not a member", "owner-1", "stranger", errors.CodeNotConversationMember},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestGroup()
+			c.Members = append(c.Members,
+				Member{UserID: "admin-2", Role: RoleAdmin, JoinedAt: now},
+				Member{UserID: "member-2", Role: RoleMember, JoinedAt: now},
+			)
+			assertErrorCode(t, c.Kick(tt.actorID, tt.userID, now), tt.errCode)
+			if len(c.Members) != 5 || len(c.Events()) != 0 {
+				t.Error("a rejected kick should change nothing")
+			}
+		})
+	}
+}
+
+func TestConversation_ChangeRole(t *testing.T) {
+	now := testJoinedAt.Add(time.Hour)
+
+	t.Run("owner promotes a member", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.ChangeRole("owner-1", "member-1", RoleAdmin, now); err != nil {
+			t.Fatalf("ChangeRole() error = %v", err)
+		}
+		if roleOf(t, c, "member-1") != RoleAdmin {
+			t.Errorf("member-1 role = %s, want admin", roleOf(t, c, "member-1"))
+		}
+		events := c.Events()
+		if len(events) != 1 {
+			t.Fatalf("ChangeRole() should add 1 event, got %d", len(events))
+		}
+		if event, ok := events[0].(MemberRoleChangedEvent); !ok || event.UserID != "member-1" || event.Role != RoleAdmin {
+			t.Errorf("event = %+v, want member-1 made admin", events[0])
+		}
+	})
+
+	t.Run("owner transfers ownership", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.ChangeRole("owner-1", "member-1", RoleOwner, now); err != nil {
+			t.Fatalf("ChangeRole() error = %v", err)
+		}
+		if roleOf(t, c, "member-1") != RoleOwner {
+			t.Errorf("member-1 role = %s, want owner", roleOf(t, c, "member-1"))
+		}
+		if roleOf(t, c, "owner-1") != RoleAdmin {
+			t.Errorf("previous owner role = %s, want admin", roleOf(t, c, "owner-1"))
+		}
+		if err := c.Validate(); err != nil {
+			t.Errorf("Validate() after transfer error = %v, want exactly one owner", err)
+		}
+		if len(c.Events()) != 2 {
+			t.Errorf("a transfer should add 2 events, got %d", len(c.Events()))
+		}
+	})
+
+	t.Run("same role is a no-op", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.ChangeRole("owner-1", "admin-1", RoleAdmin, now); err != nil {
+			t.Fatalf("ChangeRole() error = %v", err)
+		}
+		if len(c.Events()) != 0 || !c.UpdatedAt.IsZero() {
+			t.Error("ChangeRole() to the current role should change nothing")
+		}
+	})
+
+	tests := []struct {
+		name    string
+		actorID string
+		userID  string
+		role    Role
+		errCode errors.ErrorCode
+	}{
+		{"admin cannot change roles", "admin-1", "member-1", RoleAdmin, errors.CodeConversationPermissionDenied},
+		{"unknown role", "owner-1", "member-1", "guest", errors.CodeConversationRoleInvalid},
+		{"owner cannot change their own role", "owner-1", "owner-1", RoleMember, errors.CodeInvalidRequest},
+		{"not a member", "owner-1", "stranger", RoleAdmin, errors.CodeNotConversationMember},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestGroup()
+			assertErrorCode(t, c.ChangeRole(tt.actorID, tt.userID, tt.role, now), tt.errCode)
+			if len(c.Events()) != 0 {
+				t.Error("a rejected role change should add no event")
+			}
+		})
+	}
+}
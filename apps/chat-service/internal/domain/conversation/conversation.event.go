@@ -0,0 +1,97 @@
+package conversation
+
+// DomainEvent represents a domain event interface
+type DomainEvent interface {
+	Type() string
+}
+
+// Every membership event carries the state of the conversation after the change, so handlers can fan it out
+// to the members. Receipt events are far more frequent and only carry the watermark and who to tell
+
+// ConversationCreatedEvent is a domain event emitted when a conversation is created
+type ConversationCreatedEvent struct {
+	Conversation Conversation
+}
+
+func (e ConversationCreatedEvent) Type() string {
+	return "ConversationCreated"
+}
+
+// ConversationUpdatedEvent is a domain event emitted when the title or avatar of a group changes
+type ConversationUpdatedEvent struct {
+	Conversation Conversation
+	ActorID      string
+}
+
+func (e ConversationUpdatedEvent) Type() string {
+	return "ConversationUpdated"
+}
+
+// MemberJoinedEvent is a domain event emitted when a user is added to a group
+type MemberJoinedEvent struct {
+	Conversation Conversation
+	ActorID      string
+	UserID       string
+	Role         Role
+}
+
+func (e MemberJoinedEvent) Type() string {
+	return "ConversationMemberJoined"
+}
+
+// MemberLeftEvent is a domain event emitted when a member leaves a group
+type MemberLeftEvent struct {
+	Conversation Conversation
+	UserID       string
+}
+
+func (e MemberLeftEvent) Type() string {
+	return "ConversationMemberLeft"
+}
+
+// MemberRemovedEvent is a domain event emitted when an owner or admin kicks a member out of a group
+type MemberRemovedEvent struct {
+	Conversation Conversation
+	ActorID      string
+	UserID       string
+}
+
+func (e MemberRemovedEvent) Type() string {
+	return "ConversationMemberRemoved"
+}
+
+// MemberRoleChangedEvent is a domain event emitted when the role of a member changes
+type MemberRoleChangedEvent struct {
+	Conversation Conversation
+	ActorID      string
+	UserID       string
+	Role         Role
+}
+
+func (e MemberRoleChangedEvent) Type() string {
+	return "ConversationMemberRoleChanged"
+}
+
+// MessagesDeliveredEvent is a domain event emitted when the delivered watermark of a member moves forward
+type MessagesDeliveredEvent struct {
+	ConversationID string
+	UserID         string
+	Watermark      Watermark
+	RecipientIDs   []string // The other members
+}
+
+func (e MessagesDeliveredEvent) Type() string {
+	return "ConversationMessagesDelivered"
+}
+
+// MessagesReadEvent is a domain event emitted when the read watermark of a member moves forward
+type MessagesReadEvent struct {
+	ConversationID string
+	UserID         string
+	Watermark      Watermark
+	RecipientIDs   []string // The other members
+}
+
+func (e MessagesReadEvent) Type() string {
+	return "ConversationMessagesRead"
+}
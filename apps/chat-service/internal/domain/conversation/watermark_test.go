@@ -0,0 +1,163 @@
+package conversation
+
+import (
+	"testing"
+	"time"
+
+	"golang-social-media/pkg/errors"
+)
+
+func TestWatermark_Covers(t *testing.T) {
+	at := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	watermark := Watermark{MessageID: "0000000b", MessageCreatedAt: at}
+
+	tests := []struct {
+		name      string
+		messageID string
+		createdAt time.Time
+		want      bool
+	}{
+		{"the watermark message", "0000000b", at, true},
+		{"older message", "0000000z", at.Add(-time.Second), true},
+		{"newer message", "00000000", at.Add(time.Second), false},
+		// Messages of the same instant are ordered by ID, like the (created_at, id) keyset of the messages table
+		{"same instant, lower ID", "0000000a", at, true},
+		{"same instant, higher ID", "0000000c", at, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := watermark.Covers(tt.messageID, tt.createdAt); got != tt.want {
+				t.Errorf("Covers(%s, %v) = %v, want %v", tt.messageID, tt.createdAt, got, tt.want)
+			}
+		})
+	}
+}
+
+func newWatermark(messageID string, createdAt time.Time) Watermark {
+	return Watermark{MessageID: messageID, MessageCreatedAt: createdAt, At: createdAt.Add(time.Minute)}
+}
+
+func TestConversation_MarkDelivered(t *testing.T) {
+	at := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	first := newWatermark("00000001", at)
+	second := newWatermark("00000002", at.Add(time.Second))
+
+	c := newTestGroup()
+	if err := c.MarkDelivered("member-1", second); err != nil {
+		t.Fatalf("MarkDelivered() error = %v", err)
+	}
+	member, _ := c.Member("member-1")
+	if member.Delivered == nil || *member.Delivered != second {
+		t.Fatalf("Delivered = %+v, want %+v", member.Delivered, second)
+	}
+	if member.Read != nil {
+		t.Error("delivering should not mark anything read")
+	}
+
+	events := c.Events()
+	if len(events) != 1 {
+		t.Fatalf("MarkDelivered() should add 1 event, got %d", len(events))
+	}
+	event, ok := events[0].(MessagesDeliveredEvent)
+	if !ok || event.UserID != "member-1" || event.Watermark != second || len(event.RecipientIDs) != 2 {
+		t.Errorf("event = %+v, want the watermark of member-1 sent to the 2 other members", events[0])
+	}
+
+	// Receipts arrive out of order: an older or repeated one must not move the watermark back
+	c.ClearEvents()
+	for _, up := range []Watermark{first, second} {
+		if err := c.MarkDelivered("member-1", up); err != nil {
+			t.Fatalf("MarkDelivered(%s) error = %v", up.MessageID, err)
+		}
+	}
+	member, _ = c.Member("member-1")
+	if *member.Delivered != second {
+		t.Errorf("Delivered = %+v after an older receipt, want it to stay at %+v", member.Delivered, second)
+	}
+	if len(c.Events()) != 0 {
+		t.Errorf("covered receipts should add no event, got %d", len(c.Events()))
+	}
+
+	assertErrorCode(t, c.MarkDelivered("stranger", second), errors.CodeNotConversationMember)
+}
+
+func TestConversation_MarkRead(t *testing.T) {
+	at := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	first := newWatermark("00000001", at)
+	second := newWatermark("00000002", at.Add(time.Second))
+	third := newWatermark("00000003", at.Add(2*time.Second))
+
+	t.Run("read moves the delivered watermark along", func(t *testing.T) {
+		c := newTestGroup()
+		if err := c.MarkRead("member-1", second); err != nil {
+			t.Fatalf("MarkRead() error = %v", err)
+		}
+		member, _ := c.Member("member-1")
+		if member.Read == nil || *member.Read != second {
+			t.Errorf("Read = %+v, want %+v", member.Read, second)
+		}
+		if member.Delivered == nil || *member.Delivered != second {
+			t.Errorf("Delivered = %+v, want %+v: what is read was delivered", member.Delivered, second)
+		}
+
+		events := c.Events()
+		if len(events) != 1 {
+			t.Fatalf("MarkRead() should add only MessagesReadEvent, got %d events", len(events))
+		}
+		if event, ok := events[0].(MessagesReadEvent); !ok || event.Watermark != second {
+			t.Errorf("event = %+v, want MessagesReadEvent at %s", events[0], second.MessageID)
+		}
+	})
+
+	t.Run("read does not move a delivered watermark that is further", func(t *testing.T) {
+		c := newTestGroup()
+		c.MarkDelivered("member-1", third)
+		c.ClearEvents()
+
+		if err := c.MarkRead("member-1", first); err != nil {
+			t.Fatalf("MarkRead() error = %v", err)
+		}
+		member, _ := c.Member("member-1")
+		if *member.Delivered != third {
+			t.Errorf("Delivered = %+v, want it to stay at %+v", member.Delivered, third)
+		}
+		if *member.Read != first {
+			t.Errorf("Read = %+v, want %+v", member.Read, first)
+		}
+	})
+
+	t.Run("read watermark only moves forward", func(t *testing.T) {
+		c := newTestGroup()
+		c.MarkRead("member-1", third)
+		c.ClearEvents()
+
+		for _, up := range []Watermark{first, second, third} {
+			if err := c.MarkRead("member-1", up); err != nil {
+				t.Fatalf("MarkRead(%s) error = %v", up.MessageID, err)
+			}
+		}
+		member, _ := c.Member("member-1")
+		if *member.Read != third {
+			t.Errorf("Read = %+v, want it to stay at %+v", member.Read, third)
+		}
+		if len(c.Events()) != 0 {
+			t.Errorf("covered reads should add no event, got %d", len(c.Events()))
+		}
+	})
+
+	t.Run("other members keep their watermarks", func(t *testing.T) {
+		c := newTestGroup()
+		c.MarkRead("member-1", second)
+
+		admin, _ := c.Member("admin-1")
+		if admin.Read != nil || admin.Delivered != nil {
+			t.Errorf("admin-1 receipts = %+v/%+v, want none", admin.Delivered, admin.Read)
+		}
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		c := newTestGroup()
+		assertErrorCode(t, c.MarkRead("stranger", first), errors.CodeNotConversationMember)
+	})
+}
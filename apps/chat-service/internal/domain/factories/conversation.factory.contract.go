@@ -0,0 +1,11 @@
+package factories
+
+import "golang-social-media/apps/chat-service/internal/domain/conversation"
+
+// ConversationFactory defines the contract for creating Conversation aggregates
+type ConversationFactory interface {
+	// CreateDirect creates the conversation of two users
+	CreateDirect(userID, peerID string) (*conversation.Conversation, error)
+	// CreateGroup creates a group owned by its creator, with memberIDs as plain members
+	CreateGroup(creatorID, title, avatarURL string, memberIDs []string) (*conversation.Conversation, error)
+}
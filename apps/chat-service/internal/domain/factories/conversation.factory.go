@@ -0,0 +1,73 @@
+package factories
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// ConversationFactoryImpl implements ConversationFactory interface
+type ConversationFactoryImpl struct{}
+
+var _ ConversationFactory = (*ConversationFactoryImpl)(nil)
+
+// NewConversationFactory creates a new ConversationFactoryImpl
+func NewConversationFactory() ConversationFactory {
+	return &ConversationFactoryImpl{}
+}
+
+// CreateDirect creates the direct conversation of two users; the first user is recorded as its creator
+func (f *ConversationFactoryImpl) CreateDirect(userID, peerID string) (*conversation.Conversation, error) {
+	now := time.Now().UTC()
+	conv := &conversation.Conversation{
+		ID:        uuid.NewString(),
+		Kind:      conversation.KindDirect,
+		CreatedBy: userID,
+		Members: []conversation.Member{
+			{UserID: userID, Role: conversation.RoleMember, JoinedAt: now},
+			{UserID: peerID, Role: conversation.RoleMember, JoinedAt: now},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := conv.Validate(); err != nil {
+		return nil, err
+	}
+	conv.Create()
+	return conv, nil
+}
+
+// CreateGroup creates a group; duplicate member IDs and the creator in memberIDs are ignored
+func (f *ConversationFactoryImpl) CreateGroup(creatorID, title, avatarURL string, memberIDs []string) (*conversation.Conversation, error) {
+	now := time.Now().UTC()
+	members := []conversation.Member{{UserID: creatorID, Role: conversation.RoleOwner, JoinedAt: now}}
+	seen := map[string]bool{creatorID: true}
+	for _, memberID := range memberIDs {
+		memberID = strings.TrimSpace(memberID)
+		if memberID == "" || seen[memberID] {
+			continue
+		}
+		seen[memberID] = true
+		members = append(members, conversation.Member{UserID: memberID, Role: conversation.RoleMember, JoinedAt: now})
+	}
+
+	conv := &conversation.Conversation{
+		ID:        uuid.NewString(),
+		Kind:      conversation.KindGroup,
+		Title:     strings.TrimSpace(title),
+		AvatarURL: strings.TrimSpace(avatarURL),
+		CreatedBy: creatorID,
+		Members:   members,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := conv.Validate(); err != nil {
+		return nil, err
+	}
+	conv.Create()
+	return conv, nil
+}
@@ -1,10 +1,15 @@
 package factories
 
-import "golang-social-media/apps/chat-service/internal/domain/message"
+import (
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+)
 
 // MessageFactory defines the contract for creating Message entities
 type MessageFactory interface {
-	CreateMessage(senderID, receiverID, content string) (*message.Message, error)
+	// CreateMessage creates a message of conv. parent, when not nil, is the message it replies to;
+	// mentionIDs are the users its @handles resolved to, only members other than the sender are kept
+	CreateMessage(conv conversation.Conversation, senderID, content string, parent *message.Message, mentionIDs []string) (*message.Message, error)
 }
 
 
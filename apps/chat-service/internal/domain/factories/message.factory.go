@@ -1,9 +1,12 @@
 package factories
 
 import (
+	"slices"
 	"time"
 
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
 	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/errors"
 	"github.com/google/uuid"
 )
 
@@ -19,24 +22,40 @@ func NewMessageFactory() MessageFactory {
 
 // CreateMessage creates a new Message with proper initialization
 // This factory encapsulates the complex creation logic
-func (f *MessageFactoryImpl) CreateMessage(senderID, receiverID, content string) (*message.Message, error) {
+func (f *MessageFactoryImpl) CreateMessage(conv conversation.Conversation, senderID, content string, parent *message.Message, mentionIDs []string) (*message.Message, error) {
 	if senderID == "" {
 		return nil, &MessageFactoryError{Message: "sender ID cannot be empty"}
 	}
-	if receiverID == "" {
-		return nil, &MessageFactoryError{Message: "receiver ID cannot be empty"}
-	}
 	if content == "" {
 		return nil, &MessageFactoryError{Message: "content cannot be empty"}
 	}
+	// Only members post to a conversation
+	if err := conv.EnsureMember(senderID); err != nil {
+		return nil, &MessageFactoryError{Message: "sender is not a member of the conversation", Cause: err}
+	}
 
 	now := time.Now().UTC()
 	msg := &message.Message{
-		ID:         uuid.NewString(),
-		SenderID:   senderID,
-		ReceiverID: receiverID,
-		Content:    content,
-		CreatedAt:  now,
+		ID:             uuid.NewString(),
+		ConversationID: conv.ID,
+		SenderID:       senderID,
+		ReceiverID:     conv.Peer(senderID),
+		Content:        content,
+		CreatedAt:      now,
+	}
+	if parent != nil {
+		if parent.ConversationID != conv.ID {
+			return nil, &MessageFactoryError{Message: "parent message is not in the conversation", Cause: errors.NewNotFoundError(errors.CodeMessageNotFound)}
+		}
+		if err := parent.ValidateReply(); err != nil {
+			return nil, &MessageFactoryError{Message: "cannot reply to the parent message", Cause: err}
+		}
+		msg.ParentID = parent.ID
+	}
+	for _, userID := range mentionIDs {
+		if userID != senderID && conv.IsMember(userID) && !slices.Contains(msg.MentionIDs, userID) {
+			msg.MentionIDs = append(msg.MentionIDs, userID)
+		}
 	}
 
 	// Validate the created message
@@ -48,7 +67,7 @@ func (f *MessageFactoryImpl) CreateMessage(senderID, receiverID, content string)
 	}
 
 	// Domain logic: create message (this adds domain events internally)
-	msg.Create()
+	msg.Create(conv.RecipientIDs(senderID))
 
 	return msg, nil
 }
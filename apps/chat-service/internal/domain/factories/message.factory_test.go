@@ -0,0 +1,119 @@
+package factories
+
+import (
+	stderrors "errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	"golang-social-media/apps/chat-service/internal/domain/message"
+	"golang-social-media/pkg/errors"
+)
+
+func newTestGroup() conversation.Conversation {
+	joinedAt := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	return conversation.Conversation{
+		ID:        "conversation-1",
+		Kind:      conversation.KindGroup,
+		Title:     "Team",
+		CreatedBy: "user-1",
+		Members: []conversation.Member{
+			{UserID: "user-1", Role: conversation.RoleOwner, JoinedAt: joinedAt},
+			{UserID: "user-2", Role: conversation.RoleMember, JoinedAt: joinedAt},
+			{UserID: "user-3", Role: conversation.RoleMember, JoinedAt: joinedAt},
+		},
+	}
+}
+
+func assertCauseCode(t *testing.T, err error, code errors.ErrorCode) {
+	t.Helper()
+	var appErr *errors.AppError
+	if !stderrors.As(err, &appErr) {
+		t.Fatalf("error = %v, want one caused by an *errors.AppError with code %v", err, code)
+	}
+	if appErr.Code != code {
+		t.Errorf("error code = %v, want %v", appErr.Code, code)
+	}
+}
+
+func TestMessageFactory_CreateMessage_Mentions(t *testing.T) {
+	f := NewMessageFactory()
+
+	tests := []struct {
+		name       string
+		mentionIDs []string
+		want       []string
+	}{
+		{"members", []string{"user-3", "user-2"}, []string{"user-3", "user-2"}},
+		{"duplicates", []string{"user-2", "user-2", "user-3", "user-2"}, []string{"user-2", "user-3"}},
+		{"self-mention", []string{"user-1", "user-2"}, []string{"user-2"}},
+		{"non-members", []string{"stranger", "user-3"}, []string{"user-3"}},
+		{"nobody left", []string{"user-1", "stranger"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := f.CreateMessage(newTestGroup(), "user-1", "hi", nil, tt.mentionIDs)
+			if err != nil {
+				t.Fatalf("CreateMessage() error = %v", err)
+			}
+			if !reflect.DeepEqual(msg.MentionIDs, tt.want) {
+				t.Errorf("MentionIDs = %v, want %v", msg.MentionIDs, tt.want)
+			}
+
+			var mentioned []string
+			for _, event := range msg.Events() {
+				if event, ok := event.(message.MentionCreatedEvent); ok {
+					mentioned = event.MentionedUserIDs
+				}
+			}
+			if !reflect.DeepEqual(mentioned, tt.want) {
+				t.Errorf("MentionCreatedEvent mentions %v, want %v", mentioned, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageFactory_CreateMessage_Reply(t *testing.T) {
+	f := NewMessageFactory()
+	parent := message.Message{
+		ID:             "message-1",
+		ConversationID: "conversation-1",
+		SenderID:       "user-2",
+		Content:        "question",
+	}
+
+	t.Run("replies in the thread of the parent", func(t *testing.T) {
+		msg, err := f.CreateMessage(newTestGroup(), "user-1", "answer", &parent, nil)
+		if err != nil {
+			t.Fatalf("CreateMessage() error = %v", err)
+		}
+		if msg.ParentID != "message-1" {
+			t.Errorf("ParentID = %q, want message-1", msg.ParentID)
+		}
+		if event := msg.Events()[0].(message.MessageCreatedEvent); event.ParentID != "message-1" {
+			t.Errorf("MessageCreatedEvent.ParentID = %q, want message-1", event.ParentID)
+		}
+	})
+
+	t.Run("parent of another conversation", func(t *testing.T) {
+		other := parent
+		other.ConversationID = "conversation-2"
+		_, err := f.CreateMessage(newTestGroup(), "user-1", "answer", &other, nil)
+		assertCauseCode(t, err, errors.CodeMessageNotFound)
+	})
+
+	t.Run("deleted parent", func(t *testing.T) {
+		deleted := parent
+		deletedAt := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+		deleted.DeletedAt = &deletedAt
+		_, err := f.CreateMessage(newTestGroup(), "user-1", "answer", &deleted, nil)
+		assertCauseCode(t, err, errors.CodeMessageDeleted)
+	})
+
+	t.Run("sender is not a member", func(t *testing.T) {
+		_, err := f.CreateMessage(newTestGroup(), "stranger", "answer", &parent, nil)
+		assertCauseCode(t, err, errors.CodeNotConversationMember)
+	})
+}
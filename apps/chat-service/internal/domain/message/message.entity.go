@@ -1,18 +1,30 @@
 package message
 
 import (
+	"net/http"
+	"regexp"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"golang-social-media/pkg/errors"
 )
 
 type Message struct {
-	ID         string
-	SenderID   string
-	ReceiverID string
-	Content    string
-	CreatedAt  time.Time
+	ID             string
+	ConversationID string
+	SenderID       string
+	ReceiverID     string // Set for direct conversations only
+	Content        string
+	CreatedAt      time.Time
+	EditedAt       *time.Time // Last edit, nil if never edited
+	DeletedAt      *time.Time // Deleted for everyone, Content is cleared
+	ParentID       string     // Message of the conversation this one replies to, empty outside threads
+	ReplyCount     int        // Replies in the thread of this message
+	LastReplyAt    *time.Time // Latest reply, nil without replies
+	Reactions      []Reaction // One entry per emoji, in the order it was first used
+	MentionIDs     []string   // Members mentioned when the message was sent
 
 	// Domain events (internal, not persisted)
 	events []DomainEvent
@@ -20,12 +32,12 @@ type Message struct {
 
 // Validate validates business rules for the message
 func (m Message) Validate() error {
+	if strings.TrimSpace(m.ConversationID) == "" {
+		return errors.NewValidationError(errors.CodeConversationIDRequired, nil)
+	}
 	if strings.TrimSpace(m.SenderID) == "" {
 		return errors.NewValidationError(errors.CodeSenderIDRequired, nil)
 	}
-	if strings.TrimSpace(m.ReceiverID) == "" {
-		return errors.NewValidationError(errors.CodeReceiverIDRequired, nil)
-	}
 	if m.SenderID == m.ReceiverID {
 		return errors.NewValidationError(errors.CodeInvalidRequest, map[string]interface{}{
 			"reason": "sender and receiver cannot be the same",
@@ -41,13 +53,247 @@ func (m Message) Validate() error {
 }
 
 // Create is a domain method that creates a message and adds a domain event
-func (m *Message) Create() {
+// addressed to the other members of the conversation, and one for the members it mentions
+func (m *Message) Create(recipientIDs []string) {
 	m.addEvent(MessageCreatedEvent{
-		MessageID:  m.ID,
-		SenderID:   m.SenderID,
-		ReceiverID: m.ReceiverID,
-		Content:    m.Content,
-		CreatedAt:  m.CreatedAt.Format(time.RFC3339),
+		MessageID:      m.ID,
+		ConversationID: m.ConversationID,
+		ParentID:       m.ParentID,
+		SenderID:       m.SenderID,
+		ReceiverID:     m.ReceiverID,
+		RecipientIDs:   recipientIDs,
+		Content:        m.Content,
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+	})
+	if len(m.MentionIDs) > 0 {
+		m.addEvent(MentionCreatedEvent{
+			MessageID:        m.ID,
+			ConversationID:   m.ConversationID,
+			ParentID:         m.ParentID,
+			SenderID:         m.SenderID,
+			MentionedUserIDs: m.MentionIDs,
+			Content:          m.Content,
+			CreatedAt:        m.CreatedAt,
+		})
+	}
+}
+
+// ValidateReply checks that the message can start or continue a thread: a deleted message cannot
+func (m Message) ValidateReply() error {
+	if m.DeletedAt != nil {
+		return errors.NewConflictError(errors.CodeMessageDeleted)
+	}
+	return nil
+}
+
+// MaxMentions caps the @handles of a message that are resolved
+const MaxMentions = 20
+
+// mentionPattern matches @handles at the start of the content or after a character that cannot be part of
+// a handle or an email address
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}._@-])@([\p{L}\p{N}._-]{1,64})`)
+
+// ParseMentions returns the distinct @handles of content, without the @, in order of appearance and
+// at most MaxMentions. A handle is resolved later against the user IDs and names of the conversation
+func ParseMentions(content string) []string {
+	var handles []string
+	seen := make(map[string]bool)
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		// Trailing punctuation ends a sentence, not the handle
+		handle := strings.TrimRight(match[1], ".-_")
+		if handle == "" || seen[handle] {
+			continue
+		}
+		seen[handle] = true
+		handles = append(handles, handle)
+		if len(handles) == MaxMentions {
+			break
+		}
+	}
+	return handles
+}
+
+// Reaction aggregates the members who reacted to a message with one emoji
+type Reaction struct {
+	Emoji   string
+	Count   int
+	UserIDs []string
+}
+
+const (
+	// MaxReactionKinds caps the different emojis on one message
+	MaxReactionKinds = 20
+	maxEmojiLength   = 32
+)
+
+// ValidateEmoji checks that emoji is a single emoji, including modifiers and joined sequences:
+// short, without spaces and not plain ASCII text
+func ValidateEmoji(emoji string) error {
+	if emoji == "" || len(emoji) > maxEmojiLength || !utf8.ValidString(emoji) {
+		return errors.NewValidationError(errors.CodeReactionEmojiInvalid, nil)
+	}
+	ascii := true
+	for _, r := range emoji {
+		if unicode.IsSpace(r) || unicode.IsControl(r) {
+			return errors.NewValidationError(errors.CodeReactionEmojiInvalid, nil)
+		}
+		if r > unicode.MaxASCII {
+			ascii = false
+		}
+	}
+	if ascii {
+		return errors.NewValidationError(errors.CodeReactionEmojiInvalid, nil)
+	}
+	return nil
+}
+
+// ToggleReaction adds the emoji reaction of userID to the message, or removes it if they already reacted
+// with it. Deleted messages take no reactions
+func (m *Message) ToggleReaction(userID, emoji string, recipientIDs []string, now time.Time) error {
+	if m.DeletedAt != nil {
+		return errors.NewConflictError(errors.CodeMessageDeleted)
+	}
+	if err := ValidateEmoji(emoji); err != nil {
+		return err
+	}
+
+	i := m.reactionIndex(emoji)
+	if i >= 0 {
+		reaction := &m.Reactions[i]
+		for j, reactedID := range reaction.UserIDs {
+			if reactedID != userID {
+				continue
+			}
+			reaction.UserIDs = append(reaction.UserIDs[:j:j], reaction.UserIDs[j+1:]...)
+			reaction.Count = len(reaction.UserIDs)
+			count := reaction.Count
+			if count == 0 {
+				m.Reactions = append(m.Reactions[:i:i], m.Reactions[i+1:]...)
+			}
+			m.addEvent(MessageReactionRemovedEvent{
+				MessageID:      m.ID,
+				ConversationID: m.ConversationID,
+				UserID:         userID,
+				Emoji:          emoji,
+				Count:          count,
+				RecipientIDs:   recipientIDs,
+				At:             now,
+			})
+			return nil
+		}
+	} else {
+		if len(m.Reactions) >= MaxReactionKinds {
+			return errors.NewConflictError(errors.CodeReactionLimitReached)
+		}
+		m.Reactions = append(m.Reactions, Reaction{Emoji: emoji})
+		i = len(m.Reactions) - 1
+	}
+
+	reaction := &m.Reactions[i]
+	reaction.UserIDs = append(reaction.UserIDs, userID)
+	reaction.Count = len(reaction.UserIDs)
+	m.addEvent(MessageReactionAddedEvent{
+		MessageID:      m.ID,
+		ConversationID: m.ConversationID,
+		UserID:         userID,
+		Emoji:          emoji,
+		Count:          reaction.Count,
+		RecipientIDs:   recipientIDs,
+		At:             now,
+	})
+	return nil
+}
+
+func (m Message) reactionIndex(emoji string) int {
+	for i, reaction := range m.Reactions {
+		if reaction.Emoji == emoji {
+			return i
+		}
+	}
+	return -1
+}
+
+// Edit is a previous version of an edited message
+type Edit struct {
+	Content  string
+	EditedAt time.Time // When this version was replaced
+}
+
+// ValidateEdit checks that actorID may edit the message: only its sender can, and not once deleted
+func (m Message) ValidateEdit(actorID string) error {
+	if actorID != m.SenderID {
+		return errors.NewAppError(errors.CodeMessageEditForbidden, http.StatusForbidden)
+	}
+	if m.DeletedAt != nil {
+		return errors.NewConflictError(errors.CodeMessageDeleted)
+	}
+	return nil
+}
+
+// Edit replaces the content of the message, keeping the previous one in the MessageEditedEvent.
+// Editing to the same content is a no-op
+func (m *Message) Edit(actorID, content string, recipientIDs []string, now time.Time) error {
+	if err := m.ValidateEdit(actorID); err != nil {
+		return err
+	}
+	if content == m.Content {
+		return nil
+	}
+
+	edited := *m
+	edited.Content = content
+	if err := edited.Validate(); err != nil {
+		return err
+	}
+
+	previous := m.Content
+	m.Content = content
+	m.EditedAt = &now
+	m.addEvent(MessageEditedEvent{
+		MessageID:       m.ID,
+		ConversationID:  m.ConversationID,
+		SenderID:        m.SenderID,
+		RecipientIDs:    recipientIDs,
+		PreviousContent: previous,
+		Content:         m.Content,
+		EditedAt:        now,
+	})
+	return nil
+}
+
+// DeleteForEveryone clears the message for every member. Only its sender can,
+// and only within window of sending it
+func (m *Message) DeleteForEveryone(actorID string, window time.Duration, recipientIDs []string, now time.Time) error {
+	if actorID != m.SenderID {
+		return errors.NewAppError(errors.CodeMessageDeleteForbidden, http.StatusForbidden)
+	}
+	if m.DeletedAt != nil {
+		return errors.NewConflictError(errors.CodeMessageDeleted)
+	}
+	if now.Sub(m.CreatedAt) > window {
+		return errors.NewAppError(errors.CodeMessageDeleteWindowExpired, http.StatusForbidden)
+	}
+
+	m.Content = ""
+	m.DeletedAt = &now
+	m.Reactions = nil
+	m.addEvent(MessageDeletedEvent{
+		MessageID:      m.ID,
+		ConversationID: m.ConversationID,
+		SenderID:       m.SenderID,
+		RecipientIDs:   recipientIDs,
+		DeletedAt:      now,
+	})
+	return nil
+}
+
+// DeleteForMe hides the message from userID only; the other members still see it
+func (m *Message) DeleteForMe(userID string, now time.Time) {
+	m.addEvent(MessageDeletedForMeEvent{
+		MessageID:      m.ID,
+		ConversationID: m.ConversationID,
+		UserID:         userID,
+		DeletedAt:      now,
 	})
 }
 
@@ -0,0 +1,241 @@
+package message
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"golang-social-media/pkg/errors"
+)
+
+var testNow = time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+
+func newTestMessage() Message {
+	return Message{
+		ID:             "message-1",
+		ConversationID: "conversation-1",
+		SenderID:       "user-1",
+		Content:        "hello",
+		CreatedAt:      testNow.Add(-time.Minute),
+	}
+}
+
+func assertErrorCode(t *testing.T, err error, code errors.ErrorCode) {
+	t.Helper()
+	appErr, ok := err.(*errors.AppError)
+	if !ok {
+		t.Fatalf("error = %v (%T), want *errors.AppError with code %v", err, err, code)
+	}
+	if appErr.Code != code {
+		t.Errorf("error code = %v, want %v", appErr.Code, code)
+	}
+}
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"no mention", "hello everyone", nil},
+		{"start of content", "@alice hi", []string{"alice"}},
+		{"order of appearance", "hi @bob and @alice", []string{"bob", "alice"}},
+		{"duplicates", "@alice @bob @alice", []string{"alice", "bob"}},
+		{"trailing punctuation", "thanks @alice. and @bob-, @carol_!", []string{"alice", "bob", "carol"}},
+		{"punctuation inside the handle", "ping @alice.smith and @bob_jones", []string{"alice.smith", "bob_jones"}},
+		{"after punctuation", "(@alice) @bob,@carol", []string{"alice", "bob", "carol"}},
+		{"unicode handle", "xin chào @trần", []string{"trần"}},
+		{"email address", "mail alice@example.com", nil},
+		{"lone @", "meet @ 5pm", nil},
+		{"only punctuation", "@... @__", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseMentions(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseMentions(%q) = %v, want %v", tt.content, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("capped at MaxMentions", func(t *testing.T) {
+		var content strings.Builder
+		for i := 0; i < MaxMentions+5; i++ {
+			fmt.Fprintf(&content, "@user%d ", i)
+		}
+		got := ParseMentions(content.String())
+		if len(got) != MaxMentions {
+			t.Fatalf("ParseMentions() returned %d handles, want %d", len(got), MaxMentions)
+		}
+		if got[0] != "user0" || got[MaxMentions-1] != fmt.Sprintf("user%d", MaxMentions-1) {
+			t.Errorf("ParseMentions() = %v, want the first %d handles", got, MaxMentions)
+		}
+	})
+}
+
+func TestValidateEmoji(t *testing.T) {
+	for _, emoji := range []string{"👍", "❤️", "👍🏽", "👨‍👩‍👧"} {
+		if err := ValidateEmoji(emoji); err != nil {
+			t.Errorf("ValidateEmoji(%q) error = %v", emoji, err)
+		}
+	}
+	for _, emoji := range []string{"", "ok", ":+1:", "👍 👍", "👍\n", strings.Repeat("👍", 9), "\xff"} {
+		assertErrorCode(t, ValidateEmoji(emoji), errors.CodeReactionEmojiInvalid)
+	}
+}
+
+func TestMessage_ToggleReaction(t *testing.T) {
+	recipientIDs := []string{"user-2", "user-3"}
+
+	t.Run("adds a reaction", func(t *testing.T) {
+		m := newTestMessage()
+		if err := m.ToggleReaction("user-2", "👍", recipientIDs, testNow); err != nil {
+			t.Fatalf("ToggleReaction() error = %v", err)
+		}
+		want := []Reaction{{Emoji: "👍", Count: 1, UserIDs: []string{"user-2"}}}
+		if !reflect.DeepEqual(m.Reactions, want) {
+			t.Errorf("Reactions = %+v, want %+v", m.Reactions, want)
+		}
+
+		events := m.Events()
+		if len(events) != 1 {
+			t.Fatalf("ToggleReaction() should add 1 event, got %d", len(events))
+		}
+		event, ok := events[0].(MessageReactionAddedEvent)
+		if !ok || event.UserID != "user-2" || event.Emoji != "👍" || event.Count != 1 || !event.At.Equal(testNow) {
+			t.Errorf("event = %+v, want MessageReactionAddedEvent of user-2 with count 1", events[0])
+		}
+	})
+
+	t.Run("counts the members of one emoji", func(t *testing.T) {
+		m := newTestMessage()
+		m.ToggleReaction("user-2", "👍", recipientIDs, testNow)
+		m.ToggleReaction("user-3", "❤️", recipientIDs, testNow)
+		m.ToggleReaction("user-3", "👍", recipientIDs, testNow)
+
+		want := []Reaction{
+			{Emoji: "👍", Count: 2, UserIDs: []string{"user-2", "user-3"}},
+			{Emoji: "❤️", Count: 1, UserIDs: []string{"user-3"}},
+		}
+		if !reflect.DeepEqual(m.Reactions, want) {
+			t.Errorf("Reactions = %+v, want %+v", m.Reactions, want)
+		}
+		if event := m.Events()[2].(MessageReactionAddedEvent); event.Count != 2 {
+			t.Errorf("event count = %d, want 2", event.Count)
+		}
+	})
+
+	t.Run("the same reaction again removes it", func(t *testing.T) {
+		m := newTestMessage()
+		m.ToggleReaction("user-2", "👍", recipientIDs, testNow)
+		m.ToggleReaction("user-3", "👍", recipientIDs, testNow)
+		m.ClearEvents()
+
+		if err := m.ToggleReaction("user-2", "👍", recipientIDs, testNow); err != nil {
+			t.Fatalf("ToggleReaction() error = %v", err)
+		}
+		want := []Reaction{{Emoji: "👍", Count: 1, UserIDs: []string{"user-3"}}}
+		if !reflect.DeepEqual(m.Reactions, want) {
+			t.Errorf("Reactions = %+v, want %+v", m.Reactions, want)
+		}
+		event, ok := m.Events()[0].(MessageReactionRemovedEvent)
+		if !ok || event.UserID != "user-2" || event.Count != 1 {
+			t.Errorf("event = %+v, want MessageReactionRemovedEvent of user-2 with count 1", m.Events()[0])
+		}
+	})
+
+	t.Run("removing the last member drops the emoji", func(t *testing.T) {
+		m := newTestMessage()
+		m.ToggleReaction("user-2", "👍", recipientIDs, testNow)
+		m.ToggleReaction("user-2", "❤️", recipientIDs, testNow)
+		m.ClearEvents()
+
+		m.ToggleReaction("user-2", "👍", recipientIDs, testNow)
+		want := []Reaction{{Emoji: "❤️", Count: 1, UserIDs: []string{"user-2"}}}
+		if !reflect.DeepEqual(m.Reactions, want) {
+			t.Errorf("Reactions = %+v, want %+v", m.Reactions, want)
+		}
+		if event := m.Events()[0].(MessageReactionRemovedEvent); event.Count != 0 {
+			t.Errorf("event count = %d, want 0", event.Count)
+		}
+	})
+
+	t.Run("limit of emoji kinds", func(t *testing.T) {
+		m := newTestMessage()
+		for i := 0; i < MaxReactionKinds; i++ {
+			emoji := string(rune(0x1F600 + i))
+			if err := m.ToggleReaction("user-2", emoji, recipientIDs, testNow); err != nil {
+				t.Fatalf("ToggleReaction(%s) error = %v", emoji, err)
+			}
+		}
+
+		assertErrorCode(t, m.ToggleReaction("user-3", "👍", recipientIDs, testNow), errors.CodeReactionLimitReached)
+		if len(m.Reactions) != MaxReactionKinds {
+			t.Errorf("Reactions has %d emojis, want %d", len(m.Reactions), MaxReactionKinds)
+		}
+		// An emoji already on the message still takes new members
+		if err := m.ToggleReaction("user-3", string(rune(0x1F600)), recipientIDs, testNow); err != nil {
+			t.Errorf("ToggleReaction() on an existing emoji error = %v", err)
+		}
+	})
+
+	t.Run("invalid emoji", func(t *testing.T) {
+		m := newTestMessage()
+		assertErrorCode(t, m.ToggleReaction("user-2", "ok", recipientIDs, testNow), errors.CodeReactionEmojiInvalid)
+		if len(m.Reactions) != 0 || len(m.Events()) != 0 {
+			t.Error("an invalid emoji should change nothing")
+		}
+	})
+
+	t.Run("deleted message", func(t *testing.T) {
+		m := newTestMessage()
+		m.DeletedAt = &testNow
+		assertErrorCode(t, m.ToggleReaction("user-2", "👍", recipientIDs, testNow), errors.CodeMessageDeleted)
+	})
+}
+
+func TestMessage_ValidateReply(t *testing.T) {
+	m := newTestMessage()
+	if err := m.ValidateReply(); err != nil {
+		t.Errorf("ValidateReply() error = %v", err)
+	}
+
+	m.DeletedAt = &testNow
+	assertErrorCode(t, m.ValidateReply(), errors.CodeMessageDeleted)
+}
+
+func TestMessage_Create(t *testing.T) {
+	t.Run("without mentions", func(t *testing.T) {
+		m := newTestMessage()
+		m.Create([]string{"user-2"})
+
+		events := m.Events()
+		if len(events) != 1 {
+			t.Fatalf("Create() should add only MessageCreatedEvent, got %d events", len(events))
+		}
+		if _, ok := events[0].(MessageCreatedEvent); !ok {
+			t.Errorf("event = %T, want MessageCreatedEvent", events[0])
+		}
+	})
+
+	t.Run("with mentions in a thread", func(t *testing.T) {
+		m := newTestMessage()
+		m.ParentID = "message-0"
+		m.MentionIDs = []string{"user-2"}
+		m.Create([]string{"user-2", "user-3"})
+
+		events := m.Events()
+		if len(events) != 2 {
+			t.Fatalf("Create() should add 2 events, got %d", len(events))
+		}
+		event, ok := events[1].(MentionCreatedEvent)
+		if !ok {
+			t.Fatalf("event = %T, want MentionCreatedEvent", events[1])
+		}
+		if !reflect.DeepEqual(event.MentionedUserIDs, []string{"user-2"}) || event.ParentID != "message-0" {
+			t.Errorf("event = %+v, want user-2 mentioned in the thread of message-0", event)
+		}
+	})
+}
@@ -1,5 +1,7 @@
 package message
 
+import "time"
+
 // DomainEvent represents a domain event interface
 type DomainEvent interface {
 	Type() string
@@ -7,13 +9,102 @@ type DomainEvent interface {
 
 // MessageCreatedEvent is a domain event emitted when a message is created
 type MessageCreatedEvent struct {
-	MessageID  string
-	SenderID   string
-	ReceiverID string
-	Content    string
-	CreatedAt  string
+	MessageID      string
+	ConversationID string
+	ParentID       string // Set for thread replies
+	SenderID       string
+	ReceiverID     string
+	RecipientIDs   []string
+	Content        string
+	CreatedAt      string
 }
 
 func (e MessageCreatedEvent) Type() string {
 	return "MessageCreated"
 }
+
+// MessageEditedEvent is a domain event emitted when the sender edits a message
+type MessageEditedEvent struct {
+	MessageID       string
+	ConversationID  string
+	SenderID        string
+	RecipientIDs    []string
+	PreviousContent string // Kept in the edit history, not published
+	Content         string
+	EditedAt        time.Time
+}
+
+func (e MessageEditedEvent) Type() string {
+	return "MessageEdited"
+}
+
+// MessageDeletedEvent is a domain event emitted when the sender deletes a message for everyone
+type MessageDeletedEvent struct {
+	MessageID      string
+	ConversationID string
+	SenderID       string
+	RecipientIDs   []string
+	DeletedAt      time.Time
+}
+
+func (e MessageDeletedEvent) Type() string {
+	return "MessageDeleted"
+}
+
+// MessageDeletedForMeEvent is a domain event emitted when a member hides a message from themselves
+type MessageDeletedForMeEvent struct {
+	MessageID      string
+	ConversationID string
+	UserID         string
+	DeletedAt      time.Time
+}
+
+func (e MessageDeletedForMeEvent) Type() string {
+	return "MessageDeletedForMe"
+}
+
+// MentionCreatedEvent is a domain event emitted when a new message mentions members of its conversation
+type MentionCreatedEvent struct {
+	MessageID        string
+	ConversationID   string
+	ParentID         string
+	SenderID         string
+	MentionedUserIDs []string
+	Content          string
+	CreatedAt        time.Time
+}
+
+func (e MentionCreatedEvent) Type() string {
+	return "MentionCreated"
+}
+
+// MessageReactionAddedEvent is a domain event emitted when a member reacts to a message with an emoji.
+// Count is the number of members reacting with it afterwards
+type MessageReactionAddedEvent struct {
+	MessageID      string
+	ConversationID string
+	UserID         string
+	Emoji          string
+	Count          int
+	RecipientIDs   []string
+	At             time.Time
+}
+
+func (e MessageReactionAddedEvent) Type() string {
+	return "MessageReactionAdded"
+}
+
+// MessageReactionRemovedEvent is a domain event emitted when a member takes back an emoji reaction
+type MessageReactionRemovedEvent struct {
+	MessageID      string
+	ConversationID string
+	UserID         string
+	Emoji          string
+	Count          int
+	RecipientIDs   []string
+	At             time.Time
+}
+
+func (e MessageReactionRemovedEvent) Type() string {
+	return "MessageReactionRemoved"
+}
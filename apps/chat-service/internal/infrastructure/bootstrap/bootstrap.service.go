@@ -9,7 +9,8 @@ import (
 	commandcontracts "golang-social-media/apps/chat-service/internal/application/command/contracts"
 	event_dispatcher "golang-social-media/apps/chat-service/internal/application/event_dispatcher"
 	event_handler "golang-social-media/apps/chat-service/internal/application/event_handler"
-	chatcache "golang-social-media/apps/chat-service/internal/infrastructure/cache"
+	appquery "golang-social-media/apps/chat-service/internal/application/query"
+	querycontracts "golang-social-media/apps/chat-service/internal/application/query/contracts"
 	eventbuspublisher "golang-social-media/apps/chat-service/internal/infrastructure/eventbus/publisher"
 	eventbussubscriber "golang-social-media/apps/chat-service/internal/infrastructure/eventbus/subscriber"
 	"golang-social-media/apps/chat-service/internal/infrastructure/persistence"
@@ -17,20 +18,48 @@ import (
 	"golang-social-media/pkg/cache"
 	"golang-social-media/pkg/config"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
 
 // Dependencies holds all service dependencies
 type Dependencies struct {
-	DB                *gorm.DB
-	Publisher         *eventbuspublisher.KafkaPublisher
-	Cache             cache.Cache
-	MessageRepo       *persistence.MessageRepository
-	UserRepo          *persistence.UserRepository
-	EventDispatcher   *event_dispatcher.Dispatcher
-	CreateMessageCmd  commandcontracts.CreateMessageCommand
-	UserSubscriber    *eventbussubscriber.UserCreatedSubscriber
+	DB                    *gorm.DB
+	Publisher             *eventbuspublisher.KafkaPublisher
+	Cache                 cache.Cache
+	MessageRepo           *persistence.MessageRepository
+	UserRepo              *persistence.UserRepository
+	EventDispatcher       *event_dispatcher.Dispatcher
+	CreateMessageCmd      commandcontracts.CreateMessageCommand
+	UserSubscriber        *eventbussubscriber.UserCreatedSubscriber
+	UserProfileSubscriber *eventbussubscriber.UserProfileUpdatedSubscriber
+	// GDPR saga participants
+	UserDeletedSubscriber         *eventbussubscriber.UserDeletedSubscriber
+	UserExportRequestedSubscriber *eventbussubscriber.UserExportRequestedSubscriber
+	// Conversation history
+	ListConversationMessagesQuery querycontracts.ListConversationMessagesQuery
+	ListConversationsQuery        querycontracts.ListConversationsQuery
+	// Group conversations
+	ConversationRepo                *persistence.ConversationRepository
+	GetConversationQuery            querycontracts.GetConversationQuery
+	CreateGroupConversationCmd      commandcontracts.CreateGroupConversationCommand
+	JoinConversationCmd             commandcontracts.JoinConversationCommand
+	LeaveConversationCmd            commandcontracts.LeaveConversationCommand
+	KickConversationMemberCmd       commandcontracts.KickConversationMemberCommand
+	UpdateConversationCmd           commandcontracts.UpdateConversationCommand
+	ChangeConversationMemberRoleCmd commandcontracts.ChangeConversationMemberRoleCommand
+	// Message edits and deletions
+	EditMessageCmd        commandcontracts.EditMessageCommand
+	DeleteMessageCmd      commandcontracts.DeleteMessageCommand
+	ListMessageEditsQuery querycontracts.ListMessageEditsQuery
+	// Delivery and read receipts
+	MarkConversationReadCmd      commandcontracts.MarkConversationReadCommand
+	MarkConversationDeliveredCmd commandcontracts.MarkConversationDeliveredCommand
+	GetUnreadCountsQuery         querycontracts.GetUnreadCountsQuery
+	// Reactions and threads
+	ToggleReactionCmd       commandcontracts.ToggleReactionCommand
+	ListMessageRepliesQuery querycontracts.ListMessageRepliesQuery
 }
 
 // SetupDependencies initializes all service dependencies
@@ -52,16 +81,18 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 	}
 
 	// Setup cache wrappers
-	var userCache *chatcache.UserCache
+	var userCache *persistence.UserCache
 	if redisCache != nil {
-		userCache = chatcache.NewUserCache(redisCache)
+		userCache = persistence.NewUserCache(redisCache)
 	}
 
 	// Setup mappers
 	messageMapper := persistence.NewMessageMapper()
+	conversationMapper := persistence.NewConversationMapper()
 
 	// Setup repositories
-	messageRepo := persistence.NewMessageRepository(db, messageMapper)
+	messageRepo := persistence.NewMessageRepository(db, messageMapper, conversationMapper)
+	conversationRepo := persistence.NewConversationRepository(db, conversationMapper)
 	userRepo := persistence.NewUserRepository(db, userCache)
 
 	// Setup event bus publisher
@@ -75,30 +106,90 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 
 	// Setup factories
 	messageFactory := domainfactories.NewMessageFactory()
+	conversationFactory := domainfactories.NewConversationFactory()
 
 	// Setup commands
-	createMessageCmd := setupCommands(messageRepo, messageFactory, eventDispatcher)
+	createMessageCmd := setupCommands(messageRepo, conversationRepo, userRepo, messageFactory, conversationFactory, eventDispatcher)
 	handleUserCreatedCmd := setupHandleUserCreatedCommand(userRepo)
+	handleUserProfileUpdatedCmd := appcommand.NewHandleUserProfileUpdatedCommand(userRepo)
+	gdprEventBroker := eventbuspublisher.NewEventBrokerAdapter(publisher)
+	handleUserDeletedCmd := appcommand.NewHandleUserDeletedCommand(userRepo, messageRepo, gdprEventBroker)
+	handleUserExportRequestedCmd := appcommand.NewHandleUserExportRequestedCommand(userRepo, messageRepo, gdprEventBroker)
+	createGroupConversationCmd := appcommand.NewCreateGroupConversationCommand(conversationRepo, conversationFactory, eventDispatcher)
+	joinConversationCmd := appcommand.NewJoinConversationCommand(conversationRepo, eventDispatcher)
+	leaveConversationCmd := appcommand.NewLeaveConversationCommand(conversationRepo, eventDispatcher)
+	kickConversationMemberCmd := appcommand.NewKickConversationMemberCommand(conversationRepo, eventDispatcher)
+	updateConversationCmd := appcommand.NewUpdateConversationCommand(conversationRepo, eventDispatcher)
+	changeConversationMemberRoleCmd := appcommand.NewChangeConversationMemberRoleCommand(conversationRepo, eventDispatcher)
+	editMessageCmd := appcommand.NewEditMessageCommand(messageRepo, conversationRepo, eventDispatcher)
+	deleteForEveryoneWindow := time.Duration(config.GetEnvInt("CHAT_DELETE_FOR_EVERYONE_WINDOW_MINUTES", 60)) * time.Minute
+	deleteMessageCmd := appcommand.NewDeleteMessageCommand(messageRepo, conversationRepo, eventDispatcher, deleteForEveryoneWindow)
+	markConversationReadCmd := appcommand.NewMarkConversationReadCommand(messageRepo, conversationRepo, eventDispatcher)
+	markConversationDeliveredCmd := appcommand.NewMarkConversationDeliveredCommand(messageRepo, conversationRepo, eventDispatcher)
+	toggleReactionCmd := appcommand.NewToggleReactionCommand(messageRepo, conversationRepo, eventDispatcher)
+
+	// Setup queries
+	listConversationMessagesQuery := appquery.NewListConversationMessagesQuery(messageRepo, conversationRepo)
+	listConversationsQuery := appquery.NewListConversationsQuery(messageRepo)
+	getConversationQuery := appquery.NewGetConversationQuery(conversationRepo)
+	listMessageEditsQuery := appquery.NewListMessageEditsQuery(messageRepo, conversationRepo)
+	getUnreadCountsQuery := appquery.NewGetUnreadCountsQuery(messageRepo)
+	listMessageRepliesQuery := appquery.NewListMessageRepliesQuery(messageRepo, conversationRepo)
 
 	// Setup subscribers
 	userSubscriber, err := setupUserSubscriber(handleUserCreatedCmd)
 	if err != nil {
 		return nil, err
 	}
+	userProfileSubscriber, err := setupUserProfileSubscriber(handleUserProfileUpdatedCmd)
+	if err != nil {
+		return nil, err
+	}
+	userDeletedSubscriber, userExportRequestedSubscriber, err := setupGDPRSubscribers(handleUserDeletedCmd, handleUserExportRequestedCmd)
+	if err != nil {
+		return nil, err
+	}
 
 	logger.Component("chat.bootstrap").
 		Info().
 		Msg("chat service dependencies initialized")
 
 	return &Dependencies{
-		DB:               db,
-		Publisher:        publisher,
-		Cache:            redisCache,
-		MessageRepo:      messageRepo,
-		UserRepo:         userRepo,
-		EventDispatcher:  eventDispatcher,
-		CreateMessageCmd: createMessageCmd,
-		UserSubscriber:   userSubscriber,
+		DB:                    db,
+		Publisher:             publisher,
+		Cache:                 redisCache,
+		MessageRepo:           messageRepo,
+		UserRepo:              userRepo,
+		EventDispatcher:       eventDispatcher,
+		CreateMessageCmd:      createMessageCmd,
+		UserSubscriber:        userSubscriber,
+		UserProfileSubscriber: userProfileSubscriber,
+
+		UserDeletedSubscriber:         userDeletedSubscriber,
+		UserExportRequestedSubscriber: userExportRequestedSubscriber,
+
+		ListConversationMessagesQuery: listConversationMessagesQuery,
+		ListConversationsQuery:        listConversationsQuery,
+
+		ConversationRepo:                conversationRepo,
+		GetConversationQuery:            getConversationQuery,
+		CreateGroupConversationCmd:      createGroupConversationCmd,
+		JoinConversationCmd:             joinConversationCmd,
+		LeaveConversationCmd:            leaveConversationCmd,
+		KickConversationMemberCmd:       kickConversationMemberCmd,
+		UpdateConversationCmd:           updateConversationCmd,
+		ChangeConversationMemberRoleCmd: changeConversationMemberRoleCmd,
+
+		EditMessageCmd:        editMessageCmd,
+		DeleteMessageCmd:      deleteMessageCmd,
+		ListMessageEditsQuery: listMessageEditsQuery,
+
+		MarkConversationReadCmd:      markConversationReadCmd,
+		MarkConversationDeliveredCmd: markConversationDeliveredCmd,
+		GetUnreadCountsQuery:         getUnreadCountsQuery,
+
+		ToggleReactionCmd:       toggleReactionCmd,
+		ListMessageRepliesQuery: listMessageRepliesQuery,
 	}, nil
 }
 
@@ -113,6 +204,15 @@ func setupDatabase() (*gorm.DB, error) {
 		return nil, err
 	}
 
+	// One client span per SQL statement, nested under the request or message being handled
+	if err := tracing.InstrumentGorm(db); err != nil {
+		logger.Component("chat.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to instrument database")
+		return nil, err
+	}
+
 	// Configure connection pool for high concurrency
 	sqlDB, err := db.DB()
 	if err != nil {
@@ -178,9 +278,40 @@ func setupEventDispatcher(publisher *eventbuspublisher.KafkaPublisher) *event_di
 		Str("handler", "MessageCreatedHandler").
 		Msg("registered event handler")
 
+	// Register MentionCreated handler
+	mentionCreatedHandler := event_handler.NewMentionCreatedHandler(eventBrokerAdapter)
+	dispatcher.RegisterHandler("MentionCreated", mentionCreatedHandler)
+	logger.Component("chat.bootstrap").
+		Info().
+		Str("event_type", "MentionCreated").
+		Str("handler", "MentionCreatedHandler").
+		Msg("registered event handler")
+
+	// Register one handler for every conversation event
+	conversationEventsHandler := event_handler.NewConversationEventsHandler(eventBrokerAdapter)
+	for _, eventType := range conversationEventsHandler.EventTypes() {
+		dispatcher.RegisterHandler(eventType, conversationEventsHandler)
+		logger.Component("chat.bootstrap").
+			Info().
+			Str("event_type", eventType).
+			Str("handler", "ConversationEventsHandler").
+			Msg("registered event handler")
+	}
+
+	// Register one handler for every edit, deletion or reaction of a message
+	messageChangesHandler := event_handler.NewMessageChangesHandler(eventBrokerAdapter)
+	for _, eventType := range messageChangesHandler.EventTypes() {
+		dispatcher.RegisterHandler(eventType, messageChangesHandler)
+		logger.Component("chat.bootstrap").
+			Info().
+			Str("event_type", eventType).
+			Str("handler", "MessageChangesHandler").
+			Msg("registered event handler")
+	}
+
 	logger.Component("chat.bootstrap").
 		Info().
-		Int("total_handlers", 1).
+		Int("total_handlers", 2+len(conversationEventsHandler.EventTypes())+len(messageChangesHandler.EventTypes())).
 		Msg("event dispatcher configured")
 
 	return dispatcher
@@ -188,10 +319,13 @@ func setupEventDispatcher(publisher *eventbuspublisher.KafkaPublisher) *event_di
 
 func setupCommands(
 	messageRepo *persistence.MessageRepository,
+	conversationRepo *persistence.ConversationRepository,
+	userRepo *persistence.UserRepository,
 	messageFactory domainfactories.MessageFactory,
+	conversationFactory domainfactories.ConversationFactory,
 	eventDispatcher *event_dispatcher.Dispatcher,
 ) commandcontracts.CreateMessageCommand {
-	createMessageCmd := appcommand.NewCreateMessageCommand(messageRepo, messageFactory, eventDispatcher)
+	createMessageCmd := appcommand.NewCreateMessageCommand(messageRepo, conversationRepo, userRepo, messageFactory, conversationFactory, eventDispatcher)
 
 	logger.Component("chat.bootstrap").
 		Info().
@@ -243,14 +377,74 @@ func setupUserSubscriber(handleUserCreatedCmd commandcontracts.HandleUserCreated
 		Str("group_id", groupID).
 		Msg("registered subscriber")
 
+	return subscriber, nil
+}
+
+func setupUserProfileSubscriber(handler *appcommand.HandleUserProfileUpdatedCommandHandler) (*eventbussubscriber.UserProfileUpdatedSubscriber, error) {
+	brokers := config.GetEnvStringSlice("KAFKA_BROKERS", []string{"localhost:9092"})
+	groupID := config.GetEnv("CHAT_USER_PROFILE_GROUP_ID", "chat-service-user-profile")
+
+	subscriber, err := eventbussubscriber.NewUserProfileUpdatedSubscriber(brokers, groupID, handler)
+	if err != nil {
+		logger.Component("chat.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create user profile subscriber")
+		return nil, err
+	}
+
 	logger.Component("chat.bootstrap").
 		Info().
-		Int("total_subscribers", 1).
+		Str("subscriber", "UserProfileUpdatedSubscriber").
+		Str("topic", "user.profile.updated").
+		Str("group_id", groupID).
+		Msg("registered subscriber")
+
+	logger.Component("chat.bootstrap").
+		Info().
+		Int("total_subscribers", 2).
 		Msg("subscribers configured")
 
 	return subscriber, nil
 }
 
+// setupGDPRSubscribers creates the consumers that erase or export chat data for the auth-service GDPR saga
+func setupGDPRSubscribers(
+	deletedHandler *appcommand.HandleUserDeletedCommandHandler,
+	exportHandler *appcommand.HandleUserExportRequestedCommandHandler,
+) (*eventbussubscriber.UserDeletedSubscriber, *eventbussubscriber.UserExportRequestedSubscriber, error) {
+	brokers := config.GetEnvStringSlice("KAFKA_BROKERS", []string{"localhost:9092"})
+	deletionGroupID := config.GetEnv("CHAT_GDPR_DELETION_GROUP_ID", "chat-service-gdpr-deletion")
+	exportGroupID := config.GetEnv("CHAT_GDPR_EXPORT_GROUP_ID", "chat-service-gdpr-export")
+
+	deletedSubscriber, err := eventbussubscriber.NewUserDeletedSubscriber(brokers, deletionGroupID, deletedHandler)
+	if err != nil {
+		logger.Component("chat.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create user deleted subscriber")
+		return nil, nil, err
+	}
+
+	exportSubscriber, err := eventbussubscriber.NewUserExportRequestedSubscriber(brokers, exportGroupID, exportHandler)
+	if err != nil {
+		_ = deletedSubscriber.Close()
+		logger.Component("chat.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create user export requested subscriber")
+		return nil, nil, err
+	}
+
+	logger.Component("chat.bootstrap").
+		Info().
+		Str("deletion_group_id", deletionGroupID).
+		Str("export_group_id", exportGroupID).
+		Msg("registered GDPR subscribers")
+
+	return deletedSubscriber, exportSubscriber, nil
+}
+
 func setupCache() (cache.Cache, error) {
 	addr := config.GetEnv("REDIS_ADDR", "localhost:6379")
 	password := config.GetEnv("REDIS_PASSWORD", "")
@@ -269,4 +463,3 @@ func setupCache() (cache.Cache, error) {
 
 	return redisCache, nil
 }
-
@@ -9,5 +9,16 @@ import (
 // ChatPublisher publishes chat-related events
 type ChatPublisher interface {
 	PublishChatCreated(ctx context.Context, event events.ChatCreated) error
+	PublishChatMessageEdited(ctx context.Context, event events.ChatMessageEdited) error
+	PublishChatMessageDeleted(ctx context.Context, event events.ChatMessageDeleted) error
+	PublishChatMessageDeletedForMe(ctx context.Context, event events.ChatMessageDeletedForMe) error
+	PublishConversationCreated(ctx context.Context, event events.ConversationCreated) error
+	PublishConversationUpdated(ctx context.Context, event events.ConversationUpdated) error
+	PublishConversationMemberChanged(ctx context.Context, topic string, event events.ConversationMemberChanged) error
+	PublishChatMessageReceipt(ctx context.Context, topic string, event events.ChatMessageReceipt) error
+	PublishChatMessageReaction(ctx context.Context, topic string, event events.ChatMessageReaction) error
+	PublishChatMentionCreated(ctx context.Context, event events.ChatMentionCreated) error
+	PublishUserDataErased(ctx context.Context, event events.UserDataErased) error
+	PublishUserDataExported(ctx context.Context, event events.UserDataExported) error
 	Close() error
 }
@@ -2,6 +2,7 @@ package publisher
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"golang-social-media/apps/chat-service/internal/application/event_handler/contracts"
@@ -30,14 +31,193 @@ func (a *EventBrokerAdapter) PublishMessageCreated(ctx context.Context, payload
 
 	kafkaEvent := events.ChatCreated{
 		Message: events.ChatMessage{
-			ID:         payload.MessageID,
-			SenderID:   payload.SenderID,
-			ReceiverID: payload.ReceiverID,
-			Content:    payload.Content,
-			CreatedAt:  createdAt,
+			ID:              payload.MessageID,
+			ConversationID:  payload.ConversationID,
+			SenderID:        payload.SenderID,
+			ReceiverID:      payload.ReceiverID,
+			Content:         payload.Content,
+			CreatedAt:       createdAt,
+			RecipientIDs:    payload.RecipientIDs,
+			ParentMessageID: payload.ParentMessageID,
 		},
 		CreatedAt: createdAt,
 	}
 
 	return a.kafkaPublisher.PublishChatCreated(ctx, kafkaEvent)
 }
+
+// PublishMessageEdited publishes a message edited event
+func (a *EventBrokerAdapter) PublishMessageEdited(ctx context.Context, payload contracts.MessageEditedPayload) error {
+	return a.kafkaPublisher.PublishChatMessageEdited(ctx, events.ChatMessageEdited{
+		MessageID:      payload.MessageID,
+		ConversationID: payload.ConversationID,
+		SenderID:       payload.SenderID,
+		RecipientIDs:   payload.RecipientIDs,
+		Content:        payload.Content,
+		EditedAt:       payload.EditedAt,
+	})
+}
+
+// PublishMessageDeleted publishes a message deleted for everyone event
+func (a *EventBrokerAdapter) PublishMessageDeleted(ctx context.Context, payload contracts.MessageDeletedPayload) error {
+	return a.kafkaPublisher.PublishChatMessageDeleted(ctx, events.ChatMessageDeleted{
+		MessageID:      payload.MessageID,
+		ConversationID: payload.ConversationID,
+		SenderID:       payload.SenderID,
+		RecipientIDs:   payload.RecipientIDs,
+		DeletedAt:      payload.DeletedAt,
+	})
+}
+
+// PublishMessageDeletedForMe publishes a message deleted for one member event
+func (a *EventBrokerAdapter) PublishMessageDeletedForMe(ctx context.Context, payload contracts.MessageDeletedForMePayload) error {
+	return a.kafkaPublisher.PublishChatMessageDeletedForMe(ctx, events.ChatMessageDeletedForMe{
+		MessageID:      payload.MessageID,
+		ConversationID: payload.ConversationID,
+		UserID:         payload.UserID,
+		DeletedAt:      payload.DeletedAt,
+	})
+}
+
+// PublishConversationCreated publishes a conversation created event
+func (a *EventBrokerAdapter) PublishConversationCreated(ctx context.Context, payload contracts.ConversationPayload) error {
+	return a.kafkaPublisher.PublishConversationCreated(ctx, events.ConversationCreated{
+		Conversation: toConversationEvent(payload),
+		CreatedAt:    payload.CreatedAt,
+	})
+}
+
+// PublishConversationUpdated publishes a group details change
+func (a *EventBrokerAdapter) PublishConversationUpdated(ctx context.Context, payload contracts.ConversationUpdatedPayload) error {
+	return a.kafkaPublisher.PublishConversationUpdated(ctx, events.ConversationUpdated{
+		Conversation: toConversationEvent(payload.Conversation),
+		ActorID:      payload.ActorID,
+		UpdatedAt:    payload.Conversation.UpdatedAt,
+	})
+}
+
+// PublishConversationMemberChanged publishes a membership change on the topic of its kind
+func (a *EventBrokerAdapter) PublishConversationMemberChanged(ctx context.Context, payload contracts.ConversationMemberChangedPayload) error {
+	var topic string
+	switch payload.Change {
+	case contracts.MemberJoined:
+		topic = events.TopicConversationMemberJoined
+	case contracts.MemberLeft:
+		topic = events.TopicConversationMemberLeft
+	case contracts.MemberRemoved:
+		topic = events.TopicConversationMemberRemoved
+	case contracts.MemberRoleChanged:
+		topic = events.TopicConversationMemberRoleChanged
+	default:
+		return fmt.Errorf("unknown conversation member change %q", payload.Change)
+	}
+
+	return a.kafkaPublisher.PublishConversationMemberChanged(ctx, topic, events.ConversationMemberChanged{
+		Conversation: toConversationEvent(payload.Conversation),
+		ActorID:      payload.ActorID,
+		UserID:       payload.UserID,
+		Role:         payload.Role,
+		OccurredAt:   payload.Conversation.UpdatedAt,
+	})
+}
+
+// PublishMessageReceipt publishes a receipt on the topic of its watermark
+func (a *EventBrokerAdapter) PublishMessageReceipt(ctx context.Context, payload contracts.MessageReceiptPayload) error {
+	var topic string
+	switch payload.Receipt {
+	case contracts.ReceiptDelivered:
+		topic = events.TopicChatMessageDelivered
+	case contracts.ReceiptRead:
+		topic = events.TopicChatMessageRead
+	default:
+		return fmt.Errorf("unknown message receipt %q", payload.Receipt)
+	}
+
+	return a.kafkaPublisher.PublishChatMessageReceipt(ctx, topic, events.ChatMessageReceipt{
+		ConversationID:   payload.ConversationID,
+		UserID:           payload.UserID,
+		MessageID:        payload.MessageID,
+		MessageCreatedAt: payload.MessageCreatedAt,
+		RecipientIDs:     payload.RecipientIDs,
+		At:               payload.At,
+	})
+}
+
+// PublishMessageReaction publishes a reaction on the topic of its change
+func (a *EventBrokerAdapter) PublishMessageReaction(ctx context.Context, payload contracts.MessageReactionPayload) error {
+	var topic string
+	switch payload.Change {
+	case contracts.ReactionAdded:
+		topic = events.TopicChatMessageReactionAdded
+	case contracts.ReactionRemoved:
+		topic = events.TopicChatMessageReactionRemoved
+	default:
+		return fmt.Errorf("unknown reaction change %q", payload.Change)
+	}
+
+	return a.kafkaPublisher.PublishChatMessageReaction(ctx, topic, events.ChatMessageReaction{
+		MessageID:      payload.MessageID,
+		ConversationID: payload.ConversationID,
+		UserID:         payload.UserID,
+		Emoji:          payload.Emoji,
+		Count:          payload.Count,
+		RecipientIDs:   payload.RecipientIDs,
+		At:             payload.At,
+	})
+}
+
+// PublishMentionCreated publishes the mentions of a new message
+func (a *EventBrokerAdapter) PublishMentionCreated(ctx context.Context, payload contracts.MentionCreatedPayload) error {
+	return a.kafkaPublisher.PublishChatMentionCreated(ctx, events.ChatMentionCreated{
+		MessageID:        payload.MessageID,
+		ConversationID:   payload.ConversationID,
+		ParentMessageID:  payload.ParentMessageID,
+		SenderID:         payload.SenderID,
+		MentionedUserIDs: payload.MentionedUserIDs,
+		Content:          payload.Content,
+		CreatedAt:        payload.CreatedAt,
+	})
+}
+
+func toConversationEvent(payload contracts.ConversationPayload) events.Conversation {
+	members := make([]events.ConversationMember, len(payload.Members))
+	for i, member := range payload.Members {
+		members[i] = events.ConversationMember{
+			UserID:   member.UserID,
+			Role:     member.Role,
+			JoinedAt: member.JoinedAt,
+		}
+	}
+	return events.Conversation{
+		ID:        payload.ID,
+		Kind:      payload.Kind,
+		Title:     payload.Title,
+		AvatarURL: payload.AvatarURL,
+		CreatedBy: payload.CreatedBy,
+		Members:   members,
+		CreatedAt: payload.CreatedAt,
+		UpdatedAt: payload.UpdatedAt,
+	}
+}
+
+// PublishUserDataErased publishes the chat step of a GDPR deletion
+func (a *EventBrokerAdapter) PublishUserDataErased(ctx context.Context, payload contracts.UserDataErasedPayload) error {
+	return a.kafkaPublisher.PublishUserDataErased(ctx, events.UserDataErased{
+		RequestID: payload.RequestID,
+		UserID:    payload.UserID,
+		Service:   events.ServiceChat,
+		Records:   payload.Records,
+		ErasedAt:  payload.ErasedAt,
+	})
+}
+
+// PublishUserDataExported publishes the chat document of a GDPR data export
+func (a *EventBrokerAdapter) PublishUserDataExported(ctx context.Context, payload contracts.UserDataExportedPayload) error {
+	return a.kafkaPublisher.PublishUserDataExported(ctx, events.UserDataExported{
+		RequestID:  payload.RequestID,
+		UserID:     payload.UserID,
+		Service:    events.ServiceChat,
+		Document:   payload.Document,
+		ExportedAt: payload.ExportedAt,
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"golang-social-media/apps/chat-service/internal/infrastructure/eventbus/publisher/contracts"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -17,6 +18,13 @@ var _ contracts.ChatPublisher = (*KafkaPublisher)(nil)
 
 type KafkaPublisher struct {
 	writer *kafka.Writer
+
+	// Conversation events and message updates set their topic per message, keyed by conversation ID
+	conversationWriter *kafka.Writer
+
+	// GDPR saga reports go to auth-service, keyed by request ID
+	deletionWriter *kafka.Writer
+	exportWriter   *kafka.Writer
 }
 
 func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
@@ -58,31 +66,66 @@ func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
 		Strs("brokers", brokers).
 		Msg("kafka publisher initialized")
 
-	return &KafkaPublisher{writer: writer}, nil
+	conversationWriter := &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Balancer:     &kafka.Hash{}, // Events of one conversation stay in order
+		BatchSize:    100,
+		BatchBytes:   1048576,
+		BatchTimeout: 10 * time.Millisecond,
+		RequiredAcks: kafka.RequireOne,
+		MaxAttempts:  10,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		Async:        true,
+		Compression:  kafka.Snappy,
+	}
+
+	return &KafkaPublisher{
+		writer:             writer,
+		conversationWriter: conversationWriter,
+		deletionWriter:     newGDPRWriter(brokers, events.TopicUserDeletionCompleted, 1048576),
+		exportWriter:       newGDPRWriter(brokers, events.TopicUserExportCompleted, 10485760),
+	}, nil
+}
+
+// newGDPRWriter creates a synchronous writer: the subscriber commits the request only once the report is written
+func newGDPRWriter(brokers []string, topic string, batchBytes int64) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{}, // Reports of one request stay in order
+		BatchBytes:   batchBytes,    // Export documents hold every message of the user
+		BatchTimeout: 10 * time.Millisecond,
+		RequiredAcks: kafka.RequireAll,
+		MaxAttempts:  10,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+		Compression:  kafka.Snappy,
+	}
 }
 
 func (p *KafkaPublisher) PublishChatCreated(ctx context.Context, event events.ChatCreated) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("chat.publisher").
+		logger.ComponentCtx(ctx, "chat.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal ChatCreated event")
 		return err
 	}
 
-	if err := p.writer.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.writer, kafka.Message{
 		Key:   []byte(event.Message.ID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("chat.publisher").
+		logger.ComponentCtx(ctx, "chat.publisher").
 			Error().
 			Err(err).
 			Msg("failed to publish ChatCreated event")
 		return err
 	}
 
-	logger.Component("chat.publisher").
+	logger.ComponentCtx(ctx, "chat.publisher").
 		Info().
 		Str("topic", events.TopicChatCreated).
 		Str("message_id", event.Message.ID).
@@ -90,6 +133,127 @@ func (p *KafkaPublisher) PublishChatCreated(ctx context.Context, event events.Ch
 	return nil
 }
 
+// PublishChatMessageEdited announces the new content of an edited message
+func (p *KafkaPublisher) PublishChatMessageEdited(ctx context.Context, event events.ChatMessageEdited) error {
+	return p.publishConversation(ctx, events.TopicChatMessageEdited, event.ConversationID, event)
+}
+
+// PublishChatMessageDeleted announces a message deleted for everyone
+func (p *KafkaPublisher) PublishChatMessageDeleted(ctx context.Context, event events.ChatMessageDeleted) error {
+	return p.publishConversation(ctx, events.TopicChatMessageDeleted, event.ConversationID, event)
+}
+
+// PublishChatMessageDeletedForMe announces a message a member deleted for themselves
+func (p *KafkaPublisher) PublishChatMessageDeletedForMe(ctx context.Context, event events.ChatMessageDeletedForMe) error {
+	return p.publishConversation(ctx, events.TopicChatMessageDeletedForMe, event.ConversationID, event)
+}
+
+// PublishConversationCreated announces a new group, or a direct conversation on its first message
+func (p *KafkaPublisher) PublishConversationCreated(ctx context.Context, event events.ConversationCreated) error {
+	return p.publishConversation(ctx, events.TopicConversationCreated, event.Conversation.ID, event)
+}
+
+// PublishConversationUpdated announces a change to a group's title or avatar
+func (p *KafkaPublisher) PublishConversationUpdated(ctx context.Context, event events.ConversationUpdated) error {
+	return p.publishConversation(ctx, events.TopicConversationUpdated, event.Conversation.ID, event)
+}
+
+// PublishConversationMemberChanged announces a membership change on one of the conversation.member.* topics
+func (p *KafkaPublisher) PublishConversationMemberChanged(ctx context.Context, topic string, event events.ConversationMemberChanged) error {
+	return p.publishConversation(ctx, topic, event.Conversation.ID, event)
+}
+
+// PublishChatMessageReceipt announces the move of a member's watermark on chat.message.delivered or chat.message.read
+func (p *KafkaPublisher) PublishChatMessageReceipt(ctx context.Context, topic string, event events.ChatMessageReceipt) error {
+	return p.publishConversation(ctx, topic, event.ConversationID, event)
+}
+
+// PublishChatMessageReaction announces a reaction toggle on chat.message.reaction.added or chat.message.reaction.removed
+func (p *KafkaPublisher) PublishChatMessageReaction(ctx context.Context, topic string, event events.ChatMessageReaction) error {
+	return p.publishConversation(ctx, topic, event.ConversationID, event)
+}
+
+// PublishChatMentionCreated announces the members a new message mentions
+func (p *KafkaPublisher) PublishChatMentionCreated(ctx context.Context, event events.ChatMentionCreated) error {
+	return p.publishConversation(ctx, events.TopicChatMentionCreated, event.ConversationID, event)
+}
+
+// publishConversation publishes an event on topic, keyed by its conversation so that a conversation's events stay in order
+func (p *KafkaPublisher) publishConversation(ctx context.Context, topic, conversationID string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.publisher").
+			Error().
+			Err(err).
+			Str("topic", topic).
+			Msg("failed to marshal conversation event")
+		return err
+	}
+
+	if err := tracing.WriteKafkaMessage(ctx, p.conversationWriter, kafka.Message{
+		Topic: topic,
+		Key:   []byte(conversationID),
+		Value: payload,
+	}); err != nil {
+		logger.ComponentCtx(ctx, "chat.publisher").
+			Error().
+			Err(err).
+			Str("topic", topic).
+			Str("conversation_id", conversationID).
+			Msg("failed to publish conversation event")
+		return err
+	}
+
+	logger.ComponentCtx(ctx, "chat.publisher").
+		Info().
+		Str("topic", topic).
+		Str("conversation_id", conversationID).
+		Msg("published conversation event")
+	return nil
+}
+
+// PublishUserDataErased reports that chat-service erased a deleted user's data
+func (p *KafkaPublisher) PublishUserDataErased(ctx context.Context, event events.UserDataErased) error {
+	return p.publishGDPR(ctx, p.deletionWriter, event.RequestID, event)
+}
+
+// PublishUserDataExported sends the chat document of a data export
+func (p *KafkaPublisher) PublishUserDataExported(ctx context.Context, event events.UserDataExported) error {
+	return p.publishGDPR(ctx, p.exportWriter, event.RequestID, event)
+}
+
+func (p *KafkaPublisher) publishGDPR(ctx context.Context, writer *kafka.Writer, requestID string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.publisher").
+			Error().
+			Err(err).
+			Str("topic", writer.Topic).
+			Msg("failed to marshal GDPR event")
+		return err
+	}
+
+	if err := tracing.WriteKafkaMessage(ctx, writer, kafka.Message{
+		Key:   []byte(requestID),
+		Value: payload,
+	}); err != nil {
+		logger.ComponentCtx(ctx, "chat.publisher").
+			Error().
+			Err(err).
+			Str("topic", writer.Topic).
+			Str("request_id", requestID).
+			Msg("failed to publish GDPR event")
+		return err
+	}
+
+	logger.ComponentCtx(ctx, "chat.publisher").
+		Info().
+		Str("topic", writer.Topic).
+		Str("request_id", requestID).
+		Msg("published GDPR event")
+	return nil
+}
+
 func (p *KafkaPublisher) Close() error {
-	return p.writer.Close()
+	return errors.Join(p.writer.Close(), p.conversationWriter.Close(), p.deletionWriter.Close(), p.exportWriter.Close())
 }
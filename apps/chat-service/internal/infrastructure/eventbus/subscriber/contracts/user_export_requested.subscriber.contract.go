@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// UserExportRequestedSubscriber subscribes to user.export.requested events
+type UserExportRequestedSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// UserProfileUpdatedSubscriber subscribes to user.profile.updated events
+type UserProfileUpdatedSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
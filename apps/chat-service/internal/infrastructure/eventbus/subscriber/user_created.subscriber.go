@@ -11,6 +11,8 @@ import (
 	"golang-social-media/apps/chat-service/internal/infrastructure/eventbus/subscriber/contracts"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 )
 
 var _ contracts.UserCreatedSubscriber = (*UserCreatedSubscriber)(nil)
@@ -18,6 +20,7 @@ var _ contracts.UserCreatedSubscriber = (*UserCreatedSubscriber)(nil)
 type UserCreatedSubscriber struct {
 	reader  *kafka.Reader
 	handler *command.HandleUserCreatedCommandHandler
+	metrics *metrics.KafkaConsumer
 }
 
 func NewUserCreatedSubscriber(
@@ -62,6 +65,7 @@ func NewUserCreatedSubscriber(
 	return &UserCreatedSubscriber{
 		reader:  reader,
 		handler: handler,
+		metrics: metrics.NewKafkaConsumer(events.TopicUserCreated, groupID),
 	}, nil
 }
 
@@ -87,7 +91,11 @@ func (s *UserCreatedSubscriber) Consume(ctx context.Context) {
 			continue
 		}
 
-		logger.Component("chat.subscriber.user_created").
+		s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+		start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+		logger.ComponentCtx(msgCtx, "chat.subscriber.user_created").
 			Info().
 			Str("topic", msg.Topic).
 			Int("partition", msg.Partition).
@@ -96,21 +104,27 @@ func (s *UserCreatedSubscriber) Consume(ctx context.Context) {
 
 		var event events.UserCreated
 		if err := json.Unmarshal(msg.Value, &event); err != nil {
-			logger.Component("chat.subscriber.user_created").
+			logger.ComponentCtx(msgCtx, "chat.subscriber.user_created").
 				Error().
 				Err(err).
 				Msg("failed to decode UserCreated event")
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
 			continue
 		}
 
-		if err := s.handler.Execute(ctx, event); err != nil {
-			logger.Component("chat.subscriber.user_created").
+		if err := s.handler.Execute(msgCtx, event); err != nil {
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeError)
+			logger.ComponentCtx(msgCtx, "chat.subscriber.user_created").
 				Error().
 				Err(err).
 				Str("user_id", event.ID).
 				Msg("failed to handle UserCreated event")
 		} else {
-			logger.Component("chat.subscriber.user_created").
+			tracing.End(span, nil)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+			logger.ComponentCtx(msgCtx, "chat.subscriber.user_created").
 				Info().
 				Str("user_id", event.ID).
 				Str("email", event.Email).
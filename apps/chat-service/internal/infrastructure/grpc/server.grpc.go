@@ -6,6 +6,8 @@ import (
 
 	"golang-social-media/pkg/errors"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -21,7 +23,10 @@ func Start(addr string, register func(*grpc.Server)) error {
 
 	// Create server with error interceptor and performance optimizations
 	server := grpc.NewServer(
-		grpc.UnaryInterceptor(errors.GRPCErrorInterceptor(transformer)),
+		// Metrics run outermost so they record the status code produced by the error interceptor
+		grpc.ChainUnaryInterceptor(metrics.UnaryServerInterceptor(), errors.GRPCErrorInterceptor(transformer)),
+		grpc.StreamInterceptor(metrics.StreamServerInterceptor()),
+		tracing.GRPCServerOption(),
 		grpc.MaxConcurrentStreams(10000),        // Allow high concurrency
 		grpc.InitialWindowSize(65535),          // Increase initial window size
 		grpc.InitialConnWindowSize(1048576),    // 1MB initial connection window
@@ -0,0 +1,9 @@
+package persistence
+
+import "golang-social-media/apps/chat-service/internal/domain/conversation"
+
+// ConversationMapper defines the contract for mapping between the Conversation aggregate and persistence models
+type ConversationMapper interface {
+	ToModel(conv conversation.Conversation) (ConversationModel, []ConversationMemberModel)
+	ToDomain(model ConversationModel, members []ConversationMemberModel) conversation.Conversation
+}
@@ -0,0 +1,97 @@
+package persistence
+
+import (
+	"time"
+
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+)
+
+// ConversationMapperImpl implements ConversationMapper interface
+type ConversationMapperImpl struct{}
+
+var _ ConversationMapper = (*ConversationMapperImpl)(nil)
+
+// NewConversationMapper creates a new ConversationMapperImpl
+func NewConversationMapper() ConversationMapper {
+	return &ConversationMapperImpl{}
+}
+
+// ToModel converts a Conversation to its row and member rows. The last message columns are left
+// empty: they are only written with a message
+func (m *ConversationMapperImpl) ToModel(conv conversation.Conversation) (ConversationModel, []ConversationMemberModel) {
+	model := ConversationModel{
+		ID:             conv.ID,
+		Kind:           string(conv.Kind),
+		Title:          conv.Title,
+		AvatarURL:      conv.AvatarURL,
+		CreatedBy:      conv.CreatedBy,
+		CreatedAt:      conv.CreatedAt,
+		UpdatedAt:      conv.UpdatedAt,
+		LastActivityAt: conv.CreatedAt,
+	}
+	if conv.Kind == conversation.KindDirect && len(conv.Members) == 2 {
+		directKey := conversation.DirectKey(conv.Members[0].UserID, conv.Members[1].UserID)
+		model.DirectKey = &directKey
+	}
+
+	members := make([]ConversationMemberModel, len(conv.Members))
+	for i, member := range conv.Members {
+		members[i] = m.toMemberModel(conv.ID, member)
+	}
+	return model, members
+}
+
+// ToDomain converts a conversation row and its member rows to a Conversation
+func (m *ConversationMapperImpl) ToDomain(model ConversationModel, members []ConversationMemberModel) conversation.Conversation {
+	conv := conversation.Conversation{
+		ID:        model.ID,
+		Kind:      conversation.Kind(model.Kind),
+		Title:     model.Title,
+		AvatarURL: model.AvatarURL,
+		CreatedBy: model.CreatedBy,
+		Members:   make([]conversation.Member, len(members)),
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}
+	for i, member := range members {
+		conv.Members[i] = conversation.Member{
+			UserID:    member.UserID,
+			Role:      conversation.Role(member.Role),
+			JoinedAt:  member.JoinedAt,
+			Delivered: toWatermark(member.DeliveredMessageID, member.DeliveredMessageCreatedAt, member.DeliveredAt),
+			Read:      toWatermark(member.ReadMessageID, member.ReadMessageCreatedAt, member.ReadAt),
+		}
+	}
+	return conv
+}
+
+func (m *ConversationMapperImpl) toMemberModel(conversationID string, member conversation.Member) ConversationMemberModel {
+	model := ConversationMemberModel{
+		ConversationID: conversationID,
+		UserID:         member.UserID,
+		Role:           string(member.Role),
+		JoinedAt:       member.JoinedAt,
+	}
+	if member.Delivered != nil {
+		model.DeliveredMessageID = &member.Delivered.MessageID
+		model.DeliveredMessageCreatedAt = &member.Delivered.MessageCreatedAt
+		model.DeliveredAt = &member.Delivered.At
+	}
+	if member.Read != nil {
+		model.ReadMessageID = &member.Read.MessageID
+		model.ReadMessageCreatedAt = &member.Read.MessageCreatedAt
+		model.ReadAt = &member.Read.At
+	}
+	return model
+}
+
+func toWatermark(messageID *string, messageCreatedAt, at *time.Time) *conversation.Watermark {
+	if messageID == nil || messageCreatedAt == nil || at == nil {
+		return nil
+	}
+	return &conversation.Watermark{
+		MessageID:        *messageID,
+		MessageCreatedAt: *messageCreatedAt,
+		At:               *at,
+	}
+}
@@ -0,0 +1,43 @@
+package persistence
+
+import (
+	"time"
+)
+
+// ConversationModel is a direct conversation or a group. LastMessageID and LastSenderID point at
+// the last message, nil until there is one; LastActivityAt orders the inbox
+type ConversationModel struct {
+	ID             string    `gorm:"column:id;type:uuid;primaryKey"`
+	Kind           string    `gorm:"column:kind;type:text;not null"`
+	DirectKey      *string   `gorm:"column:direct_key;type:text;unique"` // Direct conversations only
+	Title          string    `gorm:"column:title;type:text;not null"`
+	AvatarURL      string    `gorm:"column:avatar_url;type:text;not null"`
+	CreatedBy      string    `gorm:"column:created_by;type:text;not null"`
+	CreatedAt      time.Time `gorm:"column:created_at;not null"`
+	UpdatedAt      time.Time `gorm:"column:updated_at;not null"`
+	LastMessageID  *string   `gorm:"column:last_message_id;type:uuid"`
+	LastSenderID   *string   `gorm:"column:last_sender_id;type:text"`
+	LastActivityAt time.Time `gorm:"column:last_activity_at;not null"`
+}
+
+func (ConversationModel) TableName() string {
+	return "conversations"
+}
+
+// ConversationMemberModel is a membership with its receipt watermarks, nil until the first receipt
+type ConversationMemberModel struct {
+	ConversationID            string     `gorm:"column:conversation_id;type:uuid;primaryKey"`
+	UserID                    string     `gorm:"column:user_id;type:text;primaryKey"`
+	Role                      string     `gorm:"column:role;type:text;not null"`
+	JoinedAt                  time.Time  `gorm:"column:joined_at;not null"`
+	DeliveredMessageID        *string    `gorm:"column:delivered_message_id;type:uuid"`
+	DeliveredMessageCreatedAt *time.Time `gorm:"column:delivered_message_created_at"`
+	DeliveredAt               *time.Time `gorm:"column:delivered_at"`
+	ReadMessageID             *string    `gorm:"column:read_message_id;type:uuid"`
+	ReadMessageCreatedAt      *time.Time `gorm:"column:read_message_created_at"`
+	ReadAt                    *time.Time `gorm:"column:read_at"`
+}
+
+func (ConversationMemberModel) TableName() string {
+	return "conversation_members"
+}
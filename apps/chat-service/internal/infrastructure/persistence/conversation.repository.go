@@ -0,0 +1,217 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"golang-social-media/apps/chat-service/internal/application/conversations"
+	domain "golang-social-media/apps/chat-service/internal/domain/conversation"
+	pkgerrors "golang-social-media/pkg/errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var _ conversations.Repository = (*ConversationRepository)(nil)
+
+type ConversationRepository struct {
+	db     *gorm.DB
+	mapper ConversationMapper
+}
+
+func NewConversationRepository(db *gorm.DB, mapper ConversationMapper) *ConversationRepository {
+	return &ConversationRepository{
+		db:     db,
+		mapper: mapper,
+	}
+}
+
+func (r *ConversationRepository) Create(ctx context.Context, conv *domain.Conversation) error {
+	model, members := r.mapper.ToModel(*conv)
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+		return tx.Create(&members).Error
+	})
+}
+
+func (r *ConversationRepository) GetOrCreateDirect(ctx context.Context, conv *domain.Conversation) (*domain.Conversation, error) {
+	model, members := r.mapper.ToModel(*conv)
+	if model.DirectKey == nil {
+		return nil, pkgerrors.NewInvalidRequestError("not a direct conversation")
+	}
+
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// The first message of a pair can be sent from both sides at once: the unique direct_key
+		// lets one insert win and the other read its conversation
+		result := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "direct_key"}}, DoNothing: true}).
+			Create(&model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		created = true
+		return tx.Create(&members).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		return conv, nil
+	}
+	return r.find(r.db.WithContext(ctx), false, "direct_key = ?", *model.DirectKey)
+}
+
+func (r *ConversationRepository) FindByID(ctx context.Context, id string) (*domain.Conversation, error) {
+	return r.find(r.db.WithContext(ctx), false, "id = ?", id)
+}
+
+func (r *ConversationRepository) FindDirect(ctx context.Context, userID, peerID string) (*domain.Conversation, error) {
+	return r.find(r.db.WithContext(ctx), false, "direct_key = ?", domain.DirectKey(userID, peerID))
+}
+
+func (r *ConversationRepository) Update(ctx context.Context, id string, change func(conv *domain.Conversation) error) (*domain.Conversation, error) {
+	var conv *domain.Conversation
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var err error
+		conv, err = r.find(tx, true, "id = ?", id)
+		if err != nil {
+			return err
+		}
+		before := make(map[string]domain.Member, len(conv.Members))
+		for _, member := range conv.Members {
+			before[member.UserID] = member
+		}
+
+		if err := change(conv); err != nil {
+			return err
+		}
+		if len(conv.Events()) == 0 {
+			return nil
+		}
+
+		model, members := r.mapper.ToModel(*conv)
+		if err := tx.Model(&ConversationModel{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"title":      model.Title,
+				"avatar_url": model.AvatarURL,
+				"updated_at": model.UpdatedAt,
+			}).Error; err != nil {
+			return err
+		}
+		return saveMembers(tx, id, before, members)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return conv, nil
+}
+
+func (r *ConversationRepository) SaveWatermarks(ctx context.Context, conversationID string, member domain.Member) (bool, error) {
+	moved := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, watermark := range []struct {
+			column    string
+			watermark *domain.Watermark
+		}{
+			{"delivered", member.Delivered},
+			{"read", member.Read},
+		} {
+			if watermark.watermark == nil {
+				continue
+			}
+			ok, err := saveWatermark(tx, conversationID, member.UserID, watermark.column, *watermark.watermark)
+			if err != nil {
+				return err
+			}
+			moved = moved || ok
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return moved, nil
+}
+
+// saveWatermark moves the <column>_* watermark of a member when w is past the stored one. The condition
+// runs in the UPDATE, so two concurrent receipts cannot move a watermark back
+func saveWatermark(tx *gorm.DB, conversationID, userID, column string, w domain.Watermark) (bool, error) {
+	result := tx.Model(&ConversationMemberModel{}).
+		Where("conversation_id = ? AND user_id = ?", conversationID, userID).
+		Where(fmt.Sprintf("%[1]s_message_id IS NULL OR (%[1]s_message_created_at, %[1]s_message_id) < (?, ?)", column),
+			w.MessageCreatedAt, w.MessageID).
+		Updates(map[string]interface{}{
+			column + "_message_id":         w.MessageID,
+			column + "_message_created_at": w.MessageCreatedAt,
+			column + "_at":                 w.At,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// saveMembers writes the difference between the members before and after a change
+func saveMembers(tx *gorm.DB, conversationID string, before map[string]domain.Member, after []ConversationMemberModel) error {
+	var changed []ConversationMemberModel
+	for _, member := range after {
+		previous, ok := before[member.UserID]
+		delete(before, member.UserID)
+		if !ok || string(previous.Role) != member.Role {
+			changed = append(changed, member)
+		}
+	}
+	if len(changed) > 0 {
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "conversation_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role"}),
+		}).Create(&changed).Error; err != nil {
+			return err
+		}
+	}
+
+	// Members left in before are gone
+	if len(before) == 0 {
+		return nil
+	}
+	removed := make([]string, 0, len(before))
+	for userID := range before {
+		removed = append(removed, userID)
+	}
+	return tx.Where("conversation_id = ? AND user_id IN ?", conversationID, removed).
+		Delete(&ConversationMemberModel{}).Error
+}
+
+// find loads the conversation matching the condition with its members, oldest member first.
+// With lock the conversation row is locked until the end of the transaction db belongs to
+func (r *ConversationRepository) find(db *gorm.DB, lock bool, query string, args ...interface{}) (*domain.Conversation, error) {
+	conversationQuery := db.Where(query, args...)
+	if lock {
+		conversationQuery = conversationQuery.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+	var model ConversationModel
+	if err := conversationQuery.Take(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NewNotFoundError(pkgerrors.CodeConversationNotFound)
+		}
+		return nil, err
+	}
+
+	var members []ConversationMemberModel
+	if err := db.
+		Where("conversation_id = ?", model.ID).
+		Order("joined_at ASC, user_id ASC").
+		Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	conv := r.mapper.ToDomain(model, members)
+	return &conv, nil
+}
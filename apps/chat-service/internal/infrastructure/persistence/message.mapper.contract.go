@@ -8,6 +8,9 @@ type MessageMapper interface {
 	ToDomain(model MessageModel) message.Message
 	ToDomainList(models []MessageModel) []message.Message
 	ToModelList(messages []message.Message) []MessageModel
+	ToEditList(models []MessageEditModel) []message.Edit
+	ToReactionList(models []MessageReactionModel) []message.Reaction
+	ToMentionModels(msg message.Message) []MessageMentionModel
 }
 
 
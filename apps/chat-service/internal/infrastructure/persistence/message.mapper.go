@@ -16,24 +16,48 @@ func NewMessageMapper() MessageMapper {
 
 // ToModel converts a domain Message to MessageModel
 func (m *MessageMapperImpl) ToModel(msg domain.Message) MessageModel {
-	return MessageModel{
-		ID:         msg.ID,
-		SenderID:   msg.SenderID,
-		ReceiverID: msg.ReceiverID,
-		Content:    msg.Content,
-		CreatedAt:  msg.CreatedAt,
+	model := MessageModel{
+		ID:             msg.ID,
+		ConversationID: msg.ConversationID,
+		SenderID:       msg.SenderID,
+		Content:        msg.Content,
+		CreatedAt:      msg.CreatedAt,
+		EditedAt:       msg.EditedAt,
+		DeletedAt:      msg.DeletedAt,
+		ReplyCount:     msg.ReplyCount,
+		LastReplyAt:    msg.LastReplyAt,
 	}
+	if msg.ReceiverID != "" {
+		receiverID := msg.ReceiverID
+		model.ReceiverID = &receiverID
+	}
+	if msg.ParentID != "" {
+		parentID := msg.ParentID
+		model.ParentID = &parentID
+	}
+	return model
 }
 
 // ToDomain converts a MessageModel to domain Message
 func (m *MessageMapperImpl) ToDomain(model MessageModel) domain.Message {
-	return domain.Message{
-		ID:         model.ID,
-		SenderID:   model.SenderID,
-		ReceiverID: model.ReceiverID,
-		Content:    model.Content,
-		CreatedAt:  model.CreatedAt,
+	msg := domain.Message{
+		ID:             model.ID,
+		ConversationID: model.ConversationID,
+		SenderID:       model.SenderID,
+		Content:        model.Content,
+		CreatedAt:      model.CreatedAt,
+		EditedAt:       model.EditedAt,
+		DeletedAt:      model.DeletedAt,
+		ReplyCount:     model.ReplyCount,
+		LastReplyAt:    model.LastReplyAt,
+	}
+	if model.ReceiverID != nil {
+		msg.ReceiverID = *model.ReceiverID
+	}
+	if model.ParentID != nil {
+		msg.ParentID = *model.ParentID
 	}
+	return msg
 }
 
 // ToDomainList converts a slice of MessageModel to domain Messages
@@ -45,6 +69,49 @@ func (m *MessageMapperImpl) ToDomainList(models []MessageModel) []domain.Message
 	return messages
 }
 
+// ToEditList converts stored previous versions of a message to domain Edits
+func (m *MessageMapperImpl) ToEditList(models []MessageEditModel) []domain.Edit {
+	edits := make([]domain.Edit, len(models))
+	for i, model := range models {
+		edits[i] = domain.Edit{
+			Content:  model.Content,
+			EditedAt: model.EditedAt,
+		}
+	}
+	return edits
+}
+
+// ToReactionList aggregates the reactions to one message by emoji. Given rows in reaction order,
+// emojis keep the order they were first used in
+func (m *MessageMapperImpl) ToReactionList(models []MessageReactionModel) []domain.Reaction {
+	var reactions []domain.Reaction
+	index := make(map[string]int)
+	for _, model := range models {
+		i, ok := index[model.Emoji]
+		if !ok {
+			i = len(reactions)
+			index[model.Emoji] = i
+			reactions = append(reactions, domain.Reaction{Emoji: model.Emoji})
+		}
+		reactions[i].UserIDs = append(reactions[i].UserIDs, model.UserID)
+		reactions[i].Count++
+	}
+	return reactions
+}
+
+// ToMentionModels converts the mentions of a message to its mention rows
+func (m *MessageMapperImpl) ToMentionModels(msg domain.Message) []MessageMentionModel {
+	models := make([]MessageMentionModel, len(msg.MentionIDs))
+	for i, userID := range msg.MentionIDs {
+		models[i] = MessageMentionModel{
+			ConversationID: msg.ConversationID,
+			MessageID:      msg.ID,
+			UserID:         userID,
+		}
+	}
+	return models
+}
+
 // ToModelList converts a slice of domain Messages to MessageModels
 func (m *MessageMapperImpl) ToModelList(messages []domain.Message) []MessageModel {
 	models := make([]MessageModel, len(messages))
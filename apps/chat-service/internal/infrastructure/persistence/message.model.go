@@ -5,15 +5,67 @@ import (
 )
 
 type MessageModel struct {
-	ID         string    `gorm:"column:id;type:uuid;primaryKey"`
-	SenderID   string    `gorm:"column:sender_id;type:text;not null"`
-	ReceiverID string    `gorm:"column:receiver_id;type:text;not null"`
-	Content    string    `gorm:"column:content;type:text;not null"`
-	CreatedAt  time.Time `gorm:"column:created_at;not null"`
-	ShardID    int       `gorm:"column:shard_id;type:integer;not null;<-:false"` // Generated column, read-only (PostgreSQL calculates automatically)
+	ID             string     `gorm:"column:id;type:uuid;primaryKey"`
+	ConversationID string     `gorm:"column:conversation_id;type:uuid;primaryKey"` // Partition key
+	SenderID       string     `gorm:"column:sender_id;type:text;not null"`
+	ReceiverID     *string    `gorm:"column:receiver_id;type:text"` // Direct conversations only
+	Content        string     `gorm:"column:content;type:text;not null"`
+	CreatedAt      time.Time  `gorm:"column:created_at;not null"`
+	EditedAt       *time.Time `gorm:"column:edited_at"`
+	DeletedAt      *time.Time `gorm:"column:deleted_at"`                  // Deleted for everyone
+	ParentID       *string    `gorm:"column:parent_message_id;type:uuid"` // Thread replies only
+	ReplyCount     int        `gorm:"column:reply_count;not null;default:0"`
+	LastReplyAt    *time.Time `gorm:"column:last_reply_at"`
 }
 
 func (MessageModel) TableName() string {
 	return "messages"
 }
 
+// MessageEditModel is a previous version of an edited message
+type MessageEditModel struct {
+	ConversationID string    `gorm:"column:conversation_id;type:uuid;primaryKey"`
+	MessageID      string    `gorm:"column:message_id;type:uuid;primaryKey"`
+	Content        string    `gorm:"column:content;type:text;not null"`
+	EditedAt       time.Time `gorm:"column:edited_at;primaryKey"`
+}
+
+func (MessageEditModel) TableName() string {
+	return "message_edits"
+}
+
+// MessageDeletionModel hides a message from one member
+type MessageDeletionModel struct {
+	ConversationID string    `gorm:"column:conversation_id;type:uuid;primaryKey"`
+	UserID         string    `gorm:"column:user_id;type:text;primaryKey"`
+	MessageID      string    `gorm:"column:message_id;type:uuid;primaryKey"`
+	DeletedAt      time.Time `gorm:"column:deleted_at;not null"`
+}
+
+func (MessageDeletionModel) TableName() string {
+	return "message_deletions"
+}
+
+// MessageReactionModel is the reaction of one member to a message with one emoji
+type MessageReactionModel struct {
+	ConversationID string    `gorm:"column:conversation_id;type:uuid;primaryKey"`
+	MessageID      string    `gorm:"column:message_id;type:uuid;primaryKey"`
+	Emoji          string    `gorm:"column:emoji;type:text;primaryKey"`
+	UserID         string    `gorm:"column:user_id;type:text;primaryKey"`
+	CreatedAt      time.Time `gorm:"column:created_at;not null"`
+}
+
+func (MessageReactionModel) TableName() string {
+	return "message_reactions"
+}
+
+// MessageMentionModel is a member mentioned by a message
+type MessageMentionModel struct {
+	ConversationID string `gorm:"column:conversation_id;type:uuid;primaryKey"`
+	MessageID      string `gorm:"column:message_id;type:uuid;primaryKey"`
+	UserID         string `gorm:"column:user_id;type:text;primaryKey"`
+}
+
+func (MessageMentionModel) TableName() string {
+	return "message_mentions"
+}
@@ -2,33 +2,460 @@ package persistence
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"golang-social-media/apps/chat-service/internal/application/messages"
 	domain "golang-social-media/apps/chat-service/internal/domain/message"
+	pkgerrors "golang-social-media/pkg/errors"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ messages.Repository = (*MessageRepository)(nil)
 
 type MessageRepository struct {
-	db     *gorm.DB
-	mapper MessageMapper
+	db                 *gorm.DB
+	mapper             MessageMapper
+	conversationMapper ConversationMapper
 }
 
-func NewMessageRepository(db *gorm.DB, mapper MessageMapper) *MessageRepository {
+func NewMessageRepository(db *gorm.DB, mapper MessageMapper, conversationMapper ConversationMapper) *MessageRepository {
 	return &MessageRepository{
-		db:     db,
-		mapper: mapper,
+		db:                 db,
+		mapper:             mapper,
+		conversationMapper: conversationMapper,
 	}
 }
 
 func (r *MessageRepository) Create(ctx context.Context, msg *domain.Message) error {
 	model := r.mapper.ToModel(*msg)
-	// Omit shard_id as it's a GENERATED column - PostgreSQL calculates it automatically
-	if err := r.db.WithContext(ctx).Omit("shard_id").Create(&model).Error; err != nil {
+	mentions := r.mapper.ToMentionModels(*msg)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			return err
+		}
+		if len(mentions) > 0 {
+			if err := tx.Create(&mentions).Error; err != nil {
+				return err
+			}
+		}
+		if model.ParentID != nil {
+			if err := countReply(tx, model); err != nil {
+				return err
+			}
+		}
+		return touchConversation(tx, model)
+	})
+	if err != nil {
 		return err
 	}
+	mentionIDs := msg.MentionIDs
 	*msg = r.mapper.ToDomain(model)
+	msg.MentionIDs = mentionIDs
 	return nil
 }
+
+// countReply adds the reply to the thread summary of its parent, in the same partition
+func countReply(tx *gorm.DB, model MessageModel) error {
+	return tx.Exec(`
+		UPDATE messages SET
+			reply_count = reply_count + 1,
+			last_reply_at = GREATEST(COALESCE(last_reply_at, ?), ?)
+		WHERE conversation_id = ? AND id = ?`,
+		model.CreatedAt, model.CreatedAt,
+		model.ConversationID, *model.ParentID,
+	).Error
+}
+
+// touchConversation points the conversation at the message, moving it to the top of every member's inbox,
+// unless it already points at a later one (a message committed out of order)
+func touchConversation(tx *gorm.DB, model MessageModel) error {
+	return tx.Exec(`
+		UPDATE conversations SET
+			last_message_id = ?,
+			last_sender_id = ?,
+			last_activity_at = ?
+		WHERE id = ?
+			AND (last_message_id IS NULL OR (last_activity_at, last_message_id) < (?, ?))`,
+		model.ID, model.SenderID, model.CreatedAt,
+		model.ConversationID,
+		model.CreatedAt, model.ID,
+	).Error
+}
+
+func (r *MessageRepository) Update(ctx context.Context, conversationID, messageID string, change func(msg *domain.Message) error) (*domain.Message, error) {
+	var msg domain.Message
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var model MessageModel
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("conversation_id = ? AND id = ?", conversationID, messageID).
+			First(&model).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return pkgerrors.NewNotFoundError(pkgerrors.CodeMessageNotFound)
+			}
+			return err
+		}
+
+		// Reactions are toggled against the ones stored, under the same lock
+		loaded := []domain.Message{r.mapper.ToDomain(model)}
+		if err := r.loadReactionsAndMentions(tx, conversationID, loaded); err != nil {
+			return err
+		}
+		msg = loaded[0]
+		if err := change(&msg); err != nil {
+			return err
+		}
+		for _, event := range msg.Events() {
+			if err := saveMessageChange(tx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// saveMessageChange writes the change a message event reports
+func saveMessageChange(tx *gorm.DB, event domain.DomainEvent) error {
+	switch event := event.(type) {
+	case domain.MessageEditedEvent:
+		if err := tx.Create(&MessageEditModel{
+			ConversationID: event.ConversationID,
+			MessageID:      event.MessageID,
+			Content:        event.PreviousContent,
+			EditedAt:       event.EditedAt,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&MessageModel{}).
+			Where("conversation_id = ? AND id = ?", event.ConversationID, event.MessageID).
+			Updates(map[string]interface{}{
+				"content":   event.Content,
+				"edited_at": event.EditedAt,
+			}).Error
+	case domain.MessageDeletedEvent:
+		// Previous versions and reactions go with the content
+		if err := tx.
+			Where("conversation_id = ? AND message_id = ?", event.ConversationID, event.MessageID).
+			Delete(&MessageEditModel{}).Error; err != nil {
+			return err
+		}
+		if err := tx.
+			Where("conversation_id = ? AND message_id = ?", event.ConversationID, event.MessageID).
+			Delete(&MessageReactionModel{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&MessageModel{}).
+			Where("conversation_id = ? AND id = ?", event.ConversationID, event.MessageID).
+			Updates(map[string]interface{}{
+				"content":    "",
+				"deleted_at": event.DeletedAt,
+			}).Error
+	case domain.MessageDeletedForMeEvent:
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&MessageDeletionModel{
+			ConversationID: event.ConversationID,
+			UserID:         event.UserID,
+			MessageID:      event.MessageID,
+			DeletedAt:      event.DeletedAt,
+		}).Error
+	case domain.MessageReactionAddedEvent:
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&MessageReactionModel{
+			ConversationID: event.ConversationID,
+			MessageID:      event.MessageID,
+			Emoji:          event.Emoji,
+			UserID:         event.UserID,
+			CreatedAt:      event.At,
+		}).Error
+	case domain.MessageReactionRemovedEvent:
+		return tx.
+			Where("conversation_id = ? AND message_id = ? AND emoji = ? AND user_id = ?",
+				event.ConversationID, event.MessageID, event.Emoji, event.UserID).
+			Delete(&MessageReactionModel{}).Error
+	default:
+		return fmt.Errorf("unexpected message event %s", event.Type())
+	}
+}
+
+func (r *MessageRepository) FindByID(ctx context.Context, conversationID, messageID string) (*domain.Message, error) {
+	var model MessageModel
+	if err := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND id = ?", conversationID, messageID).
+		Take(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, pkgerrors.NewNotFoundError(pkgerrors.CodeMessageNotFound)
+		}
+		return nil, err
+	}
+	found := []domain.Message{r.mapper.ToDomain(model)}
+	if err := r.loadReactionsAndMentions(r.db.WithContext(ctx), conversationID, found); err != nil {
+		return nil, err
+	}
+	return &found[0], nil
+}
+
+func (r *MessageRepository) ListEdits(ctx context.Context, conversationID, messageID string) ([]domain.Edit, error) {
+	var models []MessageEditModel
+	if err := r.db.WithContext(ctx).
+		Where("conversation_id = ? AND message_id = ?", conversationID, messageID).
+		Order("edited_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToEditList(models), nil
+}
+
+func (r *MessageRepository) ListConversation(ctx context.Context, conversationID, userID string, after *messages.Cursor, limit int) ([]domain.Message, error) {
+	// Equality on the partition key prunes to a single partition,
+	// read in keyset order from idx_messages_conversation_created_at_id
+	query := r.db.WithContext(ctx).Where("conversation_id = ?", conversationID)
+	return r.listPage(ctx, query, conversationID, userID, after, limit)
+}
+
+func (r *MessageRepository) ListReplies(ctx context.Context, conversationID, parentID, userID string, after *messages.Cursor, limit int) ([]domain.Message, error) {
+	// Read in keyset order from the partial idx_messages_conversation_parent_created_at_id
+	// of the conversation's partition
+	query := r.db.WithContext(ctx).Where("conversation_id = ? AND parent_message_id = ?", conversationID, parentID)
+	return r.listPage(ctx, query, conversationID, userID, after, limit)
+}
+
+// listPage reads a page of the messages query selects, newest first, with their reactions and mentions.
+// Messages the user deleted for themselves are skipped through the primary key of message_deletions
+func (r *MessageRepository) listPage(ctx context.Context, query *gorm.DB, conversationID, userID string, after *messages.Cursor, limit int) ([]domain.Message, error) {
+	query = query.Where("NOT EXISTS (SELECT 1 FROM message_deletions d WHERE d.conversation_id = messages.conversation_id AND d.user_id = ? AND d.message_id = messages.id)", userID)
+	if after != nil {
+		query = query.Where("(created_at, id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+
+	var models []MessageModel
+	if err := query.
+		Order("created_at DESC, id DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	page := r.mapper.ToDomainList(models)
+	if err := r.loadReactionsAndMentions(r.db.WithContext(ctx), conversationID, page); err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+// loadReactionsAndMentions sets the aggregated reactions and the mentions of messages of one conversation,
+// with a primary key range read of each side table
+func (r *MessageRepository) loadReactionsAndMentions(db *gorm.DB, conversationID string, msgs []domain.Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(msgs))
+	for i, msg := range msgs {
+		ids[i] = msg.ID
+	}
+
+	var reactions []MessageReactionModel
+	if err := db.
+		Where("conversation_id = ? AND message_id IN ?", conversationID, ids).
+		Order("created_at ASC, user_id ASC").
+		Find(&reactions).Error; err != nil {
+		return err
+	}
+	reactionsByMessage := make(map[string][]MessageReactionModel)
+	for _, reaction := range reactions {
+		reactionsByMessage[reaction.MessageID] = append(reactionsByMessage[reaction.MessageID], reaction)
+	}
+
+	var mentions []MessageMentionModel
+	if err := db.
+		Where("conversation_id = ? AND message_id IN ?", conversationID, ids).
+		Find(&mentions).Error; err != nil {
+		return err
+	}
+	mentionsByMessage := make(map[string][]string)
+	for _, mention := range mentions {
+		mentionsByMessage[mention.MessageID] = append(mentionsByMessage[mention.MessageID], mention.UserID)
+	}
+
+	for i := range msgs {
+		msgs[i].Reactions = r.mapper.ToReactionList(reactionsByMessage[msgs[i].ID])
+		msgs[i].MentionIDs = mentionsByMessage[msgs[i].ID]
+	}
+	return nil
+}
+
+func (r *MessageRepository) ListConversations(ctx context.Context, userID string, after *messages.Cursor, limit int) ([]messages.Conversation, error) {
+	query := r.db.WithContext(ctx).
+		Model(&ConversationModel{}).
+		Select("conversations.*").
+		Joins("JOIN conversation_members ON conversation_members.conversation_id = conversations.id").
+		Where("conversation_members.user_id = ?", userID)
+	if after != nil {
+		query = query.Where("(conversations.last_activity_at, conversations.id) < (?, ?)", after.CreatedAt, after.ID)
+	}
+	var models []ConversationModel
+	if err := query.
+		Order("conversations.last_activity_at DESC, conversations.id DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return []messages.Conversation{}, nil
+	}
+
+	ids := make([]string, len(models))
+	for i, model := range models {
+		ids[i] = model.ID
+	}
+	var members []ConversationMemberModel
+	if err := r.db.WithContext(ctx).
+		Where("conversation_id IN ?", ids).
+		Order("joined_at ASC, user_id ASC").
+		Find(&members).Error; err != nil {
+		return nil, err
+	}
+	membersByConversation := make(map[string][]ConversationMemberModel, len(models))
+	for _, member := range members {
+		membersByConversation[member.ConversationID] = append(membersByConversation[member.ConversationID], member)
+	}
+
+	// Each (id, conversation_id) key names the partition of the last message
+	var keys [][]interface{}
+	for _, model := range models {
+		if model.LastMessageID != nil {
+			keys = append(keys, []interface{}{*model.LastMessageID, model.ID})
+		}
+	}
+	lastMessages := make(map[string]MessageModel, len(keys))
+	if len(keys) > 0 {
+		var messageModels []MessageModel
+		if err := r.db.WithContext(ctx).
+			Where("(id, conversation_id) IN ?", keys).
+			Find(&messageModels).Error; err != nil {
+			return nil, err
+		}
+		for _, model := range messageModels {
+			lastMessages[model.ID] = model
+		}
+	}
+
+	conversations := make([]messages.Conversation, len(models))
+	for i, model := range models {
+		entry := messages.Conversation{
+			Conversation:   r.conversationMapper.ToDomain(model, membersByConversation[model.ID]),
+			LastActivityAt: model.LastActivityAt,
+		}
+		if model.LastMessageID != nil {
+			lastMessage, ok := lastMessages[*model.LastMessageID]
+			if !ok {
+				// Keep the entry in the inbox even if its message cannot be read, without content
+				lastMessage = MessageModel{
+					ID:             *model.LastMessageID,
+					ConversationID: model.ID,
+					SenderID:       *model.LastSenderID,
+					CreatedAt:      model.LastActivityAt,
+				}
+			}
+			msg := r.mapper.ToDomain(lastMessage)
+			entry.LastMessage = &msg
+		}
+		conversations[i] = entry
+	}
+	return conversations, nil
+}
+
+func (r *MessageRepository) UnreadCounts(ctx context.Context, userID string, conversationIDs []string, limit int) ([]messages.UnreadCount, error) {
+	// Conversations whose last message the watermark covers are skipped before touching messages.
+	// For the others, the lateral count is a keyset range read on idx_messages_conversation_created_at_id
+	// of a single partition, stopped at limit: the cost does not grow with the backlog.
+	// Without a watermark the range starts at joined_at: the nil UUID is below every message ID
+	query := `
+		SELECT cm.conversation_id, unread.count
+		FROM conversation_members cm
+		JOIN conversations c ON c.id = cm.conversation_id
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS count FROM (
+				SELECT 1 FROM messages m
+				WHERE m.conversation_id = cm.conversation_id
+					AND (m.created_at, m.id) > (
+						COALESCE(cm.read_message_created_at, cm.joined_at),
+						COALESCE(cm.read_message_id, '00000000-0000-0000-0000-000000000000'::uuid)
+					)
+					AND m.sender_id <> cm.user_id
+					AND m.deleted_at IS NULL
+					AND NOT EXISTS (
+						SELECT 1 FROM message_deletions d
+						WHERE d.conversation_id = m.conversation_id AND d.user_id = cm.user_id AND d.message_id = m.id
+					)
+				LIMIT @limit
+			) capped
+		) unread
+		WHERE cm.user_id = @user_id
+			AND c.last_message_id IS NOT NULL
+			AND (cm.read_message_id IS NULL OR (c.last_activity_at, c.last_message_id) > (cm.read_message_created_at, cm.read_message_id))
+			AND unread.count > 0`
+	args := map[string]interface{}{"user_id": userID, "limit": limit}
+	if len(conversationIDs) > 0 {
+		query += ` AND cm.conversation_id IN @conversation_ids`
+		args["conversation_ids"] = conversationIDs
+	}
+
+	var rows []struct {
+		ConversationID string
+		Count          int
+	}
+	if err := r.db.WithContext(ctx).Raw(query+` ORDER BY c.last_activity_at DESC`, args).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	counts := make([]messages.UnreadCount, len(rows))
+	for i, row := range rows {
+		counts[i] = messages.UnreadCount{ConversationID: row.ConversationID, Count: row.Count}
+	}
+	return counts, nil
+}
+
+func (r *MessageRepository) ListByParticipant(ctx context.Context, userID string) ([]domain.Message, error) {
+	var models []MessageModel
+	if err := r.db.WithContext(ctx).
+		Where("sender_id = ? OR conversation_id IN (?)", userID,
+			r.db.Model(&ConversationMemberModel{}).Select("conversation_id").Where("user_id = ?", userID)).
+		Order("created_at ASC").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return r.mapper.ToDomainList(models), nil
+}
+
+func (r *MessageRepository) RedactBySender(ctx context.Context, senderID string) (int64, error) {
+	var redacted int64
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Only the sender edits, so every previous version of their messages is their content too
+		if err := tx.
+			Where("(message_id, conversation_id) IN (?)",
+				tx.Model(&MessageModel{}).Select("id, conversation_id").Where("sender_id = ?", senderID)).
+			Delete(&MessageEditModel{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.
+			Model(&MessageModel{}).
+			Where("sender_id = ? AND content <> ''", senderID).
+			Update("content", "")
+		if result.Error != nil {
+			return result.Error
+		}
+		redacted = result.RowsAffected
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return redacted, nil
+}
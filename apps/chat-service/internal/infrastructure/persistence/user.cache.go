@@ -1,4 +1,4 @@
-package cache
+package persistence
 
 import (
 	"context"
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"golang-social-media/apps/chat-service/internal/infrastructure/persistence"
 	"golang-social-media/pkg/cache"
 )
 
@@ -25,13 +24,13 @@ func NewUserCache(cache cache.Cache) *UserCache {
 }
 
 // GetUser retrieves a user from cache
-func (c *UserCache) GetUser(ctx context.Context, id string) (*persistence.UserModel, error) {
+func (c *UserCache) GetUser(ctx context.Context, id string) (*UserModel, error) {
 	key := c.userKey(id)
 	data, err := c.cache.Get(ctx, key)
 	if err != nil {
 		return nil, err
 	}
-	var user persistence.UserModel
+	var user UserModel
 	if err := json.Unmarshal(data, &user); err != nil {
 		return nil, err
 	}
@@ -39,7 +38,7 @@ func (c *UserCache) GetUser(ctx context.Context, id string) (*persistence.UserMo
 }
 
 // SetUser stores a user in cache
-func (c *UserCache) SetUser(ctx context.Context, user *persistence.UserModel) error {
+func (c *UserCache) SetUser(ctx context.Context, user *UserModel) error {
 	key := c.userKey(user.ID)
 	data, err := json.Marshal(user)
 	if err != nil {
@@ -2,18 +2,19 @@ package persistence
 
 import (
 	"context"
+	"strings"
+	"time"
 
-	chatcache "golang-social-media/apps/chat-service/internal/infrastructure/cache"
 	"golang-social-media/pkg/logger"
 	"gorm.io/gorm"
 )
 
 type UserRepository struct {
 	db    *gorm.DB
-	cache *chatcache.UserCache
+	cache *UserCache
 }
 
-func NewUserRepository(db *gorm.DB, userCache *chatcache.UserCache) *UserRepository {
+func NewUserRepository(db *gorm.DB, userCache *UserCache) *UserRepository {
 	return &UserRepository{
 		db:    db,
 		cache: userCache,
@@ -41,6 +42,58 @@ func (r *UserRepository) Upsert(ctx context.Context, user UserModel) error {
 	return nil
 }
 
+// UpdateName changes the name of a replicated user and drops the cached copy.
+// Returns gorm.ErrRecordNotFound if the user has not been replicated yet
+func (r *UserRepository) UpdateName(ctx context.Context, id, name string, updatedAt time.Time) error {
+	result := r.db.WithContext(ctx).
+		Model(&UserModel{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"name":       name,
+			"updated_at": updatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+
+	// Invalidate cache
+	if r.cache != nil {
+		if err := r.cache.DeleteUser(ctx, id); err != nil {
+			logger.Component("chat.repository.user").
+				Warn().
+				Err(err).
+				Str("user_id", id).
+				Msg("failed to invalidate user cache")
+		}
+	}
+
+	return nil
+}
+
+// Delete removes a replicated user and drops the cached copy; it returns the number of rows deleted
+func (r *UserRepository) Delete(ctx context.Context, id string) (int64, error) {
+	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&UserModel{})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	// Invalidate cache
+	if r.cache != nil {
+		if err := r.cache.DeleteUser(ctx, id); err != nil {
+			logger.Component("chat.repository.user").
+				Warn().
+				Err(err).
+				Str("user_id", id).
+				Msg("failed to invalidate user cache")
+		}
+	}
+
+	return result.RowsAffected, nil
+}
+
 // FindByID finds a user by ID (with cache)
 func (r *UserRepository) FindByID(ctx context.Context, id string) (*UserModel, error) {
 	// Try cache first
@@ -96,3 +149,28 @@ func (r *UserRepository) Exists(ctx context.Context, id string) (bool, error) {
 	return count > 0, nil
 }
 
+
+// ResolveMentions returns the IDs of the candidate users that @handles name: a handle matches a user ID exactly,
+// or a name ignoring case and spaces ("@janedoe" for "Jane Doe"). Candidates are the members of the
+// conversation, so a lookup reads at most their primary keys
+func (r *UserRepository) ResolveMentions(ctx context.Context, handles, candidateIDs []string) ([]string, error) {
+	if len(handles) == 0 || len(candidateIDs) == 0 {
+		return nil, nil
+	}
+	names := make([]string, len(handles))
+	for i, handle := range handles {
+		names[i] = strings.ToLower(handle)
+	}
+
+	var ids []string
+	err := r.db.WithContext(ctx).
+		Model(&UserModel{}).
+		Where("id IN ?", candidateIDs).
+		Where("id IN ? OR LOWER(REPLACE(name, ' ', '')) IN ?", handles, names).
+		Pluck("id", &ids).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
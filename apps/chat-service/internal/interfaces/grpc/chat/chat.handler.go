@@ -6,21 +6,59 @@ import (
 
 	bootstrap "golang-social-media/apps/chat-service/internal/infrastructure/bootstrap"
 	commandcontracts "golang-social-media/apps/chat-service/internal/application/command/contracts"
+	querycontracts "golang-social-media/apps/chat-service/internal/application/query/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
 	"golang-social-media/apps/chat-service/internal/interfaces/grpc/mappers"
 	"golang-social-media/pkg/logger"
 	chatv1 "golang-social-media/pkg/gen/chat/v1"
 )
 
 type Handler struct {
-	createMessageCmd commandcontracts.CreateMessageCommand
-	dtoMapper        mappers.MessageDTOMapper
+	createMessageCmd            commandcontracts.CreateMessageCommand
+	listConversationMessagesQry querycontracts.ListConversationMessagesQuery
+	listConversationsQry        querycontracts.ListConversationsQuery
+	getConversationQry          querycontracts.GetConversationQuery
+	createGroupConversationCmd  commandcontracts.CreateGroupConversationCommand
+	joinConversationCmd         commandcontracts.JoinConversationCommand
+	leaveConversationCmd        commandcontracts.LeaveConversationCommand
+	kickConversationMemberCmd   commandcontracts.KickConversationMemberCommand
+	updateConversationCmd       commandcontracts.UpdateConversationCommand
+	changeMemberRoleCmd         commandcontracts.ChangeConversationMemberRoleCommand
+	editMessageCmd              commandcontracts.EditMessageCommand
+	deleteMessageCmd            commandcontracts.DeleteMessageCommand
+	listMessageEditsQry         querycontracts.ListMessageEditsQuery
+	markReadCmd                 commandcontracts.MarkConversationReadCommand
+	markDeliveredCmd            commandcontracts.MarkConversationDeliveredCommand
+	getUnreadCountsQry          querycontracts.GetUnreadCountsQuery
+	toggleReactionCmd           commandcontracts.ToggleReactionCommand
+	listMessageRepliesQry       querycontracts.ListMessageRepliesQuery
+	dtoMapper                   mappers.MessageDTOMapper
+	conversationMapper          mappers.ConversationDTOMapper
 	chatv1.UnimplementedChatServiceServer
 }
 
-func NewHandler(deps *bootstrap.Dependencies, dtoMapper mappers.MessageDTOMapper) *Handler {
+func NewHandler(deps *bootstrap.Dependencies, dtoMapper mappers.MessageDTOMapper, conversationMapper mappers.ConversationDTOMapper) *Handler {
 	return &Handler{
-		createMessageCmd: deps.CreateMessageCmd,
-		dtoMapper:        dtoMapper,
+		createMessageCmd:            deps.CreateMessageCmd,
+		listConversationMessagesQry: deps.ListConversationMessagesQuery,
+		listConversationsQry:        deps.ListConversationsQuery,
+		getConversationQry:          deps.GetConversationQuery,
+		createGroupConversationCmd:  deps.CreateGroupConversationCmd,
+		joinConversationCmd:         deps.JoinConversationCmd,
+		leaveConversationCmd:        deps.LeaveConversationCmd,
+		kickConversationMemberCmd:   deps.KickConversationMemberCmd,
+		updateConversationCmd:       deps.UpdateConversationCmd,
+		changeMemberRoleCmd:         deps.ChangeConversationMemberRoleCmd,
+		editMessageCmd:              deps.EditMessageCmd,
+		deleteMessageCmd:            deps.DeleteMessageCmd,
+		listMessageEditsQry:         deps.ListMessageEditsQuery,
+		markReadCmd:                 deps.MarkConversationReadCmd,
+		markDeliveredCmd:            deps.MarkConversationDeliveredCmd,
+		getUnreadCountsQry:          deps.GetUnreadCountsQuery,
+		toggleReactionCmd:           deps.ToggleReactionCmd,
+		listMessageRepliesQry:       deps.ListMessageRepliesQuery,
+		dtoMapper:                   dtoMapper,
+		conversationMapper:          conversationMapper,
 	}
 }
 
@@ -30,9 +68,11 @@ func (h *Handler) CreateMessage(ctx context.Context, req *chatv1.CreateMessageRe
 	// Prepare request using mapper
 	requestStart := time.Now()
 	cmdReq := commandcontracts.CreateMessageCommandRequest{
-		SenderID:   req.GetSenderId(),
-		ReceiverID: req.GetReceiverId(),
-		Content:    req.GetContent(),
+		ConversationID:  req.GetConversationId(),
+		SenderID:        req.GetSenderId(),
+		ReceiverID:      req.GetReceiverId(),
+		Content:         req.GetContent(),
+		ParentMessageID: req.GetParentMessageId(),
 	}
 	requestDuration := time.Since(requestStart)
 
@@ -46,6 +86,7 @@ func (h *Handler) CreateMessage(ctx context.Context, req *chatv1.CreateMessageRe
 		logger.Component("chat.grpc.create_message").
 			Error().
 			Err(err).
+			Str("conversation_id", req.GetConversationId()).
 			Str("sender_id", req.GetSenderId()).
 			Str("receiver_id", req.GetReceiverId()).
 			Dur("request_prep_ms", requestDuration).
@@ -73,3 +114,343 @@ func (h *Handler) CreateMessage(ctx context.Context, req *chatv1.CreateMessageRe
 
 	return resp, nil
 }
+
+func (h *Handler) ListConversationMessages(ctx context.Context, req *chatv1.ListConversationMessagesRequest) (*chatv1.ListConversationMessagesResponse, error) {
+	resp, err := h.listConversationMessagesQry.Execute(ctx, querycontracts.ListConversationMessagesQueryRequest{
+		UserID:         req.GetUserId(),
+		ConversationID: req.GetConversationId(),
+		PeerID:         req.GetPeerId(),
+		Cursor:         req.GetCursor(),
+		Limit:          int(req.GetLimit()),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.list_conversation_messages").
+			Error().
+			Err(err).
+			Str("user_id", req.GetUserId()).
+			Str("conversation_id", req.GetConversationId()).
+			Str("peer_id", req.GetPeerId()).
+			Msg("failed to list conversation messages")
+		return nil, err
+	}
+
+	return h.dtoMapper.ToListConversationMessagesResponse(resp), nil
+}
+
+func (h *Handler) ListConversations(ctx context.Context, req *chatv1.ListConversationsRequest) (*chatv1.ListConversationsResponse, error) {
+	resp, err := h.listConversationsQry.Execute(ctx, querycontracts.ListConversationsQueryRequest{
+		UserID: req.GetUserId(),
+		Cursor: req.GetCursor(),
+		Limit:  int(req.GetLimit()),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.list_conversations").
+			Error().
+			Err(err).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to list conversations")
+		return nil, err
+	}
+
+	return h.dtoMapper.ToListConversationsResponse(req.GetUserId(), resp), nil
+}
+
+func (h *Handler) CreateGroupConversation(ctx context.Context, req *chatv1.CreateGroupConversationRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.createGroupConversationCmd.Execute(ctx, commandcontracts.CreateGroupConversationCommandRequest{
+		CreatorID: req.GetCreatorId(),
+		Title:     req.GetTitle(),
+		AvatarURL: req.GetAvatarUrl(),
+		MemberIDs: req.GetMemberIds(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.create_group_conversation").
+			Error().
+			Err(err).
+			Str("creator_id", req.GetCreatorId()).
+			Msg("failed to create group conversation")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetCreatorId()), nil
+}
+
+func (h *Handler) GetConversation(ctx context.Context, req *chatv1.GetConversationRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.getConversationQry.Execute(ctx, querycontracts.GetConversationQueryRequest{
+		ConversationID: req.GetConversationId(),
+		UserID:         req.GetUserId(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.get_conversation").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to get conversation")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetUserId()), nil
+}
+
+func (h *Handler) JoinConversation(ctx context.Context, req *chatv1.JoinConversationRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.joinConversationCmd.Execute(ctx, commandcontracts.JoinConversationCommandRequest{
+		ConversationID: req.GetConversationId(),
+		ActorID:        req.GetActorId(),
+		UserID:         req.GetUserId(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.join_conversation").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("actor_id", req.GetActorId()).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to join conversation")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetActorId()), nil
+}
+
+func (h *Handler) LeaveConversation(ctx context.Context, req *chatv1.LeaveConversationRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.leaveConversationCmd.Execute(ctx, commandcontracts.LeaveConversationCommandRequest{
+		ConversationID: req.GetConversationId(),
+		UserID:         req.GetUserId(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.leave_conversation").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to leave conversation")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetUserId()), nil
+}
+
+func (h *Handler) KickConversationMember(ctx context.Context, req *chatv1.KickConversationMemberRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.kickConversationMemberCmd.Execute(ctx, commandcontracts.KickConversationMemberCommandRequest{
+		ConversationID: req.GetConversationId(),
+		ActorID:        req.GetActorId(),
+		UserID:         req.GetUserId(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.kick_conversation_member").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("actor_id", req.GetActorId()).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to kick conversation member")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetActorId()), nil
+}
+
+func (h *Handler) UpdateConversation(ctx context.Context, req *chatv1.UpdateConversationRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.updateConversationCmd.Execute(ctx, commandcontracts.UpdateConversationCommandRequest{
+		ConversationID: req.GetConversationId(),
+		ActorID:        req.GetActorId(),
+		Title:          req.GetTitle(),
+		AvatarURL:      req.GetAvatarUrl(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.update_conversation").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("actor_id", req.GetActorId()).
+			Msg("failed to update conversation")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetActorId()), nil
+}
+
+func (h *Handler) ChangeConversationMemberRole(ctx context.Context, req *chatv1.ChangeConversationMemberRoleRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.changeMemberRoleCmd.Execute(ctx, commandcontracts.ChangeConversationMemberRoleCommandRequest{
+		ConversationID: req.GetConversationId(),
+		ActorID:        req.GetActorId(),
+		UserID:         req.GetUserId(),
+		Role:           conversation.Role(req.GetRole()),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.change_conversation_member_role").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("actor_id", req.GetActorId()).
+			Str("user_id", req.GetUserId()).
+			Str("role", req.GetRole()).
+			Msg("failed to change conversation member role")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetActorId()), nil
+}
+
+func (h *Handler) EditMessage(ctx context.Context, req *chatv1.EditMessageRequest) (*chatv1.MessageResponse, error) {
+	msg, err := h.editMessageCmd.Execute(ctx, commandcontracts.EditMessageCommandRequest{
+		ConversationID: req.GetConversationId(),
+		MessageID:      req.GetMessageId(),
+		ActorID:        req.GetActorId(),
+		Content:        req.GetContent(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.edit_message").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("message_id", req.GetMessageId()).
+			Str("actor_id", req.GetActorId()).
+			Msg("failed to edit message")
+		return nil, err
+	}
+
+	return h.dtoMapper.ToMessageResponse(msg), nil
+}
+
+func (h *Handler) DeleteMessage(ctx context.Context, req *chatv1.DeleteMessageRequest) (*chatv1.MessageResponse, error) {
+	msg, err := h.deleteMessageCmd.Execute(ctx, commandcontracts.DeleteMessageCommandRequest{
+		ConversationID: req.GetConversationId(),
+		MessageID:      req.GetMessageId(),
+		ActorID:        req.GetActorId(),
+		ForEveryone:    req.GetForEveryone(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.delete_message").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("message_id", req.GetMessageId()).
+			Str("actor_id", req.GetActorId()).
+			Bool("for_everyone", req.GetForEveryone()).
+			Msg("failed to delete message")
+		return nil, err
+	}
+
+	return h.dtoMapper.ToMessageResponse(msg), nil
+}
+
+func (h *Handler) ListMessageEdits(ctx context.Context, req *chatv1.ListMessageEditsRequest) (*chatv1.ListMessageEditsResponse, error) {
+	edits, err := h.listMessageEditsQry.Execute(ctx, querycontracts.ListMessageEditsQueryRequest{
+		ConversationID: req.GetConversationId(),
+		MessageID:      req.GetMessageId(),
+		UserID:         req.GetUserId(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.list_message_edits").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("message_id", req.GetMessageId()).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to list message edits")
+		return nil, err
+	}
+
+	return h.dtoMapper.ToListMessageEditsResponse(edits), nil
+}
+
+func (h *Handler) MarkConversationRead(ctx context.Context, req *chatv1.MarkConversationReadRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.markReadCmd.Execute(ctx, commandcontracts.MarkConversationReadCommandRequest{
+		ConversationID: req.GetConversationId(),
+		UserID:         req.GetUserId(),
+		MessageID:      req.GetMessageId(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.mark_conversation_read").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("user_id", req.GetUserId()).
+			Str("message_id", req.GetMessageId()).
+			Msg("failed to mark conversation read")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetUserId()), nil
+}
+
+func (h *Handler) MarkConversationDelivered(ctx context.Context, req *chatv1.MarkConversationDeliveredRequest) (*chatv1.ConversationResponse, error) {
+	conv, err := h.markDeliveredCmd.Execute(ctx, commandcontracts.MarkConversationDeliveredCommandRequest{
+		ConversationID: req.GetConversationId(),
+		UserID:         req.GetUserId(),
+		MessageID:      req.GetMessageId(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.mark_conversation_delivered").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("user_id", req.GetUserId()).
+			Str("message_id", req.GetMessageId()).
+			Msg("failed to mark conversation delivered")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToConversationResponse(conv, req.GetUserId()), nil
+}
+
+func (h *Handler) GetUnreadCounts(ctx context.Context, req *chatv1.GetUnreadCountsRequest) (*chatv1.GetUnreadCountsResponse, error) {
+	resp, err := h.getUnreadCountsQry.Execute(ctx, querycontracts.GetUnreadCountsQueryRequest{
+		UserID:          req.GetUserId(),
+		ConversationIDs: req.GetConversationIds(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.get_unread_counts").
+			Error().
+			Err(err).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to get unread counts")
+		return nil, err
+	}
+
+	return h.conversationMapper.ToGetUnreadCountsResponse(resp), nil
+}
+
+func (h *Handler) ToggleReaction(ctx context.Context, req *chatv1.ToggleReactionRequest) (*chatv1.MessageResponse, error) {
+	msg, err := h.toggleReactionCmd.Execute(ctx, commandcontracts.ToggleReactionCommandRequest{
+		ConversationID: req.GetConversationId(),
+		MessageID:      req.GetMessageId(),
+		ActorID:        req.GetUserId(),
+		Emoji:          req.GetEmoji(),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.toggle_reaction").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("message_id", req.GetMessageId()).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to toggle reaction")
+		return nil, err
+	}
+
+	return h.dtoMapper.ToMessageResponse(msg), nil
+}
+
+func (h *Handler) ListMessageReplies(ctx context.Context, req *chatv1.ListMessageRepliesRequest) (*chatv1.ListMessageRepliesResponse, error) {
+	resp, err := h.listMessageRepliesQry.Execute(ctx, querycontracts.ListMessageRepliesQueryRequest{
+		UserID:         req.GetUserId(),
+		ConversationID: req.GetConversationId(),
+		MessageID:      req.GetMessageId(),
+		Cursor:         req.GetCursor(),
+		Limit:          int(req.GetLimit()),
+	})
+	if err != nil {
+		logger.ComponentCtx(ctx, "chat.grpc.list_message_replies").
+			Error().
+			Err(err).
+			Str("conversation_id", req.GetConversationId()).
+			Str("message_id", req.GetMessageId()).
+			Str("user_id", req.GetUserId()).
+			Msg("failed to list message replies")
+		return nil, err
+	}
+
+	return h.dtoMapper.ToListMessageRepliesResponse(resp), nil
+}
@@ -0,0 +1,15 @@
+package mappers
+
+import (
+	querycontracts "golang-social-media/apps/chat-service/internal/application/query/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	chatv1 "golang-social-media/pkg/gen/chat/v1"
+)
+
+// ConversationDTOMapper defines the contract for mapping domain Conversations to gRPC DTOs
+type ConversationDTOMapper interface {
+	// ToConversation converts a conversation as seen by userID, who is the one its peer_id is relative to
+	ToConversation(conv conversation.Conversation, userID string) *chatv1.Conversation
+	ToConversationResponse(conv conversation.Conversation, userID string) *chatv1.ConversationResponse
+	ToGetUnreadCountsResponse(resp querycontracts.GetUnreadCountsQueryResponse) *chatv1.GetUnreadCountsResponse
+}
@@ -0,0 +1,76 @@
+package mappers
+
+import (
+	querycontracts "golang-social-media/apps/chat-service/internal/application/query/contracts"
+	"golang-social-media/apps/chat-service/internal/domain/conversation"
+	chatv1 "golang-social-media/pkg/gen/chat/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ConversationDTOMapperImpl implements ConversationDTOMapper interface
+type ConversationDTOMapperImpl struct{}
+
+var _ ConversationDTOMapper = (*ConversationDTOMapperImpl)(nil)
+
+// NewConversationDTOMapper creates a new ConversationDTOMapperImpl
+func NewConversationDTOMapper() ConversationDTOMapper {
+	return &ConversationDTOMapperImpl{}
+}
+
+// ToConversation converts domain Conversation to gRPC Conversation, without its last message
+func (m *ConversationDTOMapperImpl) ToConversation(conv conversation.Conversation, userID string) *chatv1.Conversation {
+	members := make([]*chatv1.ConversationMember, len(conv.Members))
+	for i, member := range conv.Members {
+		members[i] = &chatv1.ConversationMember{
+			UserId:    member.UserID,
+			Role:      string(member.Role),
+			JoinedAt:  timestamppb.New(member.JoinedAt),
+			Delivered: toReceipt(member.Delivered),
+			Read:      toReceipt(member.Read),
+		}
+	}
+
+	return &chatv1.Conversation{
+		Id:        conv.ID,
+		Kind:      string(conv.Kind),
+		PeerId:    conv.Peer(userID),
+		Title:     conv.Title,
+		AvatarUrl: conv.AvatarURL,
+		Members:   members,
+		CreatedBy: conv.CreatedBy,
+		CreatedAt: timestamppb.New(conv.CreatedAt),
+	}
+}
+
+// ToConversationResponse wraps a converted conversation in the response of the group RPCs
+func (m *ConversationDTOMapperImpl) ToConversationResponse(conv conversation.Conversation, userID string) *chatv1.ConversationResponse {
+	return &chatv1.ConversationResponse{
+		Conversation: m.ToConversation(conv, userID),
+	}
+}
+
+// ToGetUnreadCountsResponse converts the unread counts of a user to their gRPC response
+func (m *ConversationDTOMapperImpl) ToGetUnreadCountsResponse(resp querycontracts.GetUnreadCountsQueryResponse) *chatv1.GetUnreadCountsResponse {
+	counts := make([]*chatv1.UnreadCount, len(resp.Counts))
+	for i, count := range resp.Counts {
+		counts[i] = &chatv1.UnreadCount{
+			ConversationId: count.ConversationID,
+			Count:          int32(count.Count),
+		}
+	}
+	return &chatv1.GetUnreadCountsResponse{
+		Counts: counts,
+		Total:  int32(resp.Total),
+	}
+}
+
+func toReceipt(watermark *conversation.Watermark) *chatv1.Receipt {
+	if watermark == nil {
+		return nil
+	}
+	return &chatv1.Receipt{
+		MessageId:        watermark.MessageID,
+		MessageCreatedAt: timestamppb.New(watermark.MessageCreatedAt),
+		At:               timestamppb.New(watermark.At),
+	}
+}
@@ -1,6 +1,7 @@
 package mappers
 
 import (
+	querycontracts "golang-social-media/apps/chat-service/internal/application/query/contracts"
 	domain "golang-social-media/apps/chat-service/internal/domain/message"
 	chatv1 "golang-social-media/pkg/gen/chat/v1"
 )
@@ -11,6 +12,11 @@ type MessageDTOMapper interface {
 	ToCreateMessageResponse(msg domain.Message) *chatv1.CreateMessageResponse
 	ToMessage(msg domain.Message) *chatv1.Message
 	ToMessageList(messages []domain.Message) []*chatv1.Message
+	ToMessageResponse(msg domain.Message) *chatv1.MessageResponse
+	ToListMessageEditsResponse(edits []domain.Edit) *chatv1.ListMessageEditsResponse
+	ToListConversationMessagesResponse(resp querycontracts.ListConversationMessagesQueryResponse) *chatv1.ListConversationMessagesResponse
+	ToListMessageRepliesResponse(resp querycontracts.ListMessageRepliesQueryResponse) *chatv1.ListMessageRepliesResponse
+	ToListConversationsResponse(userID string, resp querycontracts.ListConversationsQueryResponse) *chatv1.ListConversationsResponse
 }
 
 
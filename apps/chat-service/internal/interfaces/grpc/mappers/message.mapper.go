@@ -1,28 +1,33 @@
 package mappers
 
 import (
+	querycontracts "golang-social-media/apps/chat-service/internal/application/query/contracts"
 	domain "golang-social-media/apps/chat-service/internal/domain/message"
 	chatv1 "golang-social-media/pkg/gen/chat/v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // MessageDTOMapperImpl implements MessageDTOMapper interface
-type MessageDTOMapperImpl struct{}
+type MessageDTOMapperImpl struct {
+	conversations ConversationDTOMapper
+}
 
 var _ MessageDTOMapper = (*MessageDTOMapperImpl)(nil)
 
 // NewMessageDTOMapper creates a new MessageDTOMapperImpl
-func NewMessageDTOMapper() MessageDTOMapper {
-	return &MessageDTOMapperImpl{}
+func NewMessageDTOMapper(conversations ConversationDTOMapper) MessageDTOMapper {
+	return &MessageDTOMapperImpl{conversations: conversations}
 }
 
 // ToCreateMessageRequest converts gRPC CreateMessageRequest to domain Message
 // Note: ID and CreatedAt will be set by application layer
 func (m *MessageDTOMapperImpl) FromCreateMessageRequest(req *chatv1.CreateMessageRequest) domain.Message {
 	return domain.Message{
-		SenderID:   req.GetSenderId(),
-		ReceiverID: req.GetReceiverId(),
-		Content:    req.GetContent(),
+		ConversationID: req.GetConversationId(),
+		SenderID:       req.GetSenderId(),
+		ReceiverID:     req.GetReceiverId(),
+		Content:        req.GetContent(),
+		ParentID:       req.GetParentMessageId(),
 		// ID and CreatedAt will be set by application layer
 	}
 }
@@ -30,24 +35,60 @@ func (m *MessageDTOMapperImpl) FromCreateMessageRequest(req *chatv1.CreateMessag
 // ToCreateMessageResponse converts domain Message to gRPC CreateMessageResponse
 func (m *MessageDTOMapperImpl) ToCreateMessageResponse(msg domain.Message) *chatv1.CreateMessageResponse {
 	return &chatv1.CreateMessageResponse{
-		Message: &chatv1.Message{
-			Id:         msg.ID,
-			SenderId:   msg.SenderID,
-			ReceiverId: msg.ReceiverID,
-			Content:    msg.Content,
-			CreatedAt:  timestamppb.New(msg.CreatedAt),
-		},
+		Message: m.ToMessage(msg),
 	}
 }
 
 // ToMessage converts domain Message to gRPC Message
 func (m *MessageDTOMapperImpl) ToMessage(msg domain.Message) *chatv1.Message {
-	return &chatv1.Message{
-		Id:         msg.ID,
-		SenderId:   msg.SenderID,
-		ReceiverId: msg.ReceiverID,
-		Content:    msg.Content,
-		CreatedAt:  timestamppb.New(msg.CreatedAt),
+	result := &chatv1.Message{
+		Id:              msg.ID,
+		ConversationId:  msg.ConversationID,
+		SenderId:        msg.SenderID,
+		ReceiverId:      msg.ReceiverID,
+		Content:         msg.Content,
+		CreatedAt:       timestamppb.New(msg.CreatedAt),
+		ParentMessageId: msg.ParentID,
+		ReplyCount:      int32(msg.ReplyCount),
+		MentionIds:      msg.MentionIDs,
+	}
+	if msg.EditedAt != nil {
+		result.EditedAt = timestamppb.New(*msg.EditedAt)
+	}
+	if msg.DeletedAt != nil {
+		result.DeletedAt = timestamppb.New(*msg.DeletedAt)
+	}
+	if msg.LastReplyAt != nil {
+		result.LastReplyAt = timestamppb.New(*msg.LastReplyAt)
+	}
+	for _, reaction := range msg.Reactions {
+		result.Reactions = append(result.Reactions, &chatv1.Reaction{
+			Emoji:   reaction.Emoji,
+			Count:   int32(reaction.Count),
+			UserIds: reaction.UserIDs,
+		})
+	}
+	return result
+}
+
+// ToMessageResponse wraps a converted message in the response of the edit and delete RPCs
+func (m *MessageDTOMapperImpl) ToMessageResponse(msg domain.Message) *chatv1.MessageResponse {
+	return &chatv1.MessageResponse{
+		Message: m.ToMessage(msg),
+	}
+}
+
+// ToListMessageEditsResponse converts the previous versions of a message to its gRPC response
+func (m *MessageDTOMapperImpl) ToListMessageEditsResponse(edits []domain.Edit) *chatv1.ListMessageEditsResponse {
+	result := make([]*chatv1.MessageEdit, len(edits))
+	for i, edit := range edits {
+		result[i] = &chatv1.MessageEdit{
+			Content:  edit.Content,
+			EditedAt: timestamppb.New(edit.EditedAt),
+		}
+	}
+	return &chatv1.ListMessageEditsResponse{
+		Edits: result,
 	}
 }
 
@@ -59,3 +100,36 @@ func (m *MessageDTOMapperImpl) ToMessageList(messages []domain.Message) []*chatv
 	}
 	return result
 }
+
+// ToListConversationMessagesResponse converts a page of conversation history to its gRPC response
+func (m *MessageDTOMapperImpl) ToListConversationMessagesResponse(resp querycontracts.ListConversationMessagesQueryResponse) *chatv1.ListConversationMessagesResponse {
+	return &chatv1.ListConversationMessagesResponse{
+		Messages:   m.ToMessageList(resp.Messages),
+		NextCursor: resp.NextCursor,
+	}
+}
+
+// ToListMessageRepliesResponse converts a thread page to its gRPC response
+func (m *MessageDTOMapperImpl) ToListMessageRepliesResponse(resp querycontracts.ListMessageRepliesQueryResponse) *chatv1.ListMessageRepliesResponse {
+	return &chatv1.ListMessageRepliesResponse{
+		Parent:     m.ToMessage(resp.Parent),
+		Replies:    m.ToMessageList(resp.Replies),
+		NextCursor: resp.NextCursor,
+	}
+}
+
+// ToListConversationsResponse converts a page of userID's inbox to its gRPC response
+func (m *MessageDTOMapperImpl) ToListConversationsResponse(userID string, resp querycontracts.ListConversationsQueryResponse) *chatv1.ListConversationsResponse {
+	conversations := make([]*chatv1.Conversation, len(resp.Conversations))
+	for i, entry := range resp.Conversations {
+		conversations[i] = m.conversations.ToConversation(entry.Conversation, userID)
+		conversations[i].LastMessageAt = timestamppb.New(entry.LastActivityAt)
+		if entry.LastMessage != nil {
+			conversations[i].LastMessage = m.ToMessage(*entry.LastMessage)
+		}
+	}
+	return &chatv1.ListConversationsResponse{
+		Conversations: conversations,
+		NextCursor:    resp.NextCursor,
+	}
+}
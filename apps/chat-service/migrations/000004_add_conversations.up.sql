@@ -0,0 +1,121 @@
+-- Migration: Conversations (direct and group) with members, messages addressed to a conversation
+--
+-- Until now a conversation was implicit: the (sender_id, receiver_id) pair of its messages.
+-- Groups need an explicit conversation with members, so every message now belongs to a conversation
+-- and messages are hash partitioned on conversation_id: all messages of a conversation, whatever its
+-- size, live in one partition and its history is read from there.
+-- Existing messages are moved to one direct conversation per pair of users.
+
+-- Step 1: Conversations and their members
+CREATE TABLE IF NOT EXISTS conversations (
+    id UUID PRIMARY KEY,
+    kind TEXT NOT NULL CHECK (kind IN ('direct', 'group')),
+    -- "<smaller user ID>:<larger user ID>" (byte order) for direct conversations, so a pair has only one
+    direct_key TEXT UNIQUE,
+    title TEXT NOT NULL DEFAULT '',
+    avatar_url TEXT NOT NULL DEFAULT '',
+    created_by TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    -- Inbox position: the last message, or the creation time until there is one
+    last_message_id UUID,
+    last_sender_id TEXT,
+    last_activity_at TIMESTAMPTZ NOT NULL,
+    CHECK ((kind = 'direct') = (direct_key IS NOT NULL))
+);
+
+CREATE TABLE IF NOT EXISTS conversation_members (
+    conversation_id UUID NOT NULL REFERENCES conversations (id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    role TEXT NOT NULL CHECK (role IN ('owner', 'admin', 'member')),
+    joined_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (conversation_id, user_id)
+);
+
+-- Conversations of a user: inbox and GDPR export
+CREATE INDEX IF NOT EXISTS idx_conversation_members_user_id ON conversation_members (user_id);
+
+-- Step 2: One direct conversation per pair that exchanged messages, created by the sender of the first one
+INSERT INTO conversations (id, kind, direct_key, created_by, created_at, updated_at, last_activity_at)
+SELECT DISTINCT ON (direct_key)
+    gen_random_uuid(),
+    'direct',
+    direct_key,
+    sender_id,
+    created_at,
+    created_at,
+    created_at
+FROM (
+    SELECT
+        LEAST(sender_id COLLATE "C", receiver_id COLLATE "C") || ':' || GREATEST(sender_id COLLATE "C", receiver_id COLLATE "C") AS direct_key,
+        sender_id,
+        created_at,
+        id
+    FROM messages
+) AS pairs
+ORDER BY direct_key, created_at, id
+ON CONFLICT (direct_key) DO NOTHING;
+
+INSERT INTO conversation_members (conversation_id, user_id, role, joined_at)
+SELECT id, split_part(direct_key, ':', 1), 'member', created_at FROM conversations WHERE kind = 'direct'
+UNION ALL
+SELECT id, split_part(direct_key, ':', 2), 'member', created_at FROM conversations WHERE kind = 'direct'
+ON CONFLICT (conversation_id, user_id) DO NOTHING;
+
+-- Step 3: Messages partitioned by conversation. receiver_id is kept for direct conversations only
+CREATE TABLE IF NOT EXISTS messages_new (
+    id UUID NOT NULL,
+    conversation_id UUID NOT NULL,
+    sender_id TEXT NOT NULL,
+    receiver_id TEXT,
+    content TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, conversation_id)
+) PARTITION BY HASH (conversation_id);
+
+DO $$
+DECLARE
+    i INT;
+BEGIN
+    FOR i IN 0..63 LOOP
+        EXECUTE format('CREATE TABLE IF NOT EXISTS messages_conversation_p%s PARTITION OF messages_new FOR VALUES WITH (MODULUS 64, REMAINDER %s)', i, i);
+    END LOOP;
+END $$;
+
+-- History keyset order within the conversation's partition
+CREATE INDEX IF NOT EXISTS idx_messages_conversation_created_at_id ON messages_new (conversation_id, created_at DESC, id DESC);
+-- GDPR redaction of everything a user sent
+CREATE INDEX IF NOT EXISTS idx_messages_sender_id ON messages_new (sender_id);
+
+-- Step 4: Move existing messages to their direct conversation
+INSERT INTO messages_new (id, conversation_id, sender_id, receiver_id, content, created_at)
+SELECT m.id, c.id, m.sender_id, m.receiver_id, m.content, m.created_at
+FROM messages m
+JOIN conversations c
+    ON c.direct_key = LEAST(m.sender_id COLLATE "C", m.receiver_id COLLATE "C") || ':' || GREATEST(m.sender_id COLLATE "C", m.receiver_id COLLATE "C")
+ON CONFLICT DO NOTHING;
+
+UPDATE conversations c
+SET last_message_id = last.id,
+    last_sender_id = last.sender_id,
+    last_activity_at = last.created_at
+FROM (
+    SELECT DISTINCT ON (conversation_id) conversation_id, id, sender_id, created_at
+    FROM messages_new
+    ORDER BY conversation_id, created_at DESC, id DESC
+) AS last
+WHERE c.id = last.conversation_id;
+
+-- Step 5: Replace the pair-partitioned table. It is dropped rather than kept as a backup:
+-- a stale copy would escape GDPR redaction. The down migration rebuilds it from messages
+DROP TABLE IF EXISTS messages CASCADE;
+ALTER TABLE messages_new RENAME TO messages;
+
+DO $$
+DECLARE
+    i INT;
+BEGIN
+    FOR i IN 0..63 LOOP
+        EXECUTE format('ALTER TABLE messages_conversation_p%s RENAME TO messages_p%s', i, i);
+    END LOOP;
+END $$;
@@ -0,0 +1,8 @@
+-- Rollback: Message edits and deletions
+-- Messages deleted for everyone stay empty, deletions for me become visible again
+
+DROP TABLE IF EXISTS message_deletions;
+DROP TABLE IF EXISTS message_edits;
+
+ALTER TABLE messages DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE messages DROP COLUMN IF EXISTS edited_at;
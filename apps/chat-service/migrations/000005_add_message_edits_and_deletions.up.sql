@@ -0,0 +1,31 @@
+-- Migration: Message edits, delete for everyone and delete for me
+--
+-- An edit replaces messages.content and keeps the replaced version in message_edits.
+-- Deleting for everyone clears content and sets deleted_at: the row stays so the history keeps its shape.
+-- Deleting for me only hides the message from one member, recorded in message_deletions.
+-- Both side tables reference messages by its full primary key, so each lookup names the message's partition.
+
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMPTZ;
+
+-- Previous versions of edited messages. Cleared when the message is deleted for everyone
+-- or its sender is erased (GDPR)
+CREATE TABLE IF NOT EXISTS message_edits (
+    conversation_id UUID NOT NULL,
+    message_id UUID NOT NULL,
+    content TEXT NOT NULL,
+    edited_at TIMESTAMPTZ NOT NULL, -- When this version was replaced
+    PRIMARY KEY (conversation_id, message_id, edited_at),
+    FOREIGN KEY (message_id, conversation_id) REFERENCES messages (id, conversation_id) ON DELETE CASCADE
+);
+
+-- Messages a member deleted for themselves. The primary key serves the anti-join of history reads,
+-- which look up (conversation_id, user_id, message_id) for every message of the page
+CREATE TABLE IF NOT EXISTS message_deletions (
+    conversation_id UUID NOT NULL,
+    user_id TEXT NOT NULL,
+    message_id UUID NOT NULL,
+    deleted_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (conversation_id, user_id, message_id),
+    FOREIGN KEY (message_id, conversation_id) REFERENCES messages (id, conversation_id) ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+-- Rollback: Delivery and read receipts
+
+ALTER TABLE conversation_members
+    DROP COLUMN IF EXISTS read_at,
+    DROP COLUMN IF EXISTS read_message_created_at,
+    DROP COLUMN IF EXISTS read_message_id,
+    DROP COLUMN IF EXISTS delivered_at,
+    DROP COLUMN IF EXISTS delivered_message_created_at,
+    DROP COLUMN IF EXISTS delivered_message_id;
@@ -0,0 +1,27 @@
+-- Migration: Delivery and read receipts as per-member watermarks
+--
+-- A member's receipts are the last message delivered to them and the last message they read, in
+-- (created_at, id) order: every message up to it is delivered, or read. A row per message and recipient
+-- would multiply the 64-way partitioned messages table by the size of every conversation, while a
+-- watermark is one update of conversation_members and compares against the keyset index of messages.
+-- The *_message_created_at columns copy the creation time of the message so that comparison needs no lookup.
+
+ALTER TABLE conversation_members
+    ADD COLUMN IF NOT EXISTS delivered_message_id UUID,
+    ADD COLUMN IF NOT EXISTS delivered_message_created_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS delivered_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS read_message_id UUID,
+    ADD COLUMN IF NOT EXISTS read_message_created_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS read_at TIMESTAMPTZ;
+
+-- Existing conversations start read up to their last message, rather than every past message counting as unread
+UPDATE conversation_members AS cm
+SET delivered_message_id = c.last_message_id,
+    delivered_message_created_at = c.last_activity_at,
+    delivered_at = NOW(),
+    read_message_id = c.last_message_id,
+    read_message_created_at = c.last_activity_at,
+    read_at = NOW()
+FROM conversations AS c
+WHERE c.id = cm.conversation_id
+  AND c.last_message_id IS NOT NULL;
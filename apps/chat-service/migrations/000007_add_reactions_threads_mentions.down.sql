@@ -0,0 +1,11 @@
+-- Rollback: Reactions, threads and mentions
+-- Replies become plain messages of the conversation
+
+DROP TABLE IF EXISTS message_mentions;
+DROP TABLE IF EXISTS message_reactions;
+
+DROP INDEX IF EXISTS idx_messages_conversation_parent_created_at_id;
+
+ALTER TABLE messages DROP COLUMN IF EXISTS last_reply_at;
+ALTER TABLE messages DROP COLUMN IF EXISTS reply_count;
+ALTER TABLE messages DROP COLUMN IF EXISTS parent_message_id;
@@ -0,0 +1,35 @@
+-- Migration: Emoji reactions, threaded replies and mentions
+--
+-- A reply keeps parent_message_id, the message it answers in the same conversation, so a thread is read
+-- from the conversation's partition like its history. The parent counts its replies in reply_count.
+-- Reactions and mentions are side tables keyed like message_deletions: every lookup of a page names
+-- the partition of its messages.
+
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS parent_message_id UUID;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS reply_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE messages ADD COLUMN IF NOT EXISTS last_reply_at TIMESTAMPTZ;
+
+-- Thread keyset order; only replies are indexed
+CREATE INDEX IF NOT EXISTS idx_messages_conversation_parent_created_at_id
+    ON messages (conversation_id, parent_message_id, created_at DESC, id DESC)
+    WHERE parent_message_id IS NOT NULL;
+
+-- One row per member and emoji on a message; counts are aggregated on read
+CREATE TABLE IF NOT EXISTS message_reactions (
+    conversation_id UUID NOT NULL,
+    message_id UUID NOT NULL,
+    emoji TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (conversation_id, message_id, emoji, user_id),
+    FOREIGN KEY (message_id, conversation_id) REFERENCES messages (id, conversation_id) ON DELETE CASCADE
+);
+
+-- Members a message mentions, resolved from @handles against users when it was sent
+CREATE TABLE IF NOT EXISTS message_mentions (
+    conversation_id UUID NOT NULL,
+    message_id UUID NOT NULL,
+    user_id TEXT NOT NULL,
+    PRIMARY KEY (conversation_id, message_id, user_id),
+    FOREIGN KEY (message_id, conversation_id) REFERENCES messages (id, conversation_id) ON DELETE CASCADE
+);
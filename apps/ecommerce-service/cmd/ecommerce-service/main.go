@@ -12,6 +12,8 @@ import (
 	interfaces "golang-social-media/apps/ecommerce-service/internal/interfaces/grpc"
 	"golang-social-media/pkg/config"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -22,6 +24,24 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Setup tracing first so the clients created by the bootstrap report to the configured exporter
+	shutdownTracing, err := tracing.Init(ctx, "ecommerce-service")
+	if err != nil {
+		logger.Component("ecommerce.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to setup tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Component("ecommerce.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to flush traces")
+		}
+	}()
+
 	// Setup all dependencies
 	deps, err := bootstrap.SetupDependencies(ctx)
 	if err != nil {
@@ -42,6 +62,13 @@ func main() {
 		deps.OutboxProcessor.Start(ctx)
 	}()
 
+	// Start GDPR subscribers in background
+	go deps.UserDeletedSubscriber.Consume(ctx)
+	go deps.UserExportRequestedSubscriber.Consume(ctx)
+
+	// Expose Prometheus metrics on their own port, the service itself only speaks gRPC
+	go serveMetrics(ctx)
+
 	// Start gRPC server
 	port := config.GetEnvInt("ECOMMERCE_SERVICE_PORT", 9200)
 	addr := fmt.Sprintf(":%d", port)
@@ -57,6 +84,23 @@ func main() {
 	}
 }
 
+// serveMetrics exposes /metrics for Prometheus until ctx is cancelled
+func serveMetrics(ctx context.Context) {
+	addr := fmt.Sprintf(":%d", config.GetEnvInt("ECOMMERCE_METRICS_PORT", 9201))
+
+	logger.Component("ecommerce.metrics").
+		Info().
+		Str("addr", addr).
+		Msg("metrics server starting")
+
+	if err := metrics.Serve(ctx, addr); err != nil {
+		logger.Component("ecommerce.metrics").
+			Error().
+			Err(err).
+			Msg("metrics server stopped")
+	}
+}
+
 // cleanup closes all resources
 func cleanup(deps *bootstrap.Dependencies) {
 	if deps.Publisher != nil {
@@ -67,6 +111,22 @@ func cleanup(deps *bootstrap.Dependencies) {
 				Msg("failed to close kafka publisher")
 		}
 	}
+	if deps.UserDeletedSubscriber != nil {
+		if err := deps.UserDeletedSubscriber.Close(); err != nil {
+			logger.Component("ecommerce.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user deleted subscriber")
+		}
+	}
+	if deps.UserExportRequestedSubscriber != nil {
+		if err := deps.UserExportRequestedSubscriber.Close(); err != nil {
+			logger.Component("ecommerce.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user export requested subscriber")
+		}
+	}
 	if deps.Cache != nil {
 		if err := deps.Cache.Close(); err != nil {
 			logger.Component("ecommerce.bootstrap").
@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleUserDeletedCommand anonymizes the orders of a deleted user (GDPR deletion saga)
+type HandleUserDeletedCommand interface {
+	Execute(ctx context.Context, event events.UserDeleted) error
+}
@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"golang-social-media/apps/ecommerce-service/internal/application/command/contracts"
+	eventhandlercontracts "golang-social-media/apps/ecommerce-service/internal/application/event_handler/contracts"
+	"golang-social-media/apps/ecommerce-service/internal/application/orders"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+
+	"github.com/rs/zerolog"
+)
+
+var _ contracts.HandleUserDeletedCommand = (*handleUserDeletedCommand)(nil)
+
+type handleUserDeletedCommand struct {
+	orderRepo   orders.Repository
+	eventBroker eventhandlercontracts.EventBrokerPublisher
+	log         *zerolog.Logger
+}
+
+func NewHandleUserDeletedCommand(
+	orderRepo orders.Repository,
+	eventBroker eventhandlercontracts.EventBrokerPublisher,
+) contracts.HandleUserDeletedCommand {
+	return &handleUserDeletedCommand{
+		orderRepo:   orderRepo,
+		eventBroker: eventBroker,
+		log:         logger.Component("ecommerce.command.handle_user_deleted"),
+	}
+}
+
+// Execute keeps the orders for accounting but replaces the user ID on them.
+// A redelivered event finds nothing left to change and reports zero records
+func (c *handleUserDeletedCommand) Execute(ctx context.Context, event events.UserDeleted) error {
+	anonymized, err := c.orderRepo.AnonymizeUser(ctx, event.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to anonymize orders of deleted user")
+		return err
+	}
+
+	if err := c.eventBroker.PublishUserDataErased(ctx, eventhandlercontracts.UserDataErasedPayload{
+		RequestID: event.RequestID,
+		UserID:    event.UserID,
+		Records:   anonymized,
+		ErasedAt:  time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", event.UserID).
+		Str("request_id", event.RequestID).
+		Int64("anonymized_orders", anonymized).
+		Msg("orders of deleted user anonymized")
+
+	return nil
+}
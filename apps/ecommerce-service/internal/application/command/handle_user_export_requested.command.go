@@ -0,0 +1,109 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"golang-social-media/apps/ecommerce-service/internal/application/command/contracts"
+	eventhandlercontracts "golang-social-media/apps/ecommerce-service/internal/application/event_handler/contracts"
+	"golang-social-media/apps/ecommerce-service/internal/application/orders"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+
+	"github.com/rs/zerolog"
+)
+
+var _ contracts.HandleUserExportRequestedCommand = (*handleUserExportRequestedCommand)(nil)
+
+// ecommerceDataExport is the ecommerce-service document of a data export
+type ecommerceDataExport struct {
+	Orders []ecommerceExportOrder `json:"orders"`
+}
+
+type ecommerceExportOrder struct {
+	ID          string                     `json:"id"`
+	Status      string                     `json:"status"`
+	Items       []ecommerceExportOrderItem `json:"items"`
+	TotalAmount float64                    `json:"totalAmount"`
+	CreatedAt   time.Time                  `json:"createdAt"`
+	UpdatedAt   time.Time                  `json:"updatedAt"`
+}
+
+type ecommerceExportOrderItem struct {
+	ProductID string  `json:"productId"`
+	Quantity  int     `json:"quantity"`
+	UnitPrice float64 `json:"unitPrice"`
+	SubTotal  float64 `json:"subTotal"`
+}
+
+type handleUserExportRequestedCommand struct {
+	orderRepo   orders.Repository
+	eventBroker eventhandlercontracts.EventBrokerPublisher
+	log         *zerolog.Logger
+}
+
+func NewHandleUserExportRequestedCommand(
+	orderRepo orders.Repository,
+	eventBroker eventhandlercontracts.EventBrokerPublisher,
+) contracts.HandleUserExportRequestedCommand {
+	return &handleUserExportRequestedCommand{
+		orderRepo:   orderRepo,
+		eventBroker: eventBroker,
+		log:         logger.Component("ecommerce.command.handle_user_export_requested"),
+	}
+}
+
+func (c *handleUserExportRequestedCommand) Execute(ctx context.Context, event events.UserExportRequested) error {
+	userOrders, err := c.orderRepo.ListAllByUser(ctx, event.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to list orders for export")
+		return err
+	}
+
+	export := ecommerceDataExport{Orders: make([]ecommerceExportOrder, 0, len(userOrders))}
+	for _, o := range userOrders {
+		items := make([]ecommerceExportOrderItem, len(o.Items))
+		for i, item := range o.Items {
+			items[i] = ecommerceExportOrderItem{
+				ProductID: item.ProductID,
+				Quantity:  item.Quantity,
+				UnitPrice: item.UnitPrice,
+				SubTotal:  item.SubTotal,
+			}
+		}
+		export.Orders = append(export.Orders, ecommerceExportOrder{
+			ID:          o.ID,
+			Status:      string(o.Status),
+			Items:       items,
+			TotalAmount: o.TotalAmount,
+			CreatedAt:   o.CreatedAt,
+			UpdatedAt:   o.UpdatedAt,
+		})
+	}
+
+	document, err := json.Marshal(export)
+	if err != nil {
+		return err
+	}
+
+	if err := c.eventBroker.PublishUserDataExported(ctx, eventhandlercontracts.UserDataExportedPayload{
+		RequestID:  event.RequestID,
+		UserID:     event.UserID,
+		Document:   document,
+		ExportedAt: time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", event.UserID).
+		Str("request_id", event.RequestID).
+		Int("orders", len(export.Orders)).
+		Msg("order data exported")
+
+	return nil
+}
@@ -2,6 +2,8 @@ package contracts
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 )
 
 // EventBrokerPublisher represents the contract for publishing events to the event broker
@@ -12,6 +14,10 @@ type EventBrokerPublisher interface {
 	PublishOrderItemAdded(ctx context.Context, payload OrderItemAddedPayload) error
 	PublishOrderConfirmed(ctx context.Context, payload OrderConfirmedPayload) error
 	PublishOrderCancelled(ctx context.Context, payload OrderCancelledPayload) error
+	// PublishUserDataErased reports the ecommerce step of a GDPR deletion to auth-service
+	PublishUserDataErased(ctx context.Context, payload UserDataErasedPayload) error
+	// PublishUserDataExported sends the ecommerce document of a GDPR data export to auth-service
+	PublishUserDataExported(ctx context.Context, payload UserDataExportedPayload) error
 }
 
 // ProductCreatedPayload represents the payload for ProductCreated event
@@ -67,3 +73,19 @@ type OrderCancelledPayload struct {
 	CancelledAt string
 }
 
+
+// UserDataErasedPayload represents the payload for UserDataErased event
+type UserDataErasedPayload struct {
+	RequestID string
+	UserID    string
+	Records   int64
+	ErasedAt  time.Time
+}
+
+// UserDataExportedPayload represents the payload for UserDataExported event
+type UserDataExportedPayload struct {
+	RequestID  string
+	UserID     string
+	Document   json.RawMessage
+	ExportedAt time.Time
+}
@@ -12,5 +12,10 @@ type Repository interface {
 	FindByID(ctx context.Context, id string) (order.Order, error)
 	Update(ctx context.Context, o *order.Order) error
 	ListByUser(ctx context.Context, userID string, limit int) ([]order.Order, error)
+	// ListAllByUser returns every order of a user, oldest first (GDPR data export)
+	ListAllByUser(ctx context.Context, userID string) ([]order.Order, error)
+	// AnonymizeUser detaches all orders and order events from a deleted user and
+	// returns the number of orders changed
+	AnonymizeUser(ctx context.Context, userID string) (int64, error)
 }
 
@@ -15,6 +15,10 @@ const (
 	StatusCompleted Status = "completed"
 )
 
+// AnonymizedUserID replaces the user ID of orders whose owner was deleted. Orders are
+// kept for accounting, but can no longer be linked to the person who placed them
+const AnonymizedUserID = "deleted-user"
+
 // Order represents an order aggregate root
 // Aggregate Root: Entry point to access the Order aggregate, manages OrderItems
 type Order struct {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/redis/go-redis/v9"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/rs/zerolog"
 )
@@ -30,6 +31,11 @@ func NewRedisCache(addr string, password string, db int) (*RedisCache, error) {
 		WriteTimeout: 3 * time.Second,
 	})
 
+	// One client span per command, nested under the request or message being handled
+	if err := tracing.InstrumentRedis(client); err != nil {
+		return nil, err
+	}
+
 	// Test connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
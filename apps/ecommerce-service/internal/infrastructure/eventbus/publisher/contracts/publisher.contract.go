@@ -2,6 +2,8 @@ package contracts
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 )
 
 // EcommercePublisher defines the contract for publishing ecommerce events
@@ -12,6 +14,8 @@ type EcommercePublisher interface {
 	PublishOrderItemAdded(ctx context.Context, event OrderItemAdded) error
 	PublishOrderConfirmed(ctx context.Context, event OrderConfirmed) error
 	PublishOrderCancelled(ctx context.Context, event OrderCancelled) error
+	PublishUserDataErased(ctx context.Context, event UserDataErased) error
+	PublishUserDataExported(ctx context.Context, event UserDataExported) error
 	Close() error
 }
 
@@ -68,3 +72,19 @@ type OrderCancelled struct {
 	CancelledAt string
 }
 
+
+// UserDataErased reports that ecommerce-service anonymized a deleted user's orders
+type UserDataErased struct {
+	RequestID string
+	UserID    string
+	Records   int64
+	ErasedAt  time.Time
+}
+
+// UserDataExported carries the ecommerce document of a data export
+type UserDataExported struct {
+	RequestID  string
+	UserID     string
+	Document   json.RawMessage
+	ExportedAt time.Time
+}
@@ -79,3 +79,21 @@ func (a *EventBrokerAdapter) PublishOrderCancelled(ctx context.Context, payload
 	})
 }
 
+
+func (a *EventBrokerAdapter) PublishUserDataErased(ctx context.Context, payload appcontracts.UserDataErasedPayload) error {
+	return a.publisher.PublishUserDataErased(ctx, infracontracts.UserDataErased{
+		RequestID: payload.RequestID,
+		UserID:    payload.UserID,
+		Records:   payload.Records,
+		ErasedAt:  payload.ErasedAt,
+	})
+}
+
+func (a *EventBrokerAdapter) PublishUserDataExported(ctx context.Context, payload appcontracts.UserDataExportedPayload) error {
+	return a.publisher.PublishUserDataExported(ctx, infracontracts.UserDataExported{
+		RequestID:  payload.RequestID,
+		UserID:     payload.UserID,
+		Document:   payload.Document,
+		ExportedAt: payload.ExportedAt,
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"golang-social-media/apps/ecommerce-service/internal/infrastructure/eventbus/publisher/contracts"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 )
 
 var _ contracts.EcommercePublisher = (*KafkaPublisher)(nil)
@@ -21,6 +22,10 @@ type KafkaPublisher struct {
 	orderItemAddedWriter      *kafka.Writer
 	orderConfirmedWriter      *kafka.Writer
 	orderCancelledWriter      *kafka.Writer
+
+	// GDPR saga reports go to auth-service, keyed by request ID
+	userDeletionWriter *kafka.Writer
+	userExportWriter   *kafka.Writer
 }
 
 func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
@@ -148,24 +153,42 @@ func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
 		orderItemAddedWriter:      orderItemAddedWriter,
 		orderConfirmedWriter:      orderConfirmedWriter,
 		orderCancelledWriter:      orderCancelledWriter,
+		userDeletionWriter:        newGDPRWriter(brokers, events.TopicUserDeletionCompleted, 1048576),
+		userExportWriter:          newGDPRWriter(brokers, events.TopicUserExportCompleted, 10485760),
 	}, nil
 }
 
+// newGDPRWriter creates a synchronous writer: the subscriber commits the request only once the report is written
+func newGDPRWriter(brokers []string, topic string, batchBytes int64) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{}, // Reports of one request stay in order
+		BatchBytes:   batchBytes,    // Export documents hold every order of the user
+		BatchTimeout: 10 * time.Millisecond,
+		RequiredAcks: kafka.RequireAll,
+		MaxAttempts:  10,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+		Compression:  kafka.Snappy,
+	}
+}
+
 func (p *KafkaPublisher) PublishProductCreated(ctx context.Context, event contracts.ProductCreated) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal ProductCreated event")
 		return err
 	}
 
-	if err := p.productCreatedWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.productCreatedWriter, kafka.Message{
 		Key:   []byte(event.ProductID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Str("product_id", event.ProductID).
@@ -173,7 +196,7 @@ func (p *KafkaPublisher) PublishProductCreated(ctx context.Context, event contra
 		return err
 	}
 
-	logger.Component("ecommerce.publisher").
+	logger.ComponentCtx(ctx, "ecommerce.publisher").
 		Info().
 		Str("product_id", event.ProductID).
 		Str("topic", events.TopicProductCreated).
@@ -185,18 +208,18 @@ func (p *KafkaPublisher) PublishProductCreated(ctx context.Context, event contra
 func (p *KafkaPublisher) PublishProductStockUpdated(ctx context.Context, event contracts.ProductStockUpdated) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal ProductStockUpdated event")
 		return err
 	}
 
-	if err := p.productStockUpdatedWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.productStockUpdatedWriter, kafka.Message{
 		Key:   []byte(event.ProductID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Str("product_id", event.ProductID).
@@ -204,7 +227,7 @@ func (p *KafkaPublisher) PublishProductStockUpdated(ctx context.Context, event c
 		return err
 	}
 
-	logger.Component("ecommerce.publisher").
+	logger.ComponentCtx(ctx, "ecommerce.publisher").
 		Info().
 		Str("product_id", event.ProductID).
 		Str("topic", events.TopicProductStockUpdated).
@@ -216,18 +239,18 @@ func (p *KafkaPublisher) PublishProductStockUpdated(ctx context.Context, event c
 func (p *KafkaPublisher) PublishOrderCreated(ctx context.Context, event contracts.OrderCreated) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal OrderCreated event")
 		return err
 	}
 
-	if err := p.orderCreatedWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.orderCreatedWriter, kafka.Message{
 		Key:   []byte(event.OrderID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Str("order_id", event.OrderID).
@@ -235,7 +258,7 @@ func (p *KafkaPublisher) PublishOrderCreated(ctx context.Context, event contract
 		return err
 	}
 
-	logger.Component("ecommerce.publisher").
+	logger.ComponentCtx(ctx, "ecommerce.publisher").
 		Info().
 		Str("order_id", event.OrderID).
 		Str("topic", events.TopicOrderCreated).
@@ -247,18 +270,18 @@ func (p *KafkaPublisher) PublishOrderCreated(ctx context.Context, event contract
 func (p *KafkaPublisher) PublishOrderItemAdded(ctx context.Context, event contracts.OrderItemAdded) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal OrderItemAdded event")
 		return err
 	}
 
-	if err := p.orderItemAddedWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.orderItemAddedWriter, kafka.Message{
 		Key:   []byte(event.OrderID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Str("order_id", event.OrderID).
@@ -266,7 +289,7 @@ func (p *KafkaPublisher) PublishOrderItemAdded(ctx context.Context, event contra
 		return err
 	}
 
-	logger.Component("ecommerce.publisher").
+	logger.ComponentCtx(ctx, "ecommerce.publisher").
 		Info().
 		Str("order_id", event.OrderID).
 		Str("topic", events.TopicOrderItemAdded).
@@ -278,18 +301,18 @@ func (p *KafkaPublisher) PublishOrderItemAdded(ctx context.Context, event contra
 func (p *KafkaPublisher) PublishOrderConfirmed(ctx context.Context, event contracts.OrderConfirmed) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal OrderConfirmed event")
 		return err
 	}
 
-	if err := p.orderConfirmedWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.orderConfirmedWriter, kafka.Message{
 		Key:   []byte(event.OrderID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Str("order_id", event.OrderID).
@@ -297,7 +320,7 @@ func (p *KafkaPublisher) PublishOrderConfirmed(ctx context.Context, event contra
 		return err
 	}
 
-	logger.Component("ecommerce.publisher").
+	logger.ComponentCtx(ctx, "ecommerce.publisher").
 		Info().
 		Str("order_id", event.OrderID).
 		Str("topic", events.TopicOrderConfirmed).
@@ -309,18 +332,18 @@ func (p *KafkaPublisher) PublishOrderConfirmed(ctx context.Context, event contra
 func (p *KafkaPublisher) PublishOrderCancelled(ctx context.Context, event contracts.OrderCancelled) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal OrderCancelled event")
 		return err
 	}
 
-	if err := p.orderCancelledWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.orderCancelledWriter, kafka.Message{
 		Key:   []byte(event.OrderID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("ecommerce.publisher").
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
 			Error().
 			Err(err).
 			Str("order_id", event.OrderID).
@@ -328,7 +351,7 @@ func (p *KafkaPublisher) PublishOrderCancelled(ctx context.Context, event contra
 		return err
 	}
 
-	logger.Component("ecommerce.publisher").
+	logger.ComponentCtx(ctx, "ecommerce.publisher").
 		Info().
 		Str("order_id", event.OrderID).
 		Str("topic", events.TopicOrderCancelled).
@@ -337,6 +360,61 @@ func (p *KafkaPublisher) PublishOrderCancelled(ctx context.Context, event contra
 	return nil
 }
 
+// PublishUserDataErased reports that ecommerce-service anonymized a deleted user's orders
+func (p *KafkaPublisher) PublishUserDataErased(ctx context.Context, event contracts.UserDataErased) error {
+	return p.publishGDPR(ctx, p.userDeletionWriter, event.RequestID, events.UserDataErased{
+		RequestID: event.RequestID,
+		UserID:    event.UserID,
+		Service:   events.ServiceEcommerce,
+		Records:   event.Records,
+		ErasedAt:  event.ErasedAt,
+	})
+}
+
+// PublishUserDataExported sends the ecommerce document of a data export
+func (p *KafkaPublisher) PublishUserDataExported(ctx context.Context, event contracts.UserDataExported) error {
+	return p.publishGDPR(ctx, p.userExportWriter, event.RequestID, events.UserDataExported{
+		RequestID:  event.RequestID,
+		UserID:     event.UserID,
+		Service:    events.ServiceEcommerce,
+		Document:   event.Document,
+		ExportedAt: event.ExportedAt,
+	})
+}
+
+func (p *KafkaPublisher) publishGDPR(ctx context.Context, writer *kafka.Writer, requestID string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
+			Error().
+			Err(err).
+			Str("topic", writer.Topic).
+			Msg("failed to marshal GDPR event")
+		return err
+	}
+
+	if err := tracing.WriteKafkaMessage(ctx, writer, kafka.Message{
+		Key:   []byte(requestID),
+		Value: payload,
+	}); err != nil {
+		logger.ComponentCtx(ctx, "ecommerce.publisher").
+			Error().
+			Err(err).
+			Str("topic", writer.Topic).
+			Str("request_id", requestID).
+			Msg("failed to publish GDPR event")
+		return err
+	}
+
+	logger.ComponentCtx(ctx, "ecommerce.publisher").
+		Info().
+		Str("topic", writer.Topic).
+		Str("request_id", requestID).
+		Msg("published GDPR event")
+
+	return nil
+}
+
 func (p *KafkaPublisher) Close() error {
 	var errs []error
 
@@ -358,6 +436,12 @@ func (p *KafkaPublisher) Close() error {
 	if err := p.orderCancelledWriter.Close(); err != nil {
 		errs = append(errs, err)
 	}
+	if err := p.userDeletionWriter.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := p.userExportWriter.Close(); err != nil {
+		errs = append(errs, err)
+	}
 
 	if len(errs) > 0 {
 		return errors.New("failed to close some kafka writers")
@@ -40,3 +40,13 @@ type OrderCancelledSubscriber interface {
 	Subscriber
 }
 
+
+// UserDeletedSubscriber subscribes to UserDeleted events
+type UserDeletedSubscriber interface {
+	Subscriber
+}
+
+// UserExportRequestedSubscriber subscribes to UserExportRequested events
+type UserExportRequestedSubscriber interface {
+	Subscriber
+}
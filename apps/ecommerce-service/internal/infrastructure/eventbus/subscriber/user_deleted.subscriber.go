@@ -0,0 +1,116 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	commandcontracts "golang-social-media/apps/ecommerce-service/internal/application/command/contracts"
+	"golang-social-media/apps/ecommerce-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/consumer"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+var _ contracts.UserDeletedSubscriber = (*UserDeletedSubscriber)(nil)
+
+// UserDeletedSubscriber anonymizes the orders of deleted users. The offset is committed
+// only after the erasure is reported, so a deletion is retried instead of lost
+type UserDeletedSubscriber struct {
+	reader  *kafka.Reader
+	handler commandcontracts.HandleUserDeletedCommand
+	metrics *metrics.KafkaConsumer
+	retrier *consumer.Retrier
+}
+
+func NewUserDeletedSubscriber(brokers []string, groupID string, handler commandcontracts.HandleUserDeletedCommand) (*UserDeletedSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	logger.Component("ecommerce.subscriber.user_deleted").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Str("topic", events.TopicUserDeleted).
+		Msg("user deleted subscriber configured")
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: brokers,
+		GroupID: groupID,
+		Topic:   events.TopicUserDeleted,
+	})
+
+	return &UserDeletedSubscriber{
+		reader:  reader,
+		handler: handler,
+		metrics: metrics.NewKafkaConsumer(events.TopicUserDeleted, groupID),
+		retrier: consumer.NewRetrier(brokers, groupID, consumer.DefaultRetryConfig()),
+	}, nil
+}
+
+func (s *UserDeletedSubscriber) Consume(ctx context.Context) {
+	log := logger.Component("ecommerce.subscriber.user_deleted")
+	log.Info().
+		Str("topic", events.TopicUserDeleted).
+		Msg("starting to consume UserDeleted events")
+
+	for {
+		msg, err := s.reader.FetchMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+				log.Info().
+					Msg("stopping UserDeleted subscriber")
+				return
+			}
+			log.Error().
+				Err(err).
+				Msg("failed to read message")
+			continue
+		}
+
+		s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+		start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+		var event events.UserDeleted
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			// A malformed event never succeeds, skip it
+			log.Error().
+				Err(err).
+				Int64("offset", msg.Offset).
+				Msg("failed to unmarshal UserDeleted event")
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+		} else if err := s.retrier.Handle(msgCtx, log, msg, func() error { return s.handler.Execute(msgCtx, event) }); errors.Is(err, consumer.ErrDeadLettered) {
+			// The event is parked on the dead-letter topic, move on to the rest of the partition
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeDeadLetter)
+		} else if err != nil {
+			tracing.End(span, err)
+			return
+		} else {
+			tracing.End(span, nil)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+		}
+
+		if err := s.reader.CommitMessages(ctx, msg); err != nil {
+			log.Error().
+				Err(err).
+				Int64("offset", msg.Offset).
+				Msg("failed to commit UserDeleted message")
+		}
+	}
+}
+
+func (s *UserDeletedSubscriber) Close() error {
+	return errors.Join(s.reader.Close(), s.retrier.Close())
+}
@@ -0,0 +1,112 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"golang-social-media/apps/ecommerce-service/internal/infrastructure/eventbus/publisher"
+	"golang-social-media/apps/ecommerce-service/internal/infrastructure/eventbus/publisher/contracts"
+	pkgoutbox "golang-social-media/pkg/outbox"
+)
+
+var _ pkgoutbox.Publisher = (*EventPublisher)(nil)
+
+// EventPublisher publishes outbox events through the typed methods of the Kafka publisher
+type EventPublisher struct {
+	publisher *publisher.KafkaPublisher
+}
+
+// NewEventPublisher creates an EventPublisher
+func NewEventPublisher(kafkaPublisher *publisher.KafkaPublisher) *EventPublisher {
+	return &EventPublisher{publisher: kafkaPublisher}
+}
+
+// Publish sends an outbox event to Kafka and returns its topic
+func (p *EventPublisher) Publish(ctx context.Context, event pkgoutbox.Model) (string, error) {
+	// Parse payload
+	var payload map[string]interface{}
+	if err := json.Unmarshal([]byte(event.Payload), &payload); err != nil {
+		return "", pkgoutbox.Permanent(err)
+	}
+
+	// Determine topic based on event type
+	topic := getTopicForEventType(event.EventType)
+	if topic == "" {
+		return "", pkgoutbox.Permanent(fmt.Errorf("unknown outbox event type %q", event.EventType))
+	}
+
+	// Publish to Kafka using the appropriate publisher method
+	// Convert payload map to contract struct
+	var publishErr error
+	switch event.EventType {
+	case "ProductCreated":
+		var eventContract contracts.ProductCreated
+		if err := mapToStruct(payload, &eventContract); err != nil {
+			return "", pkgoutbox.Permanent(err)
+		}
+		publishErr = p.publisher.PublishProductCreated(ctx, eventContract)
+	case "ProductStockUpdated":
+		var eventContract contracts.ProductStockUpdated
+		if err := mapToStruct(payload, &eventContract); err != nil {
+			return "", pkgoutbox.Permanent(err)
+		}
+		publishErr = p.publisher.PublishProductStockUpdated(ctx, eventContract)
+	case "OrderCreated":
+		var eventContract contracts.OrderCreated
+		if err := mapToStruct(payload, &eventContract); err != nil {
+			return "", pkgoutbox.Permanent(err)
+		}
+		publishErr = p.publisher.PublishOrderCreated(ctx, eventContract)
+	case "OrderItemAdded":
+		var eventContract contracts.OrderItemAdded
+		if err := mapToStruct(payload, &eventContract); err != nil {
+			return "", pkgoutbox.Permanent(err)
+		}
+		publishErr = p.publisher.PublishOrderItemAdded(ctx, eventContract)
+	case "OrderConfirmed":
+		var eventContract contracts.OrderConfirmed
+		if err := mapToStruct(payload, &eventContract); err != nil {
+			return "", pkgoutbox.Permanent(err)
+		}
+		publishErr = p.publisher.PublishOrderConfirmed(ctx, eventContract)
+	case "OrderCancelled":
+		var eventContract contracts.OrderCancelled
+		if err := mapToStruct(payload, &eventContract); err != nil {
+			return "", pkgoutbox.Permanent(err)
+		}
+		publishErr = p.publisher.PublishOrderCancelled(ctx, eventContract)
+	}
+
+	if publishErr != nil {
+		return "", publishErr
+	}
+	return topic, nil
+}
+
+// getTopicForEventType returns the Kafka topic for an event type
+func getTopicForEventType(eventType string) string {
+	// Map event types to topics
+	topicMap := map[string]string{
+		"ProductCreated":      "product.created",
+		"ProductStockUpdated": "product.stock.updated",
+		"OrderCreated":        "order.created",
+		"OrderItemAdded":      "order.item.added",
+		"OrderConfirmed":      "order.confirmed",
+		"OrderCancelled":      "order.cancelled",
+	}
+
+	if topic, ok := topicMap[eventType]; ok {
+		return topic
+	}
+	return ""
+}
+
+// mapToStruct converts a map to a struct
+func mapToStruct(m map[string]interface{}, v interface{}) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -4,20 +4,20 @@ import (
 	"context"
 	"encoding/json"
 
-	"golang-social-media/apps/ecommerce-service/internal/infrastructure/persistence/postgres"
 	"golang-social-media/pkg/logger"
+	pkgoutbox "golang-social-media/pkg/outbox"
 
 	"github.com/rs/zerolog"
 )
 
 // OutboxService handles outbox operations
 type OutboxService struct {
-	outboxRepo *postgres.OutboxRepository
+	outboxRepo *pkgoutbox.Repository
 	log        *zerolog.Logger
 }
 
 // NewOutboxService creates a new OutboxService
-func NewOutboxService(outboxRepo *postgres.OutboxRepository) *OutboxService {
+func NewOutboxService(outboxRepo *pkgoutbox.Repository) *OutboxService {
 	return &OutboxService{
 		outboxRepo: outboxRepo,
 		log:        logger.Component("ecommerce.outbox"),
@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"golang-social-media/apps/ecommerce-service/internal/application/orders"
@@ -294,3 +295,84 @@ func (r *OrderRepository) ListByUser(ctx context.Context, userID string, limit i
 	return orders, nil
 }
 
+
+func (r *OrderRepository) ListAllByUser(ctx context.Context, userID string) ([]order.Order, error) {
+	var orderModels []OrderModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Find(&orderModels).Error; err != nil {
+		return nil, err
+	}
+	if len(orderModels) == 0 {
+		return []order.Order{}, nil
+	}
+
+	orderIDs := make([]string, len(orderModels))
+	for i, om := range orderModels {
+		orderIDs[i] = om.ID
+	}
+
+	var itemModels []OrderItemModel
+	if err := r.db.WithContext(ctx).Where("order_id IN ?", orderIDs).Find(&itemModels).Error; err != nil {
+		return nil, err
+	}
+	itemsMap := make(map[string][]OrderItemModel, len(orderModels))
+	for _, item := range itemModels {
+		itemsMap[item.OrderID] = append(itemsMap[item.OrderID], item)
+	}
+
+	orders := make([]order.Order, len(orderModels))
+	for i, om := range orderModels {
+		orders[i] = om.ToDomain(itemsMap[om.ID])
+	}
+	return orders, nil
+}
+
+func (r *OrderRepository) AnonymizeUser(ctx context.Context, userID string) (int64, error) {
+	var orderIDs []string
+	var anonymized int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&OrderModel{}).
+			Where("user_id = ?", userID).
+			Pluck("id", &orderIDs).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&OrderModel{}).
+			Where("user_id = ?", userID).
+			Updates(map[string]interface{}{
+				"user_id":    order.AnonymizedUserID,
+				"updated_at": time.Now().UTC(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		anonymized = result.RowsAffected
+
+		// Order events carry the user ID in their payload; scrub the history too
+		for _, table := range []string{"event_store", "outbox"} {
+			if err := tx.Exec(
+				`UPDATE `+table+` SET payload = jsonb_set(payload, '{UserID}', to_jsonb(?::text))
+				WHERE aggregate_type = 'Order' AND payload->>'UserID' = ?`,
+				order.AnonymizedUserID, userID,
+			).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if r.cache != nil {
+		for _, id := range orderIDs {
+			_ = r.cache.DeleteOrder(ctx, id)
+		}
+		_ = r.cache.InvalidateOrderList(ctx, userID)
+	}
+
+	return anonymized, nil
+}
@@ -0,0 +1,3 @@
+-- Drop outbox trace context column
+ALTER TABLE outbox
+    DROP COLUMN IF EXISTS trace_context;
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	bootstrap "golang-social-media/apps/gateway/internal/infrastructure/bootstrap"
 	httpserver "golang-social-media/apps/gateway/internal/infrastructure/http"
@@ -14,6 +16,7 @@ import (
 	queryrest "golang-social-media/apps/gateway/internal/interfaces/rest/query"
 	"golang-social-media/pkg/config"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/gin-gonic/gin"
 )
@@ -25,6 +28,24 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Setup tracing first so the clients created by the bootstrap report to the configured exporter
+	shutdownTracing, err := tracing.Init(ctx, "gateway")
+	if err != nil {
+		logger.Component("gateway.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to setup tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Component("gateway.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to flush traces")
+		}
+	}()
+
 	// Setup all dependencies
 	deps, err := bootstrap.SetupDependencies(ctx)
 	if err != nil {
@@ -41,7 +62,14 @@ func main() {
 		Msg("gateway service ready")
 
 	// Setup router
-	router := buildRouter(deps)
+	router, err := buildRouter(deps)
+	if err != nil {
+		logger.Component("gateway.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to setup router")
+		os.Exit(1)
+	}
 
 	port := config.GetEnvInt("GATEWAY_PORT", 8080)
 	addr := fmt.Sprintf(":%d", port)
@@ -60,7 +88,7 @@ func main() {
 	}
 }
 
-func buildRouter(deps *bootstrap.Dependencies) *gin.Engine {
+func buildRouter(deps *bootstrap.Dependencies) (*gin.Engine, error) {
 	createMessageHTTP := commandrest.NewCreateMessageHTTPHandler(deps.CreateMessageCmd)
 	registerUserHTTP := commandrest.NewRegisterUserHTTPHandler(deps.RegisterUserCmd)
 	loginUserHTTP := commandrest.NewLoginUserHTTPHandler(deps.LoginUserCmd)
@@ -69,15 +97,34 @@ func buildRouter(deps *bootstrap.Dependencies) *gin.Engine {
 	// Create auth client adapter for middleware
 	authClientAdapter := middleware.NewAuthGRPCClientAdapter(deps.AuthGRPCClient)
 
+	// Per-IP limit of the login and register routes
+	authRateLimit := middleware.RateLimiterConfig{
+		Requests: config.GetEnvInt("GATEWAY_AUTH_RATE_LIMIT_PER_MINUTE", 5),
+		Window:   time.Minute,
+	}
+
 	return httpserver.NewRouter(
 		registerUserHTTP,
 		loginUserHTTP,
 		createMessageHTTP,
 		getUserProfileHTTP,
 		authClientAdapter,
+		authRateLimit,
+		trustedProxies(),
 	)
 }
 
+// trustedProxies parses GATEWAY_TRUSTED_PROXIES; none by default, so the client IP is the peer address
+func trustedProxies() []string {
+	var proxies []string
+	for _, proxy := range strings.Split(config.GetEnv("GATEWAY_TRUSTED_PROXIES", ""), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			proxies = append(proxies, proxy)
+		}
+	}
+	return proxies
+}
+
 // cleanup closes all resources
 func cleanup(deps *bootstrap.Dependencies) {
 	if deps.ChatClient != nil {
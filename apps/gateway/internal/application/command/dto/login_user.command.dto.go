@@ -1,11 +1,17 @@
 package dto
 
 type LoginUserCommandRequest struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	UserAgent string
+	IPAddress string
 }
 
 type LoginUserCommandResponse struct {
-	UserID string
-	Token  string
+	UserID       string
+	AccessToken  string
+	RefreshToken string
+	ExpiresIn    int64 // seconds
+	MFARequired  bool
+	MFAToken     string
 }
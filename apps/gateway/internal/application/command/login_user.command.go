@@ -17,7 +17,7 @@ type loginUserCommand struct {
 }
 
 type authLoginClient interface {
-	Login(ctx context.Context, req auth.LoginRequest) (auth.LoginResponse, error)
+	Login(ctx context.Context, req auth.LoginRequest, userAgent, ipAddress string) (auth.LoginResponse, error)
 }
 
 func NewLoginUserCommand(client authLoginClient) contracts.LoginUserCommand {
@@ -31,7 +31,7 @@ func (c *loginUserCommand) Handle(ctx context.Context, req dto.LoginUserCommandR
 	resp, err := c.authClient.Login(ctx, auth.LoginRequest{
 		Email:    req.Email,
 		Password: req.Password,
-	})
+	}, req.UserAgent, req.IPAddress)
 	if err != nil {
 		c.log.Error().
 			Err(err).
@@ -42,10 +42,15 @@ func (c *loginUserCommand) Handle(ctx context.Context, req dto.LoginUserCommandR
 
 	c.log.Info().
 		Str("user_id", resp.UserID).
+		Bool("mfa_required", resp.MFARequired).
 		Msg("user login successful")
 
 	return dto.LoginUserCommandResponse{
-		UserID: resp.UserID,
-		Token:  resp.Token,
+		UserID:       resp.UserID,
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		ExpiresIn:    resp.ExpiresIn,
+		MFARequired:  resp.MFARequired,
+		MFAToken:     resp.MFAToken,
 	}, nil
 }
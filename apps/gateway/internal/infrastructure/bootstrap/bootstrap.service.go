@@ -9,7 +9,6 @@ import (
 	commandcontracts "golang-social-media/apps/gateway/internal/application/command/contracts"
 	appquery "golang-social-media/apps/gateway/internal/application/query"
 	querycontracts "golang-social-media/apps/gateway/internal/application/query/contracts"
-	authclient "golang-social-media/apps/gateway/internal/infrastructure/auth"
 	authgrpc "golang-social-media/apps/gateway/internal/infrastructure/grpc/auth"
 	chatclient "golang-social-media/apps/gateway/internal/infrastructure/grpc/chat"
 	"golang-social-media/pkg/config"
@@ -21,7 +20,6 @@ import (
 // Dependencies holds all service dependencies
 type Dependencies struct {
 	ChatClient         *chatclient.Client
-	AuthClient         *authclient.Client
 	AuthGRPCClient     *authgrpc.Client
 	CreateMessageCmd   commandcontracts.CreateMessageCommand
 	RegisterUserCmd    commandcontracts.RegisterUserCommand
@@ -40,9 +38,7 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 		return nil, err
 	}
 
-	authClient := setupAuthClient()
-
-	// Setup auth gRPC client for token validation
+	// Setup auth gRPC client for token validation and the auth commands/queries
 	authGRPCClient, err := setupAuthGRPCClient(ctx)
 	if err != nil {
 		return nil, err
@@ -50,11 +46,11 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 
 	// Setup commands
 	createMessageCmd := appcommand.NewCreateMessageCommand(chatClient)
-	registerUserCmd := appcommand.NewRegisterUserCommand(authClient)
-	loginUserCmd := appcommand.NewLoginUserCommand(authClient)
+	registerUserCmd := appcommand.NewRegisterUserCommand(authGRPCClient)
+	loginUserCmd := appcommand.NewLoginUserCommand(authGRPCClient)
 
 	// Setup queries
-	getUserProfileQuery := appquery.NewGetUserProfileQuery(authClient)
+	getUserProfileQuery := appquery.NewGetUserProfileQuery(authGRPCClient)
 
 	logger.Component("gateway.bootstrap").
 		Info().
@@ -62,7 +58,6 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 
 	return &Dependencies{
 		ChatClient:         chatClient,
-		AuthClient:         authClient,
 		AuthGRPCClient:     authGRPCClient,
 		CreateMessageCmd:   createMessageCmd,
 		RegisterUserCmd:    registerUserCmd,
@@ -109,18 +104,6 @@ func setupChatClient(ctx context.Context) (*chatclient.Client, error) {
 	return client, nil
 }
 
-func setupAuthClient() *authclient.Client {
-	authBaseURL := config.GetEnv("AUTH_SERVICE_URL", "http://localhost:9101")
-	client := authclient.NewClient(authBaseURL)
-
-	logger.Component("gateway.bootstrap").
-		Info().
-		Str("base_url", authBaseURL).
-		Msg("auth service HTTP client initialized")
-
-	return client
-}
-
 func setupAuthGRPCClient(ctx context.Context) (*authgrpc.Client, error) {
 	client, err := authgrpc.NewClient(ctx)
 	if err != nil {
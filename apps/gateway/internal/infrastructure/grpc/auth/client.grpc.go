@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"golang-social-media/pkg/config"
+	"golang-social-media/pkg/contracts/auth"
 	authv1 "golang-social-media/pkg/gen/auth/v1"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -33,6 +35,7 @@ func NewClient(ctx context.Context) (*Client, error) {
 		addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
+		tracing.GRPCDialOption(),                // Propagate the trace of the HTTP request
 		grpc.WithInitialWindowSize(65535),       // Increase initial window size
 		grpc.WithInitialConnWindowSize(1048576), // 1MB initial connection window
 		grpc.WithDefaultCallOptions(
@@ -65,3 +68,102 @@ func (c *Client) ValidateToken(ctx context.Context, token string) (*authv1.Valid
 	return c.client.ValidateToken(ctx, req)
 }
 
+func (c *Client) ValidateAPIKey(ctx context.Context, apiKey string) (*authv1.ValidateAPIKeyResponse, error) {
+	return c.client.ValidateAPIKey(ctx, &authv1.ValidateAPIKeyRequest{ApiKey: apiKey})
+}
+
+func (c *Client) Register(ctx context.Context, req auth.RegisterRequest) (auth.RegisterResponse, error) {
+	resp, err := c.client.Register(ctx, &authv1.RegisterRequest{
+		Email:    req.Email,
+		Password: req.Password,
+		Name:     req.Name,
+	})
+	if err != nil {
+		return auth.RegisterResponse{}, err
+	}
+
+	user := resp.GetUser()
+	return auth.RegisterResponse{
+		ID:    user.GetId(),
+		Email: user.GetEmail(),
+		Name:  user.GetName(),
+	}, nil
+}
+
+// Login forwards the caller's user agent and IP so auth-service records them on the new session
+func (c *Client) Login(ctx context.Context, req auth.LoginRequest, userAgent, ipAddress string) (auth.LoginResponse, error) {
+	resp, err := c.client.Login(ctx, &authv1.LoginRequest{
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: userAgent,
+		IpAddress: ipAddress,
+	})
+	if err != nil {
+		return auth.LoginResponse{}, err
+	}
+
+	return auth.LoginResponse{
+		UserID:       resp.GetUserId(),
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(),
+		ExpiresIn:    resp.GetExpiresIn(),
+		MFARequired:  resp.GetMfaRequired(),
+		MFAToken:     resp.GetMfaToken(),
+	}, nil
+}
+
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (auth.RefreshTokenResponse, error) {
+	resp, err := c.client.Refresh(ctx, &authv1.RefreshRequest{RefreshToken: refreshToken})
+	if err != nil {
+		return auth.RefreshTokenResponse{}, err
+	}
+
+	return auth.RefreshTokenResponse{
+		AccessToken:  resp.GetAccessToken(),
+		RefreshToken: resp.GetRefreshToken(),
+		ExpiresIn:    resp.GetExpiresIn(),
+	}, nil
+}
+
+func (c *Client) GetProfile(ctx context.Context, userID string) (auth.ProfileResponse, error) {
+	resp, err := c.client.GetUser(ctx, &authv1.GetUserRequest{UserId: userID})
+	if err != nil {
+		return auth.ProfileResponse{}, err
+	}
+
+	user := resp.GetUser()
+	return auth.ProfileResponse{
+		ID:    user.GetId(),
+		Email: user.GetEmail(),
+		Name:  user.GetName(),
+	}, nil
+}
+
+func (c *Client) BatchGetProfiles(ctx context.Context, userIDs []string) ([]auth.ProfileResponse, error) {
+	resp, err := c.client.BatchGetUsers(ctx, &authv1.BatchGetUsersRequest{UserIds: userIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make([]auth.ProfileResponse, len(resp.GetUsers()))
+	for i, user := range resp.GetUsers() {
+		profiles[i] = auth.ProfileResponse{
+			ID:    user.GetId(),
+			Email: user.GetEmail(),
+			Name:  user.GetName(),
+		}
+	}
+	return profiles, nil
+}
+
+func (c *Client) CheckPermission(ctx context.Context, userID, resource, action string) (bool, error) {
+	resp, err := c.client.CheckPermission(ctx, &authv1.CheckPermissionRequest{
+		UserId:   userID,
+		Resource: resource,
+		Action:   action,
+	})
+	if err != nil {
+		return false, err
+	}
+	return resp.GetHasPermission(), nil
+}
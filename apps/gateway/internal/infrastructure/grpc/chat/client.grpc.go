@@ -7,6 +7,7 @@ import (
 	"golang-social-media/pkg/config"
 	chatv1 "golang-social-media/pkg/gen/chat/v1"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
@@ -33,6 +34,7 @@ func NewClient(ctx context.Context) (*Client, error) {
 		addr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
 		grpc.WithBlock(),
+		tracing.GRPCDialOption(),                // Propagate the trace of the HTTP request
 		grpc.WithInitialWindowSize(65535),       // Increase initial window size
 		grpc.WithInitialConnWindowSize(1048576), // 1MB initial connection window
 		grpc.WithDefaultCallOptions(
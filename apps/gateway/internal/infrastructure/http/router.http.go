@@ -6,6 +6,8 @@ import (
 	"golang-social-media/apps/gateway/internal/infrastructure/middleware"
 	commandcontracts "golang-social-media/apps/gateway/internal/interfaces/rest/command/contracts"
 	querycontracts "golang-social-media/apps/gateway/internal/interfaces/rest/query/contracts"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,24 +18,40 @@ func NewRouter(
 	createMessage commandcontracts.CreateMessageHTTPHandler,
 	getUserProfile querycontracts.GetUserProfileHTTPHandler,
 	authClient middleware.AuthClient,
-) *gin.Engine {
+	authRateLimit middleware.RateLimiterConfig,
+	trustedProxies []string,
+) (*gin.Engine, error) {
 	router := gin.New()
-	router.Use(gin.Logger(), gin.Recovery())
+	// Only these proxies may set the client IP through X-Forwarded-For, so the rate limit cannot be dodged by spoofing it
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, err
+	}
+	router.Use(tracing.GinMiddleware("gateway"), tracing.TraceIDMiddleware(), gin.Logger(), gin.Recovery(), metrics.HTTPMiddleware())
 
 	router.GET("/health", func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"status": "gateway OK"})
 	})
+	router.GET(metrics.Path, metrics.GinHandler())
 
 	// Public routes (no auth required)
 	authGroup := router.Group("/auth")
-	registerUser.Mount(authGroup)
-	loginUser.Mount(authGroup)
 	getUserProfile.Mount(authGroup)
 
-	// Protected routes (JWT required - validated via auth service gRPC)
+	// Credential routes are limited per client IP: they reach auth-service over gRPC,
+	// which skips the per-IP limiter of its REST API
+	credentialGroup := authGroup.Group("")
+	credentialGroup.Use(middleware.RateLimiterMiddleware(authRateLimit))
+	registerUser.Mount(credentialGroup)
+	loginUser.Mount(credentialGroup)
+
+	// Protected routes (JWT or API key required - validated via auth service gRPC)
 	apiGroup := router.Group("")
 	apiGroup.Use(middleware.JWTAuthMiddleware(authClient))
-	createMessage.Mount(apiGroup)
 
-	return router
+	// Sending messages with an API key needs the chat:write scope
+	chatWriteGroup := apiGroup.Group("")
+	chatWriteGroup.Use(middleware.RequireScope("chat", "write"))
+	createMessage.Mount(chatWriteGroup)
+
+	return router, nil
 }
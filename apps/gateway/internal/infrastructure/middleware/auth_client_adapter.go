@@ -1,11 +1,9 @@
 package middleware
 
 import (
-	"context"
 
 	"github.com/gin-gonic/gin"
 	authgrpc "golang-social-media/apps/gateway/internal/infrastructure/grpc/auth"
-	authv1 "golang-social-media/pkg/gen/auth/v1"
 )
 
 // AuthGRPCClientAdapter adapts auth gRPC client to AuthClient interface
@@ -19,7 +17,7 @@ func NewAuthGRPCClientAdapter(client *authgrpc.Client) *AuthGRPCClientAdapter {
 	}
 }
 
-func (a *AuthGRPCClientAdapter) ValidateToken(c gin.Context, token string) (userID string, valid bool, err error) {
+func (a *AuthGRPCClientAdapter) ValidateToken(c *gin.Context, token string) (userID string, valid bool, err error) {
 	ctx := c.Request.Context()
 	resp, err := a.client.ValidateToken(ctx, token)
 	if err != nil {
@@ -28,3 +26,10 @@ func (a *AuthGRPCClientAdapter) ValidateToken(c gin.Context, token string) (user
 	return resp.GetUserId(), resp.GetValid(), nil
 }
 
+func (a *AuthGRPCClientAdapter) ValidateAPIKey(c *gin.Context, apiKey string) (userID string, scopes []string, valid bool, err error) {
+	resp, err := a.client.ValidateAPIKey(c.Request.Context(), apiKey)
+	if err != nil {
+		return "", nil, false, err
+	}
+	return resp.GetUserId(), resp.GetScopes(), resp.GetValid(), nil
+}
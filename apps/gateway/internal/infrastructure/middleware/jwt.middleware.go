@@ -11,18 +11,28 @@ import (
 
 const (
 	UserIDKey = "user_id"
+	// APIKeyHeader carries an API key as an alternative to a bearer JWT
+	APIKeyHeader = "X-API-Key"
+	// APIKeyScopesKey holds the []string scopes ("resource:action") of the API key the request was authenticated with
+	APIKeyScopesKey = "api_key_scopes"
 )
 
 // AuthClient interface for validating tokens
 type AuthClient interface {
-	ValidateToken(ctx gin.Context, token string) (userID string, valid bool, err error)
+	ValidateToken(c *gin.Context, token string) (userID string, valid bool, err error)
+	ValidateAPIKey(c *gin.Context, apiKey string) (userID string, scopes []string, valid bool, err error)
 }
 
-// JWTAuthMiddleware validates JWT token via auth service gRPC and extracts user ID
+// JWTAuthMiddleware validates the JWT token, or the X-API-Key header when no Authorization header is sent,
+// via auth service gRPC and extracts user ID
 func JWTAuthMiddleware(authClient AuthClient) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Get token from Authorization header
 		authHeader := c.GetHeader("Authorization")
+		if authHeader == "" && c.GetHeader(APIKeyHeader) != "" {
+			authenticateWithAPIKey(c, authClient, c.GetHeader(APIKeyHeader))
+			return
+		}
 		if authHeader == "" {
 			logger.Component("gateway.middleware.jwt").
 				Warn().
@@ -87,3 +97,37 @@ func JWTAuthMiddleware(authClient AuthClient) gin.HandlerFunc {
 	}
 }
 
+// authenticateWithAPIKey validates the API key via auth service gRPC and sets its owner as the user;
+// RequireScope limits the request to the key's scopes
+func authenticateWithAPIKey(c *gin.Context, authClient AuthClient, apiKey string) {
+	userID, scopes, valid, err := authClient.ValidateAPIKey(c, apiKey)
+	if err != nil {
+		logger.Component("gateway.middleware.jwt").
+			Error().
+			Err(err).
+			Msg("failed to validate API key with auth service")
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "authentication service unavailable"})
+		c.Abort()
+		return
+	}
+
+	if !valid || userID == "" {
+		logger.Component("gateway.middleware.jwt").
+			Warn().
+			Msg("invalid API key")
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid API key"})
+		c.Abort()
+		return
+	}
+
+	c.Set(UserIDKey, userID)
+	c.Set(APIKeyScopesKey, scopes)
+
+	logger.Component("gateway.middleware.jwt").
+		Debug().
+		Str("user_id", userID).
+		Strs("scopes", scopes).
+		Msg("API key authentication successful")
+
+	c.Next()
+}
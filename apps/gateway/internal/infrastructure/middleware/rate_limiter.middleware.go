@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang-social-media/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RateLimiterConfig configures RateLimiterMiddleware
+type RateLimiterConfig struct {
+	// Requests allowed per client IP and window
+	Requests int
+	// Window is the fixed time window the requests are counted in
+	Window time.Duration
+}
+
+// rateLimitWindow counts the requests of one client IP in the current window
+type rateLimitWindow struct {
+	count   int
+	resetAt time.Time
+}
+
+// RateLimiterMiddleware limits the requests per client IP (c.ClientIP, so only X-Forwarded-For
+// headers set by trusted proxies count) with a fixed window kept in memory by each gateway replica
+func RateLimiterMiddleware(config RateLimiterConfig) gin.HandlerFunc {
+	var (
+		mu        sync.Mutex
+		windows   = make(map[string]*rateLimitWindow)
+		nextSweep = time.Now().Add(config.Window)
+	)
+
+	return func(c *gin.Context) {
+		ip := c.ClientIP()
+		now := time.Now()
+
+		mu.Lock()
+		// Forget the windows of clients that went away, so the map does not grow without bound
+		if now.After(nextSweep) {
+			for key, window := range windows {
+				if now.After(window.resetAt) {
+					delete(windows, key)
+				}
+			}
+			nextSweep = now.Add(config.Window)
+		}
+
+		window, ok := windows[ip]
+		if !ok || now.After(window.resetAt) {
+			window = &rateLimitWindow{resetAt: now.Add(config.Window)}
+			windows[ip] = window
+		}
+		window.count++
+		count, resetAt := window.count, window.resetAt
+		mu.Unlock()
+
+		c.Header("X-RateLimit-Limit", strconv.Itoa(config.Requests))
+		c.Header("X-RateLimit-Reset", strconv.FormatInt(resetAt.Unix(), 10))
+
+		if count > config.Requests {
+			logger.Component("gateway.middleware.rate_limiter").
+				Warn().
+				Str("ip", ip).
+				Str("path", c.FullPath()).
+				Int("limit", config.Requests).
+				Msg("rate limit exceeded")
+			c.Header("X-RateLimit-Remaining", "0")
+			c.Header("Retry-After", strconv.Itoa(int(time.Until(resetAt).Seconds())+1))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "too many requests, please try again later"})
+			c.Abort()
+			return
+		}
+
+		c.Header("X-RateLimit-Remaining", strconv.Itoa(config.Requests-count))
+		c.Next()
+	}
+}
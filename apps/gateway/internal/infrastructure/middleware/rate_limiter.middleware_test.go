@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func newRateLimitedRouter(config RateLimiterConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.POST("/auth/login", RateLimiterMiddleware(config), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return router
+}
+
+func postLogin(router *gin.Engine, remoteAddr string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/auth/login", nil)
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestRateLimiterMiddleware_LimitsRequestsPerWindow(t *testing.T) {
+	router := newRateLimitedRouter(RateLimiterConfig{Requests: 2, Window: time.Minute})
+
+	for i, wantRemaining := range []string{"1", "0"} {
+		rec := postLogin(router, "10.0.0.1:1234")
+		if rec.Code != http.StatusOK {
+			t.Fatalf("request %d: status = %d, want %d", i+1, rec.Code, http.StatusOK)
+		}
+		if got := rec.Header().Get("X-RateLimit-Remaining"); got != wantRemaining {
+			t.Errorf("request %d: X-RateLimit-Remaining = %q, want %q", i+1, got, wantRemaining)
+		}
+		if got := rec.Header().Get("X-RateLimit-Limit"); got != "2" {
+			t.Errorf("request %d: X-RateLimit-Limit = %q, want 2", i+1, got)
+		}
+	}
+
+	rec := postLogin(router, "10.0.0.1:1234")
+	if rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+	if got := rec.Header().Get("X-RateLimit-Remaining"); got != "0" {
+		t.Errorf("X-RateLimit-Remaining = %q, want 0", got)
+	}
+	if rec.Header().Get("Retry-After") == "" {
+		t.Error("Retry-After should be set when the limit is exceeded")
+	}
+}
+
+func TestRateLimiterMiddleware_CountsEachClientIPSeparately(t *testing.T) {
+	router := newRateLimitedRouter(RateLimiterConfig{Requests: 1, Window: time.Minute})
+
+	if rec := postLogin(router, "10.0.0.1:1234"); rec.Code != http.StatusOK {
+		t.Fatalf("first client: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := postLogin(router, "10.0.0.2:1234"); rec.Code != http.StatusOK {
+		t.Errorf("second client: status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := postLogin(router, "10.0.0.1:5678"); rec.Code != http.StatusTooManyRequests {
+		t.Errorf("first client from another port: status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+}
+
+func TestRateLimiterMiddleware_ResetsAfterWindow(t *testing.T) {
+	window := 50 * time.Millisecond
+	router := newRateLimitedRouter(RateLimiterConfig{Requests: 1, Window: window})
+
+	if rec := postLogin(router, "10.0.0.1:1234"); rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusOK)
+	}
+	if rec := postLogin(router, "10.0.0.1:1234"); rec.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want %d", rec.Code, http.StatusTooManyRequests)
+	}
+
+	time.Sleep(2 * window)
+
+	if rec := postLogin(router, "10.0.0.1:1234"); rec.Code != http.StatusOK {
+		t.Errorf("status after the window = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"golang-social-media/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// scopeWildcard matches any resource or action in an API key scope, as in auth-service grants
+const scopeWildcard = "*"
+
+// RequireScope rejects requests authenticated with an API key whose scopes do not cover resource:action.
+// Requests authenticated with a JWT carry the full rights of the user and pass. Must run after JWTAuthMiddleware.
+func RequireScope(resource, action string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		value, ok := c.Get(APIKeyScopesKey)
+		if !ok {
+			c.Next()
+			return
+		}
+
+		scopes, _ := value.([]string)
+		if !scopesCover(scopes, resource, action) {
+			logger.Component("gateway.middleware.scope").
+				Warn().
+				Str("user_id", c.GetString(UserIDKey)).
+				Str("required_scope", resource+":"+action).
+				Strs("scopes", scopes).
+				Msg("API key scope missing")
+			c.JSON(http.StatusForbidden, gin.H{"error": "API key is missing the " + resource + ":" + action + " scope"})
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// scopesCover reports whether any "resource:action" scope, possibly with wildcards, covers resource and action
+func scopesCover(scopes []string, resource, action string) bool {
+	for _, scope := range scopes {
+		scopeResource, scopeAction, ok := strings.Cut(scope, ":")
+		if !ok {
+			continue
+		}
+		if (scopeResource == scopeWildcard || scopeResource == resource) &&
+			(scopeAction == scopeWildcard || scopeAction == action) {
+			return true
+		}
+	}
+	return false
+}
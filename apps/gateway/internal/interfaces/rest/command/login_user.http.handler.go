@@ -36,8 +36,10 @@ func (h *loginUserHTTPHandler) handle(c *gin.Context) {
 	}
 
 	resp, err := h.command.Handle(c.Request.Context(), appdto.LoginUserCommandRequest{
-		Email:    req.Email,
-		Password: req.Password,
+		Email:     req.Email,
+		Password:  req.Password,
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
 	})
 	if err != nil {
 		logger.Component("gateway.http.login_user").
@@ -49,8 +51,20 @@ func (h *loginUserHTTPHandler) handle(c *gin.Context) {
 		return
 	}
 
+	// An MFA challenge carries only the challenge token; tokens are issued after POST /auth/login/mfa on auth-service
+	if resp.MFARequired {
+		c.JSON(http.StatusOK, gin.H{
+			"userId":      resp.UserID,
+			"mfaRequired": true,
+			"mfaToken":    resp.MFAToken,
+		})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"userId": resp.UserID,
-		"token":  resp.Token,
+		"userId":       resp.UserID,
+		"accessToken":  resp.AccessToken,
+		"refreshToken": resp.RefreshToken,
+		"expiresIn":    resp.ExpiresIn,
 	})
 }
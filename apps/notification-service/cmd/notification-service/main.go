@@ -12,6 +12,8 @@ import (
 	interfaces "golang-social-media/apps/notification-service/internal/interfaces/grpc"
 	"golang-social-media/pkg/config"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 
 	"google.golang.org/grpc"
 )
@@ -23,6 +25,24 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Setup tracing first so the clients created by the bootstrap report to the configured exporter
+	shutdownTracing, err := tracing.Init(ctx, "notification-service")
+	if err != nil {
+		logger.Component("notification.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to setup tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Component("notification.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to flush traces")
+		}
+	}()
+
 	// Setup all dependencies
 	deps, err := bootstrap.SetupDependencies(ctx)
 	if err != nil {
@@ -41,6 +61,9 @@ func main() {
 	// Start event subscribers in background (non-blocking)
 	startSubscribers(ctx, deps)
 
+	// Expose Prometheus metrics on their own port, the service itself only speaks gRPC
+	go serveMetrics(ctx)
+
 	// Start gRPC server
 	port := config.GetEnvInt("NOTIFICATION_SERVICE_PORT", 9100)
 	addr := fmt.Sprintf(":%d", port)
@@ -56,10 +79,33 @@ func main() {
 	}
 }
 
+// serveMetrics exposes /metrics for Prometheus until ctx is cancelled
+func serveMetrics(ctx context.Context) {
+	addr := fmt.Sprintf(":%d", config.GetEnvInt("NOTIFICATION_METRICS_PORT", 9102))
+
+	logger.Component("notification.metrics").
+		Info().
+		Str("addr", addr).
+		Msg("metrics server starting")
+
+	if err := metrics.Serve(ctx, addr); err != nil {
+		logger.Component("notification.metrics").
+			Error().
+			Err(err).
+			Msg("metrics server stopped")
+	}
+}
+
 // startSubscribers starts all event subscribers
 func startSubscribers(ctx context.Context, deps *bootstrap.Dependencies) {
 	go deps.ChatSubscriber.Consume(ctx)
 	go deps.UserSubscriber.Consume(ctx)
+	go deps.UserProfileSubscriber.Consume(ctx)
+	go deps.UserDeletedSubscriber.Consume(ctx)
+	go deps.UserExportSubscriber.Consume(ctx)
+	go deps.ConversationSubscriber.Consume(ctx)
+	go deps.ChatMessageChangesSubscriber.Consume(ctx)
+	go deps.ChatMentionSubscriber.Consume(ctx)
 }
 
 // cleanup closes all resources
@@ -94,4 +140,58 @@ func cleanup(deps *bootstrap.Dependencies) {
 				Msg("failed to close user subscriber")
 		}
 	}
+
+	if deps.UserProfileSubscriber != nil {
+		if err := deps.UserProfileSubscriber.Close(); err != nil {
+			logger.Component("notification.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user profile subscriber")
+		}
+	}
+
+	if deps.UserDeletedSubscriber != nil {
+		if err := deps.UserDeletedSubscriber.Close(); err != nil {
+			logger.Component("notification.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user deleted subscriber")
+		}
+	}
+
+	if deps.UserExportSubscriber != nil {
+		if err := deps.UserExportSubscriber.Close(); err != nil {
+			logger.Component("notification.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close user export subscriber")
+		}
+	}
+
+	if deps.ConversationSubscriber != nil {
+		if err := deps.ConversationSubscriber.Close(); err != nil {
+			logger.Component("notification.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close conversation subscriber")
+		}
+	}
+
+	if deps.ChatMessageChangesSubscriber != nil {
+		if err := deps.ChatMessageChangesSubscriber.Close(); err != nil {
+			logger.Component("notification.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close chat message changes subscriber")
+		}
+	}
+
+	if deps.ChatMentionSubscriber != nil {
+		if err := deps.ChatMentionSubscriber.Close(); err != nil {
+			logger.Component("notification.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close chat mention subscriber")
+		}
+	}
 }
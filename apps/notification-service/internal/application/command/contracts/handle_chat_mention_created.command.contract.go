@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleChatMentionCreatedCommand handles ChatMentionCreated events
+type HandleChatMentionCreatedCommand interface {
+	Execute(ctx context.Context, event events.ChatMentionCreated) error
+}
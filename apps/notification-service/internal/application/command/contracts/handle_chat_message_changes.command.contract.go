@@ -0,0 +1,14 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleChatMessageChangesCommand keeps the chat message notifications in line with the edits
+// and deletions of their message
+type HandleChatMessageChangesCommand interface {
+	ExecuteEdited(ctx context.Context, event events.ChatMessageEdited) error
+	ExecuteDeleted(ctx context.Context, event events.ChatMessageDeleted) error
+}
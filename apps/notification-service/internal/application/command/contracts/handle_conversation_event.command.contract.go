@@ -0,0 +1,14 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleConversationEventCommand notifies users added to, or removed from, a group conversation
+type HandleConversationEventCommand interface {
+	ExecuteCreated(ctx context.Context, event events.ConversationCreated) error
+	// ExecuteMemberChanged handles an event of one of the conversation.member.* topics
+	ExecuteMemberChanged(ctx context.Context, topic string, event events.ConversationMemberChanged) error
+}
@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleUserExportRequestedCommand handles UserExportRequested events (GDPR data export saga)
+type HandleUserExportRequestedCommand interface {
+	Execute(ctx context.Context, event events.UserExportRequested) error
+}
@@ -0,0 +1,12 @@
+package contracts
+
+import (
+	"context"
+
+	"golang-social-media/pkg/events"
+)
+
+// HandleUserProfileUpdatedCommand handles UserProfileUpdated events
+type HandleUserProfileUpdatedCommand interface {
+	Execute(ctx context.Context, event events.UserProfileUpdated) error
+}
@@ -56,7 +56,7 @@ func (c *createNotificationCommand) Handle(ctx context.Context, req dto.CreateNo
 
 	// Validate business rules before persisting or publishing
 	if err := notificationModel.Validate(); err != nil {
-		c.log.Error().
+		c.log.Error().Ctx(ctx).
 			Err(err).
 			Str("user_id", req.UserID).
 			Str("type", string(req.Type)).
@@ -69,7 +69,7 @@ func (c *createNotificationCommand) Handle(ctx context.Context, req dto.CreateNo
 
 	// Persist to database
 	if err := c.repo.Insert(ctx, notificationModel); err != nil {
-		c.log.Error().
+		c.log.Error().Ctx(ctx).
 			Err(err).
 			Str("user_id", req.UserID).
 			Str("type", string(req.Type)).
@@ -85,7 +85,7 @@ func (c *createNotificationCommand) Handle(ctx context.Context, req dto.CreateNo
 		if err := c.eventDispatcher.Dispatch(ctx, domainEvent); err != nil {
 			// Log error but don't fail the command
 			// Events can be retried via outbox pattern in production
-			c.log.Error().
+			c.log.Error().Ctx(ctx).
 				Err(err).
 				Str("event_type", domainEvent.Type()).
 				Str("notification_id", notificationModel.ID.String()).
@@ -93,7 +93,7 @@ func (c *createNotificationCommand) Handle(ctx context.Context, req dto.CreateNo
 		}
 	}
 
-	c.log.Info().
+	c.log.Info().Ctx(ctx).
 		Str("notification_id", notificationModel.ID.String()).
 		Str("user_id", notificationModel.UserID).
 		Msg("notification created")
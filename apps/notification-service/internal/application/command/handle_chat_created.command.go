@@ -2,6 +2,7 @@ package command
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -26,20 +27,40 @@ func NewHandleChatCreatedCommand(createNotificationCmd contracts.CreateNotificat
 	}
 }
 
+// Execute notifies every recipient of the message, each member of the conversation but the sender
 func (c *HandleChatCreatedCommandHandler) Execute(ctx context.Context, event events.ChatCreated) error {
-	_, err := c.createNotificationCmd.Execute(ctx, dto.CreateNotificationCommandRequest{
-		UserID: event.Message.ReceiverID,
-		Type:   domainnotification.TypeChatMessage,
-		Title:  "Tin nhắn mới",
-		Body:   "New chat message from " + event.Message.SenderID,
-		Time:   time.Now().UTC(),
-		Metadata: map[string]string{
-			"senderId":   event.Message.SenderID,
-			"messageId":  event.Message.ID,
-			"content":    event.Message.Content,
-			"receiverId": event.Message.ReceiverID,
-		},
-	})
-	return err
+	recipientIDs := event.Message.RecipientIDs
+	if len(recipientIDs) == 0 && event.Message.ReceiverID != "" {
+		// Published before conversations carried their recipients
+		recipientIDs = []string{event.Message.ReceiverID}
+	}
+
+	var errs []error
+	now := time.Now().UTC()
+	for _, recipientID := range recipientIDs {
+		_, err := c.createNotificationCmd.Execute(ctx, dto.CreateNotificationCommandRequest{
+			UserID: recipientID,
+			Type:   domainnotification.TypeChatMessage,
+			Title:  "Tin nhắn mới",
+			Body:   "New chat message from " + event.Message.SenderID,
+			Time:   now,
+			Metadata: map[string]string{
+				"senderId":       event.Message.SenderID,
+				"messageId":      event.Message.ID,
+				"conversationId": event.Message.ConversationID,
+				"content":        event.Message.Content,
+				"receiverId":     recipientID,
+			},
+		})
+		if err != nil {
+			c.log.Error().Ctx(ctx).
+				Err(err).
+				Str("message_id", event.Message.ID).
+				Str("recipient_id", recipientID).
+				Msg("failed to notify chat message recipient")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
 }
 
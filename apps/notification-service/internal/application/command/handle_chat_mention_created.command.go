@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/notification-service/internal/application/command/contracts"
+	"golang-social-media/apps/notification-service/internal/application/command/dto"
+	domainnotification "golang-social-media/apps/notification-service/internal/domain/notification"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.HandleChatMentionCreatedCommand = (*HandleChatMentionCreatedCommandHandler)(nil)
+
+type HandleChatMentionCreatedCommandHandler struct {
+	createNotificationCmd contracts.CreateNotificationCommand
+	log                   *zerolog.Logger
+}
+
+func NewHandleChatMentionCreatedCommand(createNotificationCmd contracts.CreateNotificationCommand) *HandleChatMentionCreatedCommandHandler {
+	return &HandleChatMentionCreatedCommandHandler{
+		createNotificationCmd: createNotificationCmd,
+		log:                   logger.Component("notification.command.handle_chat_mention_created"),
+	}
+}
+
+// Execute creates a high priority mention notification for every member the message mentions
+func (c *HandleChatMentionCreatedCommandHandler) Execute(ctx context.Context, event events.ChatMentionCreated) error {
+	var errs []error
+	now := time.Now().UTC()
+	for _, userID := range event.MentionedUserIDs {
+		metadata := map[string]string{
+			"senderId":       event.SenderID,
+			"messageId":      event.MessageID,
+			"conversationId": event.ConversationID,
+			"content":        event.Content,
+		}
+		if event.ParentMessageID != "" {
+			metadata["parentMessageId"] = event.ParentMessageID
+		}
+
+		_, err := c.createNotificationCmd.Execute(ctx, dto.CreateNotificationCommandRequest{
+			UserID:   userID,
+			Type:     domainnotification.TypeMention,
+			Title:    "Bạn được nhắc đến",
+			Body:     "Mentioned by " + event.SenderID + " in a chat message",
+			Time:     now,
+			Metadata: metadata,
+		})
+		if err != nil {
+			c.log.Error().Ctx(ctx).
+				Err(err).
+				Str("message_id", event.MessageID).
+				Str("user_id", userID).
+				Msg("failed to notify mentioned user")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/notification-service/internal/application/command/contracts"
+	domainnotification "golang-social-media/apps/notification-service/internal/domain/notification"
+	scyllarepo "golang-social-media/apps/notification-service/internal/infrastructure/persistence/scylla"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.HandleChatMessageChangesCommand = (*HandleChatMessageChangesCommandHandler)(nil)
+
+// recentNotificationsScanned bounds the lookup of the notification of a message. Metadata is
+// not indexed, and a message changed after this many newer notifications is not worth a full scan
+const recentNotificationsScanned = 200
+
+type HandleChatMessageChangesCommandHandler struct {
+	notificationRepo *scyllarepo.NotificationRepository
+	log              *zerolog.Logger
+}
+
+func NewHandleChatMessageChangesCommand(notificationRepo *scyllarepo.NotificationRepository) *HandleChatMessageChangesCommandHandler {
+	return &HandleChatMessageChangesCommandHandler{
+		notificationRepo: notificationRepo,
+		log:              logger.Component("notification.command.handle_chat_message_changes"),
+	}
+}
+
+// ExecuteEdited replaces the content shown by the notification of every recipient
+func (c *HandleChatMessageChangesCommandHandler) ExecuteEdited(ctx context.Context, event events.ChatMessageEdited) error {
+	return c.update(ctx, event.MessageID, event.RecipientIDs, map[string]string{
+		"content":  event.Content,
+		"editedAt": event.EditedAt.Format(time.RFC3339),
+	})
+}
+
+// ExecuteDeleted erases the content of a message deleted for everyone from the notifications
+func (c *HandleChatMessageChangesCommandHandler) ExecuteDeleted(ctx context.Context, event events.ChatMessageDeleted) error {
+	return c.update(ctx, event.MessageID, event.RecipientIDs, map[string]string{
+		"content":   "",
+		"deletedAt": event.DeletedAt.Format(time.RFC3339),
+	})
+}
+
+func (c *HandleChatMessageChangesCommandHandler) update(ctx context.Context, messageID string, recipientIDs []string, entries map[string]string) error {
+	var errs []error
+	for _, recipientID := range recipientIDs {
+		if err := c.updateRecipient(ctx, recipientID, messageID, entries); err != nil {
+			c.log.Error().Ctx(ctx).
+				Err(err).
+				Str("message_id", messageID).
+				Str("recipient_id", recipientID).
+				Msg("failed to update chat message notification")
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *HandleChatMessageChangesCommandHandler) updateRecipient(ctx context.Context, recipientID, messageID string, entries map[string]string) error {
+	notifications, err := c.notificationRepo.ListByUser(ctx, recipientID, recentNotificationsScanned)
+	if err != nil {
+		return err
+	}
+
+	// A mentioned recipient has a mention notification besides the chat message one
+	updated := 0
+	for _, n := range notifications {
+		if (n.Type != domainnotification.TypeChatMessage && n.Type != domainnotification.TypeMention) || n.Metadata["messageId"] != messageID {
+			continue
+		}
+		if err := c.notificationRepo.UpdateMetadata(ctx, n, entries); err != nil {
+			return err
+		}
+		updated++
+	}
+
+	if updated == 0 {
+		c.log.Debug().Ctx(ctx).
+			Str("message_id", messageID).
+			Str("recipient_id", recipientID).
+			Msg("no recent notification for changed chat message")
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package command
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/notification-service/internal/application/command/contracts"
+	"golang-social-media/apps/notification-service/internal/application/command/dto"
+	domainnotification "golang-social-media/apps/notification-service/internal/domain/notification"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.HandleConversationEventCommand = (*HandleConversationEventCommandHandler)(nil)
+
+type HandleConversationEventCommandHandler struct {
+	createNotificationCmd contracts.CreateNotificationCommand
+	log                   *zerolog.Logger
+}
+
+func NewHandleConversationEventCommand(createNotificationCmd contracts.CreateNotificationCommand) *HandleConversationEventCommandHandler {
+	return &HandleConversationEventCommandHandler{
+		createNotificationCmd: createNotificationCmd,
+		log:                   logger.Component("notification.command.handle_conversation_event"),
+	}
+}
+
+// ExecuteCreated notifies the members of a new group, but its creator.
+// Direct conversations are announced by the notification of their first message
+func (c *HandleConversationEventCommandHandler) ExecuteCreated(ctx context.Context, event events.ConversationCreated) error {
+	conv := event.Conversation
+	if conv.Kind != events.ConversationKindGroup {
+		return nil
+	}
+
+	var errs []error
+	for _, memberID := range conv.MemberIDs() {
+		if memberID == conv.CreatedBy {
+			continue
+		}
+		if err := c.notify(ctx, memberID, conv, conv.CreatedBy, "Nhóm chat mới", conv.CreatedBy+" added you to "+conv.Title); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ExecuteMemberChanged notifies a user added to or removed from a group by someone else
+func (c *HandleConversationEventCommandHandler) ExecuteMemberChanged(ctx context.Context, topic string, event events.ConversationMemberChanged) error {
+	if event.ActorID == event.UserID {
+		return nil
+	}
+
+	conv := event.Conversation
+	switch topic {
+	case events.TopicConversationMemberJoined:
+		return c.notify(ctx, event.UserID, conv, event.ActorID, "Bạn đã được thêm vào nhóm", event.ActorID+" added you to "+conv.Title)
+	case events.TopicConversationMemberRemoved:
+		return c.notify(ctx, event.UserID, conv, event.ActorID, "Bạn đã bị xoá khỏi nhóm", event.ActorID+" removed you from "+conv.Title)
+	default:
+		return nil
+	}
+}
+
+func (c *HandleConversationEventCommandHandler) notify(ctx context.Context, userID string, conv events.Conversation, actorID, title, body string) error {
+	_, err := c.createNotificationCmd.Execute(ctx, dto.CreateNotificationCommandRequest{
+		UserID: userID,
+		Type:   domainnotification.TypeConversation,
+		Title:  title,
+		Body:   body,
+		Time:   conv.UpdatedAt,
+		Metadata: map[string]string{
+			"conversationId": conv.ID,
+			"title":          conv.Title,
+			"avatarUrl":      conv.AvatarURL,
+			"actorId":        actorID,
+		},
+	})
+	if err != nil {
+		c.log.Error().Ctx(ctx).
+			Err(err).
+			Str("conversation_id", conv.ID).
+			Str("user_id", userID).
+			Msg("failed to create conversation notification")
+	}
+	return err
+}
@@ -0,0 +1,73 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/notification-service/internal/application/command/contracts"
+	eventhandlercontracts "golang-social-media/apps/notification-service/internal/application/event_handler/contracts"
+	scyllarepo "golang-social-media/apps/notification-service/internal/infrastructure/persistence/scylla"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.HandleUserDeletedCommand = (*HandleUserDeletedCommandHandler)(nil)
+
+type HandleUserDeletedCommandHandler struct {
+	userRepo         *scyllarepo.UserRepository
+	notificationRepo *scyllarepo.NotificationRepository
+	eventBroker      eventhandlercontracts.EventBrokerPublisher
+	log              *zerolog.Logger
+}
+
+func NewHandleUserDeletedCommand(
+	userRepo *scyllarepo.UserRepository,
+	notificationRepo *scyllarepo.NotificationRepository,
+	eventBroker eventhandlercontracts.EventBrokerPublisher,
+) *HandleUserDeletedCommandHandler {
+	return &HandleUserDeletedCommandHandler{
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+		eventBroker:      eventBroker,
+		log:              logger.Component("notification.command.handle_user_deleted"),
+	}
+}
+
+// Execute erases the notifications and the replicated user. Notifications only exist for
+// their recipient, so they are deleted rather than anonymized
+func (c *HandleUserDeletedCommandHandler) Execute(ctx context.Context, event events.UserDeleted) error {
+	deleted, err := c.notificationRepo.DeleteByUser(ctx, event.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to delete notifications of deleted user")
+		return err
+	}
+
+	if err := c.userRepo.Delete(ctx, event.UserID); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to delete replicated user")
+		return err
+	}
+
+	if err := c.eventBroker.PublishUserDataErased(ctx, eventhandlercontracts.UserDataErasedPayload{
+		RequestID: event.RequestID,
+		UserID:    event.UserID,
+		Records:   deleted + 1,
+		ErasedAt:  time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", event.UserID).
+		Str("request_id", event.RequestID).
+		Int64("deleted_notifications", deleted).
+		Msg("notification data of deleted user erased")
+
+	return nil
+}
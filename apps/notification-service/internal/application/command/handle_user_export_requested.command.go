@@ -0,0 +1,125 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/notification-service/internal/application/command/contracts"
+	eventhandlercontracts "golang-social-media/apps/notification-service/internal/application/event_handler/contracts"
+	scyllarepo "golang-social-media/apps/notification-service/internal/infrastructure/persistence/scylla"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.HandleUserExportRequestedCommand = (*HandleUserExportRequestedCommandHandler)(nil)
+
+// notificationDataExport is the notification-service document of a data export
+type notificationDataExport struct {
+	User          *notificationExportUser  `json:"user"` // Nil when the user was never replicated
+	Notifications []notificationExportItem `json:"notifications"`
+}
+
+type notificationExportUser struct {
+	ID        string    `json:"id"`
+	Email     string    `json:"email"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type notificationExportItem struct {
+	ID        string            `json:"id"`
+	Type      string            `json:"type"`
+	Title     string            `json:"title"`
+	Body      string            `json:"body"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+	ReadAt    *time.Time        `json:"readAt,omitempty"`
+}
+
+type HandleUserExportRequestedCommandHandler struct {
+	userRepo         *scyllarepo.UserRepository
+	notificationRepo *scyllarepo.NotificationRepository
+	eventBroker      eventhandlercontracts.EventBrokerPublisher
+	log              *zerolog.Logger
+}
+
+func NewHandleUserExportRequestedCommand(
+	userRepo *scyllarepo.UserRepository,
+	notificationRepo *scyllarepo.NotificationRepository,
+	eventBroker eventhandlercontracts.EventBrokerPublisher,
+) *HandleUserExportRequestedCommandHandler {
+	return &HandleUserExportRequestedCommandHandler{
+		userRepo:         userRepo,
+		notificationRepo: notificationRepo,
+		eventBroker:      eventBroker,
+		log:              logger.Component("notification.command.handle_user_export_requested"),
+	}
+}
+
+// Execute collects the replicated user and every notification the user received
+func (c *HandleUserExportRequestedCommandHandler) Execute(ctx context.Context, event events.UserExportRequested) error {
+	export := notificationDataExport{Notifications: []notificationExportItem{}}
+
+	user, err := c.userRepo.FindByID(ctx, event.UserID)
+	switch {
+	case err == nil:
+		export.User = &notificationExportUser{
+			ID:        user.ID,
+			Email:     user.Email,
+			Name:      user.Name,
+			CreatedAt: user.CreatedAt,
+		}
+	case !errors.Is(err, gocql.ErrNotFound):
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to get replicated user")
+		return err
+	}
+
+	notifications, err := c.notificationRepo.ListAllByUser(ctx, event.UserID)
+	if err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to list notifications for export")
+		return err
+	}
+	for _, n := range notifications {
+		export.Notifications = append(export.Notifications, notificationExportItem{
+			ID:        n.ID.String(),
+			Type:      string(n.Type),
+			Title:     n.Title,
+			Body:      n.Body,
+			Metadata:  n.Metadata,
+			CreatedAt: n.CreatedAt,
+			ReadAt:    n.ReadAt,
+		})
+	}
+
+	document, err := json.Marshal(export)
+	if err != nil {
+		return err
+	}
+
+	if err := c.eventBroker.PublishUserDataExported(ctx, eventhandlercontracts.UserDataExportedPayload{
+		RequestID:  event.RequestID,
+		UserID:     event.UserID,
+		Document:   document,
+		ExportedAt: time.Now().UTC(),
+	}); err != nil {
+		return err
+	}
+
+	c.log.Info().
+		Str("user_id", event.UserID).
+		Str("request_id", event.RequestID).
+		Int("notifications", len(export.Notifications)).
+		Msg("notification data exported")
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package command
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+	"golang-social-media/apps/notification-service/internal/application/command/contracts"
+	scyllarepo "golang-social-media/apps/notification-service/internal/infrastructure/persistence/scylla"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+)
+
+var _ contracts.HandleUserProfileUpdatedCommand = (*HandleUserProfileUpdatedCommandHandler)(nil)
+
+type HandleUserProfileUpdatedCommandHandler struct {
+	userRepo *scyllarepo.UserRepository
+	log      *zerolog.Logger
+}
+
+func NewHandleUserProfileUpdatedCommand(userRepo *scyllarepo.UserRepository) *HandleUserProfileUpdatedCommandHandler {
+	return &HandleUserProfileUpdatedCommandHandler{
+		userRepo: userRepo,
+		log:      logger.Component("notification.command.handle_user_profile_updated"),
+	}
+}
+
+func (c *HandleUserProfileUpdatedCommandHandler) Execute(ctx context.Context, event events.UserProfileUpdated) error {
+	if err := c.userRepo.UpdateName(ctx, event.UserID, event.NewName); err != nil {
+		c.log.Error().
+			Err(err).
+			Str("user_id", event.UserID).
+			Msg("failed to update replicated user")
+		return err
+	}
+	return nil
+}
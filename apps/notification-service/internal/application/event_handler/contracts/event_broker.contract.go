@@ -2,6 +2,8 @@ package contracts
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 )
 
 // EventBrokerPublisher publishes events to the event broker
@@ -12,6 +14,12 @@ type EventBrokerPublisher interface {
 
 	// PublishNotificationRead publishes a notification read event
 	PublishNotificationRead(ctx context.Context, payload NotificationReadPayload) error
+
+	// PublishUserDataErased reports the notification step of a GDPR deletion to auth-service
+	PublishUserDataErased(ctx context.Context, payload UserDataErasedPayload) error
+
+	// PublishUserDataExported sends the notification document of a GDPR data export to auth-service
+	PublishUserDataExported(ctx context.Context, payload UserDataExportedPayload) error
 }
 
 // NotificationCreatedPayload represents the payload for notification created event
@@ -19,6 +27,7 @@ type NotificationCreatedPayload struct {
 	NotificationID string
 	UserID         string
 	Type           string
+	Priority       string
 	Title          string
 	Body           string
 	Metadata       map[string]string
@@ -32,3 +41,19 @@ type NotificationReadPayload struct {
 	ReadAt         string
 }
 
+
+// UserDataErasedPayload represents the payload for user data erased event
+type UserDataErasedPayload struct {
+	RequestID string
+	UserID    string
+	Records   int64
+	ErasedAt  time.Time
+}
+
+// UserDataExportedPayload represents the payload for user data exported event
+type UserDataExportedPayload struct {
+	RequestID  string
+	UserID     string
+	Document   json.RawMessage
+	ExportedAt time.Time
+}
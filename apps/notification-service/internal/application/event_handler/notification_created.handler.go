@@ -26,7 +26,7 @@ func (h *NotificationCreatedHandler) Handle(ctx context.Context, domainEvent not
 	// Type assert to NotificationCreatedEvent
 	notificationCreatedEvent, ok := domainEvent.(notification.NotificationCreatedEvent)
 	if !ok {
-		h.log.Error().
+		h.log.Error().Ctx(ctx).
 			Str("event_type", domainEvent.Type()).
 			Msg("unexpected event type in NotificationCreatedHandler")
 		return nil // Ignore unexpected events
@@ -37,6 +37,7 @@ func (h *NotificationCreatedHandler) Handle(ctx context.Context, domainEvent not
 		NotificationID: notificationCreatedEvent.NotificationID,
 		UserID:         notificationCreatedEvent.UserID,
 		Type:           string(notificationCreatedEvent.NotificationType),
+		Priority:       string(notificationCreatedEvent.NotificationType.Priority()),
 		Title:          notificationCreatedEvent.Title,
 		Body:           notificationCreatedEvent.Body,
 		Metadata:       notificationCreatedEvent.Metadata,
@@ -44,14 +45,14 @@ func (h *NotificationCreatedHandler) Handle(ctx context.Context, domainEvent not
 	}
 
 	if err := h.eventBroker.PublishNotificationCreated(ctx, payload); err != nil {
-		h.log.Error().
+		h.log.Error().Ctx(ctx).
 			Err(err).
 			Str("notification_id", notificationCreatedEvent.NotificationID).
 			Msg("failed to publish NotificationCreated event")
 		return err
 	}
 
-	h.log.Info().
+	h.log.Info().Ctx(ctx).
 		Str("notification_id", notificationCreatedEvent.NotificationID).
 		Str("user_id", notificationCreatedEvent.UserID).
 		Msg("NotificationCreated event published")
@@ -10,10 +10,28 @@ import (
 type Type string
 
 const (
-	TypeWelcome     Type = "welcome"
-	TypeChatMessage Type = "chat_message"
+	TypeWelcome      Type = "welcome"
+	TypeChatMessage  Type = "chat_message"
+	TypeConversation Type = "conversation" // Added to, or removed from, a group conversation
+	TypeMention      Type = "mention"      // Mentioned in a chat message
 )
 
+// Priority tells clients how prominently to show a notification
+type Priority string
+
+const (
+	PriorityNormal Priority = "normal"
+	PriorityHigh   Priority = "high"
+)
+
+// Priority returns the priority of notifications of the type: a mention asks for the user's attention
+func (t Type) Priority() Priority {
+	if t == TypeMention {
+		return PriorityHigh
+	}
+	return PriorityNormal
+}
+
 type Notification struct {
 	ID        gocql.UUID
 	UserID    string
@@ -39,7 +57,7 @@ func (n Notification) Validate() error {
 		return errors.New("type is required")
 	}
 
-	if n.Type != TypeWelcome && n.Type != TypeChatMessage {
+	if n.Type != TypeWelcome && n.Type != TypeChatMessage && n.Type != TypeConversation && n.Type != TypeMention {
 		return errors.New("invalid notification type")
 	}
 
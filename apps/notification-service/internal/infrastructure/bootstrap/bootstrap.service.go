@@ -34,6 +34,15 @@ type Dependencies struct {
 	HandleUserCreatedCmd    *command.HandleUserCreatedCommandHandler
 	ChatSubscriber          *eventbussubscriber.ChatCreatedSubscriber
 	UserSubscriber          *eventbussubscriber.UserCreatedSubscriber
+	UserProfileSubscriber   *eventbussubscriber.UserProfileUpdatedSubscriber
+	UserDeletedSubscriber   *eventbussubscriber.UserDeletedSubscriber
+	UserExportSubscriber    *eventbussubscriber.UserExportRequestedSubscriber
+	// Group conversation membership
+	ConversationSubscriber *eventbussubscriber.ConversationSubscriber
+	// Message edits and deletions
+	ChatMessageChangesSubscriber *eventbussubscriber.ChatMessageChangesSubscriber
+	// Mentions in chat messages
+	ChatMentionSubscriber *eventbussubscriber.ChatMentionCreatedSubscriber
 }
 
 // SetupDependencies initializes all service dependencies
@@ -53,7 +62,7 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 	// Setup ScyllaDB
 	scyllaHosts := config.GetEnvStringSlice("SCYLLA_HOSTS", []string{"localhost:9042"})
 	scyllaKeyspace := config.GetEnv("SCYLLA_KEYSPACE", "notification_service")
-	
+
 	// Debug: Log Kafka brokers being used
 	logger.Component("notification.bootstrap").
 		Info().
@@ -78,7 +87,7 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 	eventDispatcher := setupEventDispatcher(publisher)
 
 	// Setup commands
-	commands := setupCommands(notificationRepo, userRepo, eventDispatcher)
+	commands := setupCommands(notificationRepo, userRepo, eventDispatcher, eventbuspublisher.NewEventBrokerAdapter(publisher))
 
 	// Setup queries
 	queries := setupQueries(notificationRepo)
@@ -107,6 +116,14 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 		HandleUserCreatedCmd:    commands.HandleUserCreated,
 		ChatSubscriber:          subscribers.Chat,
 		UserSubscriber:          subscribers.User,
+		UserProfileSubscriber:   subscribers.UserProfile,
+		UserDeletedSubscriber:   subscribers.UserDeleted,
+		UserExportSubscriber:    subscribers.UserExport,
+
+		ConversationSubscriber:       subscribers.Conversation,
+		ChatMessageChangesSubscriber: subscribers.ChatMessageChanges,
+
+		ChatMentionSubscriber: subscribers.ChatMention,
 	}, nil
 }
 
@@ -144,10 +161,16 @@ func setupEventDispatcher(publisher *eventbuspublisher.KafkaPublisher) *event_di
 }
 
 type commands struct {
-	CreateNotification   commandcontracts.CreateNotificationCommand
-	MarkNotificationRead commandcontracts.MarkNotificationReadCommand
-	HandleChatCreated    *command.HandleChatCreatedCommandHandler
-	HandleUserCreated    *command.HandleUserCreatedCommandHandler
+	CreateNotification       commandcontracts.CreateNotificationCommand
+	MarkNotificationRead     commandcontracts.MarkNotificationReadCommand
+	HandleChatCreated        *command.HandleChatCreatedCommandHandler
+	HandleUserCreated        *command.HandleUserCreatedCommandHandler
+	HandleUserProfileUpdated *command.HandleUserProfileUpdatedCommandHandler
+	HandleUserDeleted        *command.HandleUserDeletedCommandHandler
+	HandleUserExport         *command.HandleUserExportRequestedCommandHandler
+	HandleConversationEvent  *command.HandleConversationEventCommandHandler
+	HandleChatMessageChanges *command.HandleChatMessageChangesCommandHandler
+	HandleChatMentionCreated *command.HandleChatMentionCreatedCommandHandler
 }
 
 // setupCommands initializes all command handlers
@@ -155,11 +178,18 @@ func setupCommands(
 	notificationRepo *scylladb.NotificationRepository,
 	userRepo *scylladb.UserRepository,
 	eventDispatcher *event_dispatcher.Dispatcher,
+	eventBroker *eventbuspublisher.EventBrokerAdapter,
 ) commands {
 	createNotificationCmd := command.NewCreateNotificationCommand(notificationRepo, eventDispatcher)
 	markNotificationReadCmd := command.NewMarkNotificationReadCommand(notificationRepo, eventDispatcher)
 	handleChatCreatedCmd := command.NewHandleChatCreatedCommand(createNotificationCmd)
 	handleUserCreatedCmd := command.NewHandleUserCreatedCommand(userRepo, createNotificationCmd)
+	handleUserProfileUpdatedCmd := command.NewHandleUserProfileUpdatedCommand(userRepo)
+	handleUserDeletedCmd := command.NewHandleUserDeletedCommand(userRepo, notificationRepo, eventBroker)
+	handleUserExportCmd := command.NewHandleUserExportRequestedCommand(userRepo, notificationRepo, eventBroker)
+	handleConversationEventCmd := command.NewHandleConversationEventCommand(createNotificationCmd)
+	handleChatMessageChangesCmd := command.NewHandleChatMessageChangesCommand(notificationRepo)
+	handleChatMentionCreatedCmd := command.NewHandleChatMentionCreatedCommand(createNotificationCmd)
 
 	logger.Component("notification.bootstrap").
 		Info().
@@ -183,14 +213,50 @@ func setupCommands(
 
 	logger.Component("notification.bootstrap").
 		Info().
-		Int("total_commands", 4).
+		Str("command", "HandleUserProfileUpdatedCommand").
+		Msg("registered command")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("command", "HandleUserDeletedCommand").
+		Msg("registered command")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("command", "HandleUserExportRequestedCommand").
+		Msg("registered command")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("command", "HandleConversationEventCommand").
+		Msg("registered command")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("command", "HandleChatMessageChangesCommand").
+		Msg("registered command")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("command", "HandleChatMentionCreatedCommand").
+		Msg("registered command")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Int("total_commands", 10).
 		Msg("commands configured")
 
 	return commands{
-		CreateNotification:   createNotificationCmd,
-		MarkNotificationRead: markNotificationReadCmd,
-		HandleChatCreated:    handleChatCreatedCmd,
-		HandleUserCreated:    handleUserCreatedCmd,
+		CreateNotification:       createNotificationCmd,
+		MarkNotificationRead:     markNotificationReadCmd,
+		HandleChatCreated:        handleChatCreatedCmd,
+		HandleUserCreated:        handleUserCreatedCmd,
+		HandleUserProfileUpdated: handleUserProfileUpdatedCmd,
+		HandleUserDeleted:        handleUserDeletedCmd,
+		HandleUserExport:         handleUserExportCmd,
+		HandleConversationEvent:  handleConversationEventCmd,
+		HandleChatMessageChanges: handleChatMessageChangesCmd,
+		HandleChatMentionCreated: handleChatMentionCreatedCmd,
 	}
 }
 
@@ -218,8 +284,15 @@ func setupQueries(notificationRepo *scylladb.NotificationRepository) queries {
 }
 
 type subscribers struct {
-	Chat *eventbussubscriber.ChatCreatedSubscriber
-	User *eventbussubscriber.UserCreatedSubscriber
+	Chat        *eventbussubscriber.ChatCreatedSubscriber
+	User        *eventbussubscriber.UserCreatedSubscriber
+	UserProfile *eventbussubscriber.UserProfileUpdatedSubscriber
+	UserDeleted *eventbussubscriber.UserDeletedSubscriber
+	UserExport  *eventbussubscriber.UserExportRequestedSubscriber
+	// Conversation reads several topics with one consumer group
+	Conversation       *eventbussubscriber.ConversationSubscriber
+	ChatMessageChanges *eventbussubscriber.ChatMessageChangesSubscriber
+	ChatMention        *eventbussubscriber.ChatMentionCreatedSubscriber
 }
 
 // setupSubscribers initializes all event subscribers
@@ -232,7 +305,7 @@ func setupSubscribers(
 		Info().
 		Strs("brokers_in_setupSubscribers", brokers).
 		Msg("setupSubscribers called with brokers")
-	
+
 	chatSubscriber, err := eventbussubscriber.NewChatCreatedSubscriber(
 		brokers,
 		config.GetEnv("NOTIFICATION_CHAT_GROUP_ID", "notification-service-chat"),
@@ -259,6 +332,84 @@ func setupSubscribers(
 		return subscribers{}, err
 	}
 
+	userProfileSubscriber, err := eventbussubscriber.NewUserProfileUpdatedSubscriber(
+		brokers,
+		config.GetEnv("NOTIFICATION_USER_PROFILE_GROUP_ID", "notification-service-user-profile"),
+		commands.HandleUserProfileUpdated,
+	)
+	if err != nil {
+		logger.Component("notification.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create user profile subscriber")
+		return subscribers{}, err
+	}
+
+	userDeletedSubscriber, err := eventbussubscriber.NewUserDeletedSubscriber(
+		brokers,
+		config.GetEnv("NOTIFICATION_GDPR_DELETION_GROUP_ID", "notification-service-gdpr-deletion"),
+		commands.HandleUserDeleted,
+	)
+	if err != nil {
+		logger.Component("notification.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create user deleted subscriber")
+		return subscribers{}, err
+	}
+
+	userExportSubscriber, err := eventbussubscriber.NewUserExportRequestedSubscriber(
+		brokers,
+		config.GetEnv("NOTIFICATION_GDPR_EXPORT_GROUP_ID", "notification-service-gdpr-export"),
+		commands.HandleUserExport,
+	)
+	if err != nil {
+		logger.Component("notification.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create user export requested subscriber")
+		return subscribers{}, err
+	}
+
+	conversationSubscriber, err := eventbussubscriber.NewConversationSubscriber(
+		brokers,
+		config.GetEnv("NOTIFICATION_CONVERSATION_GROUP_ID", "notification-service-conversation"),
+		commands.HandleConversationEvent,
+	)
+	if err != nil {
+		logger.Component("notification.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create conversation subscriber")
+		return subscribers{}, err
+	}
+
+	chatMessageChangesSubscriber, err := eventbussubscriber.NewChatMessageChangesSubscriber(
+		brokers,
+		config.GetEnv("NOTIFICATION_CHAT_MESSAGE_CHANGES_GROUP_ID", "notification-service-chat-message-changes"),
+		commands.HandleChatMessageChanges,
+	)
+	if err != nil {
+		logger.Component("notification.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create chat message changes subscriber")
+		return subscribers{}, err
+	}
+
+	chatMentionSubscriber, err := eventbussubscriber.NewChatMentionCreatedSubscriber(
+		brokers,
+		config.GetEnv("NOTIFICATION_CHAT_MENTION_GROUP_ID", "notification-service-chat-mention"),
+		commands.HandleChatMentionCreated,
+	)
+	if err != nil {
+		logger.Component("notification.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create chat mention subscriber")
+		return subscribers{}, err
+	}
+
 	logger.Component("notification.bootstrap").
 		Info().
 		Str("subscriber", "ChatCreatedSubscriber").
@@ -273,11 +424,54 @@ func setupSubscribers(
 
 	logger.Component("notification.bootstrap").
 		Info().
-		Int("total_subscribers", 2).
+		Str("subscriber", "UserProfileUpdatedSubscriber").
+		Str("topic", events.TopicUserProfileUpdated).
+		Msg("registered subscriber")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("subscriber", "UserDeletedSubscriber").
+		Str("topic", events.TopicUserDeleted).
+		Msg("registered subscriber")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("subscriber", "UserExportRequestedSubscriber").
+		Str("topic", events.TopicUserExportRequested).
+		Msg("registered subscriber")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("subscriber", "ConversationSubscriber").
+		Strs("topics", []string{events.TopicConversationCreated, events.TopicConversationMemberJoined, events.TopicConversationMemberRemoved}).
+		Msg("registered subscriber")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("subscriber", "ChatMessageChangesSubscriber").
+		Strs("topics", []string{events.TopicChatMessageEdited, events.TopicChatMessageDeleted}).
+		Msg("registered subscriber")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Str("subscriber", "ChatMentionCreatedSubscriber").
+		Str("topic", events.TopicChatMentionCreated).
+		Msg("registered subscriber")
+
+	logger.Component("notification.bootstrap").
+		Info().
+		Int("total_subscribers", 8).
 		Msg("subscribers configured")
 
 	return subscribers{
-		Chat: chatSubscriber,
-		User: userSubscriber,
+		Chat:        chatSubscriber,
+		User:        userSubscriber,
+		UserProfile: userProfileSubscriber,
+		UserDeleted: userDeletedSubscriber,
+		UserExport:  userExportSubscriber,
+
+		Conversation:       conversationSubscriber,
+		ChatMessageChanges: chatMessageChangesSubscriber,
+		ChatMention:        chatMentionSubscriber,
 	}, nil
 }
@@ -10,6 +10,8 @@ import (
 type NotificationPublisher interface {
 	PublishNotificationCreated(ctx context.Context, event events.NotificationCreated) error
 	PublishNotificationRead(ctx context.Context, event events.NotificationRead) error
+	PublishUserDataErased(ctx context.Context, event events.UserDataErased) error
+	PublishUserDataExported(ctx context.Context, event events.UserDataExported) error
 	Close() error
 }
 
@@ -33,6 +33,7 @@ func (a *EventBrokerAdapter) PublishNotificationCreated(ctx context.Context, pay
 			ID:        payload.NotificationID,
 			UserID:    payload.UserID,
 			Type:      payload.Type,
+			Priority:  payload.Priority,
 			Title:     payload.Title,
 			Body:      payload.Body,
 			Metadata:  payload.Metadata,
@@ -60,3 +61,25 @@ func (a *EventBrokerAdapter) PublishNotificationRead(ctx context.Context, payloa
 	return a.kafkaPublisher.PublishNotificationRead(ctx, kafkaEvent)
 }
 
+
+// PublishUserDataErased publishes the notification step of a GDPR deletion
+func (a *EventBrokerAdapter) PublishUserDataErased(ctx context.Context, payload contracts.UserDataErasedPayload) error {
+	return a.kafkaPublisher.PublishUserDataErased(ctx, events.UserDataErased{
+		RequestID: payload.RequestID,
+		UserID:    payload.UserID,
+		Service:   events.ServiceNotification,
+		Records:   payload.Records,
+		ErasedAt:  payload.ErasedAt,
+	})
+}
+
+// PublishUserDataExported publishes the notification document of a GDPR data export
+func (a *EventBrokerAdapter) PublishUserDataExported(ctx context.Context, payload contracts.UserDataExportedPayload) error {
+	return a.kafkaPublisher.PublishUserDataExported(ctx, events.UserDataExported{
+		RequestID:  payload.RequestID,
+		UserID:     payload.UserID,
+		Service:    events.ServiceNotification,
+		Document:   payload.Document,
+		ExportedAt: payload.ExportedAt,
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"golang-social-media/apps/notification-service/internal/infrastructure/eventbus/publisher/contracts"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -18,6 +19,10 @@ var _ contracts.NotificationPublisher = (*KafkaPublisher)(nil)
 type KafkaPublisher struct {
 	createdWriter *kafka.Writer
 	readWriter    *kafka.Writer
+
+	// GDPR saga reports go to auth-service, keyed by request ID
+	deletionWriter *kafka.Writer
+	exportWriter   *kafka.Writer
 }
 
 func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
@@ -91,31 +96,50 @@ func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
 	return &KafkaPublisher{
 		createdWriter: createdWriter,
 		readWriter:    readWriter,
+
+		deletionWriter: newGDPRWriter(brokers, events.TopicUserDeletionCompleted, 1048576),
+		exportWriter:   newGDPRWriter(brokers, events.TopicUserExportCompleted, 10485760),
 	}, nil
 }
 
+// newGDPRWriter creates a synchronous writer: the subscriber commits the request only once the report is written
+func newGDPRWriter(brokers []string, topic string, batchBytes int64) *kafka.Writer {
+	return &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{}, // Reports of one request stay in order
+		BatchBytes:   batchBytes,    // Export documents hold every notification of the user
+		BatchTimeout: 10 * time.Millisecond,
+		RequiredAcks: kafka.RequireAll,
+		MaxAttempts:  10,
+		ReadTimeout:  10 * time.Second,
+		WriteTimeout: 30 * time.Second,
+		Compression:  kafka.Snappy,
+	}
+}
+
 func (p *KafkaPublisher) PublishNotificationCreated(ctx context.Context, event events.NotificationCreated) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("notification.publisher").
+		logger.ComponentCtx(ctx, "notification.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal NotificationCreated event")
 		return err
 	}
 
-	if err := p.createdWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.createdWriter, kafka.Message{
 		Key:   []byte(event.Notification.ID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("notification.publisher").
+		logger.ComponentCtx(ctx, "notification.publisher").
 			Error().
 			Err(err).
 			Msg("failed to publish NotificationCreated event")
 		return err
 	}
 
-	logger.Component("notification.publisher").
+	logger.ComponentCtx(ctx, "notification.publisher").
 		Info().
 		Str("topic", events.TopicNotificationCreated).
 		Str("notification_id", event.Notification.ID).
@@ -126,25 +150,25 @@ func (p *KafkaPublisher) PublishNotificationCreated(ctx context.Context, event e
 func (p *KafkaPublisher) PublishNotificationRead(ctx context.Context, event events.NotificationRead) error {
 	payload, err := json.Marshal(event)
 	if err != nil {
-		logger.Component("notification.publisher").
+		logger.ComponentCtx(ctx, "notification.publisher").
 			Error().
 			Err(err).
 			Msg("failed to marshal NotificationRead event")
 		return err
 	}
 
-	if err := p.readWriter.WriteMessages(ctx, kafka.Message{
+	if err := tracing.WriteKafkaMessage(ctx, p.readWriter, kafka.Message{
 		Key:   []byte(event.NotificationID),
 		Value: payload,
 	}); err != nil {
-		logger.Component("notification.publisher").
+		logger.ComponentCtx(ctx, "notification.publisher").
 			Error().
 			Err(err).
 			Msg("failed to publish NotificationRead event")
 		return err
 	}
 
-	logger.Component("notification.publisher").
+	logger.ComponentCtx(ctx, "notification.publisher").
 		Info().
 		Str("topic", events.TopicNotificationRead).
 		Str("notification_id", event.NotificationID).
@@ -153,9 +177,53 @@ func (p *KafkaPublisher) PublishNotificationRead(ctx context.Context, event even
 	return nil
 }
 
-func (p *KafkaPublisher) Close() error {
-	if err := p.createdWriter.Close(); err != nil {
+// PublishUserDataErased reports that notification-service erased a deleted user's data
+func (p *KafkaPublisher) PublishUserDataErased(ctx context.Context, event events.UserDataErased) error {
+	return p.publishGDPR(ctx, p.deletionWriter, event.RequestID, event)
+}
+
+// PublishUserDataExported sends the notification document of a data export
+func (p *KafkaPublisher) PublishUserDataExported(ctx context.Context, event events.UserDataExported) error {
+	return p.publishGDPR(ctx, p.exportWriter, event.RequestID, event)
+}
+
+func (p *KafkaPublisher) publishGDPR(ctx context.Context, writer *kafka.Writer, requestID string, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.ComponentCtx(ctx, "notification.publisher").
+			Error().
+			Err(err).
+			Str("topic", writer.Topic).
+			Msg("failed to marshal GDPR event")
 		return err
 	}
-	return p.readWriter.Close()
+
+	if err := tracing.WriteKafkaMessage(ctx, writer, kafka.Message{
+		Key:   []byte(requestID),
+		Value: payload,
+	}); err != nil {
+		logger.ComponentCtx(ctx, "notification.publisher").
+			Error().
+			Err(err).
+			Str("topic", writer.Topic).
+			Str("request_id", requestID).
+			Msg("failed to publish GDPR event")
+		return err
+	}
+
+	logger.ComponentCtx(ctx, "notification.publisher").
+		Info().
+		Str("topic", writer.Topic).
+		Str("request_id", requestID).
+		Msg("published GDPR event")
+	return nil
+}
+
+func (p *KafkaPublisher) Close() error {
+	return errors.Join(
+		p.createdWriter.Close(),
+		p.readWriter.Close(),
+		p.deletionWriter.Close(),
+		p.exportWriter.Close(),
+	)
 }
@@ -10,6 +10,8 @@ import (
 	"golang-social-media/apps/notification-service/internal/infrastructure/eventbus/subscriber/contracts"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/segmentio/kafka-go"
 )
@@ -19,6 +21,7 @@ var _ contracts.ChatCreatedSubscriber = (*ChatCreatedSubscriber)(nil)
 type ChatCreatedSubscriber struct {
 	handler *command.HandleChatCreatedCommandHandler
 	reader  *kafka.Reader
+	metrics *metrics.KafkaConsumer
 }
 
 func NewChatCreatedSubscriber(brokers []string, groupID string, handler *command.HandleChatCreatedCommandHandler) (*ChatCreatedSubscriber, error) {
@@ -60,7 +63,7 @@ func NewChatCreatedSubscriber(brokers []string, groupID string, handler *command
 		CommitInterval: 1 * time.Second,
 	})
 
-	return &ChatCreatedSubscriber{handler: handler, reader: reader}, nil
+	return &ChatCreatedSubscriber{handler: handler, reader: reader, metrics: metrics.NewKafkaConsumer(events.TopicChatCreated, groupID)}, nil
 }
 
 func (s *ChatCreatedSubscriber) Consume(ctx context.Context) {
@@ -85,7 +88,11 @@ func (s *ChatCreatedSubscriber) Consume(ctx context.Context) {
 				continue
 			}
 
-			logger.Component("notification.subscriber.chat_created").
+			s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+			start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+			logger.ComponentCtx(msgCtx, "notification.subscriber.chat_created").
 				Info().
 				Str("topic", msg.Topic).
 				Int("partition", msg.Partition).
@@ -94,20 +101,26 @@ func (s *ChatCreatedSubscriber) Consume(ctx context.Context) {
 
 			var event events.ChatCreated
 			if err := json.Unmarshal(msg.Value, &event); err != nil {
-				logger.Component("notification.subscriber.chat_created").
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_created").
 					Error().
 					Err(err).
 					Msg("failed to unmarshal ChatCreated event")
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
 				continue
 			}
 
-			if err := s.handler.Execute(ctx, event); err != nil {
-				logger.Component("notification.subscriber.chat_created").
+			if err := s.handler.Execute(msgCtx, event); err != nil {
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeError)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_created").
 					Error().
 					Err(err).
 					Msg("failed to handle ChatCreated event")
 			} else {
-				logger.Component("notification.subscriber.chat_created").
+				tracing.End(span, nil)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_created").
 					Info().
 					Str("message_id", event.Message.ID).
 					Str("sender_id", event.Message.SenderID).
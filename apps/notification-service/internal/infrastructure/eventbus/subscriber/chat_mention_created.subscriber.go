@@ -0,0 +1,128 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"golang-social-media/apps/notification-service/internal/application/command"
+	"golang-social-media/apps/notification-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+var _ contracts.ChatMentionCreatedSubscriber = (*ChatMentionCreatedSubscriber)(nil)
+
+type ChatMentionCreatedSubscriber struct {
+	handler *command.HandleChatMentionCreatedCommandHandler
+	reader  *kafka.Reader
+	metrics *metrics.KafkaConsumer
+}
+
+func NewChatMentionCreatedSubscriber(brokers []string, groupID string, handler *command.HandleChatMentionCreatedCommandHandler) (*ChatMentionCreatedSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	logger.Component("notification.subscriber.chat_mention_created").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Str("topic", events.TopicChatMentionCreated).
+		Msg("chat mention subscriber configured")
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  brokers,
+		GroupID:  groupID,
+		Topic:    events.TopicChatMentionCreated,
+		MinBytes: 10e3, // 10KB
+		MaxBytes: 10e6, // 10MB
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			DualStack: true,
+			KeepAlive: 5 * time.Minute,
+		},
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		CommitInterval: 1 * time.Second,
+	})
+
+	return &ChatMentionCreatedSubscriber{handler: handler, reader: reader, metrics: metrics.NewKafkaConsumer(events.TopicChatMentionCreated, groupID)}, nil
+}
+
+func (s *ChatMentionCreatedSubscriber) Consume(ctx context.Context) {
+	logger.Component("notification.subscriber.chat_mention_created").
+		Info().
+		Str("topic", events.TopicChatMentionCreated).
+		Msg("starting chat mention consumer")
+	go func() {
+		for {
+			msg, err := s.reader.ReadMessage(ctx)
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+					logger.Component("notification.subscriber.chat_mention_created").
+						Info().
+						Msg("chat mention consumer shutting down")
+					return
+				}
+				logger.Component("notification.subscriber.chat_mention_created").
+					Error().
+					Err(err).
+					Msg("failed to read ChatMentionCreated message")
+				continue
+			}
+
+			s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+			start := time.Now()
+			msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+			logger.ComponentCtx(msgCtx, "notification.subscriber.chat_mention_created").
+				Info().
+				Str("topic", msg.Topic).
+				Int("partition", msg.Partition).
+				Int64("offset", msg.Offset).
+				Msg("received ChatMentionCreated message")
+
+			var event events.ChatMentionCreated
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_mention_created").
+					Error().
+					Err(err).
+					Msg("failed to unmarshal ChatMentionCreated event")
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+				continue
+			}
+
+			if err := s.handler.Execute(msgCtx, event); err != nil {
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeError)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_mention_created").
+					Error().
+					Err(err).
+					Msg("failed to handle ChatMentionCreated event")
+			} else {
+				tracing.End(span, nil)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_mention_created").
+					Info().
+					Str("message_id", event.MessageID).
+					Str("sender_id", event.SenderID).
+					Int("mention_count", len(event.MentionedUserIDs)).
+					Msg("successfully processed ChatMentionCreated event")
+			}
+		}
+	}()
+}
+
+func (s *ChatMentionCreatedSubscriber) Close() error {
+	return s.reader.Close()
+}
@@ -0,0 +1,160 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"golang-social-media/apps/notification-service/internal/application/command"
+	"golang-social-media/apps/notification-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+var _ contracts.ChatMessageChangesSubscriber = (*ChatMessageChangesSubscriber)(nil)
+
+// chatMessageChangesTopics are the message changes that update a chat message notification.
+// A deletion for one member only is left to their devices
+var chatMessageChangesTopics = []string{
+	events.TopicChatMessageEdited,
+	events.TopicChatMessageDeleted,
+}
+
+// ChatMessageChangesSubscriber reads every topic of chatMessageChangesTopics with one consumer group
+type ChatMessageChangesSubscriber struct {
+	handler *command.HandleChatMessageChangesCommandHandler
+	reader  *kafka.Reader
+	metrics map[string]*metrics.KafkaConsumer // By topic
+}
+
+func NewChatMessageChangesSubscriber(brokers []string, groupID string, handler *command.HandleChatMessageChangesCommandHandler) (*ChatMessageChangesSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	logger.Component("notification.subscriber.chat_message_changes").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Strs("topics", chatMessageChangesTopics).
+		Msg("chat message changes subscriber configured")
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     brokers,
+		GroupID:     groupID,
+		GroupTopics: chatMessageChangesTopics,
+		MinBytes:    10e3, // 10KB
+		MaxBytes:    10e6, // 10MB
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			DualStack: true,
+			KeepAlive: 5 * time.Minute,
+		},
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		CommitInterval: 1 * time.Second,
+	})
+
+	consumerMetrics := make(map[string]*metrics.KafkaConsumer, len(chatMessageChangesTopics))
+	for _, topic := range chatMessageChangesTopics {
+		consumerMetrics[topic] = metrics.NewKafkaConsumer(topic, groupID)
+	}
+
+	return &ChatMessageChangesSubscriber{handler: handler, reader: reader, metrics: consumerMetrics}, nil
+}
+
+func (s *ChatMessageChangesSubscriber) Consume(ctx context.Context) {
+	logger.Component("notification.subscriber.chat_message_changes").
+		Info().
+		Strs("topics", chatMessageChangesTopics).
+		Msg("starting chat message changes consumer")
+	go func() {
+		for {
+			msg, err := s.reader.ReadMessage(ctx)
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+					logger.Component("notification.subscriber.chat_message_changes").
+						Info().
+						Msg("chat message changes consumer shutting down")
+					return
+				}
+				logger.Component("notification.subscriber.chat_message_changes").
+					Error().
+					Err(err).
+					Msg("failed to read chat message change")
+				continue
+			}
+
+			consumerMetrics := s.metrics[msg.Topic]
+			consumerMetrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+			start := time.Now()
+			msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+			logger.ComponentCtx(msgCtx, "notification.subscriber.chat_message_changes").
+				Info().
+				Str("topic", msg.Topic).
+				Int("partition", msg.Partition).
+				Int64("offset", msg.Offset).
+				Msg("received chat message change")
+
+			handle, err := s.decode(msg)
+			if err != nil {
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_message_changes").
+					Error().
+					Err(err).
+					Str("topic", msg.Topic).
+					Msg("failed to unmarshal chat message change")
+				tracing.End(span, err)
+				consumerMetrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+				continue
+			}
+
+			if err := handle(msgCtx); err != nil {
+				tracing.End(span, err)
+				consumerMetrics.ObserveProcessed(start, metrics.OutcomeError)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_message_changes").
+					Error().
+					Err(err).
+					Str("topic", msg.Topic).
+					Msg("failed to handle chat message change")
+			} else {
+				tracing.End(span, nil)
+				consumerMetrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.chat_message_changes").
+					Info().
+					Str("topic", msg.Topic).
+					Str("conversation_id", string(msg.Key)).
+					Msg("successfully processed chat message change")
+			}
+		}
+	}()
+}
+
+// decode unmarshals the event of msg.Topic and returns the call of its handler
+func (s *ChatMessageChangesSubscriber) decode(msg kafka.Message) (func(ctx context.Context) error, error) {
+	if msg.Topic == events.TopicChatMessageEdited {
+		var event events.ChatMessageEdited
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error { return s.handler.ExecuteEdited(ctx, event) }, nil
+	}
+
+	var event events.ChatMessageDeleted
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error { return s.handler.ExecuteDeleted(ctx, event) }, nil
+}
+
+func (s *ChatMessageChangesSubscriber) Close() error {
+	return s.reader.Close()
+}
@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// ChatMentionCreatedSubscriber consumes ChatMentionCreated events
+type ChatMentionCreatedSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
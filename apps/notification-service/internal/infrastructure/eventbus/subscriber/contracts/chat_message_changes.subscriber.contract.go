@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// ChatMessageChangesSubscriber consumes the edits and deletions of chat messages
+type ChatMessageChangesSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
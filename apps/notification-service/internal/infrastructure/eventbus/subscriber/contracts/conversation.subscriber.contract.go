@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// ConversationSubscriber consumes the conversation events that notify users
+type ConversationSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// UserDeletedSubscriber consumes UserDeleted events
+type UserDeletedSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
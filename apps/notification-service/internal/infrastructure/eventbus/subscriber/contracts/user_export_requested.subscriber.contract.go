@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// UserExportRequestedSubscriber consumes UserExportRequested events
+type UserExportRequestedSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
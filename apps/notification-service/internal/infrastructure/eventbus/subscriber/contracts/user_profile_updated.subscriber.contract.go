@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// UserProfileUpdatedSubscriber consumes UserProfileUpdated events
+type UserProfileUpdatedSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
@@ -0,0 +1,160 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"golang-social-media/apps/notification-service/internal/application/command"
+	"golang-social-media/apps/notification-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+
+	"github.com/segmentio/kafka-go"
+)
+
+var _ contracts.ConversationSubscriber = (*ConversationSubscriber)(nil)
+
+// conversationTopics are the conversation events that create a notification
+var conversationTopics = []string{
+	events.TopicConversationCreated,
+	events.TopicConversationMemberJoined,
+	events.TopicConversationMemberRemoved,
+}
+
+// ConversationSubscriber reads every topic of conversationTopics with one consumer group
+type ConversationSubscriber struct {
+	handler *command.HandleConversationEventCommandHandler
+	reader  *kafka.Reader
+	metrics map[string]*metrics.KafkaConsumer // By topic
+}
+
+func NewConversationSubscriber(brokers []string, groupID string, handler *command.HandleConversationEventCommandHandler) (*ConversationSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	logger.Component("notification.subscriber.conversation").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Strs("topics", conversationTopics).
+		Msg("conversation subscriber configured")
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     brokers,
+		GroupID:     groupID,
+		GroupTopics: conversationTopics,
+		MinBytes:    10e3, // 10KB
+		MaxBytes:    10e6, // 10MB
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			DualStack: true,
+			KeepAlive: 5 * time.Minute,
+		},
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		CommitInterval: 1 * time.Second,
+	})
+
+	consumerMetrics := make(map[string]*metrics.KafkaConsumer, len(conversationTopics))
+	for _, topic := range conversationTopics {
+		consumerMetrics[topic] = metrics.NewKafkaConsumer(topic, groupID)
+	}
+
+	return &ConversationSubscriber{handler: handler, reader: reader, metrics: consumerMetrics}, nil
+}
+
+func (s *ConversationSubscriber) Consume(ctx context.Context) {
+	logger.Component("notification.subscriber.conversation").
+		Info().
+		Strs("topics", conversationTopics).
+		Msg("starting conversation consumer")
+	go func() {
+		for {
+			msg, err := s.reader.ReadMessage(ctx)
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+					logger.Component("notification.subscriber.conversation").
+						Info().
+						Msg("conversation consumer shutting down")
+					return
+				}
+				logger.Component("notification.subscriber.conversation").
+					Error().
+					Err(err).
+					Msg("failed to read conversation message")
+				continue
+			}
+
+			consumerMetrics := s.metrics[msg.Topic]
+			consumerMetrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+			start := time.Now()
+			msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+			logger.ComponentCtx(msgCtx, "notification.subscriber.conversation").
+				Info().
+				Str("topic", msg.Topic).
+				Int("partition", msg.Partition).
+				Int64("offset", msg.Offset).
+				Msg("received conversation message")
+
+			handle, err := s.decode(msg)
+			if err != nil {
+				logger.ComponentCtx(msgCtx, "notification.subscriber.conversation").
+					Error().
+					Err(err).
+					Str("topic", msg.Topic).
+					Msg("failed to unmarshal conversation event")
+				tracing.End(span, err)
+				consumerMetrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+				continue
+			}
+
+			if err := handle(msgCtx); err != nil {
+				tracing.End(span, err)
+				consumerMetrics.ObserveProcessed(start, metrics.OutcomeError)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.conversation").
+					Error().
+					Err(err).
+					Str("topic", msg.Topic).
+					Msg("failed to handle conversation event")
+			} else {
+				tracing.End(span, nil)
+				consumerMetrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.conversation").
+					Info().
+					Str("topic", msg.Topic).
+					Str("conversation_id", string(msg.Key)).
+					Msg("successfully processed conversation event")
+			}
+		}
+	}()
+}
+
+// decode unmarshals the event of msg.Topic and returns the call of its handler
+func (s *ConversationSubscriber) decode(msg kafka.Message) (func(ctx context.Context) error, error) {
+	if msg.Topic == events.TopicConversationCreated {
+		var event events.ConversationCreated
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error { return s.handler.ExecuteCreated(ctx, event) }, nil
+	}
+
+	var event events.ConversationMemberChanged
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context) error { return s.handler.ExecuteMemberChanged(ctx, msg.Topic, event) }, nil
+}
+
+func (s *ConversationSubscriber) Close() error {
+	return s.reader.Close()
+}
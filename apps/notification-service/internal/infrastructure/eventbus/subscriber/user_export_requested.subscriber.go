@@ -0,0 +1,129 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"golang-social-media/apps/notification-service/internal/application/command"
+	"golang-social-media/apps/notification-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/consumer"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+)
+
+var _ contracts.UserExportRequestedSubscriber = (*UserExportRequestedSubscriber)(nil)
+
+// UserExportRequestedSubscriber sends the notification data of users who asked for a data export.
+// The offset is committed only after the document is sent, so an export is retried instead of lost
+type UserExportRequestedSubscriber struct {
+	reader  *kafka.Reader
+	handler *command.HandleUserExportRequestedCommandHandler
+	metrics *metrics.KafkaConsumer
+	retrier *consumer.Retrier
+}
+
+func NewUserExportRequestedSubscriber(
+	brokers []string,
+	groupID string,
+	handler *command.HandleUserExportRequestedCommandHandler,
+) (*UserExportRequestedSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: brokers,
+		GroupID: groupID,
+		Topic:   events.TopicUserExportRequested,
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			KeepAlive: 5 * time.Minute,
+		},
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		MinBytes:       10e3, // 10KB
+		MaxBytes:       10e6, // 10MB
+	})
+
+	logger.Component("notification.subscriber.user_export_requested").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Str("topic", events.TopicUserExportRequested).
+		Msg("user export requested subscriber configured")
+
+	return &UserExportRequestedSubscriber{
+		reader:  reader,
+		handler: handler,
+		metrics: metrics.NewKafkaConsumer(events.TopicUserExportRequested, groupID),
+		retrier: consumer.NewRetrier(brokers, groupID, consumer.DefaultRetryConfig()),
+	}, nil
+}
+
+func (s *UserExportRequestedSubscriber) Consume(ctx context.Context) {
+	log := logger.Component("notification.subscriber.user_export_requested")
+	log.Info().
+		Str("topic", events.TopicUserExportRequested).
+		Msg("starting user export requested consumer")
+
+	go func() {
+		for {
+			msg, err := s.reader.FetchMessage(ctx)
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+					log.Info().
+						Msg("user export requested consumer shutting down")
+					return
+				}
+				log.Error().
+					Err(err).
+					Msg("failed to read UserExportRequested message")
+				continue
+			}
+
+			s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+			start := time.Now()
+			msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+			var event events.UserExportRequested
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				// A malformed event never succeeds, skip it
+				log.Error().
+					Err(err).
+					Int64("offset", msg.Offset).
+					Msg("failed to decode UserExportRequested event")
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+			} else if err := s.retrier.Handle(msgCtx, log, msg, func() error { return s.handler.Execute(msgCtx, event) }); errors.Is(err, consumer.ErrDeadLettered) {
+				// The event is parked on the dead-letter topic, move on to the rest of the partition
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeDeadLetter)
+			} else if err != nil {
+				tracing.End(span, err)
+				return
+			} else {
+				tracing.End(span, nil)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+			}
+
+			if err := s.reader.CommitMessages(ctx, msg); err != nil {
+				log.Error().
+					Err(err).
+					Int64("offset", msg.Offset).
+					Msg("failed to commit UserExportRequested message")
+			}
+		}
+	}()
+}
+
+func (s *UserExportRequestedSubscriber) Close() error {
+	return errors.Join(s.reader.Close(), s.retrier.Close())
+}
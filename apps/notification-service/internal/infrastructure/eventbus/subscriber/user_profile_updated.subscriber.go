@@ -0,0 +1,137 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"golang-social-media/apps/notification-service/internal/application/command"
+	"golang-social-media/apps/notification-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+)
+
+var _ contracts.UserProfileUpdatedSubscriber = (*UserProfileUpdatedSubscriber)(nil)
+
+type UserProfileUpdatedSubscriber struct {
+	reader  *kafka.Reader
+	handler *command.HandleUserProfileUpdatedCommandHandler
+	metrics *metrics.KafkaConsumer
+}
+
+func NewUserProfileUpdatedSubscriber(brokers []string, groupID string, handler *command.HandleUserProfileUpdatedCommandHandler) (*UserProfileUpdatedSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	logger.Component("notification.subscriber.user_profile_updated").
+		Info().
+		Strs("brokers_before_reader", brokers).
+		Msg("creating kafka reader with brokers")
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:  brokers,
+		GroupID:  groupID,
+		Topic:    events.TopicUserProfileUpdated,
+		MinBytes: 10e3, // 10KB
+		MaxBytes: 10e6, // 10MB
+		// Connection timeouts
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			DualStack: true,
+			KeepAlive: 5 * time.Minute,
+		},
+		// Read timeouts
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		// Commit interval - commit offsets every 1 second
+		CommitInterval: 1 * time.Second,
+	})
+
+	logger.Component("notification.subscriber.user_profile_updated").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Str("topic", events.TopicUserProfileUpdated).
+		Msg("user profile subscriber configured")
+
+	return &UserProfileUpdatedSubscriber{
+		reader:  reader,
+		handler: handler,
+		metrics: metrics.NewKafkaConsumer(events.TopicUserProfileUpdated, groupID),
+	}, nil
+}
+
+func (s *UserProfileUpdatedSubscriber) Consume(ctx context.Context) {
+	logger.Component("notification.subscriber.user_profile_updated").
+		Info().
+		Str("topic", events.TopicUserProfileUpdated).
+		Msg("starting user profile consumer")
+	go func() {
+		for {
+			msg, err := s.reader.ReadMessage(ctx)
+			if err != nil {
+				if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+					logger.Component("notification.subscriber.user_profile_updated").
+						Info().
+						Msg("user profile consumer shutting down")
+					return
+				}
+				logger.Component("notification.subscriber.user_profile_updated").
+					Error().
+					Err(err).
+					Msg("failed to read UserProfileUpdated message")
+				continue
+			}
+
+			s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+			start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+			logger.ComponentCtx(msgCtx, "notification.subscriber.user_profile_updated").
+				Info().
+				Str("topic", msg.Topic).
+				Int("partition", msg.Partition).
+				Int64("offset", msg.Offset).
+				Msg("received UserProfileUpdated message")
+
+			var event events.UserProfileUpdated
+			if err := json.Unmarshal(msg.Value, &event); err != nil {
+				logger.ComponentCtx(msgCtx, "notification.subscriber.user_profile_updated").
+					Error().
+					Err(err).
+					Msg("failed to decode UserProfileUpdated event")
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+				continue
+			}
+
+			if err := s.handler.Execute(msgCtx, event); err != nil {
+				tracing.End(span, err)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeError)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.user_profile_updated").
+					Error().
+					Err(err).
+					Msg("failed to handle UserProfileUpdated event")
+			} else {
+				tracing.End(span, nil)
+				s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+				logger.ComponentCtx(msgCtx, "notification.subscriber.user_profile_updated").
+					Info().
+					Str("user_id", event.UserID).
+					Msg("successfully processed UserProfileUpdated event")
+			}
+		}
+	}()
+}
+
+func (s *UserProfileUpdatedSubscriber) Close() error {
+	return s.reader.Close()
+}
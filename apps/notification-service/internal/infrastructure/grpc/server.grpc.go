@@ -4,6 +4,8 @@ import (
 	"net"
 
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 	"google.golang.org/grpc"
 )
 
@@ -13,7 +15,11 @@ func Start(addr string, register func(*grpc.Server)) error {
 		return err
 	}
 
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(metrics.UnaryServerInterceptor()),
+		grpc.StreamInterceptor(metrics.StreamServerInterceptor()),
+		tracing.GRPCServerOption(),
+	)
 	if register != nil {
 		register(server)
 	}
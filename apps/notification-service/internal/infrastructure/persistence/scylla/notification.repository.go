@@ -71,3 +71,54 @@ func (r *NotificationRepository) UpdateReadAt(ctx context.Context, userID string
 		readAt, userID, notificationID,
 	).WithContext(ctx).Exec()
 }
+
+// ListAllByUser pages through the whole partition of a user, newest first
+func (r *NotificationRepository) ListAllByUser(ctx context.Context, userID string) ([]notification.Notification, error) {
+	iter := r.session.Query(`SELECT user_id, created_at, notification_id, type, title, body, metadata, read_at
+		FROM notifications_by_user WHERE user_id = ?`,
+		userID,
+	).WithContext(ctx).PageSize(500).Iter()
+
+	var results []notification.Notification
+	var n notification.Notification
+	var typ string
+	var readAt *time.Time
+	for iter.Scan(&n.UserID, &n.CreatedAt, &n.ID, &typ, &n.Title, &n.Body, &n.Metadata, &readAt) {
+		n.Type = notification.Type(typ)
+		n.ReadAt = readAt
+		results = append(results, n)
+	}
+
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// DeleteByUser drops the partition of a user and returns how many notifications it held
+func (r *NotificationRepository) DeleteByUser(ctx context.Context, userID string) (int64, error) {
+	var count int64
+	if err := r.session.Query(`SELECT COUNT(*) FROM notifications_by_user WHERE user_id = ?`,
+		userID,
+	).WithContext(ctx).Scan(&count); err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, nil
+	}
+
+	if err := r.session.Query(`DELETE FROM notifications_by_user WHERE user_id = ?`,
+		userID,
+	).WithContext(ctx).Exec(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// UpdateMetadata merges entries into the metadata of a notification, keeping its other keys
+func (r *NotificationRepository) UpdateMetadata(ctx context.Context, n notification.Notification, entries map[string]string) error {
+	return r.session.Query(`UPDATE notifications_by_user SET metadata = metadata + ?
+		WHERE user_id = ? AND created_at = ? AND notification_id = ?`,
+		entries, n.UserID, n.CreatedAt, n.ID,
+	).WithContext(ctx).Exec()
+}
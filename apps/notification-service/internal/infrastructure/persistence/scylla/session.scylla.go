@@ -27,6 +27,8 @@ func NewSession(hosts []string, keyspace string) (*gocql.Session, error) {
 	cluster.Timeout = 5 * time.Second
 	// Retry policy: retry up to 3 times with exponential backoff
 	cluster.RetryPolicy = &gocql.SimpleRetryPolicy{NumRetries: 3}
+	// Trace every query under the request or message that issued it
+	cluster.QueryObserver = queryTracer{}
 
 	logger.Component("scylla.session").
 		Info().
@@ -0,0 +1,51 @@
+package scylla
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gocql/gocql"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"golang-social-media/pkg/tracing"
+)
+
+var _ gocql.QueryObserver = queryTracer{}
+
+// queryTracer records a client span for every query attempt, under the span of the context the query was run with
+// (repositories always pass it with WithContext). Bound values are never recorded
+type queryTracer struct{}
+
+func (queryTracer) ObserveQuery(ctx context.Context, q gocql.ObservedQuery) {
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system.name", "scylla"),
+		attribute.String("db.namespace", q.Keyspace),
+		attribute.String("db.query.text", q.Statement),
+		attribute.Int("db.response.returned_rows", q.Rows),
+		attribute.Int("db.cassandra.attempt", q.Attempt),
+	}
+	if q.Host != nil {
+		attrs = append(attrs, attribute.String("server.address", q.Host.ConnectAddress().String()))
+	}
+
+	_, span := tracing.Tracer().Start(ctx, queryOperation(q.Statement),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(q.Start),
+		trace.WithAttributes(attrs...),
+	)
+	if q.Err != nil {
+		span.RecordError(q.Err)
+		span.SetStatus(codes.Error, q.Err.Error())
+	}
+	span.End(trace.WithTimestamp(q.End))
+}
+
+// queryOperation names the span after the CQL verb (SELECT, INSERT, ...)
+func queryOperation(statement string) string {
+	fields := strings.Fields(statement)
+	if len(fields) == 0 {
+		return "CQL"
+	}
+	return strings.ToUpper(fields[0])
+}
@@ -21,3 +21,29 @@ func (r *UserRepository) Upsert(ctx context.Context, user domainuser.User) error
 		user.ID, user.Email, user.Name, user.CreatedAt,
 	).WithContext(ctx).Exec()
 }
+
+// UpdateName changes the name of a replicated user
+func (r *UserRepository) UpdateName(ctx context.Context, userID, name string) error {
+	return r.session.Query(`UPDATE notification_users SET name = ? WHERE user_id = ?`,
+		name, userID,
+	).WithContext(ctx).Exec()
+}
+
+// FindByID returns the replicated user, or gocql.ErrNotFound
+func (r *UserRepository) FindByID(ctx context.Context, userID string) (domainuser.User, error) {
+	var user domainuser.User
+	err := r.session.Query(`SELECT user_id, email, name, created_at FROM notification_users WHERE user_id = ?`,
+		userID,
+	).WithContext(ctx).Scan(&user.ID, &user.Email, &user.Name, &user.CreatedAt)
+	if err != nil {
+		return domainuser.User{}, err
+	}
+	return user, nil
+}
+
+// Delete removes a replicated user; deleting a missing user is not an error
+func (r *UserRepository) Delete(ctx context.Context, userID string) error {
+	return r.session.Query(`DELETE FROM notification_users WHERE user_id = ?`,
+		userID,
+	).WithContext(ctx).Exec()
+}
@@ -11,6 +11,8 @@ import (
 	bootstrap "golang-social-media/apps/socket-service/internal/infrastructure/bootstrap"
 	"golang-social-media/pkg/config"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 )
 
 func main() {
@@ -20,6 +22,24 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	// Setup tracing first so the clients created by the bootstrap report to the configured exporter
+	shutdownTracing, err := tracing.Init(ctx, "socket-service")
+	if err != nil {
+		logger.Component("socket.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to setup tracing")
+		os.Exit(1)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			logger.Component("socket.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to flush traces")
+		}
+	}()
+
 	// Setup all dependencies
 	deps, err := bootstrap.SetupDependencies(ctx)
 	if err != nil {
@@ -40,6 +60,8 @@ func main() {
 
 	// Setup router
 	router := gin.Default()
+	router.Use(tracing.GinMiddleware("socket-service"), tracing.TraceIDMiddleware(), metrics.HTTPMiddleware())
+	router.GET(metrics.Path, metrics.GinHandler())
 	deps.Hub.RegisterRoutes(router)
 
 	port := config.GetEnvInt("SOCKET_SERVICE_PORT", 9200)
@@ -63,6 +85,8 @@ func main() {
 func startSubscribers(ctx context.Context, deps *bootstrap.Dependencies) {
 	go deps.ChatSubscriber.Consume(ctx)
 	go deps.NotificationSubscriber.Consume(ctx)
+	go deps.ConversationSubscriber.Consume(ctx)
+	go deps.ChatMessageChangesSubscriber.Consume(ctx)
 }
 
 // cleanup closes all resources
@@ -84,4 +108,22 @@ func cleanup(deps *bootstrap.Dependencies) {
 				Msg("failed to close notification subscriber")
 		}
 	}
+
+	if deps.ConversationSubscriber != nil {
+		if err := deps.ConversationSubscriber.Close(); err != nil {
+			logger.Component("socket.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close conversation subscriber")
+		}
+	}
+
+	if deps.ChatMessageChangesSubscriber != nil {
+		if err := deps.ChatMessageChangesSubscriber.Close(); err != nil {
+			logger.Component("socket.bootstrap").
+				Error().
+				Err(err).
+				Msg("failed to close chat message changes subscriber")
+		}
+	}
 }
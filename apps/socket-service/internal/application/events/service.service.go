@@ -12,12 +12,27 @@ import (
 type Broadcaster interface {
 	BroadcastChatCreated(event events.ChatCreated)
 	BroadcastNotificationCreated(event events.NotificationCreated)
+	// BroadcastConversationEvent pushes an event of one of the conversation.* topics to userIDs
+	BroadcastConversationEvent(topic string, userIDs []string, event interface{})
+	// BroadcastChatMessageEvent pushes an edit, a deletion or a receipt of messages to userIDs
+	BroadcastChatMessageEvent(topic string, userIDs []string, event interface{})
 }
 
 // Service handles events and broadcasts them via WebSocket
 type Service interface {
 	HandleChatCreated(ctx context.Context, event events.ChatCreated) error
 	HandleNotificationCreated(ctx context.Context, event events.NotificationCreated) error
+	HandleConversationCreated(ctx context.Context, event events.ConversationCreated) error
+	HandleConversationUpdated(ctx context.Context, event events.ConversationUpdated) error
+	// HandleConversationMemberChanged handles an event of one of the conversation.member.* topics
+	HandleConversationMemberChanged(ctx context.Context, topic string, event events.ConversationMemberChanged) error
+	HandleChatMessageEdited(ctx context.Context, event events.ChatMessageEdited) error
+	HandleChatMessageDeleted(ctx context.Context, event events.ChatMessageDeleted) error
+	HandleChatMessageDeletedForMe(ctx context.Context, event events.ChatMessageDeletedForMe) error
+	// HandleChatMessageReceipt handles an event of chat.message.delivered or chat.message.read
+	HandleChatMessageReceipt(ctx context.Context, topic string, event events.ChatMessageReceipt) error
+	// HandleChatMessageReaction handles an event of chat.message.reaction.added or chat.message.reaction.removed
+	HandleChatMessageReaction(ctx context.Context, topic string, event events.ChatMessageReaction) error
 }
 
 type service struct {
@@ -34,18 +49,18 @@ func NewService(broadcaster Broadcaster) Service {
 }
 
 func (s *service) HandleChatCreated(ctx context.Context, event events.ChatCreated) error {
-	s.log.Info().
+	s.log.Info().Ctx(ctx).
 		Str("topic", events.TopicChatCreated).
 		Str("message_id", event.Message.ID).
+		Str("conversation_id", event.Message.ConversationID).
 		Str("sender_id", event.Message.SenderID).
-		Str("receiver_id", event.Message.ReceiverID).
 		Msg("handling ChatCreated event")
 	s.broadcaster.BroadcastChatCreated(event)
 	return nil
 }
 
 func (s *service) HandleNotificationCreated(ctx context.Context, event events.NotificationCreated) error {
-	s.log.Info().
+	s.log.Info().Ctx(ctx).
 		Str("topic", events.TopicNotificationCreated).
 		Str("notification_id", event.Notification.ID).
 		Str("user_id", event.Notification.UserID).
@@ -53,3 +68,100 @@ func (s *service) HandleNotificationCreated(ctx context.Context, event events.No
 	s.broadcaster.BroadcastNotificationCreated(event)
 	return nil
 }
+
+func (s *service) HandleConversationCreated(ctx context.Context, event events.ConversationCreated) error {
+	s.log.Info().Ctx(ctx).
+		Str("topic", events.TopicConversationCreated).
+		Str("conversation_id", event.Conversation.ID).
+		Msg("handling ConversationCreated event")
+	s.broadcaster.BroadcastConversationEvent(events.TopicConversationCreated, event.Conversation.MemberIDs(), event)
+	return nil
+}
+
+func (s *service) HandleConversationUpdated(ctx context.Context, event events.ConversationUpdated) error {
+	s.log.Info().Ctx(ctx).
+		Str("topic", events.TopicConversationUpdated).
+		Str("conversation_id", event.Conversation.ID).
+		Msg("handling ConversationUpdated event")
+	s.broadcaster.BroadcastConversationEvent(events.TopicConversationUpdated, event.Conversation.MemberIDs(), event)
+	return nil
+}
+
+func (s *service) HandleConversationMemberChanged(ctx context.Context, topic string, event events.ConversationMemberChanged) error {
+	s.log.Info().Ctx(ctx).
+		Str("topic", topic).
+		Str("conversation_id", event.Conversation.ID).
+		Str("user_id", event.UserID).
+		Msg("handling conversation member event")
+
+	userIDs := event.Conversation.MemberIDs()
+	if topic == events.TopicConversationMemberLeft || topic == events.TopicConversationMemberRemoved {
+		// No longer a member, but their clients still have to drop the conversation
+		userIDs = append(userIDs, event.UserID)
+	}
+	s.broadcaster.BroadcastConversationEvent(topic, userIDs, event)
+	return nil
+}
+
+// HandleChatMessageEdited pushes the new version to the recipients and to the other devices of the sender
+func (s *service) HandleChatMessageEdited(ctx context.Context, event events.ChatMessageEdited) error {
+	s.log.Info().Ctx(ctx).
+		Str("topic", events.TopicChatMessageEdited).
+		Str("message_id", event.MessageID).
+		Str("conversation_id", event.ConversationID).
+		Msg("handling ChatMessageEdited event")
+	userIDs := append([]string{event.SenderID}, event.RecipientIDs...)
+	s.broadcaster.BroadcastChatMessageEvent(events.TopicChatMessageEdited, userIDs, event)
+	return nil
+}
+
+func (s *service) HandleChatMessageDeleted(ctx context.Context, event events.ChatMessageDeleted) error {
+	s.log.Info().Ctx(ctx).
+		Str("topic", events.TopicChatMessageDeleted).
+		Str("message_id", event.MessageID).
+		Str("conversation_id", event.ConversationID).
+		Msg("handling ChatMessageDeleted event")
+	userIDs := append([]string{event.SenderID}, event.RecipientIDs...)
+	s.broadcaster.BroadcastChatMessageEvent(events.TopicChatMessageDeleted, userIDs, event)
+	return nil
+}
+
+// HandleChatMessageDeletedForMe only reaches the devices of the user who deleted the message
+func (s *service) HandleChatMessageDeletedForMe(ctx context.Context, event events.ChatMessageDeletedForMe) error {
+	s.log.Info().Ctx(ctx).
+		Str("topic", events.TopicChatMessageDeletedForMe).
+		Str("message_id", event.MessageID).
+		Str("conversation_id", event.ConversationID).
+		Str("user_id", event.UserID).
+		Msg("handling ChatMessageDeletedForMe event")
+	s.broadcaster.BroadcastChatMessageEvent(events.TopicChatMessageDeletedForMe, []string{event.UserID}, event)
+	return nil
+}
+
+// HandleChatMessageReceipt pushes the receipt to the other members, and to the other devices of the user
+// so they clear their unread badge
+func (s *service) HandleChatMessageReceipt(ctx context.Context, topic string, event events.ChatMessageReceipt) error {
+	s.log.Debug().Ctx(ctx).
+		Str("topic", topic).
+		Str("conversation_id", event.ConversationID).
+		Str("user_id", event.UserID).
+		Str("message_id", event.MessageID).
+		Msg("handling chat message receipt")
+	userIDs := append([]string{event.UserID}, event.RecipientIDs...)
+	s.broadcaster.BroadcastChatMessageEvent(topic, userIDs, event)
+	return nil
+}
+
+// HandleChatMessageReaction pushes the new count of the emoji to the members, including the other devices
+// of the user who toggled it
+func (s *service) HandleChatMessageReaction(ctx context.Context, topic string, event events.ChatMessageReaction) error {
+	s.log.Debug().Ctx(ctx).
+		Str("topic", topic).
+		Str("conversation_id", event.ConversationID).
+		Str("message_id", event.MessageID).
+		Str("user_id", event.UserID).
+		Msg("handling chat message reaction")
+	userIDs := append([]string{event.UserID}, event.RecipientIDs...)
+	s.broadcaster.BroadcastChatMessageEvent(topic, userIDs, event)
+	return nil
+}
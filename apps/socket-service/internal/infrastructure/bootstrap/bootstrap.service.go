@@ -16,6 +16,8 @@ type Dependencies struct {
 	EventService             appevents.Service
 	ChatSubscriber           *eventbussubscriber.ChatCreatedSubscriber
 	NotificationSubscriber   *eventbussubscriber.NotificationCreatedSubscriber
+	ConversationSubscriber   *eventbussubscriber.ConversationSubscriber
+	ChatMessageChangesSubscriber *eventbussubscriber.ChatMessageChangesSubscriber
 }
 
 // SetupDependencies initializes all service dependencies
@@ -37,6 +39,16 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 		return nil, err
 	}
 
+	conversationSubscriber, err := setupConversationSubscriber(eventService)
+	if err != nil {
+		return nil, err
+	}
+
+	chatMessageChangesSubscriber, err := setupChatMessageChangesSubscriber(eventService)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Component("socket.bootstrap").
 		Info().
 		Msg("socket service dependencies initialized")
@@ -46,6 +58,9 @@ func SetupDependencies(ctx context.Context) (*Dependencies, error) {
 		EventService:           eventService,
 		ChatSubscriber:         chatSubscriber,
 		NotificationSubscriber: notificationSubscriber,
+		ConversationSubscriber: conversationSubscriber,
+
+		ChatMessageChangesSubscriber: chatMessageChangesSubscriber,
 	}, nil
 }
 
@@ -92,3 +107,47 @@ func setupNotificationSubscriber(eventService appevents.Service) (*eventbussubsc
 
 	return subscriber, nil
 }
+
+func setupConversationSubscriber(eventService appevents.Service) (*eventbussubscriber.ConversationSubscriber, error) {
+	brokers := config.GetEnvStringSlice("KAFKA_BROKERS", []string{"localhost:9092"})
+	groupID := config.GetEnv("SOCKET_CONVERSATION_GROUP_ID", "socket-service-conversation")
+
+	subscriber, err := eventbussubscriber.NewConversationSubscriber(brokers, groupID, eventService)
+	if err != nil {
+		logger.Component("socket.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create conversation subscriber")
+		return nil, err
+	}
+
+	logger.Component("socket.bootstrap").
+		Info().
+		Str("subscriber", "ConversationSubscriber").
+		Str("topic", "conversation.*").
+		Msg("registered subscriber")
+
+	return subscriber, nil
+}
+
+func setupChatMessageChangesSubscriber(eventService appevents.Service) (*eventbussubscriber.ChatMessageChangesSubscriber, error) {
+	brokers := config.GetEnvStringSlice("KAFKA_BROKERS", []string{"localhost:9092"})
+	groupID := config.GetEnv("SOCKET_CHAT_MESSAGE_CHANGES_GROUP_ID", "socket-service-chat-message-changes")
+
+	subscriber, err := eventbussubscriber.NewChatMessageChangesSubscriber(brokers, groupID, eventService)
+	if err != nil {
+		logger.Component("socket.bootstrap").
+			Error().
+			Err(err).
+			Msg("failed to create chat message changes subscriber")
+		return nil, err
+	}
+
+	logger.Component("socket.bootstrap").
+		Info().
+		Str("subscriber", "ChatMessageChangesSubscriber").
+		Str("topic", "chat.message.*").
+		Msg("registered subscriber")
+
+	return subscriber, nil
+}
@@ -12,6 +12,8 @@ import (
 	appevents "golang-social-media/apps/socket-service/internal/application/events"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 )
 
 var _ contracts.ChatCreatedSubscriber = (*ChatCreatedSubscriber)(nil)
@@ -20,6 +22,7 @@ type ChatCreatedSubscriber struct {
 	reader      *kafka.Reader
 	eventHandler appevents.Service
 	log         *zerolog.Logger
+	metrics     *metrics.KafkaConsumer
 }
 
 func NewChatCreatedSubscriber(
@@ -64,6 +67,7 @@ func NewChatCreatedSubscriber(
 		reader:       reader,
 		eventHandler: eventHandler,
 		log:          logger.Component("socket.subscriber.chat_created"),
+		metrics:      metrics.NewKafkaConsumer(events.TopicChatCreated, groupID),
 	}, nil
 }
 
@@ -103,11 +107,17 @@ func (s *ChatCreatedSubscriber) Consume(ctx context.Context) {
 			}
 		}
 
+		s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+		start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
 		var event events.ChatCreated
 		if err := json.Unmarshal(msg.Value, &event); err != nil {
 			s.log.Error().
 				Err(err).
 				Msg("failed to decode ChatCreated event")
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
 			continue
 		}
 
@@ -117,12 +127,16 @@ func (s *ChatCreatedSubscriber) Consume(ctx context.Context) {
 			Str("receiver_id", event.Message.ReceiverID).
 			Msg("received ChatCreated message")
 
-		if err := s.eventHandler.HandleChatCreated(ctx, event); err != nil {
+		if err := s.eventHandler.HandleChatCreated(msgCtx, event); err != nil {
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeError)
 			s.log.Error().
 				Err(err).
 				Str("message_id", event.Message.ID).
 				Msg("failed to handle ChatCreated event")
 		} else {
+			tracing.End(span, nil)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
 			s.log.Info().
 				Str("message_id", event.Message.ID).
 				Msg("successfully processed ChatCreated event")
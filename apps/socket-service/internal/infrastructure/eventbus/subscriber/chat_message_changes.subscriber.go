@@ -0,0 +1,189 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/segmentio/kafka-go"
+	appevents "golang-social-media/apps/socket-service/internal/application/events"
+	"golang-social-media/apps/socket-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+)
+
+var _ contracts.ChatMessageChangesSubscriber = (*ChatMessageChangesSubscriber)(nil)
+
+// chatMessageChangesTopics are pushed to the clients showing the messages
+var chatMessageChangesTopics = []string{
+	events.TopicChatMessageEdited,
+	events.TopicChatMessageDeleted,
+	events.TopicChatMessageDeletedForMe,
+	events.TopicChatMessageDelivered,
+	events.TopicChatMessageRead,
+	events.TopicChatMessageReactionAdded,
+	events.TopicChatMessageReactionRemoved,
+}
+
+// ChatMessageChangesSubscriber reads every topic of chatMessageChangesTopics with one consumer group
+type ChatMessageChangesSubscriber struct {
+	reader       *kafka.Reader
+	eventHandler appevents.Service
+	log          *zerolog.Logger
+	metrics      map[string]*metrics.KafkaConsumer // By topic
+}
+
+func NewChatMessageChangesSubscriber(
+	brokers []string,
+	groupID string,
+	eventHandler appevents.Service,
+) (*ChatMessageChangesSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     brokers,
+		GroupID:     groupID,
+		GroupTopics: chatMessageChangesTopics,
+		MinBytes:    10e3, // 10KB
+		MaxBytes:    10e6, // 10MB
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			DualStack: true,
+			KeepAlive: 5 * time.Minute,
+		},
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		CommitInterval: 1 * time.Second,
+	})
+
+	logger.Component("socket.subscriber.chat_message_changes").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Strs("topics", chatMessageChangesTopics).
+		Msg("chat message changes subscriber configured")
+
+	consumerMetrics := make(map[string]*metrics.KafkaConsumer, len(chatMessageChangesTopics))
+	for _, topic := range chatMessageChangesTopics {
+		consumerMetrics[topic] = metrics.NewKafkaConsumer(topic, groupID)
+	}
+
+	return &ChatMessageChangesSubscriber{
+		reader:       reader,
+		eventHandler: eventHandler,
+		log:          logger.Component("socket.subscriber.chat_message_changes"),
+		metrics:      consumerMetrics,
+	}, nil
+}
+
+func (s *ChatMessageChangesSubscriber) Consume(ctx context.Context) {
+	s.log.Info().
+		Strs("topics", chatMessageChangesTopics).
+		Msg("starting chat message changes consumer")
+
+	for {
+		msg, err := s.reader.ReadMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+				s.log.Info().Msg("chat message changes listener shutting down")
+				return
+			}
+			s.log.Error().
+				Err(err).
+				Msg("chat message changes listener error")
+			// Add small delay on error to avoid tight loop
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			continue
+		}
+
+		consumerMetrics := s.metrics[msg.Topic]
+		consumerMetrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+		start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+		handle, err := s.decode(msg)
+		if err != nil {
+			s.log.Error().
+				Err(err).
+				Str("topic", msg.Topic).
+				Msg("failed to decode chat message change")
+			tracing.End(span, err)
+			consumerMetrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+			continue
+		}
+
+		if err := handle(msgCtx); err != nil {
+			tracing.End(span, err)
+			consumerMetrics.ObserveProcessed(start, metrics.OutcomeError)
+			s.log.Error().
+				Err(err).
+				Str("topic", msg.Topic).
+				Str("conversation_id", string(msg.Key)).
+				Msg("failed to handle chat message change")
+		} else {
+			tracing.End(span, nil)
+			consumerMetrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+			s.log.Info().
+				Str("topic", msg.Topic).
+				Str("conversation_id", string(msg.Key)).
+				Msg("successfully processed chat message change")
+		}
+	}
+}
+
+// decode unmarshals the event of msg.Topic and returns the call of its handler
+func (s *ChatMessageChangesSubscriber) decode(msg kafka.Message) (func(ctx context.Context) error, error) {
+	switch msg.Topic {
+	case events.TopicChatMessageEdited:
+		var event events.ChatMessageEdited
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error { return s.eventHandler.HandleChatMessageEdited(ctx, event) }, nil
+	case events.TopicChatMessageDeleted:
+		var event events.ChatMessageDeleted
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error { return s.eventHandler.HandleChatMessageDeleted(ctx, event) }, nil
+	case events.TopicChatMessageDeletedForMe:
+		var event events.ChatMessageDeletedForMe
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error { return s.eventHandler.HandleChatMessageDeletedForMe(ctx, event) }, nil
+	case events.TopicChatMessageReactionAdded, events.TopicChatMessageReactionRemoved:
+		var event events.ChatMessageReaction
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return s.eventHandler.HandleChatMessageReaction(ctx, msg.Topic, event)
+		}, nil
+	default:
+		var event events.ChatMessageReceipt
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return s.eventHandler.HandleChatMessageReceipt(ctx, msg.Topic, event)
+		}, nil
+	}
+}
+
+func (s *ChatMessageChangesSubscriber) Close() error {
+	return s.reader.Close()
+}
@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// ChatMessageChangesSubscriber subscribes to the edits, deletions and receipts of chat messages
+type ChatMessageChangesSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
@@ -0,0 +1,11 @@
+package contracts
+
+import (
+	"context"
+)
+
+// ConversationSubscriber subscribes to every conversation.* topic
+type ConversationSubscriber interface {
+	Consume(ctx context.Context)
+	Close() error
+}
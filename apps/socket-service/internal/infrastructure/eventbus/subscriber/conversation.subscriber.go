@@ -0,0 +1,174 @@
+package subscriber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/segmentio/kafka-go"
+	appevents "golang-social-media/apps/socket-service/internal/application/events"
+	"golang-social-media/apps/socket-service/internal/infrastructure/eventbus/subscriber/contracts"
+	"golang-social-media/pkg/events"
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+)
+
+var _ contracts.ConversationSubscriber = (*ConversationSubscriber)(nil)
+
+// conversationTopics are pushed to the members of the conversation
+var conversationTopics = []string{
+	events.TopicConversationCreated,
+	events.TopicConversationUpdated,
+	events.TopicConversationMemberJoined,
+	events.TopicConversationMemberLeft,
+	events.TopicConversationMemberRemoved,
+	events.TopicConversationMemberRoleChanged,
+}
+
+// ConversationSubscriber reads every topic of conversationTopics with one consumer group
+type ConversationSubscriber struct {
+	reader       *kafka.Reader
+	eventHandler appevents.Service
+	log          *zerolog.Logger
+	metrics      map[string]*metrics.KafkaConsumer // By topic
+}
+
+func NewConversationSubscriber(
+	brokers []string,
+	groupID string,
+	eventHandler appevents.Service,
+) (*ConversationSubscriber, error) {
+	if len(brokers) == 0 {
+		return nil, errors.New("kafka brokers must be provided")
+	}
+	if groupID == "" {
+		return nil, errors.New("groupID must be provided")
+	}
+
+	reader := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     brokers,
+		GroupID:     groupID,
+		GroupTopics: conversationTopics,
+		MinBytes:    10e3, // 10KB
+		MaxBytes:    10e6, // 10MB
+		Dialer: &kafka.Dialer{
+			Timeout:   10 * time.Second,
+			DualStack: true,
+			KeepAlive: 5 * time.Minute,
+		},
+		ReadBackoffMin: 100 * time.Millisecond,
+		ReadBackoffMax: 1 * time.Second,
+		CommitInterval: 1 * time.Second,
+	})
+
+	logger.Component("socket.subscriber.conversation").
+		Info().
+		Strs("brokers", brokers).
+		Str("group", groupID).
+		Strs("topics", conversationTopics).
+		Msg("conversation subscriber configured")
+
+	consumerMetrics := make(map[string]*metrics.KafkaConsumer, len(conversationTopics))
+	for _, topic := range conversationTopics {
+		consumerMetrics[topic] = metrics.NewKafkaConsumer(topic, groupID)
+	}
+
+	return &ConversationSubscriber{
+		reader:       reader,
+		eventHandler: eventHandler,
+		log:          logger.Component("socket.subscriber.conversation"),
+		metrics:      consumerMetrics,
+	}, nil
+}
+
+func (s *ConversationSubscriber) Consume(ctx context.Context) {
+	s.log.Info().
+		Strs("topics", conversationTopics).
+		Msg("starting conversation consumer")
+
+	for {
+		msg, err := s.reader.ReadMessage(ctx)
+		if err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, kafka.ErrGroupClosed) {
+				s.log.Info().Msg("conversation listener shutting down")
+				return
+			}
+			s.log.Error().
+				Err(err).
+				Msg("conversation listener error")
+			// Add small delay on error to avoid tight loop
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(100 * time.Millisecond):
+			}
+			continue
+		}
+
+		consumerMetrics := s.metrics[msg.Topic]
+		consumerMetrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+		start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
+		handle, err := s.decode(msg)
+		if err != nil {
+			s.log.Error().
+				Err(err).
+				Str("topic", msg.Topic).
+				Msg("failed to decode conversation event")
+			tracing.End(span, err)
+			consumerMetrics.ObserveProcessed(start, metrics.OutcomeInvalid)
+			continue
+		}
+
+		if err := handle(msgCtx); err != nil {
+			tracing.End(span, err)
+			consumerMetrics.ObserveProcessed(start, metrics.OutcomeError)
+			s.log.Error().
+				Err(err).
+				Str("topic", msg.Topic).
+				Str("conversation_id", string(msg.Key)).
+				Msg("failed to handle conversation event")
+		} else {
+			tracing.End(span, nil)
+			consumerMetrics.ObserveProcessed(start, metrics.OutcomeSuccess)
+			s.log.Info().
+				Str("topic", msg.Topic).
+				Str("conversation_id", string(msg.Key)).
+				Msg("successfully processed conversation event")
+		}
+	}
+}
+
+// decode unmarshals the event of msg.Topic and returns the call of its handler
+func (s *ConversationSubscriber) decode(msg kafka.Message) (func(ctx context.Context) error, error) {
+	switch msg.Topic {
+	case events.TopicConversationCreated:
+		var event events.ConversationCreated
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error { return s.eventHandler.HandleConversationCreated(ctx, event) }, nil
+	case events.TopicConversationUpdated:
+		var event events.ConversationUpdated
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error { return s.eventHandler.HandleConversationUpdated(ctx, event) }, nil
+	default:
+		var event events.ConversationMemberChanged
+		if err := json.Unmarshal(msg.Value, &event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return s.eventHandler.HandleConversationMemberChanged(ctx, msg.Topic, event)
+		}, nil
+	}
+}
+
+func (s *ConversationSubscriber) Close() error {
+	return s.reader.Close()
+}
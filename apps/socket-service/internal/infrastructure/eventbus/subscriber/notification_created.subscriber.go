@@ -12,6 +12,8 @@ import (
 	appevents "golang-social-media/apps/socket-service/internal/application/events"
 	"golang-social-media/pkg/events"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
 )
 
 var _ contracts.NotificationCreatedSubscriber = (*NotificationCreatedSubscriber)(nil)
@@ -20,6 +22,7 @@ type NotificationCreatedSubscriber struct {
 	reader       *kafka.Reader
 	eventHandler appevents.Service
 	log          *zerolog.Logger
+	metrics      *metrics.KafkaConsumer
 }
 
 func NewNotificationCreatedSubscriber(
@@ -64,6 +67,7 @@ func NewNotificationCreatedSubscriber(
 		reader:       reader,
 		eventHandler: eventHandler,
 		log:          logger.Component("socket.subscriber.notification_created"),
+		metrics:      metrics.NewKafkaConsumer(events.TopicNotificationCreated, groupID),
 	}, nil
 }
 
@@ -103,11 +107,17 @@ func (s *NotificationCreatedSubscriber) Consume(ctx context.Context) {
 			}
 		}
 
+		s.metrics.ObserveLag(msg.Partition, msg.Offset, msg.HighWaterMark)
+		start := time.Now()
+		msgCtx, span := tracing.StartKafkaConsumer(ctx, s.reader.Config().GroupID, msg)
+
 		var event events.NotificationCreated
 		if err := json.Unmarshal(msg.Value, &event); err != nil {
 			s.log.Error().
 				Err(err).
 				Msg("failed to decode NotificationCreated event")
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeInvalid)
 			continue
 		}
 
@@ -116,12 +126,16 @@ func (s *NotificationCreatedSubscriber) Consume(ctx context.Context) {
 			Str("user_id", event.Notification.UserID).
 			Msg("received NotificationCreated message")
 
-		if err := s.eventHandler.HandleNotificationCreated(ctx, event); err != nil {
+		if err := s.eventHandler.HandleNotificationCreated(msgCtx, event); err != nil {
+			tracing.End(span, err)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeError)
 			s.log.Error().
 				Err(err).
 				Str("notification_id", event.Notification.ID).
 				Msg("failed to handle NotificationCreated event")
 		} else {
+			tracing.End(span, nil)
+			s.metrics.ObserveProcessed(start, metrics.OutcomeSuccess)
 			s.log.Info().
 				Str("notification_id", event.Notification.ID).
 				Msg("successfully processed NotificationCreated event")
@@ -53,6 +53,8 @@ func (h *Hub) BroadcastChatCreated(event events.ChatCreated) {
 		Info().
 		Str("topic", events.TopicChatCreated).
 		Str("message_id", event.Message.ID).
+		Str("conversation_id", event.Message.ConversationID).
+		Strs("recipient_ids", event.Message.RecipientIDs).
 		Msg("broadcast chat update")
 	// TODO: push to connected clients
 }
@@ -66,3 +68,21 @@ func (h *Hub) BroadcastNotificationCreated(event events.NotificationCreated) {
 		Msg("broadcast notification update")
 	// TODO: push to connected clients
 }
+
+func (h *Hub) BroadcastConversationEvent(topic string, userIDs []string, event interface{}) {
+	logger.Component("socket.hub").
+		Info().
+		Str("topic", topic).
+		Strs("user_ids", userIDs).
+		Msg("broadcast conversation update")
+	// TODO: push to connected clients
+}
+
+func (h *Hub) BroadcastChatMessageEvent(topic string, userIDs []string, event interface{}) {
+	logger.Component("socket.hub").
+		Info().
+		Str("topic", topic).
+		Strs("user_ids", userIDs).
+		Msg("broadcast chat message update")
+	// TODO: push to connected clients
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/redis/go-redis/v9"
 	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/tracing"
 
 	"github.com/rs/zerolog"
 )
@@ -28,10 +29,21 @@ type Cache interface {
 	// Exists checks if a key exists in cache
 	Exists(ctx context.Context, key string) (bool, error)
 
+	// Update atomically replaces the value of a key with the one computed by fn from the current value,
+	// so concurrent read-modify-write cycles on the key never overwrite each other
+	Update(ctx context.Context, key string, fn UpdateFunc) error
+
 	// Close closes the cache connection
 	Close() error
 }
 
+// UpdateFunc computes the new value of a key and its expiration from the current value,
+// which is nil when the key does not exist. It may be called several times by one Update.
+type UpdateFunc func(current []byte) (value []byte, expiration time.Duration, err error)
+
+// maxUpdateAttempts bounds the retries of Update when the key keeps changing concurrently
+const maxUpdateAttempts = 10
+
 // RedisCache implements Cache interface using Redis
 type RedisCache struct {
 	client *redis.Client
@@ -52,6 +64,11 @@ func NewRedisCache(addr string, password string, db int, component string) (*Red
 		WriteTimeout: 3 * time.Second,
 	})
 
+	// One client span per command, nested under the request or message being handled
+	if err := tracing.InstrumentRedis(client); err != nil {
+		return nil, err
+	}
+
 	// Test connection
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -154,6 +171,51 @@ func (c *RedisCache) Exists(ctx context.Context, key string) (bool, error) {
 	return count > 0, nil
 }
 
+// Update runs fn under WATCH and writes its result in a MULTI/EXEC transaction, so the write
+// fails and fn runs again when another client changed the key meanwhile (check-and-set)
+func (c *RedisCache) Update(ctx context.Context, key string, fn UpdateFunc) error {
+	txf := func(tx *redis.Tx) error {
+		current, err := tx.Get(ctx, key).Bytes()
+		if err == redis.Nil {
+			current = nil
+		} else if err != nil {
+			return err
+		}
+
+		value, expiration, err := fn(current)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, key, value, expiration)
+			return nil
+		})
+		return err
+	}
+
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		err := c.client.Watch(ctx, txf, key)
+		if err == nil {
+			return nil
+		}
+		if errors.Is(err, redis.TxFailedErr) {
+			continue
+		}
+		c.log.Error().
+			Err(err).
+			Str("key", key).
+			Msg("failed to update cache")
+		return err
+	}
+
+	c.log.Error().
+		Str("key", key).
+		Int("attempts", maxUpdateAttempts).
+		Msg("failed to update cache, key kept changing")
+	return ErrUpdateConflict
+}
+
 // Close closes the cache connection
 func (c *RedisCache) Close() error {
 	if err := c.client.Close(); err != nil {
@@ -168,3 +230,6 @@ func (c *RedisCache) Close() error {
 // ErrCacheMiss is returned when a key is not found in cache
 var ErrCacheMiss = errors.New("cache miss")
 
+// ErrUpdateConflict is returned by Update when the key changed concurrently on every attempt
+var ErrUpdateConflict = errors.New("cache update conflict")
+
@@ -0,0 +1,172 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang-social-media/pkg/tracing"
+
+	"github.com/rs/zerolog"
+	"github.com/segmentio/kafka-go"
+)
+
+// ErrDeadLettered is returned by Retrier.Handle when a message kept failing and was moved to its dead-letter topic
+var ErrDeadLettered = errors.New("message moved to dead-letter topic")
+
+// Headers added to dead-lettered messages, next to the headers of the original message
+const (
+	HeaderOriginalTopic     = "dlq.original_topic"
+	HeaderOriginalPartition = "dlq.original_partition"
+	HeaderOriginalOffset    = "dlq.original_offset"
+	HeaderConsumerGroup     = "dlq.consumer_group"
+	HeaderAttempts          = "dlq.attempts"
+	HeaderError             = "dlq.error"
+)
+
+// DeadLetterTopic returns the topic the messages of topic are dead-lettered to
+func DeadLetterTopic(topic string) string {
+	return topic + ".dlq"
+}
+
+// RetryConfig bounds how often a consumed message is handled before it is dead-lettered
+type RetryConfig struct {
+	// MaxAttempts is the number of failed attempts after which a message is dead-lettered
+	MaxAttempts int
+	// MinDelay is the delay after the first failure; it doubles with every further failure up to MaxDelay
+	MinDelay time.Duration
+	MaxDelay time.Duration
+}
+
+// DefaultRetryConfig tries a message 10 times, backing off from 500ms to 30s (about 2 minutes in total)
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts: 10,
+		MinDelay:    500 * time.Millisecond,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// Retrier handles consumed messages with bounded retries and moves the ones that keep failing to their
+// dead-letter topic, so a poison message cannot stall its partition. It is safe for concurrent use
+type Retrier struct {
+	group  string
+	config RetryConfig
+	writer *kafka.Writer
+}
+
+// NewRetrier creates a Retrier for the consumer group; dead letters are written to brokers
+func NewRetrier(brokers []string, group string, config RetryConfig) *Retrier {
+	return &Retrier{
+		group:  group,
+		config: config,
+		writer: &kafka.Writer{
+			Addr:                   kafka.TCP(brokers...),
+			Balancer:               &kafka.Hash{}, // Dead letters of one key stay in order
+			RequiredAcks:           kafka.RequireAll,
+			MaxAttempts:            10,
+			WriteTimeout:           10 * time.Second,
+			AllowAutoTopicCreation: true,
+		},
+	}
+}
+
+// Handle calls handle until it succeeds, backing off exponentially between attempts. After
+// MaxAttempts failures msg is written to its dead-letter topic and an error wrapping ErrDeadLettered
+// and the last failure is returned; the caller commits msg in both cases. Any other error means
+// ctx is done and msg must not be committed
+func (r *Retrier) Handle(ctx context.Context, log *zerolog.Logger, msg kafka.Message, handle func() error) error {
+	delay := r.config.MinDelay
+	for attempt := 1; ; attempt++ {
+		err := handle()
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= r.config.MaxAttempts {
+			log.Error().Ctx(ctx).
+				Err(err).
+				Int("attempts", attempt).
+				Int64("offset", msg.Offset).
+				Str("dead_letter_topic", DeadLetterTopic(msg.Topic)).
+				Msg("failed to handle event, moving it to the dead-letter topic")
+			if err := r.deadLetter(ctx, log, msg, attempt, err); err != nil {
+				return err
+			}
+			return fmt.Errorf("%w after %d attempts: %v", ErrDeadLettered, attempt, err)
+		}
+
+		log.Error().Ctx(ctx).
+			Err(err).
+			Int("attempt", attempt).
+			Dur("retry_in", delay).
+			Msg("failed to handle event, retrying")
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+		delay = r.nextDelay(delay)
+	}
+}
+
+// deadLetter writes msg to its dead-letter topic, retrying until the write succeeds or ctx is done,
+// since committing the message without it would lose the event
+func (r *Retrier) deadLetter(ctx context.Context, log *zerolog.Logger, msg kafka.Message, attempts int, cause error) error {
+	headers := make([]kafka.Header, 0, len(msg.Headers)+6)
+	headers = append(headers, msg.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: HeaderOriginalTopic, Value: []byte(msg.Topic)},
+		kafka.Header{Key: HeaderOriginalPartition, Value: []byte(strconv.Itoa(msg.Partition))},
+		kafka.Header{Key: HeaderOriginalOffset, Value: []byte(strconv.FormatInt(msg.Offset, 10))},
+		kafka.Header{Key: HeaderConsumerGroup, Value: []byte(r.group)},
+		kafka.Header{Key: HeaderAttempts, Value: []byte(strconv.Itoa(attempts))},
+		kafka.Header{Key: HeaderError, Value: []byte(cause.Error())},
+	)
+	deadLetter := kafka.Message{
+		Topic:   DeadLetterTopic(msg.Topic),
+		Key:     msg.Key,
+		Value:   msg.Value,
+		Headers: headers,
+	}
+
+	delay := r.config.MinDelay
+	for {
+		err := tracing.WriteKafkaMessage(ctx, r.writer, deadLetter)
+		if err == nil {
+			return nil
+		}
+		log.Error().Ctx(ctx).
+			Err(err).
+			Str("dead_letter_topic", deadLetter.Topic).
+			Dur("retry_in", delay).
+			Msg("failed to write dead letter, retrying")
+
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+		delay = r.nextDelay(delay)
+	}
+}
+
+func (r *Retrier) nextDelay(delay time.Duration) time.Duration {
+	if delay *= 2; delay > r.config.MaxDelay {
+		return r.config.MaxDelay
+	}
+	return delay
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(delay):
+		return nil
+	}
+}
+
+// Close flushes and closes the dead-letter writer
+func (r *Retrier) Close() error {
+	return r.writer.Close()
+}
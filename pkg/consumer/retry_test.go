@@ -0,0 +1,87 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/segmentio/kafka-go"
+)
+
+func newTestRetrier(maxAttempts int) *Retrier {
+	return NewRetrier([]string{"localhost:9092"}, "test-group", RetryConfig{
+		MaxAttempts: maxAttempts,
+		MinDelay:    time.Millisecond,
+		MaxDelay:    2 * time.Millisecond,
+	})
+}
+
+func TestRetrier_HandleRetriesUntilSuccess(t *testing.T) {
+	retrier := newTestRetrier(5)
+	log := zerolog.Nop()
+
+	calls := 0
+	err := retrier.Handle(context.Background(), &log, kafka.Message{Topic: "user.deleted"}, func() error {
+		calls++
+		if calls < 3 {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Handle() error = %v, want nil", err)
+	}
+	if calls != 3 {
+		t.Errorf("handle called %d times, want 3", calls)
+	}
+}
+
+func TestRetrier_HandleStopsWhenContextDone(t *testing.T) {
+	retrier := newTestRetrier(100)
+	log := zerolog.Nop()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	calls := 0
+	err := retrier.Handle(ctx, &log, kafka.Message{Topic: "user.deleted"}, func() error {
+		calls++
+		cancel()
+		return errors.New("temporary failure")
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Handle() error = %v, want context.Canceled", err)
+	}
+	if errors.Is(err, ErrDeadLettered) {
+		t.Error("Handle() reported a dead letter for a cancelled context")
+	}
+	if calls != 1 {
+		t.Errorf("handle called %d times, want 1", calls)
+	}
+}
+
+func TestRetrier_NextDelay(t *testing.T) {
+	retrier := &Retrier{config: RetryConfig{MinDelay: time.Second, MaxDelay: 5 * time.Second}}
+
+	tests := []struct {
+		delay time.Duration
+		want  time.Duration
+	}{
+		{delay: time.Second, want: 2 * time.Second},
+		{delay: 2 * time.Second, want: 4 * time.Second},
+		{delay: 4 * time.Second, want: 5 * time.Second},
+		{delay: 5 * time.Second, want: 5 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := retrier.nextDelay(tt.delay); got != tt.want {
+			t.Errorf("nextDelay(%v) = %v, want %v", tt.delay, got, tt.want)
+		}
+	}
+}
+
+func TestDeadLetterTopic(t *testing.T) {
+	if got := DeadLetterTopic("user.deleted"); got != "user.deleted.dlq" {
+		t.Errorf("DeadLetterTopic() = %q, want %q", got, "user.deleted.dlq")
+	}
+}
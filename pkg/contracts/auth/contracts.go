@@ -1,5 +1,10 @@
 package auth
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type RegisterRequest struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
@@ -19,9 +24,16 @@ type LoginRequest struct {
 
 type LoginResponse struct {
 	UserID       string `json:"userId"`
-	AccessToken  string `json:"accessToken"`
-	RefreshToken string `json:"refreshToken"`
-	ExpiresIn    int64  `json:"expiresIn"` // seconds
+	AccessToken  string `json:"accessToken,omitempty"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+	ExpiresIn    int64  `json:"expiresIn,omitempty"` // seconds
+	MFARequired  bool   `json:"mfaRequired,omitempty"`
+	MFAToken     string `json:"mfaToken,omitempty"` // Challenge token for POST /auth/login/mfa, set instead of tokens when MFARequired
+}
+
+type MFALoginRequest struct {
+	MFAToken string `json:"mfaToken"`
+	Code     string `json:"code"` // TOTP code or recovery code
 }
 
 type RefreshTokenRequest struct {
@@ -72,6 +84,228 @@ type ProfileResponse struct {
 	Name  string `json:"name"`
 }
 
+type SessionResponse struct {
+	ID         string    `json:"id"`
+	UserAgent  string    `json:"userAgent"`
+	IPAddress  string    `json:"ipAddress"`
+	CreatedAt  time.Time `json:"createdAt"`
+	LastUsedAt time.Time `json:"lastUsedAt"`
+	Current    bool      `json:"current"`
+}
+
+type ListSessionsResponse struct {
+	Sessions []SessionResponse `json:"sessions"`
+}
+
+type RevokeOtherSessionsResponse struct {
+	Revoked int64 `json:"revoked"`
+}
+
+type EnrollMFAResponse struct {
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioningUri"`
+	RecoveryCodes   []string `json:"recoveryCodes"` // Shown once, only hashes are stored
+}
+
+type ConfirmMFARequest struct {
+	Code string `json:"code"`
+}
+
+type DisableMFARequest struct {
+	Code string `json:"code"` // TOTP code or recovery code
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"` // Token from the verification link
+}
+
+type ForgotPasswordRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token"` // Token from the password reset link
+	NewPassword string `json:"newPassword"`
+}
+
+type RoleResponse struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ParentID    string `json:"parentId,omitempty"`
+}
+
+type PermissionResponse struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+type CreateRoleRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type UpdateRoleRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type CreatePermissionRequest struct {
+	Name     string `json:"name"`
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+type UpdatePermissionRequest struct {
+	Name     string `json:"name"`
+	Resource string `json:"resource"`
+	Action   string `json:"action"`
+}
+
+type GrantPermissionRequest struct {
+	PermissionID string `json:"permissionId"`
+}
+
+type AssignRoleRequest struct {
+	RoleID string `json:"roleId"`
+}
+
+// SetRoleParentRequest makes a role inherit the permissions of parentId; an empty parentId removes inheritance
+type SetRoleParentRequest struct {
+	ParentID string `json:"parentId"`
+}
+
+type ListRolesResponse struct {
+	Roles  []RoleResponse `json:"roles"`
+	Total  int64          `json:"total"`
+	Limit  int            `json:"limit"`
+	Offset int            `json:"offset"`
+}
+
+type ListPermissionsResponse struct {
+	Permissions []PermissionResponse `json:"permissions"`
+	Total       int64                `json:"total"`
+	Limit       int                  `json:"limit"`
+	Offset      int                  `json:"offset"`
+}
+
+type RolePermissionsResponse struct {
+	RoleID      string               `json:"roleId"`
+	Permissions []PermissionResponse `json:"permissions"`
+}
+
+type UserRolesResponse struct {
+	UserID string         `json:"userId"`
+	Roles  []RoleResponse `json:"roles"`
+}
+
+type UserPermissionsResponse struct {
+	UserID      string               `json:"userId"`
+	Permissions []PermissionResponse `json:"permissions"` // Union of the permissions of all the user's roles
+}
+
+// CreateAPIKeyRequest creates an API key; scopes are "resource:action" permissions the user holds
+// and a nil expiresAt means the key never expires
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type APIKeyResponse struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"` // First characters of the key, to tell keys apart
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKeyResponse
+	Key string `json:"key"` // Shown once, only the hash is stored
+}
+
+type ListAPIKeysResponse struct {
+	APIKeys []APIKeyResponse `json:"apiKeys"`
+}
+
+type AuditEventResponse struct {
+	ID            string          `json:"id"`
+	AggregateID   string          `json:"aggregateId"`
+	AggregateType string          `json:"aggregateType"`
+	EventType     string          `json:"eventType"`
+	EventVersion  int             `json:"eventVersion"`
+	Payload       json.RawMessage `json:"payload"`
+	ActorID       string          `json:"actorId,omitempty"` // Empty for events without an authenticated caller, e.g. login
+	APIKeyID      string          `json:"apiKeyId,omitempty"`
+	IPAddress     string          `json:"ipAddress,omitempty"`
+	UserAgent     string          `json:"userAgent,omitempty"`
+	RequestID     string          `json:"requestId,omitempty"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+}
+
+type ListAuditEventsResponse struct {
+	Events     []AuditEventResponse `json:"events"`
+	NextCursor string               `json:"nextCursor,omitempty"` // Empty on the last page
+}
+
+// UserStateResponse is a user as it was at AsOf, replayed from the event store.
+// Only event-derived fields are known; Version counts the events replayed
+type UserStateResponse struct {
+	ID            string     `json:"id"`
+	Email         string     `json:"email"`
+	Name          string     `json:"name"`
+	EmailVerified bool       `json:"emailVerified"`
+	MFAEnabled    bool       `json:"mfaEnabled"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	DeletedAt     *time.Time `json:"deletedAt,omitempty"`
+	AsOf          time.Time  `json:"asOf"`
+	Version       int        `json:"version"`
+	LastEventAt   time.Time  `json:"lastEventAt"`
+}
+
+// RoleStateResponse is a role as it was at AsOf, replayed from the event store
+type RoleStateResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	ParentID    string    `json:"parentId,omitempty"`
+	Deleted     bool      `json:"deleted"`
+	CreatedAt   time.Time `json:"createdAt"`
+	AsOf        time.Time `json:"asOf"`
+	Version     int       `json:"version"`
+	LastEventAt time.Time `json:"lastEventAt"`
+}
+
+// DeleteAccountRequest confirms the deletion of the caller's account with their password
+type DeleteAccountRequest struct {
+	Password string `json:"password"`
+}
+
+type GDPRStepResponse struct {
+	Service     string     `json:"service"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	Records     int64      `json:"records,omitempty"` // Deletion: rows erased or anonymized by the service
+}
+
+// GDPRRequestResponse is the progress of a deletion or data export across services
+type GDPRRequestResponse struct {
+	ID          string             `json:"id"`
+	UserID      string             `json:"userId"`
+	Kind        string             `json:"kind"`   // deletion or export
+	Status      string             `json:"status"` // in_progress or completed
+	Steps       []GDPRStepResponse `json:"steps"`
+	Completed   int                `json:"completed"`
+	Total       int                `json:"total"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	CompletedAt *time.Time         `json:"completedAt,omitempty"`
+}
+
 type ErrorResponse struct {
 	Error string `json:"error"`
 }
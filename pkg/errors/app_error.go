@@ -79,6 +79,10 @@ func NewUnauthorizedError() *AppError {
 	return NewAppError(CodeUnauthorized, http.StatusUnauthorized)
 }
 
+func NewUnauthorizedErrorWithCode(code ErrorCode) *AppError {
+	return NewAppError(code, http.StatusUnauthorized)
+}
+
 func NewForbiddenError() *AppError {
 	return NewAppError(CodeForbidden, http.StatusForbidden)
 }
@@ -16,25 +16,67 @@ const (
 	CodeRateLimitExceeded ErrorCode = "ERR_0009"
 
 	// Auth service errors (1xxx)
-	CodeEmailRequired      ErrorCode = "ERR_1001"
-	CodeEmailInvalid       ErrorCode = "ERR_1002"
-	CodeEmailAlreadyExists ErrorCode = "ERR_1003"
-	CodePasswordRequired   ErrorCode = "ERR_1004"
-	CodePasswordTooShort   ErrorCode = "ERR_1005"
-	CodePasswordInvalid    ErrorCode = "ERR_1006"
-	CodeNameRequired       ErrorCode = "ERR_1007"
-	CodeUserNotFound       ErrorCode = "ERR_1008"
-	CodeInvalidCredentials ErrorCode = "ERR_1009"
-	CodeTokenInvalid       ErrorCode = "ERR_1010"
-	CodeTokenExpired       ErrorCode = "ERR_1011"
+	CodeEmailRequired                 ErrorCode = "ERR_1001"
+	CodeEmailInvalid                  ErrorCode = "ERR_1002"
+	CodeEmailAlreadyExists            ErrorCode = "ERR_1003"
+	CodePasswordRequired              ErrorCode = "ERR_1004"
+	CodePasswordTooShort              ErrorCode = "ERR_1005"
+	CodePasswordInvalid               ErrorCode = "ERR_1006"
+	CodeNameRequired                  ErrorCode = "ERR_1007"
+	CodeUserNotFound                  ErrorCode = "ERR_1008"
+	CodeInvalidCredentials            ErrorCode = "ERR_1009"
+	CodeTokenInvalid                  ErrorCode = "ERR_1010"
+	CodeTokenExpired                  ErrorCode = "ERR_1011"
+	CodeTokenRevoked                  ErrorCode = "ERR_1012"
+	CodeTokenReused                   ErrorCode = "ERR_1013"
+	CodeSessionNotFound               ErrorCode = "ERR_1014"
+	CodeMFAAlreadyEnabled             ErrorCode = "ERR_1015"
+	CodeMFANotEnabled                 ErrorCode = "ERR_1016"
+	CodeMFANotEnrolled                ErrorCode = "ERR_1017"
+	CodeMFACodeInvalid                ErrorCode = "ERR_1018"
+	CodeEmailNotVerified              ErrorCode = "ERR_1019"
+	CodeEmailAlreadyVerified          ErrorCode = "ERR_1020"
+	CodeVerificationTokenInvalid      ErrorCode = "ERR_1021"
+	CodeAccountLocked                 ErrorCode = "ERR_1022"
+	CodeNameTooShort                  ErrorCode = "ERR_1023"
+	CodeNameTooLong                   ErrorCode = "ERR_1024"
+	CodeRoleInheritanceCycle          ErrorCode = "ERR_1025"
+	CodeAPIKeyInvalid                 ErrorCode = "ERR_1026"
+	CodeAPIKeyNotFound                ErrorCode = "ERR_1027"
+	CodeAPIKeyScopeInvalid            ErrorCode = "ERR_1028"
+	CodeAPIKeyExpiryInvalid           ErrorCode = "ERR_1029"
+	CodePasswordTooLong               ErrorCode = "ERR_1030"
+	CodePasswordMissingCharacterClass ErrorCode = "ERR_1031"
+	CodePasswordReused                ErrorCode = "ERR_1032"
+	CodePasswordBreached              ErrorCode = "ERR_1033"
+	CodeUserDeleted                   ErrorCode = "ERR_1034"
+	CodeGDPRRequestNotFound           ErrorCode = "ERR_1035"
+	CodeDataExportNotReady            ErrorCode = "ERR_1036"
 
 	// Chat service errors (2xxx)
-	CodeMessageContentRequired ErrorCode = "ERR_2001"
-	CodeMessageContentTooLong  ErrorCode = "ERR_2002"
-	CodeSenderIDRequired       ErrorCode = "ERR_2003"
-	CodeReceiverIDRequired     ErrorCode = "ERR_2004"
-	CodeMessageNotFound        ErrorCode = "ERR_2005"
-	CodeChatNotFound           ErrorCode = "ERR_2006"
+	CodeMessageContentRequired       ErrorCode = "ERR_2001"
+	CodeMessageContentTooLong        ErrorCode = "ERR_2002"
+	CodeSenderIDRequired             ErrorCode = "ERR_2003"
+	CodeReceiverIDRequired           ErrorCode = "ERR_2004"
+	CodeMessageNotFound              ErrorCode = "ERR_2005"
+	CodeChatNotFound                 ErrorCode = "ERR_2006"
+	CodeConversationNotFound         ErrorCode = "ERR_2007"
+	CodeConversationIDRequired       ErrorCode = "ERR_2008"
+	CodeNotConversationMember        ErrorCode = "ERR_2009"
+	CodeAlreadyConversationMember    ErrorCode = "ERR_2010"
+	CodeConversationFull             ErrorCode = "ERR_2011"
+	CodeConversationTitleRequired    ErrorCode = "ERR_2012"
+	CodeConversationTitleTooLong     ErrorCode = "ERR_2013"
+	CodeConversationRoleInvalid      ErrorCode = "ERR_2014"
+	CodeConversationPermissionDenied ErrorCode = "ERR_2015"
+	CodeDirectConversationImmutable  ErrorCode = "ERR_2016"
+	CodeMessageIDRequired            ErrorCode = "ERR_2017"
+	CodeMessageEditForbidden         ErrorCode = "ERR_2018"
+	CodeMessageDeleteForbidden       ErrorCode = "ERR_2019"
+	CodeMessageDeleteWindowExpired   ErrorCode = "ERR_2020"
+	CodeMessageDeleted               ErrorCode = "ERR_2021"
+	CodeReactionEmojiInvalid         ErrorCode = "ERR_2022"
+	CodeReactionLimitReached         ErrorCode = "ERR_2023"
 
 	// Notification service errors (3xxx)
 	CodeNotificationNotFound ErrorCode = "ERR_3001"
@@ -84,25 +126,67 @@ func (c ErrorCode) GetMessage() string {
 		CodeRateLimitExceeded: "Rate limit exceeded. Please try again later.",
 
 		// Auth
-		CodeEmailRequired:      "Email is required.",
-		CodeEmailInvalid:       "Email format is invalid.",
-		CodeEmailAlreadyExists: "An account with this email already exists.",
-		CodePasswordRequired:   "Password is required.",
-		CodePasswordTooShort:   "Password must be at least 6 characters long.",
-		CodePasswordInvalid:    "Password is incorrect.",
-		CodeNameRequired:       "Name is required.",
-		CodeUserNotFound:       "User not found.",
-		CodeInvalidCredentials: "Invalid email or password.",
-		CodeTokenInvalid:       "Invalid authentication token.",
-		CodeTokenExpired:       "Authentication token has expired.",
+		CodeEmailRequired:                 "Email is required.",
+		CodeEmailInvalid:                  "Email format is invalid.",
+		CodeEmailAlreadyExists:            "An account with this email already exists.",
+		CodePasswordRequired:              "Password is required.",
+		CodePasswordTooShort:              "Password is too short.",
+		CodePasswordInvalid:               "Password is incorrect.",
+		CodeNameRequired:                  "Name is required.",
+		CodeUserNotFound:                  "User not found.",
+		CodeInvalidCredentials:            "Invalid email or password.",
+		CodeTokenInvalid:                  "Invalid authentication token.",
+		CodeTokenExpired:                  "Authentication token has expired.",
+		CodeTokenRevoked:                  "Authentication token has been revoked.",
+		CodeTokenReused:                   "Refresh token was already used. All sessions for this login have been revoked.",
+		CodeSessionNotFound:               "Session not found.",
+		CodeMFAAlreadyEnabled:             "Multi-factor authentication is already enabled.",
+		CodeMFANotEnabled:                 "Multi-factor authentication is not enabled.",
+		CodeMFANotEnrolled:                "Start multi-factor authentication enrollment before confirming it.",
+		CodeMFACodeInvalid:                "The verification code is invalid or has expired.",
+		CodeEmailNotVerified:              "Please verify your email address before logging in.",
+		CodeEmailAlreadyVerified:          "Email address is already verified.",
+		CodeVerificationTokenInvalid:      "The link is invalid or has expired. Please request a new one.",
+		CodeAccountLocked:                 "Account is temporarily locked due to too many failed login attempts. Please try again later.",
+		CodeNameTooShort:                  "Name is too short.",
+		CodeNameTooLong:                   "Name is too long.",
+		CodeRoleInheritanceCycle:          "A role cannot inherit from itself or from a role that inherits from it.",
+		CodeAPIKeyInvalid:                 "API key is invalid, expired or revoked.",
+		CodeAPIKeyNotFound:                "API key not found.",
+		CodeAPIKeyScopeInvalid:            "API key scopes must be permissions you hold, in resource:action form.",
+		CodeAPIKeyExpiryInvalid:           "API key expiry must be in the future.",
+		CodePasswordTooLong:               "Password is too long.",
+		CodePasswordMissingCharacterClass: "Password must contain the required kinds of characters.",
+		CodePasswordReused:                "Password was used recently. Please choose a different one.",
+		CodePasswordBreached:              "This password has appeared in a data breach. Please choose a different one.",
+		CodeUserDeleted:                   "User has been deleted.",
+		CodeGDPRRequestNotFound:           "Data request not found.",
+		CodeDataExportNotReady:            "Data export is not ready yet. Please try again later.",
 
 		// Chat
-		CodeMessageContentRequired: "Message content is required.",
-		CodeMessageContentTooLong:  "Message content is too long.",
-		CodeSenderIDRequired:       "Sender ID is required.",
-		CodeReceiverIDRequired:     "Receiver ID is required.",
-		CodeMessageNotFound:        "Message not found.",
-		CodeChatNotFound:           "Chat not found.",
+		CodeMessageContentRequired:       "Message content is required.",
+		CodeMessageContentTooLong:        "Message content is too long.",
+		CodeSenderIDRequired:             "Sender ID is required.",
+		CodeReceiverIDRequired:           "Receiver ID is required.",
+		CodeMessageNotFound:              "Message not found.",
+		CodeChatNotFound:                 "Chat not found.",
+		CodeConversationNotFound:         "Conversation not found.",
+		CodeConversationIDRequired:       "Conversation ID is required.",
+		CodeNotConversationMember:        "You are not a member of this conversation.",
+		CodeAlreadyConversationMember:    "User is already a member of this conversation.",
+		CodeConversationFull:             "Conversation has reached its member limit.",
+		CodeConversationTitleRequired:    "Group title is required.",
+		CodeConversationTitleTooLong:     "Group title is too long.",
+		CodeConversationRoleInvalid:      "Conversation member role is invalid.",
+		CodeConversationPermissionDenied: "Your role in this conversation does not allow this action.",
+		CodeDirectConversationImmutable:  "Members of a direct conversation cannot be changed.",
+		CodeMessageIDRequired:            "Message ID is required.",
+		CodeMessageEditForbidden:         "Only the sender can edit a message.",
+		CodeMessageDeleteForbidden:       "Only the sender can delete a message for everyone.",
+		CodeMessageDeleteWindowExpired:   "The message is too old to be deleted for everyone.",
+		CodeMessageDeleted:               "The message has been deleted.",
+		CodeReactionEmojiInvalid:         "Reaction must be a single emoji.",
+		CodeReactionLimitReached:         "The message has too many different reactions.",
 
 		// Notification
 		CodeNotificationNotFound: "Notification not found.",
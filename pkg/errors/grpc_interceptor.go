@@ -27,7 +27,7 @@ func GRPCErrorInterceptor(transformer *Transformer) grpc.UnaryServerInterceptor
 		appErr := transformer.Transform(err)
 
 		// Log the error
-		log := logger.Component("grpc.error_interceptor")
+		log := logger.ComponentCtx(ctx, "grpc.error_interceptor")
 		logGRPCError(log, appErr, info)
 
 		// Convert to gRPC status
@@ -24,7 +24,7 @@ func ErrorMiddleware(transformer *Transformer) gin.HandlerFunc {
 			appErr := transformer.Transform(err)
 
 			// Log the error
-			log := logger.Component("http.error_middleware")
+			log := logger.ComponentCtx(c.Request.Context(), "http.error_middleware")
 			logError(log, appErr, c)
 
 			// Return error response
@@ -49,7 +49,7 @@ func ErrorHandler(transformer *Transformer) func(*gin.Context, error) {
 		appErr := transformer.Transform(err)
 
 		// Log the error
-		log := logger.Component("http.error_handler")
+		log := logger.ComponentCtx(c.Request.Context(), "http.error_handler")
 		logError(log, appErr, c)
 
 		// Return error response
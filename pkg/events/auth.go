@@ -0,0 +1,40 @@
+package events
+
+import "time"
+
+// RefreshTokenReused is published when an already-rotated refresh token is replayed
+// and its whole token family has been revoked
+type RefreshTokenReused struct {
+	UserID     string    `json:"userId"`
+	FamilyID   string    `json:"familyId"`
+	TokenID    string    `json:"tokenId"`
+	DetectedAt time.Time `json:"detectedAt"`
+}
+
+// MFAEnabled is published when a user turns on TOTP multi-factor authentication
+type MFAEnabled struct {
+	UserID    string    `json:"userId"`
+	EnabledAt time.Time `json:"enabledAt"`
+}
+
+// MFADisabled is published when a user turns off multi-factor authentication
+type MFADisabled struct {
+	UserID     string    `json:"userId"`
+	DisabledAt time.Time `json:"disabledAt"`
+}
+
+// UserLockedOut is published when too many failed logins temporarily lock an account
+type UserLockedOut struct {
+	UserID         string    `json:"userId"`
+	Email          string    `json:"email"`
+	FailedAttempts int       `json:"failedAttempts"`
+	LockedUntil    time.Time `json:"lockedUntil"`
+	LockedAt       time.Time `json:"lockedAt"`
+}
+
+// UserPasswordRehashed is published when a stored password hash is upgraded to the current algorithm
+type UserPasswordRehashed struct {
+	UserID     string    `json:"userId"`
+	Algorithm  string    `json:"algorithm"`
+	RehashedAt time.Time `json:"rehashedAt"`
+}
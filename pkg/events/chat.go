@@ -8,9 +8,78 @@ type ChatCreated struct {
 }
 
 type ChatMessage struct {
-	ID         string
-	SenderID   string
-	ReceiverID string
-	Content    string
-	CreatedAt  time.Time
+	ID             string
+	ConversationID string
+	SenderID       string
+	ReceiverID     string // Set for direct conversations only
+	Content        string
+	CreatedAt      time.Time
+	// RecipientIDs are the conversation members other than the sender
+	RecipientIDs []string
+	// ParentMessageID is the message this one replies to in a thread, empty otherwise
+	ParentMessageID string `json:",omitempty"`
+}
+
+// ChatMessageEdited is published when the sender edits a message; Content is the new version
+type ChatMessageEdited struct {
+	MessageID      string
+	ConversationID string
+	SenderID       string
+	RecipientIDs   []string
+	Content        string
+	EditedAt       time.Time
+}
+
+// ChatMessageDeleted is published when the sender deletes a message for everyone
+type ChatMessageDeleted struct {
+	MessageID      string
+	ConversationID string
+	SenderID       string
+	RecipientIDs   []string
+	DeletedAt      time.Time
+}
+
+// ChatMessageDeletedForMe is published when a member deletes a message for themselves,
+// so that their other devices hide it too
+type ChatMessageDeletedForMe struct {
+	MessageID      string
+	ConversationID string
+	UserID         string
+	DeletedAt      time.Time
+}
+
+// ChatMessageReceipt is published on TopicChatMessageDelivered and TopicChatMessageRead when the watermark
+// of a member moves: every message of the conversation up to MessageID, in (created_at, id) order, was
+// delivered to, or read by, UserID. RecipientIDs are the other members, who show the receipt
+type ChatMessageReceipt struct {
+	ConversationID   string
+	UserID           string
+	MessageID        string
+	MessageCreatedAt time.Time
+	RecipientIDs     []string
+	At               time.Time
+}
+
+// ChatMessageReaction is published on TopicChatMessageReactionAdded and TopicChatMessageReactionRemoved when
+// UserID toggles Emoji on a message. Count is the number of members reacting with Emoji afterwards;
+// RecipientIDs are the other members, who update the message
+type ChatMessageReaction struct {
+	MessageID      string
+	ConversationID string
+	UserID         string
+	Emoji          string
+	Count          int
+	RecipientIDs   []string
+	At             time.Time
+}
+
+// ChatMentionCreated is published when a new message mentions members of its conversation
+type ChatMentionCreated struct {
+	MessageID        string
+	ConversationID   string
+	ParentMessageID  string `json:",omitempty"`
+	SenderID         string
+	MentionedUserIDs []string
+	Content          string
+	CreatedAt        time.Time
 }
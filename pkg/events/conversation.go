@@ -0,0 +1,69 @@
+package events
+
+import "time"
+
+// Kinds of a chat conversation
+const (
+	ConversationKindDirect = "direct"
+	ConversationKindGroup  = "group"
+)
+
+// Roles of a conversation member
+const (
+	ConversationRoleOwner  = "owner"
+	ConversationRoleAdmin  = "admin"
+	ConversationRoleMember = "member"
+)
+
+// Conversation is the state of a chat conversation after the change an event reports.
+// Members lists everyone to fan the event out to
+type Conversation struct {
+	ID        string               `json:"id"`
+	Kind      string               `json:"kind"`
+	Title     string               `json:"title,omitempty"`
+	AvatarURL string               `json:"avatarUrl,omitempty"`
+	CreatedBy string               `json:"createdBy"`
+	Members   []ConversationMember `json:"members"`
+	CreatedAt time.Time            `json:"createdAt"`
+	UpdatedAt time.Time            `json:"updatedAt"`
+}
+
+type ConversationMember struct {
+	UserID   string    `json:"userId"`
+	Role     string    `json:"role"`
+	JoinedAt time.Time `json:"joinedAt"`
+}
+
+// MemberIDs returns the user IDs of the members
+func (c Conversation) MemberIDs() []string {
+	ids := make([]string, len(c.Members))
+	for i, member := range c.Members {
+		ids[i] = member.UserID
+	}
+	return ids
+}
+
+// ConversationCreated is published when a group is created, or a direct conversation on its first message
+type ConversationCreated struct {
+	Conversation Conversation `json:"conversation"`
+	CreatedAt    time.Time    `json:"createdAt"`
+}
+
+// ConversationUpdated is published when the title or avatar of a group changes
+type ConversationUpdated struct {
+	Conversation Conversation `json:"conversation"`
+	ActorID      string       `json:"actorId"`
+	UpdatedAt    time.Time    `json:"updatedAt"`
+}
+
+// ConversationMemberChanged is published on TopicConversationMemberJoined, TopicConversationMemberLeft,
+// TopicConversationMemberRemoved and TopicConversationMemberRoleChanged.
+// UserID is the member the change is about and ActorID who made it (UserID itself when leaving).
+// A member who left or was removed is no longer in Conversation.Members
+type ConversationMemberChanged struct {
+	Conversation Conversation `json:"conversation"`
+	ActorID      string       `json:"actorId"`
+	UserID       string       `json:"userId"`
+	Role         string       `json:"role"` // Role of UserID after the change, empty once they are gone
+	OccurredAt   time.Time    `json:"occurredAt"`
+}
@@ -0,0 +1,48 @@
+package events
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Services taking part in the GDPR deletion and export sagas, as reported in Service
+const (
+	ServiceAuth         = "auth"
+	ServiceChat         = "chat"
+	ServiceNotification = "notification"
+	ServiceEcommerce    = "ecommerce"
+)
+
+// UserDeleted is published when a user is deleted; every service erases or anonymizes its copy
+// of the user's data and answers with UserDataErased
+type UserDeleted struct {
+	RequestID string    `json:"requestId"` // GDPR request tracking the deletion saga
+	UserID    string    `json:"userId"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// UserExportRequested is published when a user asks for a copy of their data;
+// every service answers with UserDataExported
+type UserExportRequested struct {
+	RequestID   string    `json:"requestId"`
+	UserID      string    `json:"userId"`
+	RequestedAt time.Time `json:"requestedAt"`
+}
+
+// UserDataErased reports that a service finished erasing a deleted user's data
+type UserDataErased struct {
+	RequestID string    `json:"requestId"`
+	UserID    string    `json:"userId"`
+	Service   string    `json:"service"`
+	Records   int64     `json:"records"` // Rows erased or anonymized, for the audit of the saga
+	ErasedAt  time.Time `json:"erasedAt"`
+}
+
+// UserDataExported carries the JSON document a service holds about a user
+type UserDataExported struct {
+	RequestID  string          `json:"requestId"`
+	UserID     string          `json:"userId"`
+	Service    string          `json:"service"`
+	Document   json.RawMessage `json:"document"`
+	ExportedAt time.Time       `json:"exportedAt"`
+}
@@ -6,6 +6,7 @@ type Notification struct {
 	ID        string            `json:"id"`
 	UserID    string            `json:"userId"`
 	Type      string            `json:"type"`
+	Priority  string            `json:"priority,omitempty"` // "high" for mentions, "normal" otherwise
 	Title     string            `json:"title"`
 	Body      string            `json:"body"`
 	Metadata  map[string]string `json:"metadata,omitempty"`
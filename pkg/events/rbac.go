@@ -0,0 +1,74 @@
+package events
+
+import "time"
+
+// RoleCreated is published when a new role is defined
+type RoleCreated struct {
+	RoleID    string    `json:"roleId"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// RoleUpdated is published when a role is renamed
+type RoleUpdated struct {
+	RoleID    string    `json:"roleId"`
+	OldName   string    `json:"oldName"`
+	NewName   string    `json:"newName"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// RoleParentChanged is published when a role starts or stops inheriting from another role.
+// An empty parent ID means the role has no parent
+type RoleParentChanged struct {
+	RoleID      string    `json:"roleId"`
+	OldParentID string    `json:"oldParentId"`
+	NewParentID string    `json:"newParentId"`
+	ChangedAt   time.Time `json:"changedAt"`
+}
+
+// RoleDeleted is published when a role is removed
+type RoleDeleted struct {
+	RoleID    string    `json:"roleId"`
+	Name      string    `json:"name"`
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+// PermissionCreated is published when a new permission is defined
+type PermissionCreated struct {
+	PermissionID string    `json:"permissionId"`
+	Name         string    `json:"name"`
+	Resource     string    `json:"resource"`
+	Action       string    `json:"action"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+// PermissionUpdated is published when a permission is changed
+type PermissionUpdated struct {
+	PermissionID string    `json:"permissionId"`
+	Name         string    `json:"name"`
+	Resource     string    `json:"resource"`
+	Action       string    `json:"action"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// PermissionDeleted is published when a permission is removed
+type PermissionDeleted struct {
+	PermissionID string    `json:"permissionId"`
+	Resource     string    `json:"resource"`
+	Action       string    `json:"action"`
+	DeletedAt    time.Time `json:"deletedAt"`
+}
+
+// RolePermissionAssigned is published when a permission is granted to a role
+type RolePermissionAssigned struct {
+	RoleID       string    `json:"roleId"`
+	PermissionID string    `json:"permissionId"`
+	AssignedAt   time.Time `json:"assignedAt"`
+}
+
+// RolePermissionRevoked is published when a permission is taken away from a role
+type RolePermissionRevoked struct {
+	RoleID       string    `json:"roleId"`
+	PermissionID string    `json:"permissionId"`
+	RevokedAt    time.Time `json:"revokedAt"`
+}
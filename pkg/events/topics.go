@@ -5,6 +5,48 @@ const (
 	TopicNotificationCreated = "notification.created"
 	TopicNotificationRead    = "notification.read"
 	TopicUserCreated         = "user.created"
+	TopicUserEmailVerified   = "user.email_verified"
+	TopicUserPasswordReset   = "user.password_reset"
+	TopicUserProfileUpdated  = "user.profile.updated"
+	TopicUserPasswordChanged = "user.password.changed"
+	TopicUserRoleAssigned    = "user.role.assigned"
+	TopicUserRoleRevoked     = "user.role.revoked"
+	// Chat conversation and message update topics, keyed by conversation ID
+	TopicConversationCreated           = "conversation.created"
+	TopicConversationUpdated           = "conversation.updated"
+	TopicConversationMemberJoined      = "conversation.member.joined"
+	TopicConversationMemberLeft        = "conversation.member.left"
+	TopicConversationMemberRemoved     = "conversation.member.removed"
+	TopicConversationMemberRoleChanged = "conversation.member.role_changed"
+	TopicChatMessageEdited             = "chat.message.edited"
+	TopicChatMessageDeleted            = "chat.message.deleted"
+	TopicChatMessageDeletedForMe       = "chat.message.deleted_for_me"
+	TopicChatMessageDelivered          = "chat.message.delivered"
+	TopicChatMessageRead               = "chat.message.read"
+	TopicChatMessageReactionAdded      = "chat.message.reaction.added"
+	TopicChatMessageReactionRemoved    = "chat.message.reaction.removed"
+	TopicChatMentionCreated            = "chat.mention.created"
+	// GDPR saga topics: auth-service requests, every service holding user data reports completion
+	TopicUserDeleted           = "user.deleted"
+	TopicUserExportRequested   = "user.export.requested"
+	TopicUserDeletionCompleted = "user.deletion.completed"
+	TopicUserExportCompleted   = "user.export.completed"
+	// Auth security topics
+	TopicAuthRefreshTokenReused = "auth.refresh_token.reused"
+	TopicAuthMFAEnabled         = "auth.mfa.enabled"
+	TopicAuthMFADisabled        = "auth.mfa.disabled"
+	TopicAuthUserLockedOut      = "auth.user.locked_out"
+	TopicAuthPasswordRehashed   = "auth.password.rehashed"
+	// Auth RBAC topics
+	TopicRoleCreated            = "auth.role.created"
+	TopicRoleUpdated            = "auth.role.updated"
+	TopicRoleParentChanged      = "auth.role.parent_changed"
+	TopicRoleDeleted            = "auth.role.deleted"
+	TopicPermissionCreated      = "auth.permission.created"
+	TopicPermissionUpdated      = "auth.permission.updated"
+	TopicPermissionDeleted      = "auth.permission.deleted"
+	TopicRolePermissionAssigned = "auth.role_permission.assigned"
+	TopicRolePermissionRevoked  = "auth.role_permission.revoked"
 	// E-commerce topics
 	TopicProductCreated      = "product.created"
 	TopicProductStockUpdated = "product.stock.updated"
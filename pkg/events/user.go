@@ -9,3 +9,43 @@ type UserCreated struct {
 	CreatedAt time.Time `json:"createdAt"`
 }
 
+// UserEmailVerified is published when a user verifies their email address
+type UserEmailVerified struct {
+	UserID     string    `json:"userId"`
+	Email      string    `json:"email"`
+	VerifiedAt time.Time `json:"verifiedAt"`
+}
+
+// UserPasswordReset is published when a user sets a new password through the forgot-password flow
+type UserPasswordReset struct {
+	UserID  string    `json:"userId"`
+	ResetAt time.Time `json:"resetAt"`
+}
+
+// UserProfileUpdated is published when a user changes their profile
+type UserProfileUpdated struct {
+	UserID    string    `json:"userId"`
+	OldName   string    `json:"oldName"`
+	NewName   string    `json:"newName"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// UserPasswordChanged is published when a user changes their password while logged in
+type UserPasswordChanged struct {
+	UserID    string    `json:"userId"`
+	ChangedAt time.Time `json:"changedAt"`
+}
+
+// UserRoleAssigned is published when a role is granted to a user
+type UserRoleAssigned struct {
+	UserID     string    `json:"userId"`
+	RoleID     string    `json:"roleId"`
+	AssignedAt time.Time `json:"assignedAt"`
+}
+
+// UserRoleRevoked is published when a role is taken away from a user
+type UserRoleRevoked struct {
+	UserID    string    `json:"userId"`
+	RoleID    string    `json:"roleId"`
+	RevokedAt time.Time `json:"revokedAt"`
+}
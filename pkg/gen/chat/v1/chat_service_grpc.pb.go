@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
 // - protoc-gen-go-grpc v1.5.1
-// - protoc             v6.33.1
+// - protoc             v4.25.3
 // source: chat/v1/chat_service.proto
 
 package chatv1
@@ -19,14 +19,67 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ChatService_CreateMessage_FullMethodName = "/chat.v1.ChatService/CreateMessage"
+	ChatService_CreateMessage_FullMethodName                = "/chat.v1.ChatService/CreateMessage"
+	ChatService_ListConversationMessages_FullMethodName     = "/chat.v1.ChatService/ListConversationMessages"
+	ChatService_ListConversations_FullMethodName            = "/chat.v1.ChatService/ListConversations"
+	ChatService_CreateGroupConversation_FullMethodName      = "/chat.v1.ChatService/CreateGroupConversation"
+	ChatService_GetConversation_FullMethodName              = "/chat.v1.ChatService/GetConversation"
+	ChatService_JoinConversation_FullMethodName             = "/chat.v1.ChatService/JoinConversation"
+	ChatService_LeaveConversation_FullMethodName            = "/chat.v1.ChatService/LeaveConversation"
+	ChatService_KickConversationMember_FullMethodName       = "/chat.v1.ChatService/KickConversationMember"
+	ChatService_UpdateConversation_FullMethodName           = "/chat.v1.ChatService/UpdateConversation"
+	ChatService_ChangeConversationMemberRole_FullMethodName = "/chat.v1.ChatService/ChangeConversationMemberRole"
+	ChatService_EditMessage_FullMethodName                  = "/chat.v1.ChatService/EditMessage"
+	ChatService_DeleteMessage_FullMethodName                = "/chat.v1.ChatService/DeleteMessage"
+	ChatService_ListMessageEdits_FullMethodName             = "/chat.v1.ChatService/ListMessageEdits"
+	ChatService_MarkConversationRead_FullMethodName         = "/chat.v1.ChatService/MarkConversationRead"
+	ChatService_MarkConversationDelivered_FullMethodName    = "/chat.v1.ChatService/MarkConversationDelivered"
+	ChatService_GetUnreadCounts_FullMethodName              = "/chat.v1.ChatService/GetUnreadCounts"
+	ChatService_ToggleReaction_FullMethodName               = "/chat.v1.ChatService/ToggleReaction"
+	ChatService_ListMessageReplies_FullMethodName           = "/chat.v1.ChatService/ListMessageReplies"
 )
 
 // ChatServiceClient is the client API for ChatService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ChatServiceClient interface {
+	// CreateMessage sends to conversation_id, or to the direct conversation with receiver_id, creating it on the first message
 	CreateMessage(ctx context.Context, in *CreateMessageRequest, opts ...grpc.CallOption) (*CreateMessageResponse, error)
+	// ListConversationMessages pages through the messages of a conversation, newest first
+	ListConversationMessages(ctx context.Context, in *ListConversationMessagesRequest, opts ...grpc.CallOption) (*ListConversationMessagesResponse, error)
+	// ListConversations pages through a user's inbox, most recently active conversation first
+	ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error)
+	// Group conversations. Every RPC returns the conversation with its members after the change
+	CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// JoinConversation adds user_id to a group, actor_id must be an admin or the owner
+	JoinConversation(ctx context.Context, in *JoinConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// LeaveConversation removes user_id from a group, handing ownership on when the owner leaves
+	LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// KickConversationMember removes user_id from a group, actor_id must outrank them
+	KickConversationMember(ctx context.Context, in *KickConversationMemberRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// UpdateConversation changes the title and avatar of a group, actor_id must be an admin or the owner
+	UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// ChangeConversationMemberRole is reserved to the owner, making someone else owner demotes them to admin
+	ChangeConversationMemberRole(ctx context.Context, in *ChangeConversationMemberRoleRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// EditMessage replaces the content of a message, reserved to its sender. The previous version is kept
+	EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// DeleteMessage hides a message from actor_id, or deletes it for every member when for_everyone is set.
+	// Deleting for everyone is reserved to the sender, within a configured window after sending
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// ListMessageEdits returns the previous versions of an edited message, most recent first
+	ListMessageEdits(ctx context.Context, in *ListMessageEditsRequest, opts ...grpc.CallOption) (*ListMessageEditsResponse, error)
+	// Receipts. A member's watermark covers every message up to it, in (created_at, id) order; it only moves forward.
+	// MarkConversationRead moves the read watermark of user_id, and the delivered one with it
+	MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// MarkConversationDelivered acknowledges that messages reached a device of user_id
+	MarkConversationDelivered(ctx context.Context, in *MarkConversationDeliveredRequest, opts ...grpc.CallOption) (*ConversationResponse, error)
+	// GetUnreadCounts counts the unread messages of each conversation of user_id
+	GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error)
+	// ToggleReaction adds the emoji reaction of user_id to a message, or takes it back if they already reacted with it
+	ToggleReaction(ctx context.Context, in *ToggleReactionRequest, opts ...grpc.CallOption) (*MessageResponse, error)
+	// ListMessageReplies returns a message and pages through its thread, newest reply first
+	ListMessageReplies(ctx context.Context, in *ListMessageRepliesRequest, opts ...grpc.CallOption) (*ListMessageRepliesResponse, error)
 }
 
 type chatServiceClient struct {
@@ -47,11 +100,217 @@ func (c *chatServiceClient) CreateMessage(ctx context.Context, in *CreateMessage
 	return out, nil
 }
 
+func (c *chatServiceClient) ListConversationMessages(ctx context.Context, in *ListConversationMessagesRequest, opts ...grpc.CallOption) (*ListConversationMessagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationMessagesResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListConversationMessages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListConversations(ctx context.Context, in *ListConversationsRequest, opts ...grpc.CallOption) (*ListConversationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConversationsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListConversations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) CreateGroupConversation(ctx context.Context, in *CreateGroupConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_CreateGroupConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetConversation(ctx context.Context, in *GetConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) JoinConversation(ctx context.Context, in *JoinConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_JoinConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) LeaveConversation(ctx context.Context, in *LeaveConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_LeaveConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) KickConversationMember(ctx context.Context, in *KickConversationMemberRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_KickConversationMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) UpdateConversation(ctx context.Context, in *UpdateConversationRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_UpdateConversation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ChangeConversationMemberRole(ctx context.Context, in *ChangeConversationMemberRoleRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_ChangeConversationMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) EditMessage(ctx context.Context, in *EditMessageRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_EditMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_DeleteMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListMessageEdits(ctx context.Context, in *ListMessageEditsRequest, opts ...grpc.CallOption) (*ListMessageEditsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessageEditsResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListMessageEdits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkConversationRead(ctx context.Context, in *MarkConversationReadRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_MarkConversationRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) MarkConversationDelivered(ctx context.Context, in *MarkConversationDeliveredRequest, opts ...grpc.CallOption) (*ConversationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConversationResponse)
+	err := c.cc.Invoke(ctx, ChatService_MarkConversationDelivered_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) GetUnreadCounts(ctx context.Context, in *GetUnreadCountsRequest, opts ...grpc.CallOption) (*GetUnreadCountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadCountsResponse)
+	err := c.cc.Invoke(ctx, ChatService_GetUnreadCounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ToggleReaction(ctx context.Context, in *ToggleReactionRequest, opts ...grpc.CallOption) (*MessageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, ChatService_ToggleReaction_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chatServiceClient) ListMessageReplies(ctx context.Context, in *ListMessageRepliesRequest, opts ...grpc.CallOption) (*ListMessageRepliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessageRepliesResponse)
+	err := c.cc.Invoke(ctx, ChatService_ListMessageReplies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChatServiceServer is the server API for ChatService service.
 // All implementations must embed UnimplementedChatServiceServer
 // for forward compatibility.
 type ChatServiceServer interface {
+	// CreateMessage sends to conversation_id, or to the direct conversation with receiver_id, creating it on the first message
 	CreateMessage(context.Context, *CreateMessageRequest) (*CreateMessageResponse, error)
+	// ListConversationMessages pages through the messages of a conversation, newest first
+	ListConversationMessages(context.Context, *ListConversationMessagesRequest) (*ListConversationMessagesResponse, error)
+	// ListConversations pages through a user's inbox, most recently active conversation first
+	ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error)
+	// Group conversations. Every RPC returns the conversation with its members after the change
+	CreateGroupConversation(context.Context, *CreateGroupConversationRequest) (*ConversationResponse, error)
+	GetConversation(context.Context, *GetConversationRequest) (*ConversationResponse, error)
+	// JoinConversation adds user_id to a group, actor_id must be an admin or the owner
+	JoinConversation(context.Context, *JoinConversationRequest) (*ConversationResponse, error)
+	// LeaveConversation removes user_id from a group, handing ownership on when the owner leaves
+	LeaveConversation(context.Context, *LeaveConversationRequest) (*ConversationResponse, error)
+	// KickConversationMember removes user_id from a group, actor_id must outrank them
+	KickConversationMember(context.Context, *KickConversationMemberRequest) (*ConversationResponse, error)
+	// UpdateConversation changes the title and avatar of a group, actor_id must be an admin or the owner
+	UpdateConversation(context.Context, *UpdateConversationRequest) (*ConversationResponse, error)
+	// ChangeConversationMemberRole is reserved to the owner, making someone else owner demotes them to admin
+	ChangeConversationMemberRole(context.Context, *ChangeConversationMemberRoleRequest) (*ConversationResponse, error)
+	// EditMessage replaces the content of a message, reserved to its sender. The previous version is kept
+	EditMessage(context.Context, *EditMessageRequest) (*MessageResponse, error)
+	// DeleteMessage hides a message from actor_id, or deletes it for every member when for_everyone is set.
+	// Deleting for everyone is reserved to the sender, within a configured window after sending
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*MessageResponse, error)
+	// ListMessageEdits returns the previous versions of an edited message, most recent first
+	ListMessageEdits(context.Context, *ListMessageEditsRequest) (*ListMessageEditsResponse, error)
+	// Receipts. A member's watermark covers every message up to it, in (created_at, id) order; it only moves forward.
+	// MarkConversationRead moves the read watermark of user_id, and the delivered one with it
+	MarkConversationRead(context.Context, *MarkConversationReadRequest) (*ConversationResponse, error)
+	// MarkConversationDelivered acknowledges that messages reached a device of user_id
+	MarkConversationDelivered(context.Context, *MarkConversationDeliveredRequest) (*ConversationResponse, error)
+	// GetUnreadCounts counts the unread messages of each conversation of user_id
+	GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error)
+	// ToggleReaction adds the emoji reaction of user_id to a message, or takes it back if they already reacted with it
+	ToggleReaction(context.Context, *ToggleReactionRequest) (*MessageResponse, error)
+	// ListMessageReplies returns a message and pages through its thread, newest reply first
+	ListMessageReplies(context.Context, *ListMessageRepliesRequest) (*ListMessageRepliesResponse, error)
 	mustEmbedUnimplementedChatServiceServer()
 }
 
@@ -65,6 +324,57 @@ type UnimplementedChatServiceServer struct{}
 func (UnimplementedChatServiceServer) CreateMessage(context.Context, *CreateMessageRequest) (*CreateMessageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMessage not implemented")
 }
+func (UnimplementedChatServiceServer) ListConversationMessages(context.Context, *ListConversationMessagesRequest) (*ListConversationMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversationMessages not implemented")
+}
+func (UnimplementedChatServiceServer) ListConversations(context.Context, *ListConversationsRequest) (*ListConversationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConversations not implemented")
+}
+func (UnimplementedChatServiceServer) CreateGroupConversation(context.Context, *CreateGroupConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupConversation not implemented")
+}
+func (UnimplementedChatServiceServer) GetConversation(context.Context, *GetConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConversation not implemented")
+}
+func (UnimplementedChatServiceServer) JoinConversation(context.Context, *JoinConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinConversation not implemented")
+}
+func (UnimplementedChatServiceServer) LeaveConversation(context.Context, *LeaveConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveConversation not implemented")
+}
+func (UnimplementedChatServiceServer) KickConversationMember(context.Context, *KickConversationMemberRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KickConversationMember not implemented")
+}
+func (UnimplementedChatServiceServer) UpdateConversation(context.Context, *UpdateConversationRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversation not implemented")
+}
+func (UnimplementedChatServiceServer) ChangeConversationMemberRole(context.Context, *ChangeConversationMemberRoleRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeConversationMemberRole not implemented")
+}
+func (UnimplementedChatServiceServer) EditMessage(context.Context, *EditMessageRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditMessage not implemented")
+}
+func (UnimplementedChatServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
+func (UnimplementedChatServiceServer) ListMessageEdits(context.Context, *ListMessageEditsRequest) (*ListMessageEditsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessageEdits not implemented")
+}
+func (UnimplementedChatServiceServer) MarkConversationRead(context.Context, *MarkConversationReadRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationRead not implemented")
+}
+func (UnimplementedChatServiceServer) MarkConversationDelivered(context.Context, *MarkConversationDeliveredRequest) (*ConversationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkConversationDelivered not implemented")
+}
+func (UnimplementedChatServiceServer) GetUnreadCounts(context.Context, *GetUnreadCountsRequest) (*GetUnreadCountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadCounts not implemented")
+}
+func (UnimplementedChatServiceServer) ToggleReaction(context.Context, *ToggleReactionRequest) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ToggleReaction not implemented")
+}
+func (UnimplementedChatServiceServer) ListMessageReplies(context.Context, *ListMessageRepliesRequest) (*ListMessageRepliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessageReplies not implemented")
+}
 func (UnimplementedChatServiceServer) mustEmbedUnimplementedChatServiceServer() {}
 func (UnimplementedChatServiceServer) testEmbeddedByValue()                     {}
 
@@ -104,6 +414,312 @@ func _ChatService_CreateMessage_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChatService_ListConversationMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListConversationMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListConversationMessages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListConversationMessages(ctx, req.(*ListConversationMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListConversations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConversationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListConversations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListConversations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListConversations(ctx, req.(*ListConversationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_CreateGroupConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).CreateGroupConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_CreateGroupConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).CreateGroupConversation(ctx, req.(*CreateGroupConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetConversation(ctx, req.(*GetConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_JoinConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).JoinConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_JoinConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).JoinConversation(ctx, req.(*JoinConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_LeaveConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).LeaveConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_LeaveConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).LeaveConversation(ctx, req.(*LeaveConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_KickConversationMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KickConversationMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).KickConversationMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_KickConversationMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).KickConversationMember(ctx, req.(*KickConversationMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_UpdateConversation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateConversationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).UpdateConversation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_UpdateConversation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).UpdateConversation(ctx, req.(*UpdateConversationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ChangeConversationMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeConversationMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ChangeConversationMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ChangeConversationMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ChangeConversationMemberRole(ctx, req.(*ChangeConversationMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_EditMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).EditMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_EditMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).EditMessage(ctx, req.(*EditMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_DeleteMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListMessageEdits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessageEditsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListMessageEdits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListMessageEdits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListMessageEdits(ctx, req.(*ListMessageEditsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MarkConversationRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkConversationRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MarkConversationRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkConversationRead(ctx, req.(*MarkConversationReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_MarkConversationDelivered_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkConversationDeliveredRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).MarkConversationDelivered(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_MarkConversationDelivered_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).MarkConversationDelivered(ctx, req.(*MarkConversationDeliveredRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_GetUnreadCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadCountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).GetUnreadCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_GetUnreadCounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).GetUnreadCounts(ctx, req.(*GetUnreadCountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ToggleReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleReactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ToggleReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ToggleReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ToggleReaction(ctx, req.(*ToggleReactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChatService_ListMessageReplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessageRepliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChatServiceServer).ListMessageReplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ChatService_ListMessageReplies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChatServiceServer).ListMessageReplies(ctx, req.(*ListMessageRepliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ChatService_ServiceDesc is the grpc.ServiceDesc for ChatService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +731,74 @@ var ChatService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateMessage",
 			Handler:    _ChatService_CreateMessage_Handler,
 		},
+		{
+			MethodName: "ListConversationMessages",
+			Handler:    _ChatService_ListConversationMessages_Handler,
+		},
+		{
+			MethodName: "ListConversations",
+			Handler:    _ChatService_ListConversations_Handler,
+		},
+		{
+			MethodName: "CreateGroupConversation",
+			Handler:    _ChatService_CreateGroupConversation_Handler,
+		},
+		{
+			MethodName: "GetConversation",
+			Handler:    _ChatService_GetConversation_Handler,
+		},
+		{
+			MethodName: "JoinConversation",
+			Handler:    _ChatService_JoinConversation_Handler,
+		},
+		{
+			MethodName: "LeaveConversation",
+			Handler:    _ChatService_LeaveConversation_Handler,
+		},
+		{
+			MethodName: "KickConversationMember",
+			Handler:    _ChatService_KickConversationMember_Handler,
+		},
+		{
+			MethodName: "UpdateConversation",
+			Handler:    _ChatService_UpdateConversation_Handler,
+		},
+		{
+			MethodName: "ChangeConversationMemberRole",
+			Handler:    _ChatService_ChangeConversationMemberRole_Handler,
+		},
+		{
+			MethodName: "EditMessage",
+			Handler:    _ChatService_EditMessage_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _ChatService_DeleteMessage_Handler,
+		},
+		{
+			MethodName: "ListMessageEdits",
+			Handler:    _ChatService_ListMessageEdits_Handler,
+		},
+		{
+			MethodName: "MarkConversationRead",
+			Handler:    _ChatService_MarkConversationRead_Handler,
+		},
+		{
+			MethodName: "MarkConversationDelivered",
+			Handler:    _ChatService_MarkConversationDelivered_Handler,
+		},
+		{
+			MethodName: "GetUnreadCounts",
+			Handler:    _ChatService_GetUnreadCounts_Handler,
+		},
+		{
+			MethodName: "ToggleReaction",
+			Handler:    _ChatService_ToggleReaction_Handler,
+		},
+		{
+			MethodName: "ListMessageReplies",
+			Handler:    _ChatService_ListMessageReplies_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chat/v1/chat_service.proto",
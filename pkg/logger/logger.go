@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type ctxKey struct{}
@@ -47,6 +48,26 @@ func Component(name string) *zerolog.Logger {
 	return &l
 }
 
+// ComponentCtx returns a component logger bound to ctx.
+// Lines written inside a traced request or message carry its trace_id and span_id.
+// Example usage: logger.ComponentCtx(ctx, "chat.command.create_message").Info().Msg("example")
+func ComponentCtx(ctx context.Context, name string) *zerolog.Logger {
+	l := getLogger().With().Str("component", name).Ctx(ctx).Logger()
+	return &l
+}
+
+// traceHook adds the IDs of the span found in the event's context, set with ComponentCtx or Event.Ctx
+type traceHook struct{}
+
+func (traceHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	spanContext := trace.SpanContextFromContext(e.GetCtx())
+	if !spanContext.IsValid() {
+		return
+	}
+	e.Str("trace_id", spanContext.TraceID().String()).
+		Str("span_id", spanContext.SpanID().String())
+}
+
 func getLogger() *zerolog.Logger {
 	once.Do(func() {
 		dir := os.Getenv("LOG_OUTPUT_DIR")
@@ -68,7 +89,8 @@ func getLogger() *zerolog.Logger {
 			With().
 			Timestamp().
 			Str("module", module).
-			Logger()
+			Logger().
+			Hook(traceHook{})
 		global = logger
 	})
 	return &global
@@ -0,0 +1,91 @@
+package metrics
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	grpcTypeUnary        = "unary"
+	grpcTypeClientStream = "client_stream"
+	grpcTypeServerStream = "server_stream"
+	grpcTypeBidiStream   = "bidi_stream"
+)
+
+var (
+	grpcHandled = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_server_handled_total",
+		Help: "Total number of RPCs completed on the server by service, method, type and status code.",
+	}, []string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"})
+
+	grpcDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "grpc_server_handling_seconds",
+		Help:    "RPC latency on the server by service, method and type.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"grpc_service", "grpc_method", "grpc_type"})
+
+	grpcInFlight = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_server_in_flight",
+		Help: "Number of RPCs currently being handled by service and method.",
+	}, []string{"grpc_service", "grpc_method"})
+)
+
+// UnaryServerInterceptor records RPC count, latency and status codes of unary calls.
+// Chain it before the error interceptor so the recorded code is the one sent to the client
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		service, method := splitFullMethod(info.FullMethod)
+		done := observeRPC(service, method, grpcTypeUnary)
+		resp, err := handler(ctx, req)
+		done(err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor records RPC count, latency and status codes of streaming calls
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, method := splitFullMethod(info.FullMethod)
+		done := observeRPC(service, method, streamType(info))
+		err := handler(srv, ss)
+		done(err)
+		return err
+	}
+}
+
+func observeRPC(service, method, rpcType string) func(error) {
+	start := time.Now()
+	inFlight := grpcInFlight.WithLabelValues(service, method)
+	inFlight.Inc()
+
+	return func(err error) {
+		inFlight.Dec()
+		grpcHandled.WithLabelValues(service, method, rpcType, status.Code(err).String()).Inc()
+		grpcDuration.WithLabelValues(service, method, rpcType).Observe(time.Since(start).Seconds())
+	}
+}
+
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return grpcTypeBidiStream
+	case info.IsClientStream:
+		return grpcTypeClientStream
+	default:
+		return grpcTypeServerStream
+	}
+}
+
+// splitFullMethod splits "/package.Service/Method" into its service and method
+func splitFullMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// UnmatchedRoute is the route label of requests that did not match any route,
+// so scanners hitting random paths cannot blow up the label cardinality
+const UnmatchedRoute = "unmatched"
+
+var (
+	httpRequests = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "Total number of HTTP requests by method, route template and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpDuration = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "HTTP request latency by method, route template and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	httpErrors = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_errors_total",
+		Help: "Total number of HTTP requests answered with a 4xx (client_error) or 5xx (server_error) status.",
+	}, []string{"method", "route", "type"})
+
+	httpInFlight = factory.NewGauge(prometheus.GaugeOpts{
+		Name: "http_requests_in_flight",
+		Help: "Number of HTTP requests currently being served.",
+	})
+)
+
+// HTTPCollector records HTTP request metrics in Prometheus.
+// The path arguments must be route templates (gin's FullPath), never raw request paths
+type HTTPCollector struct{}
+
+// NewHTTPCollector creates an HTTP collector backed by the package registry
+func NewHTTPCollector() *HTTPCollector {
+	return &HTTPCollector{}
+}
+
+// IncrementRequestCount counts a served request
+func (HTTPCollector) IncrementRequestCount(method, route string, statusCode int) {
+	httpRequests.WithLabelValues(method, route, strconv.Itoa(statusCode)).Inc()
+}
+
+// RecordRequestDuration observes the latency of a served request
+func (HTTPCollector) RecordRequestDuration(method, route string, statusCode int, duration time.Duration) {
+	httpDuration.WithLabelValues(method, route, strconv.Itoa(statusCode)).Observe(duration.Seconds())
+}
+
+// IncrementErrorCount counts a request that ended with an error status
+func (HTTPCollector) IncrementErrorCount(method, route string, errorType string) {
+	httpErrors.WithLabelValues(method, route, errorType).Inc()
+}
+
+// Route returns the route template of the request, or UnmatchedRoute when no route matched
+func Route(c *gin.Context) string {
+	if route := c.FullPath(); route != "" {
+		return route
+	}
+	return UnmatchedRoute
+}
+
+// ErrorType classifies a status code as client_error or server_error, it returns "" below 400
+func ErrorType(statusCode int) string {
+	switch {
+	case statusCode >= 500:
+		return "server_error"
+	case statusCode >= 400:
+		return "client_error"
+	default:
+		return ""
+	}
+}
+
+// HTTPMiddleware records request count, latency, errors and in-flight requests of a gin router
+func HTTPMiddleware() gin.HandlerFunc {
+	collector := NewHTTPCollector()
+
+	return func(c *gin.Context) {
+		start := time.Now()
+		httpInFlight.Inc()
+		defer httpInFlight.Dec()
+
+		c.Next()
+
+		method := c.Request.Method
+		route := Route(c)
+		statusCode := c.Writer.Status()
+
+		collector.IncrementRequestCount(method, route, statusCode)
+		collector.RecordRequestDuration(method, route, statusCode, time.Since(start))
+		if errorType := ErrorType(statusCode); errorType != "" {
+			collector.IncrementErrorCount(method, route, errorType)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Outcomes of a consumed Kafka message
+const (
+	OutcomeSuccess = "success"
+	OutcomeError   = "error"
+	// OutcomeInvalid is used for messages that could not be decoded
+	OutcomeInvalid = "invalid"
+	// OutcomeDeadLetter is used for messages moved to their dead-letter topic after exhausting their retries
+	OutcomeDeadLetter = "dead_letter"
+)
+
+var (
+	kafkaLag = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kafka_consumer_lag",
+		Help: "Messages between the last consumed offset and the partition high watermark, by topic, group and partition.",
+	}, []string{"topic", "group", "partition"})
+
+	kafkaMessages = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "kafka_consumer_messages_total",
+		Help: "Total number of consumed Kafka messages by topic, group and outcome (success, error, invalid).",
+	}, []string{"topic", "group", "outcome"})
+
+	kafkaProcessing = factory.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "kafka_consumer_processing_seconds",
+		Help:    "Time spent decoding and handling a consumed Kafka message, by topic and group.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"topic", "group"})
+)
+
+// KafkaConsumer records the lag and processing metrics of one consumer group on one topic
+type KafkaConsumer struct {
+	topic string
+	group string
+}
+
+// NewKafkaConsumer creates the metrics of the consumer group reading topic
+func NewKafkaConsumer(topic, group string) *KafkaConsumer {
+	return &KafkaConsumer{topic: topic, group: group}
+}
+
+// ObserveLag records the lag of a partition from a fetched message's offset and the partition high watermark
+func (k *KafkaConsumer) ObserveLag(partition int, offset, highWaterMark int64) {
+	lag := highWaterMark - offset - 1
+	if lag < 0 {
+		lag = 0
+	}
+	kafkaLag.WithLabelValues(k.topic, k.group, strconv.Itoa(partition)).Set(float64(lag))
+}
+
+// ObserveProcessed counts a handled message and records how long it took since start
+func (k *KafkaConsumer) ObserveProcessed(start time.Time, outcome string) {
+	kafkaMessages.WithLabelValues(k.topic, k.group, outcome).Inc()
+	kafkaProcessing.WithLabelValues(k.topic, k.group).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Outcomes of a processed outbox event
+const (
+	OutboxPublished  = "published"
+	OutboxRetried    = "retried"
+	OutboxDeadLetter = "dead_letter"
+)
+
+var (
+	outboxBacklog = factory.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "outbox_events",
+		Help: "Number of outbox events waiting to be published, by status (pending, dead_letter).",
+	}, []string{"status"})
+
+	outboxOldestPending = factory.NewGauge(prometheus.GaugeOpts{
+		Name: "outbox_oldest_pending_age_seconds",
+		Help: "Age of the oldest pending outbox event, 0 when nothing is pending.",
+	})
+
+	outboxProcessed = factory.NewCounterVec(prometheus.CounterOpts{
+		Name: "outbox_events_processed_total",
+		Help: "Total number of outbox events processed by event type and outcome (published, retried, dead_letter).",
+	}, []string{"event_type", "outcome"})
+)
+
+// SetOutboxBacklog records the current outbox backlog
+func SetOutboxBacklog(pending, deadLetter int64, oldestPendingAge time.Duration) {
+	outboxBacklog.WithLabelValues("pending").Set(float64(pending))
+	outboxBacklog.WithLabelValues("dead_letter").Set(float64(deadLetter))
+	outboxOldestPending.Set(oldestPendingAge.Seconds())
+}
+
+// ObserveOutboxEvent counts an outbox event the processor has published, rescheduled or dead-lettered
+func ObserveOutboxEvent(eventType, outcome string) {
+	outboxProcessed.WithLabelValues(eventType, outcome).Inc()
+}
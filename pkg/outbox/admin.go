@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"golang-social-media/pkg/logger"
+)
+
+const defaultDeadLetterLimit = 50
+
+func usage() {
+	fmt.Println("Usage:")
+	fmt.Println("  go run ./cmd/outbox dead-letters [limit]")
+	fmt.Println("  go run ./cmd/outbox replay <event-id>...")
+	fmt.Println("  go run ./cmd/outbox replay --all")
+}
+
+// RunAdmin runs the dead letter admin command given by args (os.Args[1:]) and returns the exit code.
+// openRepository connects to the service database; service prefixes the logger component
+func RunAdmin(args []string, service string, openRepository func() (*Repository, error)) int {
+	log := logger.Component(service + ".outbox_admin")
+	if len(args) < 1 {
+		usage()
+		return 1
+	}
+
+	outboxRepo, err := openRepository()
+	if err != nil {
+		log.Error().Err(err).Msg("failed to connect database")
+		return 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	switch args[0] {
+	case "dead-letters":
+		limit := defaultDeadLetterLimit
+		if len(args) > 1 {
+			limit, err = strconv.Atoi(args[1])
+			if err != nil || limit <= 0 {
+				log.Error().Str("limit", args[1]).Msg("limit must be a positive number")
+				return 1
+			}
+		}
+		if err := listDeadLetters(ctx, outboxRepo, limit); err != nil {
+			log.Error().Err(err).Msg("failed to list dead letters")
+			return 1
+		}
+	case "replay":
+		if len(args) < 2 {
+			log.Error().Msg("missing event IDs. Example: go run ./cmd/outbox replay <event-id>")
+			return 1
+		}
+		var replayed int64
+		if args[1] == "--all" {
+			replayed, err = outboxRepo.ReplayAllDeadLetters(ctx)
+		} else {
+			replayed, err = outboxRepo.ReplayDeadLetters(ctx, args[1:])
+		}
+		if err != nil {
+			log.Error().Err(err).Msg("failed to replay dead letters")
+			return 1
+		}
+		fmt.Printf("Replayed %d event(s)\n", replayed)
+	default:
+		usage()
+		return 1
+	}
+	return 0
+}
+
+func listDeadLetters(ctx context.Context, outboxRepo *Repository, limit int) error {
+	events, err := outboxRepo.ListDeadLetters(ctx, limit)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		fmt.Println("No dead-lettered events")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tEVENT TYPE\tAGGREGATE\tATTEMPTS\tDEAD-LETTERED AT\tERROR")
+	for _, event := range events {
+		deadLetteredAt := ""
+		if event.DeadLetteredAt != nil {
+			deadLetteredAt = event.DeadLetteredAt.Format(time.RFC3339)
+		}
+		errorMessage := ""
+		if event.ErrorMessage != nil {
+			errorMessage = *event.ErrorMessage
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s/%s\t%d\t%s\t%s\n",
+			event.ID, event.EventType, event.AggregateType, event.AggregateID,
+			event.RetryCount, deadLetteredAt, errorMessage)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,100 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"time"
+
+	"golang-social-media/pkg/logger"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/rs/zerolog"
+)
+
+// NotifyChannel is the channel the outbox trigger notifies when an event becomes pending
+const NotifyChannel = "outbox_pending"
+
+// Listener wakes the processor on Postgres notifications from the outbox trigger
+type Listener struct {
+	db             *sql.DB
+	channel        string
+	reconnectDelay time.Duration
+	log            *zerolog.Logger
+}
+
+// NewListener creates a Listener on NotifyChannel. It holds one connection of the pool while listening.
+// service prefixes the logger component, e.g. "auth" logs as auth.outbox.listener
+func NewListener(db *sql.DB, service string) *Listener {
+	return &Listener{
+		db:             db,
+		channel:        NotifyChannel,
+		reconnectDelay: 5 * time.Second,
+		log:            logger.Component(service + ".outbox.listener"),
+	}
+}
+
+// Listen sends on wake for every notification until ctx is done, reconnecting after errors.
+// Sends never block: a pending wake-up already covers any event inserted since
+func (l *Listener) Listen(ctx context.Context, wake chan<- struct{}) {
+	for {
+		err := l.listen(ctx, wake)
+		if ctx.Err() != nil {
+			return
+		}
+		l.log.Warn().
+			Err(err).
+			Dur("retry_in", l.reconnectDelay).
+			Msg("outbox listener disconnected")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(l.reconnectDelay):
+		}
+	}
+}
+
+func (l *Listener) listen(ctx context.Context, wake chan<- struct{}) error {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn interface{}) error {
+		stdConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("outbox listener needs a pgx connection, got %T", driverConn)
+		}
+		pgConn := stdConn.Conn()
+
+		if _, err := pgConn.Exec(ctx, "LISTEN "+pgx.Identifier{l.channel}.Sanitize()); err != nil {
+			return err
+		}
+		l.log.Info().
+			Str("channel", l.channel).
+			Msg("outbox listener started")
+
+		// Catch up on events inserted while not listening
+		notify(wake)
+
+		for {
+			if _, err := pgConn.WaitForNotification(ctx); err != nil {
+				// The connection still listens on the channel, so it must not go back to the pool
+				return fmt.Errorf("%w: %v", driver.ErrBadConn, err)
+			}
+			notify(wake)
+		}
+	})
+}
+
+// notify sends a wake-up without blocking
+func notify(wake chan<- struct{}) {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,39 @@
+package outbox
+
+import (
+	"time"
+)
+
+// Status represents the status of an outbox event
+type Status string
+
+const (
+	StatusPending   Status = "pending"
+	StatusPublished Status = "published"
+	// StatusDeadLetter is set once an event has failed too often; it is only retried after a manual replay
+	StatusDeadLetter Status = "dead_letter"
+)
+
+// Model represents an outbox event in the database
+type Model struct {
+	ID             string     `gorm:"column:id;type:uuid;primaryKey"`
+	AggregateID    string     `gorm:"column:aggregate_id;type:text;not null"`
+	AggregateType  string     `gorm:"column:aggregate_type;type:text;not null"`
+	EventType      string     `gorm:"column:event_type;type:text;not null"`
+	EventVersion   int        `gorm:"column:event_version;type:integer;not null;default:1"`
+	Payload        string     `gorm:"column:payload;type:jsonb;not null"` // JSON string
+	Status         Status     `gorm:"column:status;type:text;not null;default:'pending'"`
+	RetryCount     int        `gorm:"column:retry_count;type:integer;not null;default:0"`
+	CreatedAt      time.Time  `gorm:"column:created_at;not null"`
+	PublishedAt    *time.Time `gorm:"column:published_at"`
+	ErrorMessage   *string    `gorm:"column:error_message;type:text"`
+	NextAttemptAt  time.Time  `gorm:"column:next_attempt_at;not null"`
+	LockedUntil    *time.Time `gorm:"column:locked_until"`        // lease of the processor that claimed the event
+	LockedBy       *string    `gorm:"column:locked_by;type:text"` // worker ID of that processor
+	DeadLetteredAt *time.Time `gorm:"column:dead_lettered_at"`
+	TraceContext   *string    `gorm:"column:trace_context;type:jsonb"` // W3C trace context of the writing request, JSON object
+}
+
+func (Model) TableName() string {
+	return "outbox"
+}
@@ -0,0 +1,291 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"golang-social-media/pkg/logger"
+	"golang-social-media/pkg/metrics"
+	"golang-social-media/pkg/tracing"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+// Publisher delivers a claimed outbox event to the broker and returns the topic it was published on.
+// Failures wrapped with Permanent are dead-lettered at once, any other error is retried
+type Publisher interface {
+	Publish(ctx context.Context, event Model) (topic string, err error)
+}
+
+// PublisherFunc adapts a function to Publisher
+type PublisherFunc func(ctx context.Context, event Model) (string, error)
+
+// Publish calls f
+func (f PublisherFunc) Publish(ctx context.Context, event Model) (string, error) {
+	return f(ctx, event)
+}
+
+// permanentError marks failures that retrying cannot fix, such as unknown event types
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not retryable, so the event is dead-lettered without using up its retries
+func Permanent(err error) error {
+	return permanentError{err: err}
+}
+
+// ProcessorConfig configures how the outbox processor claims and retries events
+type ProcessorConfig struct {
+	// WorkerID identifies this processor in the locked_by column
+	WorkerID  string
+	BatchSize int
+	// PollInterval is the fallback polling interval; notifications wake the processor immediately
+	PollInterval time.Duration
+	// LeaseTimeout is how long a claimed event stays locked before another processor may claim it
+	LeaseTimeout time.Duration
+	Retry        RetryPolicy
+}
+
+// DefaultProcessorConfig claims 50 events at a time with a 30 second lease and polls every 5 seconds.
+// service names the worker when the hostname is unknown
+func DefaultProcessorConfig(service string) ProcessorConfig {
+	return ProcessorConfig{
+		WorkerID:     defaultWorkerID(service),
+		BatchSize:    50,
+		PollInterval: 5 * time.Second,
+		LeaseTimeout: 30 * time.Second,
+		Retry:        DefaultRetryPolicy(),
+	}
+}
+
+func defaultWorkerID(service string) string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = service
+	}
+	return fmt.Sprintf("%s-%d-%s", hostname, os.Getpid(), uuid.NewString()[:8])
+}
+
+// Processor relays outbox events to the broker
+type Processor struct {
+	outboxRepo *Repository
+	publisher  Publisher
+	listener   *Listener
+	config     ProcessorConfig
+	log        *zerolog.Logger
+}
+
+// NewProcessor creates a Processor. listener may be nil, the processor then only polls.
+// service prefixes the logger component, e.g. "auth" logs as auth.outbox.processor
+func NewProcessor(outboxRepo *Repository, publisher Publisher, listener *Listener, config ProcessorConfig, service string) *Processor {
+	return &Processor{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		listener:   listener,
+		config:     config,
+		log:        logger.Component(service + ".outbox.processor"),
+	}
+}
+
+// Start processes outbox events until ctx is done
+func (p *Processor) Start(ctx context.Context) {
+	wake := make(chan struct{}, 1)
+	if p.listener != nil {
+		go p.listener.Listen(ctx, wake)
+	}
+
+	ticker := time.NewTicker(p.config.PollInterval)
+	defer ticker.Stop()
+
+	p.log.Info().
+		Str("worker_id", p.config.WorkerID).
+		Int("batch_size", p.config.BatchSize).
+		Dur("poll_interval", p.config.PollInterval).
+		Dur("lease_timeout", p.config.LeaseTimeout).
+		Int("max_attempts", p.config.Retry.MaxAttempts).
+		Bool("listen", p.listener != nil).
+		Msg("outbox processor started")
+
+	p.drain(ctx)
+	p.observeBacklog(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			p.log.Info().Msg("outbox processor stopped")
+			return
+		case <-wake:
+			p.drain(ctx)
+		case <-ticker.C:
+			p.drain(ctx)
+			p.observeBacklog(ctx)
+		}
+	}
+}
+
+// observeBacklog refreshes the outbox backlog gauges, once per poll interval
+func (p *Processor) observeBacklog(ctx context.Context) {
+	backlog, err := p.outboxRepo.Backlog(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.log.Warn().
+				Err(err).
+				Msg("failed to read outbox backlog")
+		}
+		return
+	}
+
+	var oldestPendingAge time.Duration
+	if backlog.OldestPendingAt != nil {
+		oldestPendingAge = time.Since(*backlog.OldestPendingAt)
+	}
+	metrics.SetOutboxBacklog(backlog.Pending, backlog.DeadLetter, oldestPendingAge)
+}
+
+// drain processes batches until no full batch is left, so a burst is published without waiting for the next wake-up
+func (p *Processor) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		claimed, err := p.processBatch(ctx)
+		if err != nil {
+			p.log.Error().
+				Err(err).
+				Msg("failed to process outbox batch")
+			return
+		}
+		if claimed < p.config.BatchSize {
+			return
+		}
+	}
+}
+
+// processBatch claims and processes a batch of due events, returning how many were claimed
+func (p *Processor) processBatch(ctx context.Context) (int, error) {
+	events, err := p.outboxRepo.ClaimPending(ctx, p.config.WorkerID, p.config.BatchSize, p.config.LeaseTimeout)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	p.log.Debug().
+		Int("event_count", len(events)).
+		Msg("processing outbox batch")
+
+	// The lease is renewed for the rest of the batch once half of it is used up, so a slow batch
+	// is not claimed again by another processor while this one is still publishing it
+	leaseUntil := time.Now().Add(p.config.LeaseTimeout)
+	for i, event := range events {
+		if time.Until(leaseUntil) < p.config.LeaseTimeout/2 {
+			if err := p.outboxRepo.ExtendLease(ctx, p.config.WorkerID, eventIDs(events[i:]), p.config.LeaseTimeout); err != nil {
+				// The remaining events become claimable again once their lease expires
+				return len(events), err
+			}
+			leaseUntil = time.Now().Add(p.config.LeaseTimeout)
+		}
+
+		if err := p.processEvent(ctx, event, leaseUntil); err != nil {
+			p.handleFailure(ctx, event, err)
+		}
+	}
+
+	return len(events), nil
+}
+
+func eventIDs(events []Model) []string {
+	ids := make([]string, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+	return ids
+}
+
+// processEvent publishes a single outbox event. The publish is cut off at leaseUntil, after which
+// another processor may already own the event
+func (p *Processor) processEvent(ctx context.Context, event Model, leaseUntil time.Time) error {
+	// Continue the trace of the request that wrote the event; the producer span becomes its child
+	publishCtx := ctx
+	if event.TraceContext != nil {
+		var carrier map[string]string
+		if err := json.Unmarshal([]byte(*event.TraceContext), &carrier); err == nil {
+			publishCtx = tracing.ContextFromCarrier(ctx, carrier)
+		}
+	}
+
+	leaseCtx, cancel := context.WithDeadline(publishCtx, leaseUntil)
+	defer cancel()
+	topic, err := p.publisher.Publish(leaseCtx, event)
+	if err != nil {
+		return err
+	}
+
+	if err := p.outboxRepo.MarkAsPublished(ctx, event.ID, p.config.WorkerID); err != nil {
+		return err
+	}
+	metrics.ObserveOutboxEvent(event.EventType, metrics.OutboxPublished)
+
+	p.log.Debug().Ctx(publishCtx).
+		Str("event_id", event.ID).
+		Str("event_type", event.EventType).
+		Str("topic", topic).
+		Msg("event published successfully")
+
+	return nil
+}
+
+// handleFailure schedules a retry with backoff, or dead-letters the event once it is out of attempts
+func (p *Processor) handleFailure(ctx context.Context, event Model, cause error) {
+	if errors.Is(cause, ErrLeaseLost) {
+		// Another processor claimed the event after the lease expired; its outcome wins
+		p.log.Warn().
+			Str("event_id", event.ID).
+			Str("event_type", event.EventType).
+			Msg("outbox lease lost, event left to its new owner")
+		return
+	}
+
+	attempts := event.RetryCount + 1
+	var permanent permanentError
+
+	if errors.As(cause, &permanent) || p.config.Retry.Exhausted(attempts) {
+		p.log.Error().
+			Err(cause).
+			Str("event_id", event.ID).
+			Str("event_type", event.EventType).
+			Int("attempts", attempts).
+			Msg("outbox event moved to dead letter")
+		metrics.ObserveOutboxEvent(event.EventType, metrics.OutboxDeadLetter)
+		if err := p.outboxRepo.MarkAsDeadLetter(ctx, event.ID, p.config.WorkerID, cause.Error()); err != nil {
+			p.log.Error().
+				Err(err).
+				Str("event_id", event.ID).
+				Msg("failed to dead-letter event")
+		}
+		return
+	}
+
+	backoff := p.config.Retry.Backoff(attempts)
+	p.log.Warn().
+		Err(cause).
+		Str("event_id", event.ID).
+		Str("event_type", event.EventType).
+		Int("attempts", attempts).
+		Dur("retry_in", backoff).
+		Msg("failed to process event, retry scheduled")
+	metrics.ObserveOutboxEvent(event.EventType, metrics.OutboxRetried)
+	if err := p.outboxRepo.ScheduleRetry(ctx, event.ID, p.config.WorkerID, cause.Error(), time.Now().Add(backoff)); err != nil {
+		p.log.Error().
+			Err(err).
+			Str("event_id", event.ID).
+			Msg("failed to schedule event retry")
+	}
+}
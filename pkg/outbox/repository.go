@@ -0,0 +1,263 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+
+	"golang-social-media/pkg/tracing"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ErrLeaseLost is returned when a processor updates an event whose lease it no longer holds
+var ErrLeaseLost = errors.New("outbox lease lost")
+
+// Repository stores and delivers the events of a service's outbox table
+type Repository struct {
+	db *gorm.DB
+}
+
+// NewRepository creates a Repository on db, which may be a transaction
+func NewRepository(db *gorm.DB) *Repository {
+	return &Repository{db: db}
+}
+
+// Create stores an event in the outbox
+func (r *Repository) Create(ctx context.Context, aggregateID, aggregateType, eventType string, eventVersion int, payload interface{}) error {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	var traceContext *string
+	if carrier := tracing.Carrier(ctx); len(carrier) > 0 {
+		carrierJSON, err := json.Marshal(carrier)
+		if err != nil {
+			return err
+		}
+		value := string(carrierJSON)
+		traceContext = &value
+	}
+
+	now := time.Now().UTC()
+	model := Model{
+		ID:            uuid.NewString(),
+		AggregateID:   aggregateID,
+		AggregateType: aggregateType,
+		EventType:     eventType,
+		EventVersion:  eventVersion,
+		Payload:       string(payloadJSON),
+		Status:        StatusPending,
+		RetryCount:    0,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+		TraceContext:  traceContext,
+	}
+
+	return r.db.WithContext(ctx).Create(&model).Error
+}
+
+// ClaimPending leases up to limit due pending events to workerID and returns them oldest first.
+// FOR UPDATE SKIP LOCKED lets several processors claim concurrently without blocking on or
+// double-claiming the same rows; an expired lease makes the event claimable again
+func (r *Repository) ClaimPending(ctx context.Context, workerID string, limit int, lease time.Duration) ([]Model, error) {
+	now := time.Now().UTC()
+	var events []Model
+	err := r.db.WithContext(ctx).Raw(`
+		UPDATE outbox SET locked_until = ?, locked_by = ?
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE status = ?
+				AND next_attempt_at <= ?
+				AND (locked_until IS NULL OR locked_until < ?)
+			ORDER BY created_at ASC
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), workerID, StatusPending, now, now, limit,
+	).Scan(&events).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the subquery order
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].CreatedAt.Before(events[j].CreatedAt)
+	})
+	return events, nil
+}
+
+// ExtendLease moves the lease of the events still held by workerID to now + lease.
+// Events claimed by another processor meanwhile are left alone
+func (r *Repository) ExtendLease(ctx context.Context, workerID string, ids []string, lease time.Duration) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).
+		Model(&Model{}).
+		Where("id IN ? AND locked_by = ? AND status = ?", ids, workerID, StatusPending).
+		Update("locked_until", time.Now().UTC().Add(lease)).Error
+}
+
+// MarkAsPublished marks an event as published and releases its lease
+func (r *Repository) MarkAsPublished(ctx context.Context, id, workerID string) error {
+	now := time.Now().UTC()
+	return r.updateLeased(ctx, id, workerID, map[string]interface{}{
+		"status":       StatusPublished,
+		"published_at": now,
+		"locked_until": nil,
+		"locked_by":    nil,
+	})
+}
+
+// ScheduleRetry records a failed attempt, releases the lease and makes the event due again at nextAttemptAt
+func (r *Repository) ScheduleRetry(ctx context.Context, id, workerID string, errorMessage string, nextAttemptAt time.Time) error {
+	return r.updateLeased(ctx, id, workerID, map[string]interface{}{
+		"retry_count":     gorm.Expr("retry_count + 1"),
+		"error_message":   errorMessage,
+		"next_attempt_at": nextAttemptAt.UTC(),
+		"locked_until":    nil,
+		"locked_by":       nil,
+	})
+}
+
+// MarkAsDeadLetter records a failed attempt and parks the event until it is replayed
+func (r *Repository) MarkAsDeadLetter(ctx context.Context, id, workerID string, errorMessage string) error {
+	now := time.Now().UTC()
+	return r.updateLeased(ctx, id, workerID, map[string]interface{}{
+		"status":           StatusDeadLetter,
+		"retry_count":      gorm.Expr("retry_count + 1"),
+		"error_message":    errorMessage,
+		"dead_lettered_at": now,
+		"locked_until":     nil,
+		"locked_by":        nil,
+	})
+}
+
+// updateLeased updates an event only while workerID still holds its lease, so a processor whose
+// lease expired cannot overwrite the outcome of the processor that claimed the event after it
+func (r *Repository) updateLeased(ctx context.Context, id, workerID string, values map[string]interface{}) error {
+	result := r.db.WithContext(ctx).
+		Model(&Model{}).
+		Where("id = ? AND locked_by = ?", id, workerID).
+		Updates(values)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrLeaseLost
+	}
+	return nil
+}
+
+// Redact overwrites the given payload fields of every event of the aggregate, published or not,
+// so erased personal data does not survive in the outbox. Returns the number of events changed
+func (r *Repository) Redact(ctx context.Context, aggregateType, aggregateID string, fields map[string]string) (int64, error) {
+	query := r.db.WithContext(ctx).
+		Model(&Model{}).
+		Where("aggregate_type = ? AND aggregate_id = ?", aggregateType, aggregateID)
+	return RedactPayloads(query, fields)
+}
+
+// RedactPayloads overwrites the fields present in the jsonb payload column of the rows selected by
+// query; fields missing from a payload are not added. Shared with tables storing payloads the same
+// way, such as an event store
+func RedactPayloads(query *gorm.DB, fields map[string]string) (int64, error) {
+	if len(fields) == 0 {
+		return 0, nil
+	}
+	replacements, err := json.Marshal(fields)
+	if err != nil {
+		return 0, err
+	}
+
+	result := query.
+		Where("jsonb_exists_any(payload, ARRAY(SELECT jsonb_object_keys(?::jsonb)))", string(replacements)).
+		Update("payload", gorm.Expr(
+			"payload || (SELECT jsonb_object_agg(f.key, f.value) FROM jsonb_each(?::jsonb) AS f WHERE jsonb_exists(payload, f.key))",
+			string(replacements),
+		))
+	return result.RowsAffected, result.Error
+}
+
+// Backlog is a snapshot of the events still waiting in the outbox
+type Backlog struct {
+	Pending    int64
+	DeadLetter int64
+	// OldestPendingAt is the creation time of the oldest pending event, nil when nothing is pending
+	OldestPendingAt *time.Time
+}
+
+// Backlog counts the pending and dead-lettered events; both are served by idx_outbox_status
+func (r *Repository) Backlog(ctx context.Context) (Backlog, error) {
+	var rows []struct {
+		Status  Status
+		Count   int64
+		MinTime *time.Time
+	}
+	err := r.db.WithContext(ctx).
+		Model(&Model{}).
+		Select("status, COUNT(*) AS count, MIN(created_at) AS min_time").
+		Where("status IN ?", []Status{StatusPending, StatusDeadLetter}).
+		Group("status").
+		Scan(&rows).Error
+	if err != nil {
+		return Backlog{}, err
+	}
+
+	var backlog Backlog
+	for _, row := range rows {
+		switch row.Status {
+		case StatusPending:
+			backlog.Pending = row.Count
+			backlog.OldestPendingAt = row.MinTime
+		case StatusDeadLetter:
+			backlog.DeadLetter = row.Count
+		}
+	}
+	return backlog, nil
+}
+
+// ListDeadLetters returns up to limit dead-lettered events, most recent first
+func (r *Repository) ListDeadLetters(ctx context.Context, limit int) ([]Model, error) {
+	var events []Model
+	err := r.db.WithContext(ctx).
+		Where("status = ?", StatusDeadLetter).
+		Order("dead_lettered_at DESC").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+// ReplayDeadLetters moves the given dead-lettered events back to pending with a fresh retry budget.
+// Returns the number of events replayed; IDs that are not dead-lettered are ignored
+func (r *Repository) ReplayDeadLetters(ctx context.Context, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return r.replay(r.db.WithContext(ctx).Where("id IN ?", ids))
+}
+
+// ReplayAllDeadLetters moves every dead-lettered event back to pending
+func (r *Repository) ReplayAllDeadLetters(ctx context.Context) (int64, error) {
+	return r.replay(r.db.WithContext(ctx))
+}
+
+func (r *Repository) replay(query *gorm.DB) (int64, error) {
+	result := query.
+		Model(&Model{}).
+		Where("status = ?", StatusDeadLetter).
+		Updates(map[string]interface{}{
+			"status":           StatusPending,
+			"retry_count":      0,
+			"error_message":    nil,
+			"next_attempt_at":  time.Now().UTC(),
+			"dead_lettered_at": nil,
+		})
+	return result.RowsAffected, result.Error
+}
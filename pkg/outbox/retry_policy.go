@@ -0,0 +1,44 @@
+package outbox
+
+import "time"
+
+// RetryPolicy decides when a failed outbox event is retried and when it is dead-lettered
+type RetryPolicy struct {
+	// MaxAttempts is the number of failed attempts after which an event is dead-lettered. 0 retries forever
+	MaxAttempts int
+	// BaseDelay is the delay after the first failure; it doubles with every further failure up to MaxDelay
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+}
+
+// DefaultRetryPolicy retries 10 times, backing off from 1 second to 5 minutes (about 13 minutes in total)
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 10,
+		BaseDelay:   time.Second,
+		MaxDelay:    5 * time.Minute,
+	}
+}
+
+// Backoff returns the delay before the next attempt of an event that has failed attempts times
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	delay := p.BaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		return p.MaxDelay
+	}
+	return delay
+}
+
+// Exhausted reports whether an event that has failed attempts times must be dead-lettered
+func (p RetryPolicy) Exhausted(attempts int) bool {
+	return p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
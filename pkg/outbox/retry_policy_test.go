@@ -0,0 +1,49 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Second,
+		MaxDelay:    10 * time.Second,
+	}
+
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 0, want: time.Second},
+		{attempts: 1, want: time.Second},
+		{attempts: 2, want: 2 * time.Second},
+		{attempts: 3, want: 4 * time.Second},
+		{attempts: 4, want: 8 * time.Second},
+		{attempts: 5, want: 10 * time.Second},
+		{attempts: 60, want: 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		if got := policy.Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestRetryPolicy_Exhausted(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second}
+
+	if policy.Exhausted(2) {
+		t.Error("Exhausted(2) = true, want false")
+	}
+	if !policy.Exhausted(3) {
+		t.Error("Exhausted(3) = false, want true")
+	}
+
+	unlimited := RetryPolicy{BaseDelay: time.Second}
+	if unlimited.Exhausted(1000) {
+		t.Error("Exhausted with MaxAttempts 0 = true, want false")
+	}
+}
@@ -0,0 +1,23 @@
+package tracing
+
+import (
+	"github.com/redis/go-redis/extra/redisotel/v9"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
+)
+
+// InstrumentGorm adds a client span per SQL statement run through db.
+// Statements are recorded without their bound values, which hold password hashes and personal data
+func InstrumentGorm(db *gorm.DB) error {
+	return db.Use(gormtracing.NewPlugin(
+		gormtracing.WithoutMetrics(),
+		gormtracing.WithoutQueryVariables(),
+	))
+}
+
+// InstrumentRedis adds a client span per Redis command run through client.
+// Command arguments are not recorded, cached values can hold tokens and profiles
+func InstrumentRedis(client redis.UniversalClient) error {
+	return redisotel.InstrumentTracing(client, redisotel.WithDBStatement(false))
+}
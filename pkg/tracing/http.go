@@ -0,0 +1,31 @@
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceIDHeader returns the trace ID of the request so a client can quote it in bug reports
+const TraceIDHeader = "X-Trace-ID"
+
+// GinMiddleware starts a server span per request, named after the route template, continuing the
+// caller's trace when a traceparent header is present. Health checks and metric scrapes are not traced
+func GinMiddleware(service string) gin.HandlerFunc {
+	return otelgin.Middleware(service, otelgin.WithFilter(func(r *http.Request) bool {
+		return r.URL.Path != "/health" && r.URL.Path != "/metrics"
+	}))
+}
+
+// TraceIDMiddleware writes the trace ID of the current span into the X-Trace-ID response header.
+// It must run after GinMiddleware
+func TraceIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if spanContext := trace.SpanContextFromContext(c.Request.Context()); spanContext.HasTraceID() {
+			c.Header(TraceIDHeader, spanContext.TraceID().String())
+		}
+		c.Next()
+	}
+}
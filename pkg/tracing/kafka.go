@@ -0,0 +1,96 @@
+package tracing
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// KafkaHeaderCarrier adapts Kafka message headers to the OpenTelemetry propagators,
+// so the W3C traceparent travels with the message
+type KafkaHeaderCarrier struct {
+	headers *[]kafka.Header
+}
+
+// NewKafkaHeaderCarrier wraps the headers of a message
+func NewKafkaHeaderCarrier(headers *[]kafka.Header) KafkaHeaderCarrier {
+	return KafkaHeaderCarrier{headers: headers}
+}
+
+// Get returns the value of the first header with key
+func (c KafkaHeaderCarrier) Get(key string) string {
+	for _, header := range *c.headers {
+		if header.Key == key {
+			return string(header.Value)
+		}
+	}
+	return ""
+}
+
+// Set replaces the header with key, or appends it
+func (c KafkaHeaderCarrier) Set(key, value string) {
+	for i, header := range *c.headers {
+		if header.Key == key {
+			(*c.headers)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c.headers = append(*c.headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+// Keys lists the header keys
+func (c KafkaHeaderCarrier) Keys() []string {
+	keys := make([]string, 0, len(*c.headers))
+	for _, header := range *c.headers {
+		keys = append(keys, header.Key)
+	}
+	return keys
+}
+
+// WriteKafkaMessage writes msg inside a producer span and injects the span context into its headers.
+// The topic is taken from the message, or from the writer when the message has none
+func WriteKafkaMessage(ctx context.Context, writer *kafka.Writer, msg kafka.Message) error {
+	topic := msg.Topic
+	if topic == "" {
+		topic = writer.Topic
+	}
+
+	ctx, span := Tracer().Start(ctx, topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.operation.type", "publish"),
+			attribute.String("messaging.destination.name", topic),
+			attribute.String("messaging.kafka.message.key", string(msg.Key)),
+		),
+	)
+	otel.GetTextMapPropagator().Inject(ctx, NewKafkaHeaderCarrier(&msg.Headers))
+
+	err := writer.WriteMessages(ctx, msg)
+	End(span, err)
+	return err
+}
+
+// StartKafkaConsumer extracts the trace context of a consumed message and starts a consumer span under it.
+// The caller ends the span with End once the message is handled
+func StartKafkaConsumer(ctx context.Context, group string, msg kafka.Message) (context.Context, trace.Span) {
+	headers := msg.Headers
+	ctx = otel.GetTextMapPropagator().Extract(ctx, NewKafkaHeaderCarrier(&headers))
+
+	return Tracer().Start(ctx, msg.Topic+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "kafka"),
+			attribute.String("messaging.operation.type", "process"),
+			attribute.String("messaging.destination.name", msg.Topic),
+			attribute.String("messaging.consumer.group.name", group),
+			attribute.String("messaging.destination.partition.id", strconv.Itoa(msg.Partition)),
+			attribute.Int64("messaging.kafka.offset", msg.Offset),
+			attribute.String("messaging.kafka.message.key", string(msg.Key)),
+		),
+	)
+}